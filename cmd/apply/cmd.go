@@ -0,0 +1,162 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apply
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/apply"
+	"github.com/openshift/rosa/pkg/clusterspec"
+	"github.com/openshift/rosa/pkg/dryrun"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "apply"
+	short = "Reconcile the resources of a cluster with a desired state file"
+	long  = "Reconcile the machine pools, node pools, identity providers, ingresses, kubelet configs, " +
+		"tuning configs, cluster autoscaler, external authentication providers and break glass credentials " +
+		"of a cluster with a desired state file.\n\n" +
+		"The file contains one or more YAML or JSON documents. Each document has an 'apiVersion', a 'kind', " +
+		"a 'metadata.name' and a 'spec' written in the same format as the output of " +
+		"'rosa describe <resource> --output json'. Only the fields present in the spec are compared and " +
+		"updated. The target cluster is taken from the '--cluster' flag or from the name in a " +
+		"'ClusterSpec' document. The other fields of the 'ClusterSpec' document aren't reconciled, " +
+		"the ones that differ from the cluster are reported as warnings."
+	example = `  # Show what would change on cluster "mycluster"
+  rosa apply -f mycluster.yaml --cluster=mycluster --dry-run
+
+  # Reconcile the cluster, deleting resources of the managed kinds that are not in the file
+  rosa apply -f mycluster.yaml --prune`
+
+	filenameFlag = "filename"
+	pruneFlag    = "prune"
+)
+
+type ApplyOptions struct {
+	Filename string
+	Prune    bool
+}
+
+func NewApplyCommand() *cobra.Command {
+	options := &ApplyOptions{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), ApplyRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(
+		&options.Filename,
+		filenameFlag,
+		"f",
+		"",
+		"Path to the desired state file.",
+	)
+	cmd.MarkFlagRequired(filenameFlag)
+	flags.BoolVar(
+		&options.Prune,
+		pruneFlag,
+		false,
+		"Delete resources of the kinds present in the file that are not defined in the file.",
+	)
+	ocm.AddOptionalClusterFlag(cmd)
+	confirm.AddFlag(flags)
+	return cmd
+}
+
+func ApplyRunner(options *ApplyOptions) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
+		state, err := apply.Load(options.Filename)
+		if err != nil {
+			return err
+		}
+
+		if !command.Flags().Changed("cluster") {
+			if state.Cluster == nil {
+				return fmt.Errorf("The target cluster must be set with '--cluster' or with a '%s' document",
+					clusterspec.Kind)
+			}
+			ocm.SetClusterKey(state.Cluster.Spec.Name)
+		}
+		clusterKey := r.GetClusterKey()
+		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+		if err != nil {
+			if state.Cluster != nil {
+				return fmt.Errorf("%v. Create it with 'rosa create cluster --from-file %s' first",
+					err, options.Filename)
+			}
+			return err
+		}
+
+		plan, err := apply.NewPlan(ctx, r.OCMClient, cluster.ID(), state.Resources, options.Prune)
+		if err != nil {
+			return err
+		}
+		if state.Cluster != nil {
+			warnings, err := apply.ClusterWarnings(state.Cluster, cluster)
+			if err != nil {
+				return err
+			}
+			plan.Warnings = append(warnings, plan.Warnings...)
+		}
+		for _, warning := range plan.Warnings {
+			r.Reporter.Warnf("%s", warning)
+		}
+		if len(plan.Changes) == 0 {
+			r.Reporter.Infof("Cluster '%s' is up to date", clusterKey)
+			return nil
+		}
+
+		plan.Print(os.Stdout)
+		// With the global '--dry-run' flag the changes are only printed:
+		if dryrun.Enabled() {
+			return nil
+		}
+
+		deletions := plan.Deletions()
+		if len(deletions) > 0 {
+			keys := []string{}
+			for _, change := range deletions {
+				keys = append(keys, change.Key())
+			}
+			if !confirm.Confirm("delete %s from cluster '%s'", strings.Join(keys, ", "), clusterKey) {
+				return nil
+			}
+		}
+
+		err = plan.Execute(ctx, r.OCMClient, cluster.ID(), func(change apply.Change) {
+			r.Reporter.Infof("Applied %s of %s", change.Action, change.Key())
+		})
+		if err != nil {
+			return err
+		}
+		r.Reporter.Infof("Successfully applied '%s' to cluster '%s'", options.Filename, clusterKey)
+		return nil
+	}
+}
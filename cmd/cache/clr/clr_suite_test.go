@@ -0,0 +1,13 @@
+package clr
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestClearCache(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Clear cache suite")
+}
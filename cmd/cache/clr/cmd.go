@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clr
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/cache"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "clear"
+	short = "Clear the cached OCM catalog data"
+	long  = "Remove the responses of OCM kept in the cache, for all the OCM URLs and organizations. " +
+		"The '--kind' flag removes only one kind of data."
+	example = `  # Clear the cached OCM catalog data
+  rosa cache clear
+
+  # Clear only the cached versions
+  rosa cache clear --kind versions`
+
+	kindFlag = "kind"
+)
+
+type Options struct {
+	path string
+	kind string
+}
+
+func NewClearCacheOptions() *Options {
+	return &Options{}
+}
+
+func NewClearCacheCommand() *cobra.Command {
+	options := NewClearCacheOptions()
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(nil, ClearCacheRunner(options)),
+	}
+	cmd.Flags().StringVar(
+		&options.kind,
+		kindFlag,
+		"",
+		fmt.Sprintf("Kind of data to remove, one of %s.", strings.Join(cache.CatalogKindNames(), ", ")),
+	)
+	return cmd
+}
+
+func ClearCacheRunner(options *Options) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
+		if options.kind != "" && !slices.Contains(cache.CatalogKindNames(), options.kind) {
+			return fmt.Errorf("Invalid value '%s' for '--%s', expected one of %s", options.kind, kindFlag,
+				strings.Join(cache.CatalogKindNames(), ", "))
+		}
+		path := options.path
+		if path == "" {
+			var err error
+			path, err = cache.DefaultCatalogPath()
+			if err != nil {
+				return err
+			}
+		}
+		catalog, err := cache.LoadCatalogCache(path)
+		if err != nil {
+			return err
+		}
+		removed, err := catalog.Clear(options.kind)
+		if err != nil {
+			return err
+		}
+		r.Reporter.Infof("Removed %d cached responses", removed)
+		return nil
+	}
+}
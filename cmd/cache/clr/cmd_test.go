@@ -0,0 +1,68 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clr
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift/rosa/pkg/cache"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Clear cache", func() {
+	var (
+		t       *TestingRuntime
+		options *Options
+	)
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+		options = NewClearCacheOptions()
+		options.path = filepath.Join(GinkgoT().TempDir(), cache.CatalogGobName)
+		catalog, err := cache.LoadCatalogCache(options.path)
+		Expect(err).NotTo(HaveOccurred())
+		for _, kind := range []string{"versions", "regions"} {
+			Expect(catalog.Set(kind, cache.CatalogEntry{Kind: kind}, time.Now().Add(time.Hour))).To(Succeed())
+		}
+	})
+
+	It("Clears one kind of data", func() {
+		options.kind = "versions"
+		err := ClearCacheRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		catalog, err := cache.LoadCatalogCache(options.path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(catalog.Items()).To(HaveLen(1))
+	})
+
+	It("Clears all the data", func() {
+		err := ClearCacheRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(options.path).NotTo(BeAnExistingFile())
+	})
+
+	It("Fails with an unknown kind", func() {
+		options.kind = "clusters"
+		err := ClearCacheRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).To(MatchError(ContainSubstring("Invalid value 'clusters' for '--kind'")))
+	})
+})
@@ -0,0 +1,39 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/cmd/cache/clr"
+	"github.com/openshift/rosa/cmd/cache/list"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Inspect and clear the cache of OCM catalog data",
+	Long: "Inspect and clear the persistent cache of OCM catalog data: versions, regions, machine types, " +
+		"STS policies, credential requests and add-ons. The cache is enabled by setting the ROSA_CACHE " +
+		"environment variable to true, and is kept separately for each OCM URL and organization. Use the " +
+		"'--no-cache' flag to skip it for a command, and '--refresh-cache' to fetch the data again.",
+	Args: cobra.NoArgs,
+}
+
+func init() {
+	Cmd.AddCommand(list.NewListCacheCommand())
+	Cmd.AddCommand(clr.NewClearCacheCommand())
+}
@@ -0,0 +1,112 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/cache"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use     = "list"
+	short   = "List the cached OCM catalog data"
+	long    = "List the responses of OCM kept in the cache, with the OCM URL and organization they belong to."
+	example = `  # List the cached OCM catalog data
+  rosa cache list`
+)
+
+type Options struct {
+	path string
+}
+
+func NewListCacheOptions() *Options {
+	return &Options{}
+}
+
+type entry struct {
+	URL          string    `json:"url"`
+	Organization string    `json:"organization"`
+	Kind         string    `json:"kind"`
+	Path         string    `json:"path"`
+	Size         int       `json:"size"`
+	Expiration   time.Time `json:"expiration"`
+}
+
+func NewListCacheCommand() *cobra.Command {
+	options := NewListCacheOptions()
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: []string{"ls"},
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(nil, ListCacheRunner(options)),
+	}
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func ListCacheRunner(options *Options) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
+		path := options.path
+		if path == "" {
+			var err error
+			path, err = cache.DefaultCatalogPath()
+			if err != nil {
+				return err
+			}
+		}
+		catalog, err := cache.LoadCatalogCache(path)
+		if err != nil {
+			return err
+		}
+
+		entries := make([]entry, 0)
+		for _, item := range catalog.Items() {
+			cached := item.Object.(cache.CatalogEntry)
+			entries = append(entries, entry{
+				URL:          cached.URL,
+				Organization: cached.Organization,
+				Kind:         cached.Kind,
+				Path:         cached.Path,
+				Size:         len(cached.Body),
+				Expiration:   item.Expiration,
+			})
+		}
+		if output.HasFlag() {
+			return output.Print(entries)
+		}
+		if len(entries) == 0 {
+			r.Reporter.Infof("There is no cached OCM catalog data. Set the %s environment variable to true "+
+				"to enable the cache", cache.EnvVar)
+			return nil
+		}
+		table := output.NewTable("OCM URL", "ORGANIZATION", "KIND", "PATH", "SIZE", "EXPIRES")
+		for _, entry := range entries {
+			table.AddRow(entry.URL, entry.Organization, entry.Kind, entry.Path, entry.Size,
+				entry.Expiration.Local().Format(time.RFC3339))
+		}
+		return table.Print()
+	}
+}
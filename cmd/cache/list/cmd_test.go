@@ -0,0 +1,73 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift/rosa/pkg/cache"
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("List cache", func() {
+	var (
+		t       *TestingRuntime
+		options *Options
+	)
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+		options = NewListCacheOptions()
+		options.path = filepath.Join(GinkgoT().TempDir(), cache.CatalogGobName)
+		output.SetOutput("")
+	})
+
+	AfterEach(func() {
+		output.SetOutput("")
+	})
+
+	It("Lists the cached responses", func() {
+		catalog, err := cache.LoadCatalogCache(options.path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(catalog.Set("key", cache.CatalogEntry{
+			URL:          "https://api.openshift.com",
+			Organization: "123",
+			Kind:         "versions",
+			Path:         "/api/clusters_mgmt/v1/versions",
+			Body:         []byte("{}"),
+		}, time.Now().Add(time.Hour))).To(Succeed())
+
+		t.StdOutReader.Record()
+		err = ListCacheRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		stdout, err := t.StdOutReader.Read()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stdout).To(ContainSubstring("OCM URL"))
+		Expect(stdout).To(MatchRegexp(`https://api.openshift.com\s+123\s+versions\s+/api/clusters_mgmt/v1/versions\s+2`))
+	})
+
+	It("Succeeds without a cache", func() {
+		err := ListCacheRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
@@ -23,7 +23,9 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/cmd/config/get"
+	"github.com/openshift/rosa/cmd/config/getcontexts"
 	"github.com/openshift/rosa/cmd/config/set"
+	"github.com/openshift/rosa/cmd/config/usecontext"
 	"github.com/openshift/rosa/pkg/config"
 	"github.com/openshift/rosa/pkg/properties"
 )
@@ -54,6 +56,10 @@ following backends are supported for the keyring:
 - Windows: wincred
 
 Available Keyrings on your OS: %s
+
+Credentials for several environments or organizations can be kept side by side as named login contexts.
+Use 'rosa login --context NAME' to create a context, 'rosa config use-context NAME' to switch to it and
+the '--context' flag to use a context for a single command.
 `, loc, strings.Join(config.ConfigVarDocs(), "\n"), properties.KeyringEnvKey, strings.Join(config.GetKeyrings(), ", "))
 }
 
@@ -66,6 +72,8 @@ func NewConfigCommand() *cobra.Command {
 	}
 	Cmd.AddCommand(get.Cmd)
 	Cmd.AddCommand(set.Cmd)
+	Cmd.AddCommand(usecontext.Cmd)
+	Cmd.AddCommand(getcontexts.Cmd)
 	return Cmd
 }
 
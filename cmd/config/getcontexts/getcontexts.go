@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package getcontexts
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/config"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+var (
+	Writer io.Writer = os.Stdout
+)
+
+var Cmd = NewConfigGetContextsCommand()
+
+func NewConfigGetContextsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-contexts",
+		Short: "Lists the login contexts",
+		Long:  "Lists the login contexts saved in the configuration. The current context is marked with '*'.",
+		Example: `  # List the login contexts
+  rosa config get-contexts`,
+		Args: cobra.NoArgs,
+		Run:  run,
+	}
+	output.AddNoHeadersFlag(cmd)
+	return cmd
+}
+
+func run(_ *cobra.Command, _ []string) {
+	r := rosa.NewRuntime()
+
+	err := PrintContexts()
+	if err != nil {
+		r.Reporter.Errorf(err.Error())
+		reporter.Exit(1)
+	}
+}
+
+func PrintContexts() error {
+	current, contexts, err := config.GetContexts()
+	if err != nil {
+		return fmt.Errorf("can't load config: %v", err)
+	}
+	if len(contexts) == 0 {
+		return fmt.Errorf("There are no login contexts, run 'rosa login --context NAME' to create one")
+	}
+
+	table := output.NewTable("CURRENT", "NAME", "URL", "USERNAME")
+	for _, name := range config.ContextNames(contexts) {
+		cfg := contexts[name]
+		marker := ""
+		if name == current {
+			marker = "*"
+		}
+		username, err := cfg.GetData("preferred_username")
+		if err != nil {
+			username, _ = cfg.GetData("username")
+		}
+		table.AddRow(marker, name, cfg.URL, username)
+	}
+	return table.Write(Writer)
+}
@@ -18,13 +18,13 @@ package set
 
 import (
 	"fmt"
-	"os"
 	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/config"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -47,7 +47,7 @@ func run(_ *cobra.Command, argv []string) {
 	err := SaveConfig(argv[0], argv[1])
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 }
 
@@ -0,0 +1,51 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usecontext
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/config"
+	"github.com/openshift/rosa/pkg/reporter"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+var Cmd = NewConfigUseContextCommand()
+
+func NewConfigUseContextCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "use-context [flags] NAME",
+		Short: "Sets the current login context",
+		Long: "Sets the current login context. Contexts are created with 'rosa login --context NAME' and " +
+			"the current context is used by every command that doesn't have the '--context' flag.",
+		Example: `  # Use the credentials of the "staging" context by default
+  rosa config use-context staging`,
+		Args: cobra.ExactArgs(1),
+		Run:  run,
+	}
+}
+
+func run(_ *cobra.Command, argv []string) {
+	r := rosa.NewRuntime()
+
+	err := config.UseContext(argv[0])
+	if err != nil {
+		r.Reporter.Errorf("Failed to use context: %v", err)
+		reporter.Exit(1)
+	}
+	r.Reporter.Infof("Switched to context '%s'", argv[0])
+}
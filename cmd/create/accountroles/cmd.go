@@ -17,7 +17,7 @@ limitations under the License.
 package accountroles
 
 import (
-	"os"
+	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -26,9 +26,11 @@ import (
 	"github.com/openshift/rosa/cmd/verify/oc"
 	"github.com/openshift/rosa/cmd/verify/quota"
 	"github.com/openshift/rosa/pkg/aws"
+	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -134,6 +136,7 @@ func init() {
 	)
 
 	interactive.AddModeFlag(Cmd)
+	interactive.AddManualFormatFlag(Cmd)
 
 	confirm.AddFlag(flags)
 	interactive.AddFlag(flags)
@@ -145,7 +148,7 @@ func run(cmd *cobra.Command, argv []string) {
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// If necessary, call `login` as part of `init`. We do this before
@@ -154,7 +157,7 @@ func run(cmd *cobra.Command, argv []string) {
 	err = login.Call(cmd, argv, r.Reporter)
 	if err != nil {
 		r.Reporter.Errorf("Failed to login to OCM: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	r.WithOCM()
 	defer r.Cleanup()
@@ -162,13 +165,13 @@ func run(cmd *cobra.Command, argv []string) {
 	env, err := ocm.GetEnv()
 	if err != nil {
 		r.Reporter.Errorf("Failed to determine OCM environment: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	managedPolicies := args.managed
 	if args.forcePolicyCreation && managedPolicies {
 		r.Reporter.Warnf("Forcing creation of policies only works for unmanaged policies")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if args.hostedCP && cmd.Flags().Changed("version") {
@@ -191,18 +194,18 @@ func run(cmd *cobra.Command, argv []string) {
 			managedPolicies = false
 		} else {
 			r.Reporter.Errorf("Setting `hosted-cp` as unmanaged policies is not supported")
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
 	if isManagedSet && env == ocm.Production {
 		r.Reporter.Errorf("Classic ROSA managed policies are not supported in this environment")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if isHostedCPValueSet && r.Creator.IsGovcloud {
 		r.Reporter.Errorf("Setting `hosted-cp` is not supported for Govcloud AWS accounts")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Validate AWS credentials for current user
@@ -213,12 +216,12 @@ func run(cmd *cobra.Command, argv []string) {
 	if err != nil {
 		r.OCMClient.LogEvent("ROSAInitCredentialsFailed", nil)
 		r.Reporter.Errorf("Error validating AWS credentials: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if !ok {
 		r.OCMClient.LogEvent("ROSAInitCredentialsInvalid", nil)
 		r.Reporter.Errorf("AWS credentials are invalid")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if r.Reporter.IsTerminal() {
 		r.Reporter.Infof("AWS credentials are valid!")
@@ -244,7 +247,7 @@ func run(cmd *cobra.Command, argv []string) {
 	policyVersion, err := r.OCMClient.GetPolicyVersion(version, channelGroup)
 	if err != nil {
 		r.Reporter.Errorf("Error getting version: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	r.Reporter.Debugf("Creating account roles compatible with OpenShift versions up to %s", policyVersion)
@@ -263,20 +266,20 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid role prefix: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	if len(prefix) > 32 {
 		r.Reporter.Errorf("Expected a prefix with no more than 32 characters")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if !aws.RoleNameRE.MatchString(prefix) {
 		r.Reporter.Errorf("Expected a valid role prefix matching %s", aws.RoleNameRE.String())
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if !args.hostedCP && strings.HasSuffix(prefix, "-HCP") {
 		r.Reporter.Errorf("The '-HCP' suffix is reserved for hosted CP managed policies")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	permissionsBoundary := args.permissionsBoundary
@@ -291,7 +294,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid policy ARN for permissions boundary: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -299,7 +302,7 @@ func run(cmd *cobra.Command, argv []string) {
 		err = aws.ARNValidator(permissionsBoundary)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid policy ARN for permissions boundary: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -315,33 +318,39 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid path: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
 	if path != "" && !aws.ARNPath.MatchString(path) {
 		r.Reporter.Errorf("The specified value for path is invalid. " +
 			"It must begin and end with '/' and contain only alphanumeric characters and/or '/' characters.")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if interactive.Enabled() {
 		mode, err = interactive.GetOptionMode(cmd, mode, "Role creation mode")
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
+	manualFormat, err := interactive.GetManualFormat(mode)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		reporter.Exit(1)
+	}
+
 	if args.forcePolicyCreation && mode != interactive.ModeAuto {
 		r.Reporter.Warnf("Forcing creation of policies only works in auto mode")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	policies, err := r.OCMClient.GetPolicies("AccountRole")
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	createClassic := args.classic
@@ -356,7 +365,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		isClassicValueSet = true
 	}
@@ -371,7 +380,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		isHostedCPValueSet = true
 	}
@@ -379,7 +388,7 @@ func run(cmd *cobra.Command, argv []string) {
 	rolesCreator, createRoles := initCreator(r, managedPolicies, createClassic, createHostedCP,
 		isClassicValueSet, isHostedCPValueSet)
 	if !createRoles {
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	input := buildRolesCreationInput(prefix, permissionsBoundary, r.Creator.AccountID, env, policies,
@@ -396,12 +405,12 @@ func run(cmd *cobra.Command, argv []string) {
 					ocm.Version:    policyVersion,
 					ocm.IsThrottle: "true",
 				})
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			r.OCMClient.LogEvent("ROSACreateAccountRolesModeAuto", map[string]string{
 				ocm.Response: ocm.Failure,
 			})
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		r.OCMClient.LogEvent("ROSACreateAccountRolesModeAuto", map[string]string{
 			ocm.Response: ocm.Success,
@@ -415,12 +424,12 @@ func run(cmd *cobra.Command, argv []string) {
 			r.OCMClient.LogEvent("ROSACreateAccountRolesModeManual", map[string]string{
 				ocm.Response: ocm.Failure,
 			})
-			os.Exit(1)
+			reporter.Exit(1)
 		}
-		err = rolesCreator.printCommands(r, input)
+		err = printCommands(r, rolesCreator, input, manualFormat)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		if r.Reporter.IsTerminal() {
 			r.Reporter.Infof("All policy files saved to the current directory")
@@ -430,6 +439,23 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		reporter.Exit(1)
+	}
+}
+
+func printCommands(r *rosa.Runtime, rolesCreator creator, input *accountRolesCreationInput,
+	format awscb.Format) error {
+	if format == awscb.FormatShell {
+		return rolesCreator.printCommands(r, input)
+	}
+	commands, err := rolesCreator.buildCommands(r, input)
+	if err != nil {
+		return err
+	}
+	output, err := awscb.RenderCommands(format, commands)
+	if err != nil {
+		return err
 	}
+	fmt.Println(output)
+	return nil
 }
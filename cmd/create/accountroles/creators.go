@@ -15,6 +15,7 @@ import (
 type creator interface {
 	createRoles(*rosa.Runtime, *accountRolesCreationInput) error
 	getRoleTags(string, *accountRolesCreationInput) map[string]string
+	buildCommands(*rosa.Runtime, *accountRolesCreationInput) ([]*awscb.Operation, error)
 	printCommands(*rosa.Runtime, *accountRolesCreationInput) error
 	skipPermissionFiles() bool
 	getAccountRolesMap() map[string]aws.AccountRole
@@ -124,8 +125,8 @@ func attachManagedPolicies(r *rosa.Runtime, input *accountRolesCreationInput, ro
 	return nil
 }
 
-func (mp *managedPoliciesCreator) printCommands(r *rosa.Runtime, input *accountRolesCreationInput) error {
-	commands := []string{}
+func (mp *managedPoliciesCreator) buildCommands(r *rosa.Runtime, input *accountRolesCreationInput) ([]*awscb.Operation, error) {
+	commands := []*awscb.Operation{}
 	for file, role := range aws.AccountRoles {
 		accRoleName := common.GetRoleName(input.prefix, role.Name)
 		iamTags := mp.getRoleTags(file, input)
@@ -137,7 +138,7 @@ func (mp *managedPoliciesCreator) printCommands(r *rosa.Runtime, input *accountR
 		for _, policyKey := range policyKeys {
 			policyARN, err := aws.GetManagedPolicyARN(input.policies, policyKey)
 			if err != nil {
-				return err
+				return nil, err
 			}
 
 			attachRolePolicy := buildAttachRolePolicyCommand(accRoleName, policyARN)
@@ -145,8 +146,17 @@ func (mp *managedPoliciesCreator) printCommands(r *rosa.Runtime, input *accountR
 		}
 	}
 
+	return commands, nil
+}
+
+func (mp *managedPoliciesCreator) printCommands(r *rosa.Runtime, input *accountRolesCreationInput) error {
+	commands, err := mp.buildCommands(r, input)
+	if err != nil {
+		return err
+	}
+
 	r.Reporter.Infof("Run the following commands to create the classic account roles and policies:\n")
-	fmt.Println(awscb.JoinCommands(commands) + "\n")
+	fmt.Println(awscb.JoinOperations(commands) + "\n")
 
 	return nil
 }
@@ -186,8 +196,8 @@ func (up *unmanagedPoliciesCreator) createRoles(r *rosa.Runtime, input *accountR
 	return nil
 }
 
-func (up *unmanagedPoliciesCreator) printCommands(r *rosa.Runtime, input *accountRolesCreationInput) error {
-	commands := []string{}
+func (up *unmanagedPoliciesCreator) buildCommands(r *rosa.Runtime, input *accountRolesCreationInput) ([]*awscb.Operation, error) {
+	commands := []*awscb.Operation{}
 	for file, role := range aws.AccountRoles {
 		accRoleName := common.GetRoleName(input.prefix, role.Name)
 		iamTags := up.getRoleTags(file, input)
@@ -206,8 +216,17 @@ func (up *unmanagedPoliciesCreator) printCommands(r *rosa.Runtime, input *accoun
 		commands = append(commands, createRole, createPolicy, attachRolePolicy)
 	}
 
+	return commands, nil
+}
+
+func (up *unmanagedPoliciesCreator) printCommands(r *rosa.Runtime, input *accountRolesCreationInput) error {
+	commands, err := up.buildCommands(r, input)
+	if err != nil {
+		return err
+	}
+
 	r.Reporter.Infof("Run the following commands to create the classic account roles and policies:\n")
-	fmt.Println(awscb.JoinCommands(commands) + "\n")
+	fmt.Println(awscb.JoinOperations(commands) + "\n")
 
 	return nil
 }
@@ -239,6 +258,21 @@ func (db *doubleRolesCreator) createRoles(r *rosa.Runtime, input *accountRolesCr
 	return hcpCreator.createRoles(r, input)
 }
 
+func (db *doubleRolesCreator) buildCommands(r *rosa.Runtime, input *accountRolesCreationInput) ([]*awscb.Operation, error) {
+	unmanagedCreator := unmanagedPoliciesCreator{}
+	commands, err := unmanagedCreator.buildCommands(r, input)
+	if err != nil {
+		return nil, err
+	}
+
+	hcpCreator := hcpManagedPoliciesCreator{}
+	hcpCommands, err := hcpCreator.buildCommands(r, input)
+	if err != nil {
+		return nil, err
+	}
+	return append(commands, hcpCommands...), nil
+}
+
 func (db *doubleRolesCreator) printCommands(r *rosa.Runtime, input *accountRolesCreationInput) error {
 	// Build classic account roles command
 	unmanagedCreator := unmanagedPoliciesCreator{}
@@ -338,8 +372,9 @@ func (hcp *hcpManagedPoliciesCreator) createRoles(r *rosa.Runtime, input *accoun
 	return nil
 }
 
-func (hcp *hcpManagedPoliciesCreator) printCommands(r *rosa.Runtime, input *accountRolesCreationInput) error {
-	commands := []string{}
+func (hcp *hcpManagedPoliciesCreator) buildCommands(r *rosa.Runtime,
+	input *accountRolesCreationInput) ([]*awscb.Operation, error) {
+	commands := []*awscb.Operation{}
 	for file, role := range aws.HCPAccountRoles {
 		accRoleName := common.GetRoleName(input.prefix, role.Name)
 		iamTags := hcp.getRoleTags(file, input)
@@ -349,15 +384,24 @@ func (hcp *hcpManagedPoliciesCreator) printCommands(r *rosa.Runtime, input *acco
 		policyKey := fmt.Sprintf("sts_hcp_%s_permission_policy", file)
 		policyARN, err := aws.GetManagedPolicyARN(input.policies, policyKey)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		attachRolePolicy := buildAttachRolePolicyCommand(accRoleName, policyARN)
 		commands = append(commands, createRole, attachRolePolicy)
 	}
 
+	return commands, nil
+}
+
+func (hcp *hcpManagedPoliciesCreator) printCommands(r *rosa.Runtime, input *accountRolesCreationInput) error {
+	commands, err := hcp.buildCommands(r, input)
+	if err != nil {
+		return err
+	}
+
 	r.Reporter.Infof("Run the following commands to create the hosted CP account roles and policies:\n")
-	fmt.Println(awscb.JoinCommands(commands) + "\n")
+	fmt.Println(awscb.JoinOperations(commands) + "\n")
 
 	return nil
 }
@@ -388,7 +432,7 @@ func getBaseRoleTags(roleType string, input *accountRolesCreationInput) map[stri
 }
 
 func buildCreateRoleCommand(accRoleName string, file string, iamTags map[string]string,
-	input *accountRolesCreationInput) string {
+	input *accountRolesCreationInput) *awscb.Operation {
 	return awscb.NewIAMCommandBuilder().
 		SetCommand(awscb.CreateRole).
 		AddParam(awscb.RoleName, accRoleName).
@@ -396,23 +440,24 @@ func buildCreateRoleCommand(accRoleName string, file string, iamTags map[string]
 		AddParam(awscb.PermissionsBoundary, input.permissionsBoundary).
 		AddTags(iamTags).
 		AddParam(awscb.Path, input.path).
-		Build()
+		BuildOperation()
 }
 
-func buildCreatePolicyCommand(policyName string, policyDocument string, iamTags map[string]string, path string) string {
+func buildCreatePolicyCommand(policyName string, policyDocument string, iamTags map[string]string,
+	path string) *awscb.Operation {
 	return awscb.NewIAMCommandBuilder().
 		SetCommand(awscb.CreatePolicy).
 		AddParam(awscb.PolicyName, policyName).
 		AddParam(awscb.PolicyDocument, policyDocument).
 		AddTags(iamTags).
 		AddParam(awscb.Path, path).
-		Build()
+		BuildOperation()
 }
 
-func buildAttachRolePolicyCommand(accRoleName string, policyARN string) string {
+func buildAttachRolePolicyCommand(accRoleName string, policyARN string) *awscb.Operation {
 	return awscb.NewIAMCommandBuilder().
 		SetCommand(awscb.AttachRolePolicy).
 		AddParam(awscb.RoleName, accRoleName).
 		AddParam(awscb.PolicyArn, policyARN).
-		Build()
+		BuildOperation()
 }
@@ -18,7 +18,6 @@ package admin
 
 import (
 	"fmt"
-	"os"
 
 	idputils "github.com/openshift-online/ocm-common/pkg/idp/utils"
 	passwordValidator "github.com/openshift-online/ocm-common/pkg/idp/validations"
@@ -28,6 +27,7 @@ import (
 	"github.com/openshift/rosa/pkg/object"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -74,24 +74,24 @@ func run(_ *cobra.Command, _ []string) {
 	cluster := r.FetchCluster()
 	if cluster.State() != cmv1.ClusterStateReady {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if cluster.ExternalAuthConfig().Enabled() {
 		r.Reporter.Errorf(
 			"Creating the 'cluster-admin' user is not supported for clusters with external authentication configured.")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	adminUser, err := r.OCMClient.GetUser(cluster.ID(), ClusterAdminGroupname, ClusterAdminUsername)
 	if err != nil {
 		r.Reporter.Errorf("Failed to get user '%s' in 'cluster-admins' group for cluster '%s'",
 			ClusterAdminUsername, clusterKey)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if adminUser != nil {
 		r.Reporter.Errorf("Cluster '%s' already has '%s' user", clusterKey, ClusterAdminUsername)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// No cluster admin yet: proceed to create it.
@@ -102,7 +102,7 @@ func run(_ *cobra.Command, _ []string) {
 		password, err = idputils.GenerateRandomPassword()
 		if err != nil {
 			r.Reporter.Errorf("Failed to generate a random password")
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	} else {
 		password = passwordArg
@@ -113,7 +113,7 @@ func run(_ *cobra.Command, _ []string) {
 	err = passwordValidator.PasswordValidator(password)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Add admin user to the cluster-admins group:
@@ -121,20 +121,20 @@ func run(_ *cobra.Command, _ []string) {
 	user, err := cmv1.NewUser().ID(ClusterAdminUsername).Build()
 	if err != nil {
 		r.Reporter.Errorf("Failed to create user '%s' for cluster '%s'", ClusterAdminUsername, clusterKey)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	_, err = r.OCMClient.CreateUser(cluster.ID(), ClusterAdminGroupname, user)
 	if err != nil {
 		r.Reporter.Errorf("Failed to add user '%s' to cluster '%s': %s",
 			ClusterAdminUsername, clusterKey, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	existingIdp, err := FindClusterAdminIDP(cluster, r)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if existingIdp == nil {
 		// No ClusterAdmin IDP exists, create an Htpasswd IDP
@@ -158,7 +158,7 @@ func run(_ *cobra.Command, _ []string) {
 				ClusterAdminIDPname,
 				clusterKey,
 			)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		// Add HTPasswd IDP to cluster:
@@ -181,7 +181,7 @@ func run(_ *cobra.Command, _ []string) {
 			r.Reporter.Errorf("Failed to revert the admin user for cluster '%s'. Please try again: %s",
 				clusterKey, err)
 		}
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	outputObject := object.Object{
@@ -197,7 +197,7 @@ func run(_ *cobra.Command, _ []string) {
 		err = output.Print(outputObject)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		return
 	}
@@ -248,7 +248,7 @@ func FindIDPWithAdmin(cluster *cmv1.Cluster, r *rosa.Runtime) (
 			r.Reporter.Debugf("user list %s: %v", item.Name(), itemUserList)
 			if err != nil {
 				r.Reporter.Errorf("Failed to get user list of the HTPasswd IDP of '%s: %s': %v", item.Name(), r.ClusterKey, err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			if HasClusterAdmin(itemUserList) {
 				return item, itemUserList, nil
@@ -2,19 +2,24 @@ package breakglasscredential
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/breakglasscredential"
 	"github.com/openshift/rosa/pkg/externalauthprovider"
 	"github.com/openshift/rosa/pkg/interactive"
+	"github.com/openshift/rosa/pkg/kubeconfig"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
 var breakGlassCredentialArgs *breakglasscredential.BreakGlassCredentialArgs
 
+const writeKubeconfigFlag = "write-kubeconfig"
+
+var writeKubeconfig string
+
 var Cmd = makeCmd()
 
 func makeCmd() *cobra.Command {
@@ -24,7 +29,10 @@ func makeCmd() *cobra.Command {
 		Short:   "Create a break glass credential for a cluster.",
 		Long:    "Create a break glass credential for a hosted control plane cluster with external authentication enabled.",
 		Example: `  # Interactively create a break glass credential to a cluster named "mycluster"
-  rosa create break-glass-credential --cluster=mycluster --interactive`,
+  rosa create break-glass-credential --cluster=mycluster --interactive
+
+  # Create a break glass credential and add it as a context of the kubeconfig
+  rosa create break-glass-credential --cluster=mycluster --write-kubeconfig`,
 		Run:  run,
 		Args: cobra.NoArgs,
 	}
@@ -34,6 +42,14 @@ func init() {
 	ocm.AddClusterFlag(Cmd)
 	interactive.AddFlag(Cmd.Flags())
 	breakGlassCredentialArgs = breakglasscredential.AddBreakGlassCredentialFlags(Cmd)
+	Cmd.Flags().StringVar(
+		&writeKubeconfig,
+		writeKubeconfigFlag,
+		"",
+		"Add the credential as a context of the kubeconfig file at this path instead of printing it. "+
+			"Without a path the file is the one kubectl uses: the first file of KUBECONFIG or ~/.kube/config.",
+	)
+	Cmd.Flags().Lookup(writeKubeconfigFlag).NoOptDefVal = kubeconfig.DefaultPath
 }
 
 func run(cmd *cobra.Command, argv []string) {
@@ -42,7 +58,7 @@ func run(cmd *cobra.Command, argv []string) {
 	err := runWithRuntime(r, cmd, argv)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 }
 
@@ -81,6 +97,16 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
 
 	r.Reporter.Infof("Successfully created a break glass credential for cluster '%s'.",
 		clusterKey)
+	if cmd.Flags().Changed(writeKubeconfigFlag) {
+		context, path, err := breakglasscredential.WriteKubeconfig(
+			writeKubeconfig, r.OCMClient.GetConnectionURL(), cluster, credentialResponse, kubeconfig, false)
+		if err != nil {
+			return err
+		}
+		r.Reporter.Infof("Added context '%s' to kubeconfig '%s'. To use it run 'kubectl config use-context %s' "+
+			"or 'rosa use break-glass-credential %s -c %s'", context, path, context, credentialResponse.ID(), clusterKey)
+		return nil
+	}
 	r.Reporter.Infof(
 		"To retrieve only the kubeconfig for this credential "+
 			"use: 'rosa describe break-glass-credential %s -c %s --kubeconfig'",
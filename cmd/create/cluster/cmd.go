@@ -49,6 +49,8 @@ import (
 	"github.com/openshift/rosa/pkg/aws/tags"
 	"github.com/openshift/rosa/pkg/clusterautoscaler"
 	"github.com/openshift/rosa/pkg/clusterregistryconfig"
+	"github.com/openshift/rosa/pkg/clusterspec"
+	"github.com/openshift/rosa/pkg/dryrun"
 	"github.com/openshift/rosa/pkg/fedramp"
 	"github.com/openshift/rosa/pkg/helper"
 	mpHelpers "github.com/openshift/rosa/pkg/helper/machinepools"
@@ -93,6 +95,9 @@ const (
 )
 
 var args struct {
+	// Load cluster configuration from a spec file
+	fromFile string
+
 	// Watch logs during cluster installation
 	watch bool
 
@@ -247,7 +252,10 @@ func makeCmd() *cobra.Command {
   rosa create cluster --cluster-name=mycluster
 
   # Create a cluster in the us-east-2 region
-  rosa create cluster --cluster-name=mycluster --region=us-east-2`,
+  rosa create cluster --cluster-name=mycluster --region=us-east-2
+
+  # Create a cluster from a spec file, overriding the name from the file
+  rosa create cluster --from-file=cluster.yaml --cluster-name=mycluster-2`,
 		Run:  run,
 		Args: cobra.NoArgs,
 	}
@@ -261,6 +269,14 @@ func initFlags(cmd *cobra.Command) {
 	flags := cmd.Flags()
 	flags.SortFlags = false
 
+	flags.StringVar(
+		&args.fromFile,
+		clusterspec.FromFileFlag,
+		"",
+		"Path to a YAML or JSON cluster spec file, as produced by 'rosa describe cluster --output spec'. "+
+			"Flags given on the command line take precedence over the values in the file.",
+	)
+
 	// Basic options
 	flags.StringVarP(
 		&args.clusterName,
@@ -674,13 +690,6 @@ func initFlags(cmd *cobra.Command) {
 		"Watch cluster installation logs.",
 	)
 
-	flags.BoolVar(
-		&args.dryRun,
-		"dry-run",
-		false,
-		"Simulate creating the cluster.",
-	)
-
 	flags.BoolVar(
 		&args.fakeCluster,
 		"fake-cluster",
@@ -863,21 +872,42 @@ func networkTypeCompletion(cmd *cobra.Command, args []string, toComplete string)
 }
 
 func run(cmd *cobra.Command, _ []string) {
-	r := rosa.NewRuntime().WithAWS().WithOCM()
+	r := rosa.NewRuntime()
+
+	// The global '--dry-run' flag also asks OCM to validate the cluster instead of creating it:
+	args.dryRun = dryrun.Enabled()
+
+	// The spec file has to be applied before the clients are created so that values
+	// such as the region are taken into account
+	if args.fromFile != "" {
+		spec, err := clusterspec.Load(args.fromFile)
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			reporter.Exit(1)
+		}
+		err = spec.Apply(cmd.Flags())
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			reporter.Exit(1)
+		}
+		r.Reporter.Debugf("Loaded cluster spec from '%s'", args.fromFile)
+	}
+
+	r.WithAWS().WithOCM()
 	defer r.Cleanup()
 
 	// Validate mode
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	for _, val := range userSpecifiedAutoscalerValues {
 		if val.Changed && !args.autoscalingEnabled {
 			r.Reporter.Errorf("Using autoscaling flag '%s', requires flag '--enable-autoscaling'. "+
 				"Please try again with flag", val.Name)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -885,7 +915,7 @@ func run(cmd *cobra.Command, _ []string) {
 	isHostedCP := args.hostedClusterEnabled
 	if isHostedCP && fedramp.Enabled() {
 		r.Reporter.Errorf("Fedramp does not currently support Hosted Control Plane clusters. Please use classic")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	supportedRegions, err := r.OCMClient.GetDatabaseRegionList()
@@ -898,7 +928,7 @@ func run(cmd *cobra.Command, _ []string) {
 	awsCreator, err := awsClient.GetCreator()
 	if err != nil {
 		r.Reporter.Errorf("Unable to get IAM credentials: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	shardPinningEnabled := false
@@ -948,7 +978,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid cluster name: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -959,7 +989,7 @@ func run(cmd *cobra.Command, _ []string) {
 		r.Reporter.Errorf("Cluster name must consist"+
 			" of no more than %d lowercase alphanumeric characters or '-', "+
 			"start with a letter, and end with an alphanumeric character.", ocm.MaxClusterNameLength)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Get cluster domain prefix
@@ -977,7 +1007,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid domain prefix: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -988,7 +1018,7 @@ func run(cmd *cobra.Command, _ []string) {
 		r.Reporter.Errorf("Domain prefix must consist"+
 			" of no more than %d lowercase alphanumeric characters or '-', "+
 			"start with a letter, and end with an alphanumeric character.", ocm.MaxClusterDomainPrefixLength)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if clusterHasLongNameWithoutDomainPrefix(clusterName, domainPrefix) {
@@ -999,7 +1029,7 @@ func run(cmd *cobra.Command, _ []string) {
 			r.Reporter.Warnf("You opted out from creating a cluster with an autogenerated " +
 				"sub-domain for your cluster on openshiftapps.com. To customise the sub-domain" +
 				", use the '--domain-prefix' flag")
-			os.Exit(0)
+			reporter.Exit(0)
 		}
 	}
 
@@ -1012,7 +1042,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid --hosted-cp value: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -1020,7 +1050,7 @@ func run(cmd *cobra.Command, _ []string) {
 		techPreviewMsg, err := r.OCMClient.GetTechnologyPreviewMessage(ocm.HcpProduct, time.Now())
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		if techPreviewMsg != "" {
 			r.Reporter.Infof(techPreviewMsg)
@@ -1041,20 +1071,20 @@ func run(cmd *cobra.Command, _ []string) {
 			clusterAdminPassword, err = idputils.GenerateRandomPassword()
 			if err != nil {
 				r.Reporter.Errorf("Failed to generate a random password")
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		}
 		// validates both user inputted custom password and randomly generated password
 		err = passwordValidator.PasswordValidator(clusterAdminPassword)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		if clusterAdminUser != "" {
 			err = idp.UsernameValidator(clusterAdminUser)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		} else {
 			clusterAdminUser = admin.ClusterAdminUsername
@@ -1070,7 +1100,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		if isClusterAdmin {
 			//clusterAdminUser = idp.GetIdpUserNameFromPrompt(cmd, r, "cluster-admin-user", clusterAdminUser, true)
@@ -1081,13 +1111,13 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid value: %s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			if !isCustomAdminPassword {
 				clusterAdminPassword, err = idputils.GenerateRandomPassword()
 				if err != nil {
 					r.Reporter.Errorf("Failed to generate a random password")
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 			} else {
 				clusterAdminPassword = idp.GetIdpPasswordFromPrompt(cmd, r,
@@ -1100,7 +1130,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if isHostedCP && cmd.Flags().Changed(arguments.NewDefaultMPLabelsFlag) {
 		r.Reporter.Errorf("Setting the worker machine pool labels is not supported for hosted clusters")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Billing Account
@@ -1109,7 +1139,7 @@ func run(cmd *cobra.Command, _ []string) {
 		isHcpBillingTechPreview, err := r.OCMClient.IsTechnologyPreview(ocm.HcpBillingAccount, time.Now())
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		if !isHcpBillingTechPreview {
@@ -1117,13 +1147,13 @@ func run(cmd *cobra.Command, _ []string) {
 			if billingAccount != "" && !ocm.IsValidAWSAccount(billingAccount) {
 				r.Reporter.Errorf("Billing account is invalid. Run the command again with a valid billing account. %s",
 					listBillingAccountMessage)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 
 			cloudAccounts, err := r.OCMClient.GetBillingAccounts()
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 
 			billingAccounts := ocm.GenerateBillingAccountsList(cloudAccounts)
@@ -1133,7 +1163,7 @@ func run(cmd *cobra.Command, _ []string) {
 				billingAccount, err = provideBillingAccount(billingAccounts, awsCreator.AccountID, r)
 				if err != nil {
 					r.Reporter.Errorf("%s", err)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 			}
 
@@ -1149,7 +1179,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 					if err != nil {
 						r.Reporter.Errorf("Expected a valid billing account: '%s'", err)
-						os.Exit(1)
+						reporter.Exit(1)
 					}
 
 					billingAccount = aws.ParseOption(billingAccount)
@@ -1158,7 +1188,7 @@ func run(cmd *cobra.Command, _ []string) {
 				err := validateBillingAccount(billingAccount)
 				if err != nil {
 					r.Reporter.Errorf("%v", err)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 
 				// Get contract info
@@ -1186,7 +1216,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if !isHostedCP && billingAccount != "" {
 		r.Reporter.Errorf("Billing accounts are only supported for Hosted Control Plane clusters")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	externalAuthProvidersEnabled := args.externalAuthProvidersEnabled
@@ -1195,7 +1225,7 @@ func run(cmd *cobra.Command, _ []string) {
 			r.Reporter.Errorf(
 				"External authentication configuration is only supported for a Hosted Control Plane cluster.",
 			)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -1203,7 +1233,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if etcdEncryptionKmsARN != "" && !isHostedCP {
 		r.Reporter.Errorf("etcd encryption kms arn is only allowed for hosted cp")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// all hosted clusters are sts
@@ -1212,7 +1242,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if isSTS && isIAM {
 		r.Reporter.Errorf("Can't use both STS and mint mode at the same time.")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if interactive.Enabled() && (!isSTS && !isIAM) {
@@ -1224,7 +1254,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid --sts value: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		isIAM = !isSTS
 	}
@@ -1242,7 +1272,7 @@ func run(cmd *cobra.Command, _ []string) {
 		err = aws.ARNValidator(permissionsBoundary)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid policy ARN for permissions boundary: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -1250,12 +1280,12 @@ func run(cmd *cobra.Command, _ []string) {
 		if awsCreator.IsSTS {
 			r.Reporter.Errorf("Since your AWS credentials are returning an STS ARN you can only " +
 				"create STS clusters. Otherwise, switch to IAM credentials.")
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		err := awsClient.CheckAdminUserExists(aws.AdminUserName)
 		if err != nil {
 			r.Reporter.Errorf("IAM user '%s' does not exist. Run `rosa init` first", aws.AdminUserName)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		r.Reporter.Debugf("IAM user is valid!")
 	}
@@ -1272,7 +1302,7 @@ func run(cmd *cobra.Command, _ []string) {
 	defaultVersion, versionList, err := versions.GetVersionList(r, channelGroup, isSTS, isHostedCP, isHostedCP, true)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if version == "" {
 		version = defaultVersion
@@ -1287,18 +1317,18 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid OpenShift version: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	version, err = r.OCMClient.ValidateVersion(version, versionList, channelGroup, isSTS, isHostedCP)
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid OpenShift version: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if err := r.OCMClient.IsVersionCloseToEol(ocm.CloseToEolDays, version, channelGroup); err != nil {
 		r.Reporter.Warnf("%v", err)
 		if !confirm.Confirm("continue with version '%s'", ocm.GetRawVersionId(version)) {
-			os.Exit(0)
+			reporter.Exit(0)
 		}
 	}
 
@@ -1316,16 +1346,16 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid http tokens value : %v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	if err = ocm.ValidateHttpTokensValue(httpTokens); err != nil {
 		r.Reporter.Errorf("Expected a valid http tokens value : %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if err := ocm.ValidateHttpTokensVersion(ocm.GetVersionMinor(version), httpTokens); err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// warn if mode is used for non sts cluster
@@ -1339,7 +1369,7 @@ func run(cmd *cobra.Command, _ []string) {
 		isValidMode := arguments.IsValidMode(interactive.Modes, mode)
 		if !isValidMode {
 			r.Reporter.Errorf("Invalid --mode '%s'. Allowed values are %s", mode, interactive.Modes)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -1347,14 +1377,14 @@ func run(cmd *cobra.Command, _ []string) {
 		r.Reporter.Errorf("Cannot watch for STS cluster installation logs in mode 'auto' " +
 			"without also supplying '--yes' option." +
 			"To watch your cluster installation logs, run 'rosa logs install' instead after the cluster has began creating.")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if args.watch && isSTS && mode == interactive.ModeManual {
 		r.Reporter.Errorf("Cannot watch for STS cluster installation logs in mode 'manual'." +
 			"It requires manual commands to be performed as part of the process." +
 			"To watch your cluster installation logs, run 'rosa logs install' after the cluster has began creating.")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	hasRoles := false
@@ -1371,7 +1401,7 @@ func run(cmd *cobra.Command, _ []string) {
 		}
 		if err != nil {
 			r.Reporter.Errorf("Failed to find %s role: %s", role.Name, err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		if len(roleARNs) > 1 {
@@ -1410,7 +1440,7 @@ func run(cmd *cobra.Command, _ []string) {
 				})
 				if err != nil {
 					r.Reporter.Errorf("Expected a valid role ARN: %s", err)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 			}
 		} else if len(roleARNs) == 1 {
@@ -1434,7 +1464,7 @@ func run(cmd *cobra.Command, _ []string) {
 			hostedCPPolicies, err := awsClient.HasHostedCPPolicies(roleARN)
 			if err != nil {
 				r.Reporter.Errorf("Failed to determine if cluster has hosted CP policies: %v", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			hasRoles = true
 			for roleType, role := range aws.AccountRoles {
@@ -1453,14 +1483,14 @@ func run(cmd *cobra.Command, _ []string) {
 				}
 				if err != nil {
 					r.Reporter.Errorf("Failed to find %s role: %s", role.Name, err)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 				selectedARN := ""
 				expectedResourceIDForAccRole, rolePrefix, err := getExpectedResourceIDForAccRole(
 					hostedCPPolicies, roleARN, roleType)
 				if err != nil {
 					r.Reporter.Errorf("Failed to get the expected resource ID for role type: %s", roleType)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 				r.Reporter.Debugf(
 					"Using '%s' as the role prefix to retrieve the expected resource ID for role type '%s'",
@@ -1472,7 +1502,7 @@ func run(cmd *cobra.Command, _ []string) {
 					resourceId, err := aws.GetResourceIdFromARN(rARN)
 					if err != nil {
 						r.Reporter.Errorf("Failed to get resource ID from arn. %s", err)
-						os.Exit(1)
+						reporter.Exit(1)
 					}
 					lowerCaseResourceIdToCheck := strings.ToLower(resourceId)
 					if lowerCaseResourceIdToCheck == expectedResourceIDForAccRole {
@@ -1521,7 +1551,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid ARN: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -1529,7 +1559,7 @@ func run(cmd *cobra.Command, _ []string) {
 		err = aws.ARNValidator(roleARN)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid Role ARN: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		isSTS = true
 	}
@@ -1550,7 +1580,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid External ID: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -1570,18 +1600,18 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid ARN: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	if supportRoleARN != "" {
 		err = aws.ARNValidator(supportRoleARN)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid Support Role ARN: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	} else if roleARN != "" {
 		r.Reporter.Errorf("Support Role ARN is required: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Instance IAM Roles
@@ -1602,18 +1632,18 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid control plane IAM role ARN: %s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		}
 		if controlPlaneRoleARN != "" {
 			err = aws.ARNValidator(controlPlaneRoleARN)
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid control plane instance IAM role ARN: %s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		} else if roleARN != "" {
 			r.Reporter.Errorf("Control plane instance IAM role ARN is required: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -1634,18 +1664,18 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid worker IAM role ARN: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	if workerRoleARN != "" {
 		err = aws.ARNValidator(workerRoleARN)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid worker instance IAM role ARN: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	} else if roleARN != "" {
 		r.Reporter.Errorf("Worker instance IAM role ARN is required: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// combine role arns to list
@@ -1659,32 +1689,32 @@ func run(cmd *cobra.Command, _ []string) {
 	managedPolicies, err := awsClient.HasManagedPolicies(roleARN)
 	if err != nil {
 		r.Reporter.Errorf("Failed to determine if cluster has managed policies: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	// check if role has hosted cp policy via AWS tag value
 	hostedCPPolicies, err := awsClient.HasHostedCPPolicies(roleARN)
 	if err != nil {
 		r.Reporter.Errorf("Failed to determine if cluster has hosted CP policies: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if managedPolicies {
 		rolePrefix, err := getAccountRolePrefix(hostedCPPolicies, roleARN, aws.InstallerAccountRole)
 		if err != nil {
 			r.Reporter.Errorf("Failed to find prefix from account role: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		err = roles.ValidateAccountRolesManagedPolicies(r, rolePrefix, hostedCPPolicies)
 		if err != nil {
 			r.Reporter.Errorf("Failed while validating account roles: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	} else {
 		err = roles.ValidateUnmanagedAccountRoles(roleARNs, awsClient, version)
 		if err != nil {
 			r.Reporter.Errorf("Failed while validating account roles: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -1710,31 +1740,31 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a prefix for the operator IAM roles: %s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		}
 		if len(operatorRolesPrefix) == 0 {
 			r.Reporter.Errorf("Expected a prefix for the operator IAM roles: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		if len(operatorRolesPrefix) > 32 {
 			r.Reporter.Errorf("Expected a prefix with no more than 32 characters")
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		if !aws.RoleNameRE.MatchString(operatorRolesPrefix) {
 			r.Reporter.Errorf("Expected valid operator roles prefix matching %s", aws.RoleNameRE.String())
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		credRequests, err := r.OCMClient.GetAllCredRequests()
 		if err != nil {
 			r.Reporter.Errorf("Error getting operator credential request from OCM %v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		operatorRoles, err = r.AWSClient.GetOperatorRolesFromAccountByPrefix(operatorRolesPrefix, credRequests)
 		if err != nil {
 			r.Reporter.Errorf("There was a problem retrieving the Operator Roles from AWS: %v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -1743,12 +1773,12 @@ func run(cmd *cobra.Command, _ []string) {
 		credRequests, err := r.OCMClient.GetCredRequests(isHostedCP)
 		if err != nil {
 			r.Reporter.Errorf("Error getting operator credential request from OCM %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		accRolesPrefix, err := getAccountRolePrefix(hostedCPPolicies, roleARN, aws.InstallerAccountRole)
 		if err != nil {
 			r.Reporter.Errorf("Failed to find prefix from account role: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		if expectedOperatorRolePath != "" && !output.HasFlag() && r.Reporter.IsTerminal() {
 			r.Reporter.Infof("ARN path '%s' detected. This ARN path will be used for subsequent"+
@@ -1761,7 +1791,7 @@ func run(cmd *cobra.Command, _ []string) {
 				isSupported, err := ocm.CheckSupportedVersion(ocm.GetVersionMinor(version), operator.MinVersion())
 				if err != nil {
 					r.Reporter.Errorf("Error validating operator role '%s' version %s", operator.Name(), err)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 				if !isSupported {
 					continue
@@ -1782,13 +1812,13 @@ func run(cmd *cobra.Command, _ []string) {
 				if !strings.Contains(role, ",") {
 					r.Reporter.Errorf("Expected operator IAM roles to be a comma-separated " +
 						"list of name,namespace,role_arn")
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 				roleData := strings.Split(role, ",")
 				if len(roleData) != 3 {
 					r.Reporter.Errorf("Expected operator IAM roles to be a comma-separated " +
 						"list of name,namespace,role_arn")
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 				computedOperatorIamRoleList = append(computedOperatorIamRoleList, ocm.OperatorIAMRole{
 					Name:      roleData[0],
@@ -1802,20 +1832,20 @@ func run(cmd *cobra.Command, _ []string) {
 		if err != nil {
 			if !oidcConfig.Reusable() {
 				r.Reporter.Errorf("%v", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			} else {
 				err = ocm.ValidateOperatorRolesMatchOidcProvider(r.Reporter, awsClient, computedOperatorIamRoleList,
 					oidcConfig.IssuerUrl(), ocm.GetVersionMinor(version), expectedOperatorRolePath, managedPolicies, true)
 				if err != nil {
 					r.Reporter.Errorf("%v", err)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 			}
 		}
 		err = validateUniqueIamRoleArnsForStsCluster(roleARNs, computedOperatorIamRoleList)
 		if err != nil {
 			r.Reporter.Errorf(err.Error())
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -1834,7 +1864,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid set of tags: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		if len(tagsInput) > 0 {
 			_tags = strings.Split(tagsInput, ",")
@@ -1843,7 +1873,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if len(_tags) > 0 {
 		if err := aws.UserTagValidator(_tags); err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		delim := aws.GetTagsDelimiter(_tags)
 		for _, tag := range _tags {
@@ -1862,7 +1892,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid multi-AZ value: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -1880,7 +1910,7 @@ func run(cmd *cobra.Command, _ []string) {
 	region, err := aws.GetRegion(arguments.GetRegion())
 	if err != nil {
 		r.Reporter.Errorf("Error getting region: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	// Filter regions by OCP version for displaying in interactive mode
 	var versionFilter string
@@ -1893,11 +1923,11 @@ func run(cmd *cobra.Command, _ []string) {
 		awsClient, isHostedCP, shardPinningEnabled)
 	if err != nil {
 		r.Reporter.Errorf(fmt.Sprintf("%s", err))
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if region == "" {
 		r.Reporter.Errorf("Expected a valid AWS region")
-		os.Exit(1)
+		reporter.Exit(1)
 	} else if found := helper.Contains(regionList, region); isHostedCP && !shardPinningEnabled && !found {
 		r.Reporter.Warnf("Region '%s' not currently available for Hosted Control Plane cluster.", region)
 		interactive.Enable()
@@ -1913,17 +1943,17 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid AWS region: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	if supportsMultiAZ, found := regionAZ[region]; found {
 		if !supportsMultiAZ && multiAZ {
 			r.Reporter.Errorf("Region '%s' does not support multiple availability zones", region)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	} else {
 		r.Reporter.Errorf("Region '%s' is not supported for this AWS account", region)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	awsClient, err = aws.NewClient().
@@ -1933,7 +1963,7 @@ func run(cmd *cobra.Command, _ []string) {
 		Build()
 	if err != nil {
 		r.Reporter.Errorf("Failed to create awsClient: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	r.AWSClient = awsClient
 
@@ -1956,13 +1986,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid private-link value: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	} else if (privateLink || (isSTS && private)) && !fedramp.Enabled() && !isPrivateHostedCP {
 		// do not prompt users for privatelink if it is private hosted cluster
 		r.Reporter.Warnf("You are choosing to use AWS PrivateLink for your cluster. %s", privateLinkWarning)
 		if !confirm.Confirm("use AWS PrivateLink for cluster '%s'", clusterName) {
-			os.Exit(0)
+			reporter.Exit(0)
 		}
 		privateLink = true
 	}
@@ -1971,7 +2001,7 @@ func run(cmd *cobra.Command, _ []string) {
 		private = true
 	} else if isSTS && private {
 		r.Reporter.Errorf("Private STS clusters are only supported through AWS PrivateLink")
-		os.Exit(1)
+		reporter.Exit(1)
 	} else if !isSTS {
 		privateWarning := "You will not be able to access your cluster until " +
 			"you edit network settings in your cloud provider."
@@ -1983,19 +2013,19 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid private value: %s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		} else if private {
 			r.Reporter.Warnf("You are choosing to make your cluster private. %s", privateWarning)
 			if !confirm.Confirm("set cluster '%s' as private", clusterName) {
-				os.Exit(0)
+				reporter.Exit(0)
 			}
 		}
 	}
 
 	if isSTS && private && !privateLink {
 		r.Reporter.Errorf("Private STS clusters are only supported through AWS PrivateLink")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if privateLink || isHostedCP {
@@ -2023,7 +2053,7 @@ func run(cmd *cobra.Command, _ []string) {
 		GetDefaultClusterFlavors(args.flavour)
 	if dMachinecidr == nil || dPodcidr == nil || dServicecidr == nil {
 		r.Reporter.Errorf("Error retrieving default cluster flavors")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Machine CIDR:
@@ -2039,7 +2069,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid CIDR value: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -2056,7 +2086,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid CIDR value: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	// Pod CIDR:
@@ -2072,7 +2102,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid CIDR value: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -2095,13 +2125,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
 	if isHostedCP && !subnetsProvided && !useExistingVPC {
 		r.Reporter.Errorf("All hosted clusters need a pre-configured VPC. Make sure to specify the subnet ids")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// For hosted cluster we will need the number of the private subnets the users has selected
@@ -2114,7 +2144,7 @@ func run(cmd *cobra.Command, _ []string) {
 		initialSubnets, err := getInitialValidSubnets(awsClient, subnetIDs, r.Reporter)
 		if err != nil {
 			r.Reporter.Errorf("Failed to get the list of subnets: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		if subnetsProvided {
 			useExistingVPC = true
@@ -2122,18 +2152,18 @@ func run(cmd *cobra.Command, _ []string) {
 		_, machineNetwork, err := net.ParseCIDR(machineCIDR.String())
 		if err != nil {
 			r.Reporter.Errorf("Unable to parse machine CIDR")
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		_, serviceNetwork, err := net.ParseCIDR(serviceCIDR.String())
 		if err != nil {
 			r.Reporter.Errorf("Unable to parse service CIDR")
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		var filterError error
 		subnets, filterError = filterCidrRangeSubnets(initialSubnets, machineNetwork, serviceNetwork, r)
 		if filterError != nil {
 			r.Reporter.Errorf("%s", filterError)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		if privateLink {
 			subnets = filterPrivateSubnets(subnets, r)
@@ -2145,10 +2175,10 @@ func run(cmd *cobra.Command, _ []string) {
 					"All Hosted Control Plane clusters need a pre-configured VPC. Please check: %s",
 					createVpcForHcpDoc,
 				)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			if ok := confirm.Prompt(false, "Continue with default? A new RH Managed VPC will be created for your cluster"); !ok {
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			useExistingVPC = false
 			subnetsProvided = false
@@ -2169,7 +2199,7 @@ func run(cmd *cobra.Command, _ []string) {
 				if !verifiedSubnet {
 					r.Reporter.Errorf("Could not find the following subnet provided in region '%s': %s",
 						r.AWSClient.GetRegion(), subnetArg)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 			}
 		}
@@ -2217,7 +2247,7 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected valid subnet IDs: %s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			for i, subnet := range subnetIDs {
 				subnetIDs[i] = aws.ParseOption(subnet)
@@ -2236,7 +2266,7 @@ func run(cmd *cobra.Command, _ []string) {
 			}
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		}
 
@@ -2262,7 +2292,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if len(subnetIDs) == 0 && isSharedVPC {
 		r.Reporter.Errorf("Installing a cluster into a shared VPC is only supported for BYO VPC clusters")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if isSubnetBelongToSharedVpc(r, awsCreator.AccountID, subnetIDs, mapSubnetIDToSubnet) {
@@ -2271,7 +2301,7 @@ func run(cmd *cobra.Command, _ []string) {
 			r.Reporter.Errorf("Installing a cluster into shared VPC is only supported for cluster "+
 				"which has a name no longer than %d characters or with a cluster domain prefix",
 				ocm.MaxClusterDomainPrefixLength)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		isSharedVPC = true
@@ -2283,19 +2313,19 @@ func run(cmd *cobra.Command, _ []string) {
 			privateHostedZoneID, err = getPrivateHostedZoneID(cmd, privateHostedZoneID)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 
 			sharedVPCRoleARN, err = getSharedVpcRoleArn(cmd, sharedVPCRoleARN)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 
 			baseDomain, err = getBaseDomain(r, cmd, baseDomain)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		}
 	}
@@ -2316,20 +2346,20 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid value for select-availability-zones: %s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 
 			if selectAvailabilityZones {
 				optionsAvailabilityZones, err := awsClient.DescribeAvailabilityZones()
 				if err != nil {
 					r.Reporter.Errorf("Failed to get the list of the availability zone: %s", err)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 
 				availabilityZones, err = selectAvailabilityZonesInteractively(cmd, optionsAvailabilityZones, multiAZ)
 				if err != nil {
 					r.Reporter.Errorf("%s", err)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 			}
 		}
@@ -2338,7 +2368,7 @@ func run(cmd *cobra.Command, _ []string) {
 			err = validateAvailabilityZones(multiAZ, availabilityZones, awsClient)
 			if err != nil {
 				r.Reporter.Errorf(fmt.Sprintf("%s", err))
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		}
 	}
@@ -2358,7 +2388,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for enable-customer-managed-key: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -2374,14 +2404,14 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for kms-key-arn: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
 	err = kmsArnRegexpValidator.ValidateKMSKeyARN(&kmsKeyARN)
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid value for kms-key-arn: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Compute node instance type:
@@ -2390,7 +2420,7 @@ func run(cmd *cobra.Command, _ []string) {
 		awsClient, externalID)
 	if err != nil {
 		r.Reporter.Errorf(fmt.Sprintf("%s", err))
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if computeMachineType == "" {
 		computeMachineType = defaultComputeMachineType
@@ -2404,13 +2434,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid machine type: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	err = computeMachineTypeList.ValidateMachineType(computeMachineType, multiAZ)
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid machine type: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	isAutoscalingSet := cmd.Flags().Changed("enable-autoscaling")
@@ -2427,7 +2457,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for enable-autoscaling: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -2450,7 +2480,7 @@ func run(cmd *cobra.Command, _ []string) {
 		// if the user set compute-nodes and enabled autoscaling
 		if isReplicasSet {
 			r.Reporter.Errorf("Compute-nodes can't be set when autoscaling is enabled")
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		if interactive.Enabled() || !isMinReplicasSet {
 			minReplicas, err = interactive.GetInt(interactive.Input{
@@ -2464,13 +2494,13 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid number of min replicas: %s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		}
 		err = minReplicaValidator(multiAZ, isHostedCP, privateSubnetsCount)(minReplicas)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		if interactive.Enabled() || !isMaxReplicasSet {
@@ -2485,26 +2515,26 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid number of max replicas: %s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		}
 		err = maxReplicaValidator(multiAZ, minReplicas, isHostedCP, privateSubnetsCount)(maxReplicas)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		if isHostedCP {
 			if clusterautoscaler.IsAutoscalerSetViaCLI(cmd.Flags(), clusterAutoscalerFlagsPrefix) {
 				r.Reporter.Errorf("Hosted Control Plane clusters do not support cluster-autoscaler configuration")
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		} else {
 			clusterAutoscaler, err = clusterautoscaler.GetAutoscalerOptions(
 				cmd.Flags(), clusterAutoscalerFlagsPrefix, true, autoscalerArgs)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		}
 	}
@@ -2519,7 +2549,7 @@ func run(cmd *cobra.Command, _ []string) {
 		// if the user set min/max replicas and hasn't enabled autoscaling
 		if isMinReplicasSet || isMaxReplicasSet {
 			r.Reporter.Errorf("Autoscaling must be enabled in order to set min and max replicas")
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		if interactive.Enabled() {
@@ -2533,13 +2563,13 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid number of compute nodes: %s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		}
 		err = minReplicaValidator(multiAZ, isHostedCP, privateSubnetsCount)(computeNodes)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -2556,20 +2586,20 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	labelMap, err := mpHelpers.ParseLabels(labels)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	isVersionCompatibleComputeSgIds, err := versions.IsGreaterThanOrEqual(
 		version, ocm.MinVersionForAdditionalComputeSecurityGroupIdsDay1)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem checking version compatibility: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	additionalComputeSecurityGroupIds := args.additionalComputeSecurityGroupIds
 	getSecurityGroups(r, cmd, isVersionCompatibleComputeSgIds,
@@ -2590,13 +2620,13 @@ func run(cmd *cobra.Command, _ []string) {
 	expiration, err := validateExpiration()
 	if err != nil {
 		r.Reporter.Errorf(fmt.Sprintf("%s", err))
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Network Type:
 	if err := validateNetworkType(args.networkType); err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if cmd.Flags().Changed("network-type") && interactive.Enabled() {
 		args.networkType, err = interactive.GetOption(interactive.Input{
@@ -2607,7 +2637,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid network type: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -2627,30 +2657,30 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid host prefix value: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	err = hostPrefixValidator(hostPrefix)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	machinePoolRootDisk, err := getMachinePoolRootDisk(r, cmd, version,
 		isHostedCP, defaultMachinePoolRootDiskSize)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// No CNI
 	if cmd.Flags().Changed("no-cni") && !isHostedCP {
 		r.Reporter.Errorf("Disabling CNI is supported only for Hosted Control Planes")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if cmd.Flags().Changed("no-cni") && cmd.Flags().Changed("network-type") {
 		r.Reporter.Errorf("--no-cni and --network-type are mutually exclusive parameters")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	noCni := args.noCni
 	if cmd.Flags().Changed("no-cni") && interactive.Enabled() {
@@ -2661,13 +2691,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for no CNI: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
 	if cmd.Flags().Changed("fips") && isHostedCP {
 		r.Reporter.Errorf("FIPS support not available for Hosted Control Plane clusters")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	fips := args.fips || fedramp.Enabled()
 	if interactive.Enabled() && !fedramp.Enabled() && !isHostedCP {
@@ -2678,7 +2708,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid FIPS value: %v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -2688,7 +2718,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if etcdEncryptionKmsARN != "" {
 		if cmd.Flags().Changed("etcd-encryption") && !etcdEncryption {
 			r.Reporter.Errorf("etcd encryption cannot be disabled when encryption kms arn is provided")
-			os.Exit(1)
+			reporter.Exit(1)
 		} else {
 			etcdEncryption = true
 		}
@@ -2702,13 +2732,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid etcd-encryption value: %v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	if fips {
 		if cmd.Flags().Changed("etcd-encryption") && !etcdEncryption {
 			r.Reporter.Errorf("etcd encryption cannot be disabled on clusters with FIPS mode")
-			os.Exit(1)
+			reporter.Exit(1)
 		} else {
 			etcdEncryption = true
 		}
@@ -2726,7 +2756,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for etcd-encryption-kms-arn: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -2736,7 +2766,7 @@ func run(cmd *cobra.Command, _ []string) {
 			"Expected a valid value for etcd-encryption-kms-arn matching %s",
 			kmsArnRegexpValidator.KmsArnRE,
 		)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	disableWorkloadMonitoring := args.disableWorkloadMonitoring
@@ -2748,7 +2778,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid disable-workload-monitoring value: %v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -2762,7 +2792,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid proxy-enabled value: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -2777,13 +2807,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid http proxy: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	err = ocm.ValidateHTTPProxy(httpProxy)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if enableProxy && interactive.Enabled() {
@@ -2797,13 +2827,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid https proxy: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	err = interactive.IsURL(httpsProxy)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if enableProxy && interactive.Enabled() {
@@ -2818,7 +2848,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid set of no proxy domains/CIDR's: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		noProxySlice = helper.HandleEmptyStringOnSlice(strings.Split(noProxyInput, ","))
 	}
@@ -2827,20 +2857,20 @@ func run(cmd *cobra.Command, _ []string) {
 		duplicate, found := aws.HasDuplicates(noProxySlice)
 		if found {
 			r.Reporter.Errorf("Invalid no-proxy list, duplicate key '%s' found", duplicate)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		for _, domain := range noProxySlice {
 			err := aws.UserNoProxyValidator(domain)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		}
 	}
 
 	if httpProxy == "" && httpsProxy == "" && len(noProxySlice) > 0 {
 		r.Reporter.Errorf("Expected at least one of the following: http-proxy, https-proxy")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if useExistingVPC && interactive.Enabled() {
@@ -2854,13 +2884,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid additional trust bundle file name: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	err = ocm.ValidateAdditionalTrustBundle(additionalTrustBundleFile)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Get certificate contents
@@ -2869,7 +2899,7 @@ func run(cmd *cobra.Command, _ []string) {
 		cert, err := os.ReadFile(additionalTrustBundleFile)
 		if err != nil {
 			r.Reporter.Errorf("Failed to read additional trust bundle file: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		additionalTrustBundle = new(string)
 		*additionalTrustBundle = string(cert)
@@ -2877,13 +2907,13 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if enableProxy && httpProxy == "" && httpsProxy == "" && additionalTrustBundleFile == "" {
 		r.Reporter.Errorf("Expected at least one of the following: http-proxy, https-proxy, additional-trust-bundle")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Additional Allowed Principals
 	if cmd.Flags().Changed("additional-allowed-principals") && !isHostedCP {
 		r.Reporter.Errorf("Additional Allowed Principals is supported only for Hosted Control Planes")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	additionalAllowedPrincipals := args.additionalAllowedPrincipals
 	if isHostedCP && interactive.Enabled() {
@@ -2894,14 +2924,14 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for Additional Allowed Principal ARNs: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		additionalAllowedPrincipals = helper.HandleEmptyStringOnSlice(strings.Split(aapInputs, ","))
 	}
 	if len(additionalAllowedPrincipals) > 0 {
 		if err := roles.ValidateAdditionalAllowedPrincipals(additionalAllowedPrincipals); err != nil {
 			r.Reporter.Errorf(err.Error())
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -2910,7 +2940,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if auditLogRoleARN != "" && !isHostedCP {
 		r.Reporter.Errorf("Audit log forwarding to AWS CloudWatch is only supported for Hosted Control Plane clusters")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if interactive.Enabled() && isHostedCP {
@@ -2921,7 +2951,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		if requestAuditLogForwarding {
 
@@ -2939,7 +2969,7 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid value for audit-log-arn: %s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		} else {
 			auditLogRoleARN = ""
@@ -2948,31 +2978,31 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if auditLogRoleARN != "" && !aws.RoleArnRE.MatchString(auditLogRoleARN) {
 		r.Reporter.Errorf("Expected a valid value for audit log arn matching %s", aws.RoleArnRE)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	isVersionCompatibleManagedIngressV2, err := versions.IsGreaterThanOrEqual(
 		version, ocm.MinVersionForManagedIngressV2)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem checking version compatibility: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if ingress.IsDefaultIngressSetViaCLI(cmd.Flags()) {
 		if isHostedCP {
 			r.Reporter.Errorf("Updating default ingress settings is not supported for Hosted Control Plane clusters")
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		if !isVersionCompatibleManagedIngressV2 {
 			formattedVersion, err := versions.FormatMajorMinorPatch(ocm.MinVersionForManagedIngressV2)
 			if err != nil {
 				r.Reporter.Errorf(versions.MajorMinorPatchFormattedErrorOutput, err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			r.Reporter.Errorf(
 				"Updating default ingress settings is not supported for versions prior to '%s'",
 				formattedVersion,
 			)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	routeSelector := ""
@@ -2989,7 +3019,7 @@ func run(cmd *cobra.Command, _ []string) {
 		if cmd.Flags().Changed(ingress.DefaultIngressRouteSelectorFlag) {
 			if isHostedCP {
 				r.Reporter.Errorf("Updating route selectors is not supported for Hosted Control Plane clusters")
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			routeSelector = args.defaultIngressRouteSelectors
 		} else if interactive.Enabled() && !isHostedCP && shouldAskCustomIngress {
@@ -3006,20 +3036,20 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			routeSelector = routeSelectorArg
 		}
 		routeSelectors, err = ingress.GetRouteSelector(routeSelector)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		if cmd.Flags().Changed(ingress.DefaultIngressExcludedNamespacesFlag) {
 			if isHostedCP {
 				r.Reporter.Errorf("Updating excluded namespace is not supported for Hosted Control Plane clusters")
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			excludedNamespaces = args.defaultIngressExcludedNamespaces
 		} else if interactive.Enabled() && !isHostedCP && shouldAskCustomIngress {
@@ -3030,7 +3060,7 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			excludedNamespaces = excludedNamespacesArg
 		}
@@ -3039,7 +3069,7 @@ func run(cmd *cobra.Command, _ []string) {
 		if cmd.Flags().Changed(ingress.DefaultIngressWildcardPolicyFlag) {
 			if isHostedCP {
 				r.Reporter.Errorf("Updating Wildcard Policy is not supported for Hosted Control Plane clusters")
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			wildcardPolicy = args.defaultIngressWildcardPolicy
 		} else {
@@ -3057,7 +3087,7 @@ func run(cmd *cobra.Command, _ []string) {
 				})
 				if err != nil {
 					r.Reporter.Errorf("Expected a valid Wildcard Policy: %s", err)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 				wildcardPolicy = wildcardPolicyArg
 			}
@@ -3068,7 +3098,7 @@ func run(cmd *cobra.Command, _ []string) {
 				r.Reporter.Errorf(
 					"Updating Namespace Ownership Policy is not supported for Hosted Control Plane clusters",
 				)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			namespaceOwnershipPolicy = args.defaultIngressNamespaceOwnershipPolicy
 		} else {
@@ -3086,7 +3116,7 @@ func run(cmd *cobra.Command, _ []string) {
 				})
 				if err != nil {
 					r.Reporter.Errorf("Expected a valid Namespace Ownership Policy: %s", err)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 				namespaceOwnershipPolicy = namespaceOwnershipPolicyArg
 			}
@@ -3190,7 +3220,7 @@ func run(cmd *cobra.Command, _ []string) {
 		autoscalerConfig, err := clusterautoscaler.CreateAutoscalerConfig(clusterAutoscaler)
 		if err != nil {
 			r.Reporter.Errorf("Failed creating autoscaler configuration: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		clusterConfig.AutoscalerConfig = autoscalerConfig
@@ -3200,7 +3230,7 @@ func run(cmd *cobra.Command, _ []string) {
 		cmd.Flags(), clusterRegistryConfigArgs, isHostedCP, nil)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if clusterRegistryConfigArgs != nil {
 		allowedRegistries, blockedRegistries, insecureRegistries,
@@ -3216,7 +3246,7 @@ func run(cmd *cobra.Command, _ []string) {
 			ca, err := clusterregistryconfig.BuildAdditionalTrustedCAFromInputFile(additionalTrustedCa)
 			if err != nil {
 				r.Reporter.Errorf("Failed to build the additional trusted ca from file %s, got error: %s", additionalTrustedCa, err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			clusterConfig.AdditionalTrustedCa = ca
 			clusterConfig.AdditionalTrustedCaFile = additionalTrustedCa
@@ -3231,7 +3261,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if args.useLocalCredentials {
 		if isSTS {
 			r.Reporter.Errorf("Local credentials are not supported for STS clusters")
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		props = append(props, properties.UseLocalCredentials)
 	}
@@ -3250,7 +3280,7 @@ func run(cmd *cobra.Command, _ []string) {
 	clusterConfig, err = clusterConfigFor(r.Reporter, clusterConfig, awsCreator, awsClient)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if !output.HasFlag() || r.Reporter.IsTerminal() {
@@ -3266,7 +3296,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if !clusterConfig.IsSTS {
 		if err := r.OCMClient.EnsureNoPendingClusters(awsCreator); err != nil {
 			r.Reporter.Errorf("%v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -3277,14 +3307,14 @@ func run(cmd *cobra.Command, _ []string) {
 		} else {
 			r.Reporter.Errorf("Failed to create cluster: %s", err)
 		}
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if args.dryRun {
 		r.Reporter.Infof(
 			"Creating cluster '%s' should succeed. Run without the '--dry-run' flag to create the cluster.",
 			clusterName)
-		os.Exit(0)
+		return
 	}
 
 	if !output.HasFlag() || r.Reporter.IsTerminal() {
@@ -3328,7 +3358,7 @@ func run(cmd *cobra.Command, _ []string) {
 					r.Reporter.Debugf("Failed to verify if OIDC provider exists: %s", err)
 				} else {
 					r.Reporter.Errorf("Failed to verify if OIDC provider exists: %s", err)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 			}
 			if !oidcProviderExists {
@@ -3497,7 +3527,7 @@ func handleOidcConfigOptions(r *rosa.Runtime, cmd *cobra.Command, isSTS bool, is
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid value: %s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			isOidcConfig = _isOidcConfig
 		}
@@ -3517,12 +3547,12 @@ func handleOidcConfigOptions(r *rosa.Runtime, cmd *cobra.Command, isSTS bool, is
 		}
 		r.Reporter.Errorf("Hosted Control Plane requires an OIDC Configuration ID\n" +
 			"Please run `rosa create oidc-config -h` and create one.")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	oidcConfig, err := r.OCMClient.GetOidcConfig(oidcConfigId)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem retrieving OIDC Config '%s': %v", oidcConfigId, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	return oidcConfig
 }
@@ -3533,7 +3563,7 @@ func filterPrivateSubnets(initialSubnets []ec2types.Subnet, r *rosa.Runtime) []e
 	publicSubnetMap, err := r.AWSClient.FetchPublicSubnetMap(initialSubnets)
 	if err != nil {
 		r.Reporter.Errorf("Unable to check if subnet have an IGW: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	for _, subnet := range initialSubnets {
 		skip := false
@@ -4126,13 +4156,13 @@ func getSecurityGroups(r *rosa.Runtime, cmd *cobra.Command, isVersionCompatibleC
 		if !useExistingVpc {
 			r.Reporter.Errorf("Setting the `%s` flag is only allowed for BYO VPC clusters",
 				securitygroups.SgKindFlagMap[kind])
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		// HCP is still unsupported
 		if isHostedCp {
 			r.Reporter.Errorf("Parameter '%s' is not supported for Hosted Control Plane clusters",
 				securitygroups.SgKindFlagMap[kind])
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		if !isVersionCompatibleComputeSgIds {
 			formattedVersion, err := versions.FormatMajorMinorPatch(
@@ -4140,11 +4170,11 @@ func getSecurityGroups(r *rosa.Runtime, cmd *cobra.Command, isVersionCompatibleC
 			)
 			if err != nil {
 				r.Reporter.Errorf(versions.MajorMinorPatchFormattedErrorOutput, err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			r.Reporter.Errorf("Parameter '%s' is not supported prior to version '%s'",
 				securitygroups.SgKindFlagMap[kind], formattedVersion)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	} else if interactive.Enabled() && isVersionCompatibleComputeSgIds && useExistingVpc && !isHostedCp {
 		vpcId := ""
@@ -4155,7 +4185,7 @@ func getSecurityGroups(r *rosa.Runtime, cmd *cobra.Command, isVersionCompatibleC
 		}
 		if vpcId == "" {
 			r.Reporter.Warnf("Unexpected situation a VPC ID should have been selected based on chosen subnets")
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		*additionalSgIds = interactiveSgs.
 			GetSecurityGroupIds(r, cmd, vpcId, kind, "")
@@ -4178,7 +4208,7 @@ func getMachinePoolRootDisk(r *rosa.Runtime, cmd *cobra.Command, version string,
 			formattedVersion, err := versions.FormatMajorMinorPatch(ocm.MinVersionForMachinePoolRootDisk)
 			if err != nil {
 				r.Reporter.Errorf(versions.MajorMinorPatchFormattedErrorOutput, err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			return nil, fmt.Errorf(
 				"Updating Worker disk size is not supported for versions prior to '%s'",
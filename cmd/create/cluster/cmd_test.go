@@ -19,6 +19,7 @@ import (
 
 	mock "github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/clusterspec"
 	"github.com/openshift/rosa/pkg/logging"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -572,6 +573,116 @@ var _ = Describe("validateUniqueIamRoleArnsForStsCluster()", func() {
 	})
 })
 
+var _ = Describe("Cluster spec file", func() {
+	It("maps every spec field to a create cluster flag", func() {
+		replicas := 3
+		sts := true
+		spec := &clusterspec.ClusterSpec{
+			APIVersion: clusterspec.APIVersion,
+			Kind:       clusterspec.Kind,
+			Spec: clusterspec.Spec{
+				Name:                      "mycluster",
+				DomainPrefix:              "mycluster",
+				Region:                    "us-east-1",
+				Version:                   "4.15.9",
+				ChannelGroup:              "candidate",
+				MultiAZ:                   true,
+				STS:                       &sts,
+				Mode:                      "auto",
+				Expiration:                "24h",
+				BillingAccount:            "123456789012",
+				DisableSCPChecks:          true,
+				DisableWorkloadMonitoring: true,
+				Ec2MetadataHttpTokens:     "required",
+				Tags:                      map[string]string{"team": "sre"},
+				Properties:                []string{"a:b"},
+				Roles: &clusterspec.Roles{
+					RoleARN:             "arn:aws:iam::123456789012:role/Installer",
+					ExternalID:          "external",
+					SupportRoleARN:      "arn:aws:iam::123456789012:role/Support",
+					ControlPlaneRoleARN: "arn:aws:iam::123456789012:role/ControlPlane",
+					WorkerRoleARN:       "arn:aws:iam::123456789012:role/Worker",
+					OperatorRolesPrefix: "mycluster",
+					PermissionsBoundary: "arn:aws:iam::123456789012:policy/Boundary",
+					OidcConfigID:        "2a3b4c",
+					ClassicOidcConfig:   true,
+					AuditLogRoleARN:     "arn:aws:iam::123456789012:role/AuditLog",
+				},
+				Encryption: &clusterspec.Encryption{
+					FIPS:                     true,
+					EtcdEncryption:           true,
+					EtcdEncryptionKMSArn:     "arn:aws:kms:us-east-1:123456789012:key/etcd",
+					EnableCustomerManagedKey: true,
+					KMSKeyArn:                "arn:aws:kms:us-east-1:123456789012:key/disk",
+				},
+				Network: &clusterspec.Network{
+					Type:                        "OVNKubernetes",
+					MachineCIDR:                 "10.0.0.0/16",
+					ServiceCIDR:                 "172.30.0.0/16",
+					PodCIDR:                     "10.128.0.0/14",
+					HostPrefix:                  23,
+					Private:                     true,
+					PrivateLink:                 true,
+					SubnetIDs:                   []string{"subnet-1"},
+					AvailabilityZones:           []string{"us-east-1a"},
+					NoCNI:                       true,
+					AdditionalAllowedPrincipals: []string{"arn:aws:iam::123456789012:role/Principal"},
+				},
+				Proxy: &clusterspec.Proxy{
+					HTTPProxy:                 "http://proxy:3128",
+					HTTPSProxy:                "https://proxy:3128",
+					NoProxy:                   []string{"example.com"},
+					AdditionalTrustBundleFile: "ca.pem",
+				},
+				Compute: &clusterspec.Compute{
+					MachineType: "m5.xlarge",
+					Replicas:    &replicas,
+					Labels:      map[string]string{"role": "worker"},
+					DiskSize:    "300GiB",
+				},
+				SecurityGroups: &clusterspec.SecurityGroups{
+					Compute:      []string{"sg-1"},
+					Infra:        []string{"sg-2"},
+					ControlPlane: []string{"sg-3"},
+				},
+				DefaultIngress: &clusterspec.DefaultIngress{
+					RouteSelector:            map[string]string{"route": "default"},
+					ExcludedNamespaces:       []string{"excluded"},
+					WildcardPolicy:           "WildcardsAllowed",
+					NamespaceOwnershipPolicy: "Strict",
+				},
+				RegistryConfig: &clusterspec.RegistryConfig{
+					AllowedRegistries:          []string{"quay.io"},
+					BlockedRegistries:          []string{"docker.io"},
+					InsecureRegistries:         []string{"insecure.io"},
+					AllowedRegistriesForImport: "quay.io:false",
+					PlatformAllowlist:          "allowlist",
+					AdditionalTrustedCaFile:    "registry-ca.json",
+				},
+				ClusterAutoscaler: map[string]string{"max-nodes-total": "100"},
+				ClusterAdmin:      &clusterspec.ClusterAdmin{Create: true, Username: "admin"},
+				SharedVPC: &clusterspec.SharedVPC{
+					PrivateHostedZoneID: "Z123",
+					RoleARN:             "arn:aws:iam::123456789012:role/SharedVPC",
+					BaseDomain:          "example.com",
+				},
+				ExternalAuth: &clusterspec.ExternalAuth{Enabled: true},
+			},
+		}
+		// The flags are bound to the package arguments, restore them so that other tests aren't affected
+		saved := args
+		DeferCleanup(func() {
+			args = saved
+		})
+		cmd := makeCmd()
+		initFlags(cmd)
+		Expect(spec.Apply(cmd.Flags())).To(Succeed())
+		for _, flag := range spec.Flags() {
+			Expect(cmd.Flags().Changed(flag.Name)).To(BeTrue(), flag.Name)
+		}
+	})
+})
+
 func mustParseCIDR(s string) *net.IPNet {
 	_, ipnet, err := net.ParseCIDR(s)
 	Expect(err).To(BeNil())
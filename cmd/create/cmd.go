@@ -27,6 +27,7 @@ import (
 	"github.com/openshift/rosa/cmd/create/decision"
 	"github.com/openshift/rosa/cmd/create/dnsdomains"
 	"github.com/openshift/rosa/cmd/create/externalauthprovider"
+	"github.com/openshift/rosa/cmd/create/hibernationschedule"
 	"github.com/openshift/rosa/cmd/create/idp"
 	"github.com/openshift/rosa/cmd/create/kubeletconfig"
 	"github.com/openshift/rosa/cmd/create/machinepool"
@@ -72,6 +73,8 @@ func init() {
 	Cmd.AddCommand(breakglasscredential.Cmd)
 	decisionCommand := decision.NewCreateDecisionCommand()
 	Cmd.AddCommand(decisionCommand)
+	hibernationScheduleCommand := hibernationschedule.NewCreateHibernationScheduleCommand()
+	Cmd.AddCommand(hibernationScheduleCommand)
 
 	flags := Cmd.PersistentFlags()
 	arguments.AddProfileFlag(flags)
@@ -84,7 +87,7 @@ func init() {
 		oidcprovider.Cmd, breakglasscredential.Cmd,
 		admin.Cmd, autoscalerCommand, dnsdomains.Cmd,
 		externalauthprovider.Cmd, idp.Cmd, kubeletConfig, tuningconfigs.Cmd,
-		decisionCommand,
+		decisionCommand, hibernationScheduleCommand,
 	}
 	arguments.MarkRegionDeprecated(Cmd, globallyAvailableCommands)
 }
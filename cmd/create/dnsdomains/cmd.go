@@ -18,10 +18,10 @@ package dnsdomains
 
 import (
 	// nolint:gosec
-	"os"
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -43,7 +43,7 @@ func run(_ *cobra.Command, _ []string) {
 	dnsdomain, err := r.OCMClient.CreateDNSDomain()
 	if err != nil {
 		r.Reporter.Errorf("Failed to create dns domain: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	r.Reporter.Infof("DNS domain ‘%s’ has been created.", dnsdomain.ID())
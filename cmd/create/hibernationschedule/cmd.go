@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hibernationschedule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/hibernation"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "hibernation-schedule"
+	short = "Create a hibernation schedule for a cluster"
+	long  = "Create a schedule that hibernates and resumes a cluster. The schedule is stored in the " +
+		"properties of the cluster and it is executed by the 'rosa run-schedules' command, which should " +
+		"run periodically, for example from cron or from a pod."
+	example = `  # Hibernate cluster 'foo' on weekday evenings and resume it on weekday mornings
+  rosa create hibernation-schedule --cluster foo --hibernate "0 19 * * 1-5" --resume "0 7 * * 1-5" \
+    --timezone Europe/Berlin`
+)
+
+var aliases = []string{"hibernationschedule"}
+
+type Options struct {
+	Hibernate string
+	Resume    string
+	Timezone  string
+}
+
+func NewCreateHibernationScheduleCommand() *cobra.Command {
+	options := &Options{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), CreateHibernationScheduleRunner(options)),
+		Args:    cobra.NoArgs,
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+	ocm.AddClusterFlag(cmd)
+	flags.StringVar(
+		&options.Hibernate,
+		"hibernate",
+		"",
+		"Cron expression of the times when the cluster is hibernated, for example '0 19 * * 1-5'.",
+	)
+	flags.StringVar(
+		&options.Resume,
+		"resume",
+		"",
+		"Cron expression of the times when the cluster is resumed, for example '0 7 * * 1-5'.",
+	)
+	flags.StringVar(
+		&options.Timezone,
+		"timezone",
+		hibernation.DefaultTimezone,
+		"Time zone used to evaluate the cron expressions, for example 'Europe/Berlin'.",
+	)
+	return cmd
+}
+
+func CreateHibernationScheduleRunner(options *Options) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
+		schedule, err := hibernation.NewSchedule(options.Hibernate, options.Resume, options.Timezone)
+		if err != nil {
+			return err
+		}
+
+		cluster, err := r.OCMClient.GetCluster(r.GetClusterKey(), r.Creator)
+		if err != nil {
+			return err
+		}
+		_, found, _ := hibernation.GetSchedule(cluster)
+		if found {
+			return fmt.Errorf("Cluster '%s' already has a hibernation schedule, "+
+				"delete it with 'rosa delete hibernation-schedule --cluster %s' before creating a new one",
+				r.GetClusterKey(), r.GetClusterKey())
+		}
+
+		enabled, err := r.OCMClient.IsCapabilityEnabled(ocm.HibernateCapability)
+		if err != nil {
+			return err
+		}
+		if !enabled {
+			return fmt.Errorf("The '%s' capability is not set for current org", ocm.HibernateCapability)
+		}
+
+		err = r.OCMClient.UpdateClusterProperties(cluster.ID(), hibernation.SetSchedule(cluster, schedule))
+		if err != nil {
+			return fmt.Errorf("Failed to create the hibernation schedule for cluster '%s': %w",
+				r.GetClusterKey(), err)
+		}
+
+		description := hibernation.NewDescription(cluster, schedule, time.Now())
+		r.Reporter.Infof("Created the hibernation schedule for cluster '%s'. "+
+			"Make sure that 'rosa run-schedules' runs periodically to execute it.", r.GetClusterKey())
+		fmt.Print(hibernation.PrintDescription(description))
+		return nil
+	}
+}
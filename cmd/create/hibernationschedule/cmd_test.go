@@ -0,0 +1,114 @@
+package hibernationschedule
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/properties"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Create hibernation schedule", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewCreateHibernationScheduleCommand()
+		Expect(cmd).NotTo(BeNil())
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Run).NotTo(BeNil())
+		for _, flag := range []string{"cluster", "hibernate", "resume", "timezone"} {
+			Expect(cmd.Flags().Lookup(flag)).NotTo(BeNil(), flag)
+		}
+	})
+
+	Context("Runner", func() {
+		var t *TestingRuntime
+		var options *Options
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			options = &Options{
+				Hibernate: "0 19 * * 1-5",
+				Resume:    "0 7 * * 1-5",
+				Timezone:  "Europe/Berlin",
+			}
+		})
+
+		It("Validates the schedule before calling the API", func() {
+			options.Resume = "every morning"
+			runner := CreateHibernationScheduleRunner(options)
+			err := runner(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError("Invalid resume schedule: 'every morning' is not a valid cron expression"))
+			Expect(t.ApiServer.ReceivedRequests()).To(BeEmpty())
+		})
+
+		It("Fails if the cluster already has a schedule", func() {
+			cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.Properties(map[string]string{properties.HibernateSchedule: "0 19 * * *"})
+			})
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+			t.SetCluster("cluster", nil)
+
+			runner := CreateHibernationScheduleRunner(options)
+			err := runner(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError(ContainSubstring("Cluster 'cluster' already has a hibernation schedule")))
+		})
+
+		It("Stores the schedule in the properties of the cluster", func() {
+			cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.ID("123").State(cmv1.ClusterStateReady).Properties(map[string]string{"rosa_creator_arn": "arn"})
+			})
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})),
+				RespondWithJSON(http.StatusOK, `{"kind": "Account", "organization": {"id": "org"}}`),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "Organization",
+					"id": "org",
+					"capabilities": [{"name": "capability.organization.hibernate_cluster", "value": "true"}]
+				}`),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPatch, "/api/clusters_mgmt/v1/clusters/123"),
+					ghttp.VerifyJSON(`{
+						"kind": "Cluster",
+						"properties": {
+							"rosa_creator_arn": "arn",
+							"rosa_hibernate_schedule": "0 19 * * 1-5",
+							"rosa_resume_schedule": "0 7 * * 1-5",
+							"rosa_schedule_timezone": "Europe/Berlin"
+						}
+					}`),
+					RespondWithJSON(http.StatusOK, `{"kind": "Cluster", "id": "123"}`),
+				),
+			)
+			t.SetCluster("cluster", nil)
+
+			runner := CreateHibernationScheduleRunner(options)
+			t.StdOutReader.Record()
+			err := runner(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			stdout, _ := t.StdOutReader.Read()
+			Expect(stdout).To(ContainSubstring("Time zone:                  Europe/Berlin\n"))
+			Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(4))
+		})
+
+		It("Fails if hibernation isn't enabled for the organization", func() {
+			cluster := MockCluster(func(c *cmv1.ClusterBuilder) {})
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})),
+				RespondWithJSON(http.StatusOK, `{"kind": "Account", "organization": {"id": "org"}}`),
+				RespondWithJSON(http.StatusOK, `{"kind": "Organization", "id": "org"}`),
+			)
+			t.SetCluster("cluster", nil)
+
+			runner := CreateHibernationScheduleRunner(options)
+			err := runner(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError(
+				"The 'capability.organization.hibernate_cluster' capability is not set for current org"))
+		})
+	})
+})
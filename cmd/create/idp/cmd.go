@@ -18,7 +18,6 @@ package idp
 
 import (
 	"fmt"
-	"os"
 	"regexp"
 	"strconv"
 	"strings"
@@ -28,6 +27,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -332,12 +332,12 @@ func run(cmd *cobra.Command, _ []string) {
 	cluster := r.FetchCluster()
 	if cluster.State() != cmv1.ClusterStateReady {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if cluster.ExternalAuthConfig().Enabled() {
 		r.Reporter.Errorf("Adding IDP is not supported for clusters with external authentication configured.")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Grab all the IDP information interactively if necessary
@@ -364,12 +364,12 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid IdP type: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	if idpType == "" {
 		r.Reporter.Errorf("Expected a valid IDP type. Options are: %s", strings.Join(validIdps, ","))
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if idpType != "" {
@@ -381,7 +381,7 @@ func run(cmd *cobra.Command, _ []string) {
 		}
 		if !isValidIdp {
 			r.Reporter.Errorf("Expected a valid IDP type. Options are %s", validIdps)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -401,7 +401,7 @@ func run(cmd *cobra.Command, _ []string) {
 	err = ValidateIdpName(idpName)
 	if err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	var idpBuilder cmv1.IdentityProviderBuilder
@@ -414,7 +414,7 @@ func run(cmd *cobra.Command, _ []string) {
 		idpBuilder, err = buildGoogleIdp(cmd, cluster, idpName)
 	case "htpasswd":
 		createHTPasswdIDP(cmd, cluster, clusterKey, idpName, r)
-		os.Exit(0)
+		reporter.Exit(0)
 	case "ldap":
 		idpBuilder, err = buildLdapIdp(cmd, cluster, idpName)
 	case "openid":
@@ -422,7 +422,7 @@ func run(cmd *cobra.Command, _ []string) {
 	}
 	if err != nil {
 		r.Reporter.Errorf("Failed to create IDP for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	doCreateIDP(idpName, idpBuilder, cluster, clusterKey, r)
@@ -440,7 +440,7 @@ func getIDPName(cmd *cobra.Command, idpName string, r *rosa.Runtime) string {
 	})
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid name for the identity provider: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	return strings.Trim(idpName, " \t")
 }
@@ -473,13 +473,13 @@ func doCreateIDP(
 	idp, err := idpBuilder.Build()
 	if err != nil {
 		r.Reporter.Errorf("Failed to create IDP for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	createdIdp, err := r.OCMClient.CreateIdentityProvider(cluster.ID(), idp)
 	if err != nil {
 		r.Reporter.Errorf("Failed to add IDP to cluster '%s': %s", clusterKey, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	r.Reporter.Infof(
@@ -563,7 +563,7 @@ func getIdps(r *rosa.Runtime, cluster *cmv1.Cluster) []IdentityProvider {
 	ocmIdps, err := r.OCMClient.GetIdentityProviders(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get identity providers for cluster '%s': %v", cluster.ID(), err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	idps := []IdentityProvider{}
 	for _, idp := range ocmIdps {
@@ -27,6 +27,7 @@ import (
 	mpOpts "github.com/openshift/rosa/pkg/options/machinepool"
 	"github.com/openshift/rosa/pkg/properties"
 	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/wait"
 )
 
 type CreateMachinePoolSpec struct {
@@ -46,6 +47,7 @@ func NewCreateMachinePool(spec CreateMachinePoolSpec) CreateMachinePool {
 func NewCreateMachinePoolCommand() *cobra.Command {
 	cmd, options := mpOpts.BuildMachinePoolCreateCommandWithOptions()
 	cmd.Run = rosa.DefaultRunner(rosa.RuntimeWithOCM(), CreateMachinepoolRunner(options))
+	wait.AddFlags(cmd.Flags())
 	return cmd
 }
 
@@ -19,7 +19,6 @@ package oidcconfig
 import (
 	"bytes"
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
@@ -40,6 +39,7 @@ import (
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	interactiveRoles "github.com/openshift/rosa/pkg/interactive/roles"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -107,6 +107,7 @@ func init() {
 	)
 
 	interactive.AddModeFlag(Cmd)
+	interactive.AddManualFormatFlag(Cmd)
 
 	confirm.AddFlag(flags)
 	interactive.AddFlag(flags)
@@ -141,14 +142,14 @@ func run(cmd *cobra.Command, _ []string) {
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Get AWS region
 	region, err := aws.GetRegion(arguments.GetRegion())
 	if err != nil {
 		r.Reporter.Errorf("Error getting region: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	args.region = region
 
@@ -160,17 +161,17 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if args.rawFiles && mode != "" {
 		r.Reporter.Warnf("--%s param is not supported alongside --mode param.", rawFilesFlag)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if args.rawFiles && args.installerRoleArn != "" {
 		r.Reporter.Warnf("--%s param is not supported alongside --%s param", rawFilesFlag, InstallerRoleArnFlag)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if args.rawFiles && args.managed {
 		r.Reporter.Warnf("--%s param is not supported alongside --%s param", rawFilesFlag, managedFlag)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if !args.rawFiles && interactive.Enabled() && !cmd.Flags().Changed("mode") {
@@ -183,23 +184,29 @@ func run(cmd *cobra.Command, _ []string) {
 		mode, err = interactive.GetOptionMode(cmd, mode, question)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid %s: %s", question, err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
+	manualFormat, err := interactive.GetManualFormat(mode)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		reporter.Exit(1)
+	}
+
 	if output.HasFlag() && mode != "" && mode != interactive.ModeAuto {
 		r.Reporter.Warnf("--output param is not supported outside auto mode.")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if args.managed && args.userPrefix != "" {
 		r.Reporter.Warnf("--%s param is not supported for managed OIDC config", userPrefixFlag)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if args.managed && args.installerRoleArn != "" {
 		r.Reporter.Warnf("--%s param is not supported for managed OIDC config", InstallerRoleArnFlag)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if !args.managed {
@@ -227,7 +234,7 @@ func run(cmd *cobra.Command, _ []string) {
 				})
 				if err != nil {
 					r.Reporter.Errorf("Expected a valid prefix for the configuration: %s", err)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 				args.userPrefix = prefix
 			}
@@ -239,7 +246,7 @@ func run(cmd *cobra.Command, _ []string) {
 				err := aws.ARNValidator(args.installerRoleArn)
 				if err != nil {
 					r.Reporter.Errorf("Expected a valid ARN: %s", err)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 				roleExists, _, err := r.AWSClient.CheckRoleExists(roleName)
 				if err != nil {
@@ -248,17 +255,17 @@ func run(cmd *cobra.Command, _ []string) {
 						args.installerRoleArn,
 						err,
 					)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 				if !roleExists {
 					r.Reporter.Errorf("Role '%s' does not exist", args.installerRoleArn)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 				isValid, err := r.AWSClient.ValidateAccountRoleVersionCompatibility(
 					roleName, aws.InstallerAccountRole, MinorVersionForGetSecret)
 				if err != nil {
 					r.Reporter.Errorf("There was a problem listing role tags: %v", err)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 				if !isValid {
 					r.Reporter.Errorf(
@@ -266,7 +273,7 @@ func run(cmd *cobra.Command, _ []string) {
 						args.installerRoleArn,
 						MinorVersionForGetSecret,
 					)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 			}
 		}
@@ -276,7 +283,7 @@ func run(cmd *cobra.Command, _ []string) {
 		if len([]rune(args.userPrefix)) > maxLengthUserPrefix {
 			r.Reporter.Errorf("Expected a valid prefix for the configuration: "+
 				"length of prefix is limited to %d characters", maxLengthUserPrefix)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -285,14 +292,14 @@ func run(cmd *cobra.Command, _ []string) {
 		oidcConfigInput, err = oidcconfigs.BuildOidcConfigInput(args.userPrefix, args.region)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
-	oidcConfigStrategy, err := getOidcConfigStrategy(mode, &oidcConfigInput)
+	oidcConfigStrategy, err := getOidcConfigStrategy(mode, manualFormat, &oidcConfigInput)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	oidcConfigId := oidcConfigStrategy.execute(r)
 	if !args.rawFiles {
@@ -308,7 +315,7 @@ func run(cmd *cobra.Command, _ []string) {
 		} else {
 			r.Reporter.Errorf("Unable to attempt creation of OIDC provider; oidc config ID"+
 				" not found / not created successfully: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		oidcprovider.Cmd.Run(oidcprovider.Cmd, providerArgs)
 		arguments.DisableRegionDeprecationWarning = false // enable region deprecation again
@@ -332,19 +339,19 @@ func (s *CreateUnmanagedOidcConfigRawStrategy) execute(r *rosa.Runtime) string {
 	err := helper.SaveDocument(string(privateKey), privateKeyFilename)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem saving private key to a file: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	discoveryDocumentFilename := fmt.Sprintf("discovery-document-%s.json", bucketName)
 	err = helper.SaveDocument(discoveryDocument, discoveryDocumentFilename)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem saving discovery document to a file: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	jwksFilename := fmt.Sprintf("jwks-%s.json", bucketName)
 	err = helper.SaveDocument(string(jwks[:]), jwksFilename)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem saving JSON Web Key Set to a file: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if !output.HasFlag() && r.Reporter.IsTerminal() {
 		r.Reporter.Infof(
@@ -382,14 +389,14 @@ func (s *CreateUnmanagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) string
 	err := r.AWSClient.CreateS3Bucket(bucketName, args.region)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem creating S3 bucket '%s': %s", bucketName, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	err = r.AWSClient.PutPublicReadObjectInS3Bucket(
 		bucketName, strings.NewReader(discoveryDocument), discoveryDocumentKey)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem populating discovery "+
 			"document to S3 bucket '%s': %s", bucketName, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	err = r.AWSClient.PutPublicReadObjectInS3Bucket(bucketName, bytes.NewReader(jwks), jwksKey)
 	if err != nil {
@@ -398,12 +405,12 @@ func (s *CreateUnmanagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) string
 		}
 		r.Reporter.Errorf("There was a problem populating JWKS "+
 			"to S3 bucket '%s': %s", bucketName, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	secretARN, err := r.AWSClient.CreateSecretInSecretsManager(privateKeySecretName, string(privateKey[:]))
 	if err != nil {
 		r.Reporter.Errorf("There was a problem saving private key to secrets manager: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	oidcConfig, err := v1.NewOidcConfig().
 		Managed(false).
@@ -422,15 +429,15 @@ func (s *CreateUnmanagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) string
 			"Please refer to documentation and try again through:\n"+
 			"\trosa register oidc-config --issuer-url %s --secret-arn %s --role-arn %s",
 			err, bucketUrl, secretARN, installerRoleArn)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if output.HasFlag() {
 		err = output.Print(oidcConfig)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 	if r.Reporter.IsTerminal() {
 		if spin != nil {
@@ -443,11 +450,12 @@ func (s *CreateUnmanagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) string
 }
 
 type CreateUnmanagedOidcConfigManualStrategy struct {
-	oidcConfig *oidcconfigs.OidcConfigInput
+	oidcConfig   *oidcconfigs.OidcConfigInput
+	manualFormat awscb.Format
 }
 
 func (s *CreateUnmanagedOidcConfigManualStrategy) execute(r *rosa.Runtime) string {
-	commands := []string{}
+	commands := []*awscb.Operation{}
 	bucketName := s.oidcConfig.BucketName
 	discoveryDocument := s.oidcConfig.DiscoveryDocument
 	jwks := s.oidcConfig.Jwks
@@ -457,7 +465,7 @@ func (s *CreateUnmanagedOidcConfigManualStrategy) execute(r *rosa.Runtime) strin
 	err := helper.SaveDocument(string(privateKey), privateKeyFilename)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem saving private key to a file: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	createBucketConfig := ""
 	if args.region != aws.DefaultRegion {
@@ -468,14 +476,14 @@ func (s *CreateUnmanagedOidcConfigManualStrategy) execute(r *rosa.Runtime) strin
 		AddParam(awscb.Bucket, bucketName).
 		AddParam(awscb.CreateBucketConfiguration, createBucketConfig).
 		AddParam(awscb.Region, args.region).
-		Build()
+		BuildOperation()
 	commands = append(commands, createS3BucketCommand)
 
 	putBucketTaggingCommand := awscb.NewS3ApiCommandBuilder().
 		SetCommand(awscb.PutBucketTagging).
 		AddParam(awscb.Bucket, bucketName).
-		AddParam(awscb.Tagging, fmt.Sprintf("'TagSet=[{Key=%s,Value=%s}]'", tags.RedHatManaged, tags.True)).
-		Build()
+		AddTagging(map[string]string{tags.RedHatManaged: tags.True}).
+		BuildOperation()
 	commands = append(commands, putBucketTaggingCommand)
 
 	PutPublicAccessBlockCommand := awscb.NewS3ApiCommandBuilder().
@@ -483,67 +491,72 @@ func (s *CreateUnmanagedOidcConfigManualStrategy) execute(r *rosa.Runtime) strin
 		AddParam(awscb.Bucket, bucketName).
 		AddParam(awscb.PublicAccessBlockConfiguration,
 			"BlockPublicAcls=true,IgnorePublicAcls=true,BlockPublicPolicy=false,RestrictPublicBuckets=false").
-		Build()
+		BuildOperation()
 	commands = append(commands, PutPublicAccessBlockCommand)
 
 	readOnlyPolicyFilename := fmt.Sprintf("readOnlyPolicy-%s.json", bucketName)
 	err = helper.SaveDocument(fmt.Sprintf(aws.ReadOnlyAnonUserPolicyTemplate, bucketName), readOnlyPolicyFilename)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem saving bucket policy document to a file: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	putBucketBucketPolicyCommand := awscb.NewS3ApiCommandBuilder().
 		SetCommand(awscb.PutBucketPolicy).
 		AddParam(awscb.Bucket, bucketName).
 		AddParam(awscb.Policy, fmt.Sprintf("file://%s", readOnlyPolicyFilename)).
-		Build()
+		BuildOperation()
 	commands = append(commands, putBucketBucketPolicyCommand)
-	commands = append(commands, fmt.Sprintf("rm %s", readOnlyPolicyFilename))
+	commands = append(commands, awscb.NewShellOperation(fmt.Sprintf("rm %s", readOnlyPolicyFilename)))
 
 	discoveryDocumentFilename := fmt.Sprintf("discovery-document-%s.json", bucketName)
 	err = helper.SaveDocument(discoveryDocument, discoveryDocumentFilename)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem saving discovery document to a file: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	putDiscoveryDocumentCommand := awscb.NewS3ApiCommandBuilder().
 		SetCommand(awscb.PutObject).
 		AddParam(awscb.Body, fmt.Sprintf("./%s", discoveryDocumentFilename)).
 		AddParam(awscb.Bucket, bucketName).
 		AddParam(awscb.Key, discoveryDocumentKey).
-		AddParam(awscb.Tagging, fmt.Sprintf("'%s=%s'", tags.RedHatManaged, tags.True)).
-		Build()
+		AddTagging(map[string]string{tags.RedHatManaged: tags.True}).
+		BuildOperation()
 	commands = append(commands, putDiscoveryDocumentCommand)
-	commands = append(commands, fmt.Sprintf("rm %s", discoveryDocumentFilename))
+	commands = append(commands, awscb.NewShellOperation(fmt.Sprintf("rm %s", discoveryDocumentFilename)))
 	jwksFilename := fmt.Sprintf("jwks-%s.json", bucketName)
 	err = helper.SaveDocument(string(jwks[:]), jwksFilename)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem saving JSON Web Key Set to a file: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	putJwksCommand := awscb.NewS3ApiCommandBuilder().
 		SetCommand(awscb.PutObject).
 		AddParam(awscb.Body, fmt.Sprintf("./%s", jwksFilename)).
 		AddParam(awscb.Bucket, bucketName).
 		AddParam(awscb.Key, jwksKey).
-		AddParam(awscb.Tagging, fmt.Sprintf("'%s=%s'", tags.RedHatManaged, tags.True)).
-		Build()
+		AddTagging(map[string]string{tags.RedHatManaged: tags.True}).
+		BuildOperation()
 	commands = append(commands, putJwksCommand)
-	commands = append(commands, fmt.Sprintf("rm %s", jwksFilename))
+	commands = append(commands, awscb.NewShellOperation(fmt.Sprintf("rm %s", jwksFilename)))
 	createSecretCommand := awscb.NewSecretsManagerCommandBuilder().
 		SetCommand(awscb.CreateSecret).
 		AddParam(awscb.Name, privateKeySecretName).
 		AddParam(awscb.SecretString, fmt.Sprintf("file://%s", privateKeyFilename)).
-		AddParam(awscb.Description, fmt.Sprintf("\"Secret for %s\"", bucketName)).
+		AddQuotedParam(awscb.Description, fmt.Sprintf("Secret for %s", bucketName)).
 		AddParam(awscb.Region, args.region).
 		AddTags(map[string]string{
 			tags.RedHatManaged: "true",
 		}).
-		Build()
+		BuildOperation()
 	commands = append(commands, createSecretCommand)
-	commands = append(commands, fmt.Sprintf("rm %s", privateKeyFilename))
-	fmt.Println(awscb.JoinCommands(commands))
-	if r.Reporter.IsTerminal() {
+	commands = append(commands, awscb.NewShellOperation(fmt.Sprintf("rm %s", privateKeyFilename)))
+	rendered, err := awscb.RenderCommands(s.manualFormat, commands)
+	if err != nil {
+		r.Reporter.Errorf("There was a problem rendering the commands: %s", err)
+		reporter.Exit(1)
+	}
+	fmt.Println(rendered)
+	if r.Reporter.IsTerminal() && s.manualFormat == awscb.FormatShell {
 		r.Reporter.Infof("Please run commands above to generate OIDC compliant configuration in your AWS account. " +
 			"To register this OIDC Configuration, please run the following command:\n" +
 			"rosa register oidc-config\n" +
@@ -568,7 +581,7 @@ func (s *CreateManagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) string {
 	oidcConfig, err := v1.NewOidcConfig().Managed(true).Build()
 	if err != nil {
 		r.Reporter.Errorf("There was a problem building the managed OIDC Configuration: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	oidcConfig, err = r.OCMClient.CreateOidcConfig(oidcConfig)
 	if err != nil {
@@ -576,16 +589,16 @@ func (s *CreateManagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) string {
 			spin.Stop()
 		}
 		r.Reporter.Errorf("There was a problem registering your managed OIDC Configuration: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	s.oidcConfigInput.IssuerUrl = oidcConfig.IssuerUrl()
 	if output.HasFlag() {
 		err = output.Print(oidcConfig)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 	if r.Reporter.IsTerminal() {
 		if spin != nil {
@@ -597,7 +610,8 @@ func (s *CreateManagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) string {
 	return oidcConfig.ID()
 }
 
-func getOidcConfigStrategy(mode string, input *oidcconfigs.OidcConfigInput) (CreateOidcConfigStrategy, error) {
+func getOidcConfigStrategy(mode string, manualFormat awscb.Format,
+	input *oidcconfigs.OidcConfigInput) (CreateOidcConfigStrategy, error) {
 	if args.rawFiles {
 		return &CreateUnmanagedOidcConfigRawStrategy{oidcConfig: input}, nil
 	}
@@ -608,7 +622,7 @@ func getOidcConfigStrategy(mode string, input *oidcconfigs.OidcConfigInput) (Cre
 	case interactive.ModeAuto:
 		return &CreateUnmanagedOidcConfigAutoStrategy{oidcConfig: input}, nil
 	case interactive.ModeManual:
-		return &CreateUnmanagedOidcConfigManualStrategy{oidcConfig: input}, nil
+		return &CreateUnmanagedOidcConfigManualStrategy{oidcConfig: input, manualFormat: manualFormat}, nil
 	default:
 		return nil, weberr.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
 	}
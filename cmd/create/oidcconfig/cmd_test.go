@@ -0,0 +1,57 @@
+package oidcconfig
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/openshift-online/ocm-common/pkg/rosa/oidcconfigs"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Create managed OIDC config", func() {
+	var t *TestingRuntime
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+		output.SetOutput("json")
+		DeferCleanup(func() {
+			output.SetOutput("")
+		})
+	})
+
+	It("Calls the flush hooks when it exits after printing the configuration", func() {
+		exitCode := -1
+		previous := reporter.SetExit(func(code int) {
+			exitCode = code
+		})
+		DeferCleanup(func() {
+			reporter.SetExit(previous)
+		})
+		// The plan of a dry run is printed by a flush hook:
+		flushed := false
+		reporter.AddFlushHook(func() {
+			flushed = true
+		})
+		t.ApiServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/oidc_configs"),
+				RespondWithJSON(http.StatusCreated,
+					`{"id": "abcd", "issuer_url": "https://oidc.example.com/abcd", "managed": true}`),
+			),
+		)
+
+		strategy := &CreateManagedOidcConfigAutoStrategy{oidcConfigInput: &oidcconfigs.OidcConfigInput{}}
+		t.StdOutReader.Record()
+		strategy.execute(t.RosaRuntime)
+		stdout, _ := t.StdOutReader.Read()
+		Expect(stdout).To(ContainSubstring(`"id": "abcd"`))
+		Expect(exitCode).To(Equal(0))
+		Expect(flushed).To(BeTrue())
+	})
+})
@@ -18,7 +18,6 @@ package oidcprovider
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
@@ -32,6 +31,7 @@ import (
 	interactiveOidc "github.com/openshift/rosa/pkg/interactive/oidc"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -68,6 +68,7 @@ func init() {
 
 	ocm.AddOptionalClusterFlag(Cmd)
 	interactive.AddModeFlag(Cmd)
+	interactive.AddManualFormatFlag(Cmd)
 
 	confirm.AddFlag(flags)
 	interactive.AddFlag(flags)
@@ -97,13 +98,13 @@ func run(cmd *cobra.Command, argv []string) {
 	if cmd.Flag("cluster").Changed && cmd.Flag(OidcConfigIdFlag).Changed {
 		r.Reporter.Errorf("A cluster key for STS cluster and an OIDC Config ID " +
 			"cannot be specified alongside each other.")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Determine if interactive mode is needed
@@ -119,7 +120,7 @@ func run(cmd *cobra.Command, argv []string) {
 		cluster = r.FetchCluster()
 		if !ocm.IsSts(cluster) {
 			r.Reporter.Errorf("Cluster '%s' is not an STS cluster.", clusterKey)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -127,10 +128,16 @@ func run(cmd *cobra.Command, argv []string) {
 		mode, err = interactive.GetOptionMode(cmd, mode, "OIDC provider creation mode")
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid OIDC provider creation mode: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
+	manualFormat, err := interactive.GetManualFormat(mode)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		reporter.Exit(1)
+	}
+
 	oidcEndpointURL := ""
 	if cluster != nil {
 		oidcEndpointURL = cluster.AWS().STS().OIDCEndpointURL()
@@ -144,7 +151,7 @@ func run(cmd *cobra.Command, argv []string) {
 			oidcConfig, err := r.OCMClient.GetOidcConfig(args.oidcConfigId)
 			if err != nil {
 				r.Reporter.Errorf("There was a problem retrieving OIDC Config '%s': %v", args.oidcConfigId, err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			oidcEndpointURL = oidcConfig.IssuerUrl()
 		}
@@ -162,7 +169,7 @@ func run(cmd *cobra.Command, argv []string) {
 			r.Reporter.Debugf("Failed to verify if OIDC provider exists: %s", err)
 		} else {
 			r.Reporter.Errorf("Failed to verify if OIDC provider exists: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	if oidcProviderExists {
@@ -170,7 +177,7 @@ func run(cmd *cobra.Command, argv []string) {
 			cluster.AWS().STS().OidcConfig() != nil && !cluster.AWS().STS().OidcConfig().Reusable() {
 			r.Reporter.Warnf("Cluster '%s' already has OIDC provider but has not yet started installation. "+
 				"Verify that the cluster operator roles exist and are configured correctly.", clusterKey)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		// Returns so that when called from create cluster does not interrupt flow
 		r.Reporter.Infof("OIDC provider already exists")
@@ -187,7 +194,7 @@ func run(cmd *cobra.Command, argv []string) {
 			confirmPromptMessage = fmt.Sprintf("Create the OIDC provider for cluster '%s'?", clusterKey)
 		}
 		if !confirm.Prompt(true, confirmPromptMessage) {
-			os.Exit(0)
+			reporter.Exit(0)
 		}
 		if clusterId == "" && clusterKey != "" {
 			clusterId = r.FetchCluster().ID()
@@ -199,23 +206,23 @@ func run(cmd *cobra.Command, argv []string) {
 				ocm.ClusterID: clusterKey,
 				ocm.Response:  ocm.Failure,
 			})
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		r.OCMClient.LogEvent("ROSACreateOIDCProviderModeAuto", map[string]string{
 			ocm.ClusterID: clusterKey,
 			ocm.Response:  ocm.Success,
 		})
 	case interactive.ModeManual:
-		commands, err := buildCommands(r, oidcEndpointURL, clusterId)
+		commands, err := buildCommands(r, oidcEndpointURL, clusterId, manualFormat)
 		if err != nil {
 			r.Reporter.Errorf("There was an error building the list of resources: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 			r.OCMClient.LogEvent("ROSACreateOIDCProviderModeManual", map[string]string{
 				ocm.ClusterID: clusterKey,
 				ocm.Response:  ocm.Failure,
 			})
 		}
-		if r.Reporter.IsTerminal() {
+		if r.Reporter.IsTerminal() && manualFormat == awscb.FormatShell {
 			r.Reporter.Infof("Run the following commands to create the OIDC provider:\n")
 		}
 		r.OCMClient.LogEvent("ROSACreateOIDCProviderModeManual", map[string]string{
@@ -224,7 +231,7 @@ func run(cmd *cobra.Command, argv []string) {
 		fmt.Println(commands)
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 }
 
@@ -256,8 +263,9 @@ func createProvider(r *rosa.Runtime, oidcEndpointUrl string, clusterId string, i
 	return nil
 }
 
-func buildCommands(r *rosa.Runtime, oidcEndpointUrl string, clusterId string) (string, error) {
-	commands := []string{}
+func buildCommands(r *rosa.Runtime, oidcEndpointUrl string, clusterId string,
+	manualFormat awscb.Format) (string, error) {
+	commands := []*awscb.Operation{}
 
 	input, err := cmv1.NewOidcThumbprintInput().OidcConfigId(args.oidcConfigId).ClusterId(clusterId).Build()
 	if err != nil {
@@ -287,8 +295,8 @@ func buildCommands(r *rosa.Runtime, oidcEndpointUrl string, clusterId string) (s
 		AddParam(awscb.ClientIdList, clientIdList).
 		AddParam(awscb.ThumbprintList, thumbprint.Thumbprint()).
 		AddTags(iamTags).
-		Build()
+		BuildOperation()
 	commands = append(commands, createOpenIDConnectProvider)
 
-	return awscb.JoinCommands(commands), nil
+	return awscb.RenderCommands(manualFormat, commands)
 }
@@ -2,7 +2,6 @@ package operatorroles
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	common "github.com/openshift-online/ocm-common/pkg/aws/validations"
@@ -15,18 +14,19 @@ import (
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
 func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
-	permissionsBoundary string, mode string,
+	permissionsBoundary string, mode string, manualFormat awscb.Format,
 	policies map[string]*cmv1.AWSSTSPolicy,
 	defaultPolicyVersion string) error {
 	clusterKey := r.GetClusterKey()
 	cluster := r.FetchCluster()
 	if cluster.AWS().STS().RoleARN() == "" {
 		r.Reporter.Errorf("Cluster '%s' is not an STS cluster.", clusterKey)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Check to see if IAM operator roles have already created
@@ -36,7 +36,7 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 			r.Reporter.Debugf("Failed to verify if operator roles exist: '%v'", err)
 		} else {
 			r.Reporter.Errorf("Failed to verify if operator roles exist: '%v'", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -50,13 +50,13 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 	credRequests, err := r.OCMClient.GetCredRequests(cluster.Hypershift().Enabled())
 	if err != nil {
 		r.Reporter.Errorf("Error getting operator credential request from OCM '%v'", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	managedPolicies := cluster.AWS().STS().ManagedPolicies()
 	if args.forcePolicyCreation && managedPolicies {
 		r.Reporter.Warnf("Forcing creation of policies only works for unmanaged policies")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	switch mode {
@@ -78,13 +78,13 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 		roleName, err := aws.GetInstallerAccountRoleName(cluster)
 		if err != nil {
 			r.Reporter.Errorf("Expected parsing role account role '%s': '%v'", cluster.AWS().STS().RoleARN(), err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		path, err := aws.GetPathFromAccountRole(cluster, aws.AccountRoles[aws.InstallerAccountRole].Name)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid path for '%s': '%v'", cluster.AWS().STS().RoleARN(), err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		if path != "" && !output.HasFlag() && r.Reporter.IsTerminal() {
 			r.Reporter.Infof("ARN path '%s' detected in installer role '%s'. "+
@@ -99,7 +99,7 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 		accountRoleVersion, err = r.AWSClient.GetAccountRoleVersion(roleName)
 		if err != nil {
 			r.Reporter.Errorf("Error getting account role version '%v'", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		err = createRoles(r, operatorRolePolicyPrefix, permissionsBoundary, cluster,
 			accountRoleVersion, policies, defaultPolicyVersion, credRequests, managedPolicies, hostedCPPolicies)
@@ -114,7 +114,7 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 				ocm.Response:   ocm.Failure,
 				ocm.IsThrottle: isThrottle,
 			})
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		r.OCMClient.LogEvent("ROSACreateOperatorRolesModeAuto", map[string]string{
 			ocm.ClusterID: clusterKey,
@@ -122,16 +122,16 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 		})
 	case interactive.ModeManual:
 		commands, err := buildCommands(r, env, operatorRolePolicyPrefix, permissionsBoundary, defaultPolicyVersion,
-			cluster, policies, credRequests, managedPolicies, hostedCPPolicies)
+			cluster, policies, credRequests, managedPolicies, hostedCPPolicies, manualFormat)
 		if err != nil {
 			r.Reporter.Errorf("There was an error building the list of resources: '%v'", err)
-			os.Exit(1)
+			reporter.Exit(1)
 			r.OCMClient.LogEvent("ROSACreateOperatorRolesModeManual", map[string]string{
 				ocm.ClusterID: clusterKey,
 				ocm.Response:  ocm.Failure,
 			})
 		}
-		if r.Reporter.IsTerminal() {
+		if r.Reporter.IsTerminal() && manualFormat == awscb.FormatShell {
 			r.Reporter.Infof("All policy files saved to the current directory")
 			r.Reporter.Infof("Run the following commands to create the operator roles:\n")
 		}
@@ -142,7 +142,7 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are '%s'", interactive.Modes)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	return nil
 }
@@ -168,7 +168,7 @@ func createRoles(
 			isSupported, err := ocm.CheckSupportedVersion(ocm.GetVersionMinor(ver.ID()), operator.MinVersion())
 			if err != nil {
 				r.Reporter.Errorf("Error validating operator role '%s' version %s", operator.Name(), err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			if !isSupported {
 				continue
@@ -275,7 +275,7 @@ func createRoles(
 func buildCommands(r *rosa.Runtime, env string,
 	prefix string, permissionsBoundary string, defaultPolicyVersion string, cluster *cmv1.Cluster,
 	policies map[string]*cmv1.AWSSTSPolicy, credRequests map[string]*cmv1.STSOperator,
-	managedPolicies bool, hostedCPPolicies bool) (string, error) {
+	managedPolicies bool, hostedCPPolicies bool, manualFormat awscb.Format) (string, error) {
 	sharedVpcRoleArn := cluster.AWS().PrivateHostedZoneRoleARN()
 	isSharedVpc := sharedVpcRoleArn != ""
 
@@ -283,11 +283,11 @@ func buildCommands(r *rosa.Runtime, env string,
 		err := aws.GenerateOperatorRolePolicyFiles(r.Reporter, policies, credRequests, sharedVpcRoleArn, r.Creator.Partition)
 		if err != nil {
 			r.Reporter.Errorf("There was an error generating the policy files: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
-	commands := []string{}
+	commands := []*awscb.Operation{}
 
 	for credrequest, operator := range credRequests {
 		ver := cluster.Version()
@@ -295,7 +295,7 @@ func buildCommands(r *rosa.Runtime, env string,
 			isSupported, err := ocm.CheckSupportedVersion(ocm.GetVersionMinor(ver.ID()), operator.MinVersion())
 			if err != nil {
 				r.Reporter.Errorf("Error validating operator role '%s' version %s", operator.Name(), err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			if !isSupported {
 				continue
@@ -334,7 +334,7 @@ func buildCommands(r *rosa.Runtime, env string,
 					AddParam(awscb.PolicyDocument, fileName).
 					AddTags(iamTags).
 					AddParam(awscb.Path, path).
-					Build()
+					BuildOperation()
 				commands = append(commands, createPolicy)
 			} else if isSharedVpc && credrequest == aws.IngressOperatorCloudCredentialsRoleType {
 				err := validateIngressOperatorPolicyOverride(r, policyARN, sharedVpcRoleArn, prefix)
@@ -347,7 +347,7 @@ func buildCommands(r *rosa.Runtime, env string,
 					AddParam(awscb.PolicyArn, policyARN).
 					AddParam(awscb.PolicyDocument, fileName).
 					AddParamNoValue(awscb.SetAsDefault).
-					Build()
+					BuildOperation()
 				commands = append(commands, createPolicyVersion)
 			}
 		}
@@ -387,16 +387,16 @@ func buildCommands(r *rosa.Runtime, env string,
 			AddParam(awscb.PermissionsBoundary, permissionsBoundary).
 			AddTags(iamTags).
 			AddParam(awscb.Path, path).
-			Build()
+			BuildOperation()
 
 		attachRolePolicy := awscb.NewIAMCommandBuilder().
 			SetCommand(awscb.AttachRolePolicy).
 			AddParam(awscb.RoleName, roleName).
 			AddParam(awscb.PolicyArn, policyARN).
-			Build()
+			BuildOperation()
 		commands = append(commands, createRole, attachRolePolicy)
 	}
-	return awscb.JoinCommands(commands), nil
+	return awscb.RenderCommands(manualFormat, commands)
 }
 
 func validateOperatorRoles(r *rosa.Runtime, cluster *cmv1.Cluster) ([]string, error) {
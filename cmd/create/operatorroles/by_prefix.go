@@ -3,7 +3,6 @@ package operatorroles
 import (
 	"fmt"
 	"net/url"
-	"os"
 	"strings"
 
 	awserr "github.com/openshift-online/ocm-common/pkg/aws/errors"
@@ -20,6 +19,7 @@ import (
 	interactiveRoles "github.com/openshift/rosa/pkg/interactive/roles"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -37,7 +37,7 @@ func handleOperatorRolesPrefixOptions(r *rosa.Runtime, cmd *cobra.Command) {
 	})
 	if err != nil {
 		r.Reporter.Errorf("Expected a prefix for the operator IAM roles: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	args.prefix = operatorRolesPrefix
 
@@ -47,7 +47,7 @@ func handleOperatorRolesPrefixOptions(r *rosa.Runtime, cmd *cobra.Command) {
 
 	if cmd.Flags().Changed("hosted-cp") && r.Creator.IsGovcloud {
 		r.Reporter.Errorf("Setting `hosted-cp` is not supported for Govcloud AWS accounts")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	isHostedCP := args.hostedCp
@@ -62,7 +62,7 @@ func handleOperatorRolesPrefixOptions(r *rosa.Runtime, cmd *cobra.Command) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid --hosted-cp value: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	args.hostedCp = isHostedCP
@@ -76,13 +76,13 @@ func handleOperatorRolesPrefixOptions(r *rosa.Runtime, cmd *cobra.Command) {
 }
 
 func handleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
-	permissionsBoundary string, mode string,
+	permissionsBoundary string, mode string, manualFormat awscb.Format,
 	policies map[string]*cmv1.AWSSTSPolicy,
 	defaultPolicyVersion string) error {
 	oidcConfig, err := r.OCMClient.GetOidcConfig(args.oidcConfigId)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem retrieving OIDC Config '%s': %v", args.oidcConfigId, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	includeHostedCpSet := args.hostedCp
 	operatorRolesPrefix := args.prefix
@@ -95,12 +95,12 @@ func handleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 	installerRoleName, err := aws.GetResourceIdFromARN(installerRoleArn)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	path, err := aws.GetPathFromARN(installerRoleArn)
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid path for '%s': %v", installerRoleArn, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if path != "" && !output.HasFlag() && r.Reporter.IsTerminal() {
 		r.Reporter.Infof("ARN path '%s' detected in installer role '%s'. "+
@@ -112,35 +112,35 @@ func handleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 		aws.AccountRoles[aws.InstallerAccountRole].Name)
 	if !hasStandardNamedInstallerRole {
 		r.Reporter.Infof("Can only use installer roles created through ROSA CLI for this flow.")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	operatorRolePolicyPrefix := installerRolePrefix
 	credRequests, err := r.OCMClient.GetCredRequests(includeHostedCpSet)
 	if err != nil {
 		r.Reporter.Errorf("Error getting operator credential request from OCM %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	managedPolicies, err := r.AWSClient.HasManagedPolicies(installerRoleArn)
 	if err != nil {
 		r.Reporter.Errorf("Failed to determine if cluster has managed policies: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if managedPolicies && sharedVpcRoleArn != "" {
 		r.Reporter.Errorf("Installer role '%s' has managed policies, the 'shared-vpc-role-arn' flag is not "+
 			"supported for managed policies", installerRoleArn)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	awsCreator, err := r.AWSClient.GetCreator()
 	if err != nil {
 		r.Reporter.Errorf("Unable to get IAM credentials: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	operatorIAMRoleList, err := convertCredRequestsOperatorRolesIntoV1OperatorIAMRole(credRequests,
 		args.prefix, awsCreator, path)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	var hostedCPPolicies bool
@@ -148,27 +148,27 @@ func handleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 		hostedCPPolicies, err = r.AWSClient.HasHostedCPPolicies(args.installerRoleArn)
 		if err != nil {
 			r.Reporter.Errorf("Failed to determine if the Installer role ARN has hosted CP policies: %v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		if !hostedCPPolicies {
 			r.Reporter.Errorf(
 				"Failed to create the operator role since the Installer role ARN '%v' does not have managed policies",
 				args.installerRoleArn)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
 	operatorRolesList, err := convertV1OperatorIAMRoleIntoOcmOperatorIamRole(operatorIAMRoleList)
 	if err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	err = ocm.ValidateOperatorRolesMatchOidcProvider(r.Reporter, r.AWSClient,
 		operatorRolesList, oidcConfig.IssuerUrl(), "4.0", path, managedPolicies, true)
 	if err != nil && !awserr.IsNoSuchEntityException(err) {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	switch mode {
@@ -192,7 +192,7 @@ func handleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 				ocm.Response:            ocm.Failure,
 				ocm.IsThrottle:          isThrottle,
 			})
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		if r.Reporter.IsTerminal() {
 			hostedCpOutputParam := ""
@@ -217,16 +217,16 @@ func handleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 			defaultPolicyVersion, policies,
 			credRequests, managedPolicies,
 			path, operatorIAMRoleList,
-			oidcEndpointUrl, hostedCPPolicies, sharedVpcRoleArn)
+			oidcEndpointUrl, hostedCPPolicies, sharedVpcRoleArn, manualFormat)
 		if err != nil {
 			r.Reporter.Errorf("There was an error building the list of resources: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 			r.OCMClient.LogEvent("ROSACreateOperatorRolesModeManual", map[string]string{
 				ocm.OperatorRolesPrefix: operatorRolesPrefix,
 				ocm.Response:            ocm.Failure,
 			})
 		}
-		if r.Reporter.IsTerminal() {
+		if r.Reporter.IsTerminal() && manualFormat == awscb.FormatShell {
 			r.Reporter.Infof("All policy files saved to the current directory")
 			r.Reporter.Infof("Run the following commands to create the operator roles:\n")
 		}
@@ -236,7 +236,7 @@ func handleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 		fmt.Println(commands)
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	return nil
 }
@@ -263,29 +263,29 @@ func validateArgumentsOperatorRolesCreationByPrefix(r *rosa.Runtime, operatorRol
 	oidcEndpointUrl string, installerRoleArn string) {
 	if len(operatorRolesPrefix) == 0 {
 		r.Reporter.Errorf("Expected a prefix for the operator IAM roles")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if len(operatorRolesPrefix) > 32 {
 		r.Reporter.Errorf("Expected a prefix with no more than 32 characters")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if !aws.RoleNameRE.MatchString(operatorRolesPrefix) {
 		r.Reporter.Errorf("Expected valid operator roles prefix matching %s", aws.RoleNameRE.String())
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	parsedURI, err := url.ParseRequestURI(oidcEndpointUrl)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if parsedURI.Scheme != helper.ProtocolHttps {
 		r.Reporter.Errorf("Expected OIDC endpoint URL '%s' to use an https:// scheme", oidcEndpointUrl)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	err = aws.ARNValidator(installerRoleArn)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 }
 
@@ -396,17 +396,18 @@ func buildCommandsFromPrefix(r *rosa.Runtime, env string,
 	policies map[string]*cmv1.AWSSTSPolicy, credRequests map[string]*cmv1.STSOperator,
 	managedPolicies bool, path string,
 	operatorIAMRoleList []*cmv1.OperatorIAMRole,
-	oidcEndpointUrl string, hostedCPPolicies bool, sharedVpcRoleArn string) (string, error) {
+	oidcEndpointUrl string, hostedCPPolicies bool, sharedVpcRoleArn string,
+	manualFormat awscb.Format) (string, error) {
 	if !managedPolicies {
 		err := aws.GenerateOperatorRolePolicyFiles(r.Reporter, policies, credRequests, sharedVpcRoleArn, r.Creator.Partition)
 		if err != nil {
 			r.Reporter.Errorf("There was an error generating the policy files: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
 	isSharedVpc := sharedVpcRoleArn != ""
-	commands := []string{}
+	commands := []*awscb.Operation{}
 
 	for credrequest, operator := range credRequests {
 		roleArn := aws.FindOperatorRoleBySTSOperator(operatorIAMRoleList, operator)
@@ -442,7 +443,7 @@ func buildCommandsFromPrefix(r *rosa.Runtime, env string,
 					AddParam(awscb.PolicyDocument, fileName).
 					AddTags(iamTags).
 					AddParam(awscb.Path, path).
-					Build()
+					BuildOperation()
 				commands = append(commands, createPolicy)
 			} else if isSharedVpc && credrequest == aws.IngressOperatorCloudCredentialsRoleType {
 				err := validateIngressOperatorPolicyOverride(r, policyARN, sharedVpcRoleArn, prefix)
@@ -455,7 +456,7 @@ func buildCommandsFromPrefix(r *rosa.Runtime, env string,
 					AddParam(awscb.PolicyArn, policyARN).
 					AddParam(awscb.PolicyDocument, fileName).
 					AddParamNoValue(awscb.SetAsDefault).
-					Build()
+					BuildOperation()
 				commands = append(commands, createPolicyVersion)
 			}
 		}
@@ -492,14 +493,14 @@ func buildCommandsFromPrefix(r *rosa.Runtime, env string,
 			AddParam(awscb.PermissionsBoundary, permissionsBoundary).
 			AddTags(iamTags).
 			AddParam(awscb.Path, path).
-			Build()
+			BuildOperation()
 
 		attachRolePolicy := awscb.NewIAMCommandBuilder().
 			SetCommand(awscb.AttachRolePolicy).
 			AddParam(awscb.RoleName, roleName).
 			AddParam(awscb.PolicyArn, policyARN).
-			Build()
+			BuildOperation()
 		commands = append(commands, createRole, attachRolePolicy)
 	}
-	return awscb.JoinCommands(commands), nil
+	return awscb.RenderCommands(manualFormat, commands)
 }
@@ -17,8 +17,6 @@ limitations under the License.
 package operatorroles
 
 import (
-	"os"
-
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
@@ -27,6 +25,7 @@ import (
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -130,6 +129,7 @@ func init() {
 	flags.MarkHidden("channel-group")
 
 	interactive.AddModeFlag(Cmd)
+	interactive.AddManualFormatFlag(Cmd)
 	confirm.AddFlag(flags)
 	interactive.AddFlag(flags)
 }
@@ -154,13 +154,13 @@ func run(cmd *cobra.Command, argv []string) {
 	env, err := ocm.GetEnv()
 	if err != nil {
 		r.Reporter.Errorf("Failed to determine OCM environment: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Determine if interactive mode is needed
@@ -170,30 +170,30 @@ func run(cmd *cobra.Command, argv []string) {
 
 	if !cmd.Flag("cluster").Changed && !cmd.Flag(PrefixFlag).Changed && !isProgmaticallyCalled {
 		r.Reporter.Errorf("Either a cluster key for STS cluster or an operator roles prefix must be specified.")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if cmd.Flag("cluster").Changed && cmd.Flag(PrefixFlag).Changed {
 		r.Reporter.Errorf("A cluster key for STS cluster and an operator roles prefix " +
 			"cannot be specified alongside each other.")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if cmd.Flag("cluster").Changed && cmd.Flag(OidcConfigIdFlag).Changed {
 		r.Reporter.Errorf("A cluster key for STS cluster and an OIDC configuration ID " +
 			"cannot be specified alongside each other.")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if !args.hostedCp && args.installerRoleArn != "" {
 		managedPolicies, err := r.AWSClient.HasManagedPolicies(args.installerRoleArn)
 		if err != nil {
 			r.Reporter.Errorf("Failed to determine if cluster has managed policies: %v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		if managedPolicies {
 			r.Reporter.Errorf("The managed policies are not supported for classic operator-roles.")
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -204,17 +204,23 @@ func run(cmd *cobra.Command, argv []string) {
 
 	if args.forcePolicyCreation && mode != interactive.ModeAuto {
 		r.Reporter.Warnf("Forcing creation of policies only works in auto mode")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if interactive.Enabled() && !isProgmaticallyCalled {
 		mode, err = interactive.GetOptionMode(cmd, mode, "Role creation mode")
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
+	manualFormat, err := interactive.GetManualFormat(mode)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		reporter.Exit(1)
+	}
+
 	if cluster == nil && interactive.Enabled() && !isProgmaticallyCalled {
 		handleOperatorRolesPrefixOptions(r, cmd)
 	}
@@ -231,7 +237,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid policy ARN for permissions boundary: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -239,50 +245,50 @@ func run(cmd *cobra.Command, argv []string) {
 		err = aws.ARNValidator(permissionsBoundary)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid policy ARN for permissions boundary: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
 	policies, err := r.OCMClient.GetPolicies("OperatorRole")
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if args.prefix != "" {
 		if args.oidcConfigId == "" {
 			r.Reporter.Errorf("%s is mandatory for %s param flow.", OidcConfigIdFlag, PrefixFlag)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		if args.installerRoleArn == "" {
 			r.Reporter.Errorf("%s is mandatory for %s param flow.", InstallerRoleArnFlag, PrefixFlag)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		channelGroup := args.channelGroup
 		latestPolicyVersion, err := r.OCMClient.GetLatestVersion(channelGroup)
 		if err != nil {
 			r.Reporter.Errorf("Error getting latest version: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		err = handleOperatorRoleCreationByPrefix(r, env, permissionsBoundary,
-			mode, policies, latestPolicyVersion)
+			mode, manualFormat, policies, latestPolicyVersion)
 		if err != nil {
 			r.Reporter.Errorf("Error creating operator roles: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		return
 	}
 	latestPolicyVersion, err := r.OCMClient.GetLatestVersion(cluster.Version().ChannelGroup())
 	if err != nil {
 		r.Reporter.Errorf("Error getting latest version: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	err = handleOperatorRoleCreationByClusterKey(r, env, permissionsBoundary,
-		mode, policies, latestPolicyVersion)
+		mode, manualFormat, policies, latestPolicyVersion)
 	if err != nil {
 		r.Reporter.Errorf("Error creating operator roles: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 }
 
@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accessrequest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/accesstransparency/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use     = "access-request"
+	short   = "Show details of an access request"
+	long    = "Show the requester, justification, duration and decision history of an access request."
+	example = `  # Describe the access request with identifier '123'
+  rosa describe access-request --id 123`
+)
+
+var aliases = []string{"accessrequest"}
+
+type Options struct {
+	id string
+}
+
+func NewDescribeAccessRequestOptions() *Options {
+	return &Options{}
+}
+
+func NewDescribeAccessRequestCommand() *cobra.Command {
+	options := NewDescribeAccessRequestOptions()
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), DescribeAccessRequestRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(
+		&options.id,
+		"id",
+		"",
+		"Identifier of the access request (required).",
+	)
+	cmd.MarkFlagRequired("id")
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func DescribeAccessRequestRunner(options *Options) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
+		accessRequest, err := r.OCMClient.GetAccessRequest(options.id)
+		if err != nil {
+			return fmt.Errorf("Failed to get access request '%s': %w", options.id, err)
+		}
+		if output.HasFlag() {
+			return output.Print(accessRequest)
+		}
+		fmt.Print(printAccessRequest(accessRequest))
+		return nil
+	}
+}
+
+func printAccessRequest(accessRequest *v1.AccessRequest) string {
+	result := fmt.Sprintf(""+
+		"ID:                         %s\n"+
+		"Cluster ID:                 %s\n"+
+		"Subscription ID:            %s\n"+
+		"Status:                     %s\n"+
+		"Requested by:               %s\n"+
+		"Justification:              %s\n"+
+		"Support case:               %s\n"+
+		"Duration:                   %s\n"+
+		"Deadline:                   %s\n"+
+		"Created:                    %s\n"+
+		"Expires:                    %s\n",
+		accessRequest.ID(),
+		accessRequest.ClusterId(),
+		accessRequest.SubscriptionId(),
+		accessRequest.Status().State(),
+		accessRequest.RequestedBy(),
+		accessRequest.Justification(),
+		accessRequest.SupportCaseId(),
+		accessRequest.Duration(),
+		formatTime(accessRequest.DeadlineAt()),
+		formatTime(accessRequest.CreatedAt()),
+		formatTime(accessRequest.Status().ExpiresAt()),
+	)
+
+	decisions := accessRequest.Decisions()
+	if len(decisions) == 0 {
+		return result + "Decisions:                  none\n"
+	}
+	var builder strings.Builder
+	builder.WriteString(result)
+	builder.WriteString("Decisions:\n")
+	for _, decision := range decisions {
+		builder.WriteString(fmt.Sprintf(" - %s %s by %s", formatTime(decision.CreatedAt()),
+			decision.Decision(), decision.DecidedBy()))
+		if decision.Justification() != "" {
+			builder.WriteString(fmt.Sprintf(": %s", decision.Justification()))
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+func formatTime(value time.Time) string {
+	if value.IsZero() {
+		return ""
+	}
+	return value.Format(time.RFC3339)
+}
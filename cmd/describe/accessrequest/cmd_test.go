@@ -0,0 +1,90 @@
+package accessrequest
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	v1 "github.com/openshift-online/ocm-sdk-go/accesstransparency/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Describe access request", func() {
+	var t *TestingRuntime
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+		output.SetOutput("")
+	})
+
+	AfterEach(func() {
+		output.SetOutput("")
+	})
+
+	It("Correctly builds the command", func() {
+		cmd := NewDescribeAccessRequestCommand()
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Flags().Lookup("id")).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("output")).NotTo(BeNil())
+	})
+
+	It("Prints the details and the decisions", func() {
+		created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		accessRequest, err := v1.NewAccessRequest().
+			ID("abc").
+			ClusterId("123").
+			SubscriptionId("456").
+			RequestedBy("sre@redhat.com").
+			Justification("Investigating an alert").
+			SupportCaseId("789").
+			Duration("1h").
+			DeadlineAt(created.Add(30 * time.Minute)).
+			CreatedAt(created).
+			Status(v1.NewAccessRequestStatus().State(v1.AccessRequestStateApproved).ExpiresAt(created.Add(time.Hour))).
+			Decisions(v1.NewDecision().
+				Decision(v1.DecisionDecisionApproved).
+				DecidedBy("oncall@example.com").
+				Justification("Known incident").
+				CreatedAt(created.Add(10 * time.Minute))).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		t.ApiServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/access_transparency/v1/access_requests/abc"),
+				RespondWithJSON(http.StatusOK, FormatResource(accessRequest)),
+			),
+		)
+
+		t.StdOutReader.Record()
+		err = DescribeAccessRequestRunner(&Options{id: "abc"})(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		stdout, _ := t.StdOutReader.Read()
+		Expect(stdout).To(Equal("" +
+			"ID:                         abc\n" +
+			"Cluster ID:                 123\n" +
+			"Subscription ID:            456\n" +
+			"Status:                     Approved\n" +
+			"Requested by:               sre@redhat.com\n" +
+			"Justification:              Investigating an alert\n" +
+			"Support case:               789\n" +
+			"Duration:                   1h\n" +
+			"Deadline:                   2024-05-01T12:30:00Z\n" +
+			"Created:                    2024-05-01T12:00:00Z\n" +
+			"Expires:                    2024-05-01T13:00:00Z\n" +
+			"Decisions:\n" +
+			" - 2024-05-01T12:10:00Z Approved by oncall@example.com: Known incident\n"))
+	})
+
+	It("Fails if the access request doesn't exist", func() {
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusNotFound, `{"kind": "Error", "reason": "Not found"}`))
+		err := DescribeAccessRequestRunner(&Options{id: "abc"})(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Failed to get access request 'abc'"))
+	})
+})
@@ -20,7 +20,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"os"
 	"strconv"
 	"strings"
 
@@ -30,9 +29,11 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clusterspec"
 	"github.com/openshift/rosa/pkg/helper/rolepolicybindings"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -49,9 +50,16 @@ const (
 var Cmd = &cobra.Command{
 	Use:   "cluster",
 	Short: "Show details of a cluster",
-	Long:  "Show details of a cluster",
+	Long: "Show details of a cluster.\n\n" +
+		"With '--output spec' the cluster is printed as a spec file for 'rosa create cluster --from-file'. " +
+		"The spec doesn't include the cluster admin password, the contents of the trust bundles, nor the " +
+		"mode used to create the operator roles.",
 	Example: `  # Describe a cluster named "mycluster"
-  rosa describe cluster --cluster=mycluster`,
+  rosa describe cluster --cluster=mycluster
+
+  # Save the spec of a cluster named "mycluster" so that it can be recreated with
+  # 'rosa create cluster --from-file'
+  rosa describe cluster --cluster=mycluster --output spec > mycluster.yaml`,
 	Run:  run,
 	Args: cobra.MaximumNArgs(1),
 }
@@ -61,7 +69,7 @@ var args struct {
 }
 
 func init() {
-	output.AddFlag(Cmd)
+	output.AddFlagWithFormats(Cmd, clusterspec.OutputFormat)
 	ocm.AddClusterFlag(Cmd)
 
 	Cmd.Flags().BoolVar(
@@ -87,6 +95,21 @@ func run(cmd *cobra.Command, argv []string) {
 	cluster := r.FetchCluster()
 	isHypershift := cluster.Hypershift().Enabled()
 
+	if output.Output() == clusterspec.OutputFormat {
+		clusterSpec := clusterspec.FromCluster(cluster)
+		// The default ingress and the cluster autoscaler can only be set when creating classic clusters:
+		if !isHypershift {
+			addClassicSpec(r, cluster, clusterSpec)
+		}
+		spec, err := clusterspec.Marshal(clusterSpec)
+		if err != nil {
+			r.Reporter.Errorf("Failed to generate spec for cluster '%s': %v", clusterKey, err)
+			reporter.Exit(1)
+		}
+		fmt.Print(string(spec))
+		return
+	}
+
 	displayName := ""
 	subscription, subscriptionExists, err := r.OCMClient.GetSubscriptionBySubscriptionID(cluster.Subscription().ID())
 	if err != nil {
@@ -104,19 +127,19 @@ func run(cmd *cobra.Command, argv []string) {
 		scheduledUpgrade, upgradeState, err = r.OCMClient.GetScheduledUpgrade(cluster.ID())
 		if err != nil {
 			r.Reporter.Errorf("Failed to get scheduled upgrades for cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		if output.HasFlag() {
 			f, err := formatCluster(cluster, scheduledUpgrade, upgradeState, displayName)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			err = output.Print(f)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			return
 		}
@@ -124,19 +147,19 @@ func run(cmd *cobra.Command, argv []string) {
 		controlPlaneScheduledUpgrade, err = r.OCMClient.GetControlPlaneScheduledUpgrade(cluster.ID())
 		if err != nil {
 			r.Reporter.Errorf("Failed to get scheduled upgrades for cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		if output.HasFlag() {
 			f, err := formatClusterHypershift(cluster, controlPlaneScheduledUpgrade, displayName)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			err = output.Print(f)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			return
 		}
@@ -146,7 +169,7 @@ func run(cmd *cobra.Command, argv []string) {
 	creatorARN, err := arn.Parse(cluster.Properties()[ocmConsts.CreatorArn])
 	if err != nil {
 		r.Reporter.Errorf("Failed to parse creator ARN for cluster '%s'", clusterKey)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	phase := ""
 
@@ -211,7 +234,7 @@ func run(cmd *cobra.Command, argv []string) {
 	}
 	if err != nil {
 		r.Reporter.Errorf("Failed to get machine pools for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Print short cluster description:
@@ -305,7 +328,7 @@ func run(cmd *cobra.Command, argv []string) {
 			rolePolicyBindings, err := r.OCMClient.ListRolePolicyBindings(cluster.ID(), true)
 			if err != nil {
 				r.Reporter.Errorf("Failed to get rolePolicyBinding: %s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			rolePolicyDetails = rolepolicybindings.TransformToRolePolicyDetails(rolePolicyBindings)
 		}
@@ -317,7 +340,7 @@ func run(cmd *cobra.Command, argv []string) {
 				"                            -")
 			if err != nil {
 				r.Reporter.Errorf(err.Error())
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			str = str + policyStr
 		}
@@ -335,7 +358,7 @@ func run(cmd *cobra.Command, argv []string) {
 					"                            -")
 				if err != nil {
 					r.Reporter.Errorf(err.Error())
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 				str = str + policyStr
 			}
@@ -353,7 +376,7 @@ func run(cmd *cobra.Command, argv []string) {
 						"                            -")
 					if err != nil {
 						r.Reporter.Errorf(err.Error())
-						os.Exit(1)
+						reporter.Exit(1)
 					}
 					str = str + policyStr
 				}
@@ -368,7 +391,7 @@ func run(cmd *cobra.Command, argv []string) {
 						"                            -")
 					if err != nil {
 						r.Reporter.Errorf(err.Error())
-						os.Exit(1)
+						reporter.Exit(1)
 					}
 					str = str + policyStr
 				}
@@ -386,7 +409,7 @@ func run(cmd *cobra.Command, argv []string) {
 						"   -")
 					if err != nil {
 						r.Reporter.Errorf(err.Error())
-						os.Exit(1)
+						reporter.Exit(1)
 					}
 					str = str + policyStr
 				}
@@ -503,7 +526,7 @@ func run(cmd *cobra.Command, argv []string) {
 				if err != nil {
 					r.Reporter.Errorf("Failed to get allowlist with id '%s': %v",
 						cluster.RegistryConfig().PlatformAllowlist().ID(), err)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 			}
 			registryConfigOutput := getClusterRegistryConfig(cluster, allowlist)
@@ -526,7 +549,7 @@ func run(cmd *cobra.Command, argv []string) {
 	limitedSupportReasons, err := r.OCMClient.GetLimitedSupportReasons(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get limited support reasons for cluster '%s': %v", cluster.ID(), err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if len(limitedSupportReasons) > 0 {
 		str = fmt.Sprintf("%s"+"Limited Support:\n", str)
@@ -541,7 +564,7 @@ func run(cmd *cobra.Command, argv []string) {
 	inflightChecks, err := r.OCMClient.GetInflightChecks(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get inflight checks for cluster '%s': %v", cluster.ID(), err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if len(inflightChecks) > 0 {
 		summaries := []string{}
@@ -935,3 +958,24 @@ func getRolePolicyBindings(roleARN string, rolePolicyDetails map[string][]aws.Po
 	}
 	return str, nil
 }
+
+// Adds to the spec the default ingress and the cluster autoscaler of a classic cluster. They are
+// separate resources, so when they can't be fetched the spec is still printed without them.
+func addClassicSpec(r *rosa.Runtime, cluster *cmv1.Cluster, clusterSpec *clusterspec.ClusterSpec) {
+	ingress, err := r.OCMClient.GetIngress(cluster.ID(), "apps")
+	if err != nil {
+		r.Reporter.Warnf("The spec doesn't include the default ingress: %v", err)
+	} else {
+		clusterSpec.SetDefaultIngress(ingress)
+	}
+
+	autoscaler, err := r.OCMClient.GetClusterAutoscaler(cluster.ID())
+	if err != nil {
+		r.Reporter.Warnf("The spec doesn't include the cluster autoscaler: %v", err)
+	} else if autoscaler != nil {
+		for _, setting := range clusterSpec.SetClusterAutoscaler(autoscaler) {
+			r.Reporter.Warnf("The spec doesn't include the '%s' setting of the cluster autoscaler, "+
+				"as it has more than one value", setting)
+		}
+	}
+}
@@ -19,12 +19,14 @@ package describe
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/cmd/describe/accessrequest"
 	"github.com/openshift/rosa/cmd/describe/addon"
 	"github.com/openshift/rosa/cmd/describe/admin"
 	"github.com/openshift/rosa/cmd/describe/autoscaler"
 	"github.com/openshift/rosa/cmd/describe/breakglasscredential"
 	"github.com/openshift/rosa/cmd/describe/cluster"
 	"github.com/openshift/rosa/cmd/describe/externalauthprovider"
+	"github.com/openshift/rosa/cmd/describe/hibernationschedule"
 	"github.com/openshift/rosa/cmd/describe/ingress"
 	"github.com/openshift/rosa/cmd/describe/installation"
 	"github.com/openshift/rosa/cmd/describe/kubeletconfig"
@@ -46,12 +48,15 @@ func init() {
 	machinePoolCommand := machinepool.NewDescribeMachinePoolCommand()
 	ingressCommand := ingress.NewDescribeIngressCommand()
 	kubeletconfig := kubeletconfig.NewDescribeKubeletConfigCommand()
+	hibernationScheduleCommand := hibernationschedule.NewDescribeHibernationScheduleCommand()
+	accessRequestCommand := accessrequest.NewDescribeAccessRequestCommand()
 	cmds := []*cobra.Command{
 		addon.Cmd, admin.Cmd, cluster.Cmd, service.Cmd,
 		installation.Cmd, upgrade.Cmd, tuningconfigs.Cmd,
 		machinePoolCommand, kubeletconfig,
 		autoscaler.NewDescribeAutoscalerCommand(), ingressCommand,
 		externalauthprovider.Cmd, breakglasscredential.Cmd,
+		hibernationScheduleCommand, accessRequestCommand,
 	}
 	for _, cmd := range cmds {
 		Cmd.AddCommand(cmd)
@@ -67,6 +72,7 @@ func init() {
 		admin.Cmd, breakglasscredential.Cmd,
 		externalauthprovider.Cmd, installation.Cmd,
 		kubeletconfig, upgrade.Cmd, ingressCommand,
+		hibernationScheduleCommand, accessRequestCommand,
 	}
 	arguments.MarkRegionDeprecated(Cmd, globallyAvailableCommands)
 }
@@ -0,0 +1,79 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hibernationschedule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/hibernation"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use     = "hibernation-schedule"
+	short   = "Show details of the hibernation schedule of a cluster"
+	long    = short
+	example = `  # Describe the hibernation schedule of cluster 'foo'
+  rosa describe hibernation-schedule --cluster foo`
+)
+
+var aliases = []string{"hibernationschedule"}
+
+func NewDescribeHibernationScheduleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), DescribeHibernationScheduleRunner()),
+		Args:    cobra.NoArgs,
+	}
+
+	ocm.AddClusterFlag(cmd)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func DescribeHibernationScheduleRunner() rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
+		cluster, err := r.OCMClient.GetCluster(r.GetClusterKey(), r.Creator)
+		if err != nil {
+			return err
+		}
+		schedule, found, err := hibernation.GetSchedule(cluster)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("Cluster '%s' doesn't have a hibernation schedule", r.GetClusterKey())
+		}
+
+		description := hibernation.NewDescription(cluster, schedule, time.Now())
+		if output.HasFlag() {
+			return output.Print(description)
+		}
+		fmt.Print(hibernation.PrintDescription(description))
+		return nil
+	}
+}
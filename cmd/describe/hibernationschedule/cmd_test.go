@@ -0,0 +1,63 @@
+package hibernationschedule
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/properties"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Describe hibernation schedule", func() {
+	var t *TestingRuntime
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+		output.SetOutput("")
+	})
+
+	AfterEach(func() {
+		output.SetOutput("")
+	})
+
+	It("Correctly builds the command", func() {
+		cmd := NewDescribeHibernationScheduleCommand()
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Flags().Lookup("cluster")).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("output")).NotTo(BeNil())
+	})
+
+	It("Fails if the cluster doesn't have a schedule", func() {
+		cluster := MockCluster(func(c *cmv1.ClusterBuilder) {})
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+		t.SetCluster("cluster", nil)
+
+		err := DescribeHibernationScheduleRunner()(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).To(MatchError("Cluster 'cluster' doesn't have a hibernation schedule"))
+	})
+
+	It("Prints the schedule as JSON", func() {
+		cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.ID("123").Name("foo").State(cmv1.ClusterStateReady).Properties(map[string]string{
+				properties.HibernateSchedule: "0 19 * * 1-5",
+				properties.ResumeSchedule:    "0 7 * * 1-5",
+				properties.ScheduleTimezone:  "Europe/Berlin",
+			})
+		})
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+		t.SetCluster("cluster", nil)
+		output.SetOutput("jsonpath={.cluster_id} {.hibernate} {.timezone}")
+
+		t.StdOutReader.Record()
+		err := DescribeHibernationScheduleRunner()(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+		stdout, _ := t.StdOutReader.Read()
+		Expect(stdout).To(Equal("123 0 19 * * 1-5 Europe/Berlin\n"))
+	})
+})
@@ -18,12 +18,13 @@ package installation
 
 import (
 	"fmt"
-	"os"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -60,6 +61,8 @@ func init() {
 		"",
 		"Name or ID of the addon installation (required).",
 	)
+
+	output.AddFlag(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -69,19 +72,19 @@ func run(_ *cobra.Command, _ []string) {
 	if args.clusterKey == "" {
 		r.Reporter.Errorf(
 			"Expected the cluster to be specified with the --cluster flag")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	ocm.SetClusterKey(args.clusterKey)
 
 	if args.installationKey == "" {
 		r.Reporter.Errorf(
 			"Expected the add-on installation to be specified with the --addon flag")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if err := describeAddonInstallation(r, args.installationKey); err != nil {
 		r.Reporter.Errorf("Failed to describe add-on installation: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 }
 
@@ -93,6 +96,10 @@ func describeAddonInstallation(r *rosa.Runtime, installationKey string) error {
 		return err
 	}
 
+	if output.HasFlag() {
+		return output.Print(installation)
+	}
+
 	fmt.Printf(`%-28s %s
 %-28s %s
 %-28s %s
@@ -18,11 +18,12 @@ package service
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -49,6 +50,8 @@ func init() {
 		"",
 		"The id of the service to describe",
 	)
+
+	output.AddFlag(Cmd)
 }
 
 func run(cmd *cobra.Command, _ []string) {
@@ -58,7 +61,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if args.ID == "" {
 		r.Reporter.Errorf("id not specified.")
 		cmd.Help()
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Try to find the cluster:
@@ -66,7 +69,16 @@ func run(cmd *cobra.Command, _ []string) {
 	service, err := r.OCMClient.GetManagedService(args)
 	if err != nil {
 		r.Reporter.Errorf("Failed to get service with id %q: %v", args.ID, err)
-		os.Exit(1)
+		reporter.Exit(1)
+	}
+
+	if output.HasFlag() {
+		err = output.Print(service)
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			reporter.Exit(1)
+		}
+		reporter.Exit(0)
 	}
 
 	fmt.Printf(`%-28s%s
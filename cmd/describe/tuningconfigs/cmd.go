@@ -19,13 +19,13 @@ package tuningconfigs
 import (
 	"encoding/json"
 	"fmt"
-	"os"
 
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/input"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -68,23 +68,23 @@ func run(_ *cobra.Command, argv []string) {
 	tuningConfig, err := r.OCMClient.FindTuningConfigByName(cluster.ID(), tuningConfigName)
 	if err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if output.HasFlag() {
 		err = output.Print(tuningConfig)
 		if err != nil {
 			r.Reporter.Errorf("%v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	// Pretty print the spec
 	tuningConfigSpec, err := json.MarshalIndent(tuningConfig.Spec(), "                            ", "  ")
 	if err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	r.Reporter.Debugf("Describing tuning config '%s' on cluster '%s'", tuningConfig.Name(), clusterKey)
@@ -18,7 +18,6 @@ package upgrade
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
@@ -27,6 +26,8 @@ import (
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/machinepool"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -60,6 +61,7 @@ func init() {
 	)
 
 	confirm.AddFlag(flags)
+	output.AddFlag(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -68,7 +70,7 @@ func run(_ *cobra.Command, _ []string) {
 	err := runWithRuntime(r)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 }
 
@@ -103,6 +105,9 @@ func describeHypershiftUpgrades(r *rosa.Runtime, clusterID string, nodePoolID st
 			r.Reporter.Infof("No scheduled upgrades for cluster '%s'", clusterKey)
 			return nil
 		}
+		if output.HasFlag() {
+			return output.Print(upgrades)
+		}
 
 		for _, upgrade := range upgrades {
 			fmt.Print(formatHypershiftUpgrade(upgrade))
@@ -117,6 +122,9 @@ func describeHypershiftUpgrades(r *rosa.Runtime, clusterID string, nodePoolID st
 			r.Reporter.Infof("No scheduled upgrades for machine pool '%s' in cluster '%s'", nodePoolID, clusterKey)
 			return nil
 		}
+		if output.HasFlag() {
+			return output.Print(upgrades)
+		}
 
 		for _, upgrade := range upgrades {
 			fmt.Print(formatHypershiftUpgrade(upgrade))
@@ -197,6 +205,9 @@ func describeClassicUpgrades(r *rosa.Runtime, clusterID string) error {
 		r.Reporter.Infof("No scheduled upgrades for cluster id '%s'", clusterID)
 		return nil
 	}
+	if output.HasFlag() {
+		return output.Print(upgrades)
+	}
 
 	for _, upgrade := range upgrades {
 		fmt.Print(formatClassicUpgrade(upgrade, upgradeState))
@@ -17,8 +17,6 @@ limitations under the License.
 package admin
 
 import (
-	"os"
-
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
@@ -27,6 +25,7 @@ import (
 	"github.com/openshift/rosa/cmd/create/idp"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -41,7 +40,7 @@ func (d *DeleteAdminIDP) deleteAdmin(r *rosa.Runtime, identityProvider *cmv1.Ide
 	if err != nil {
 		r.Reporter.Errorf("Failed to delete htpasswd idp '%s' of cluster '%s': %s",
 			identityProvider.ID(), r.ClusterKey, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 }
 
@@ -56,21 +55,21 @@ func (d *DeleteUserAdminFromIDP) deleteAdmin(r *rosa.Runtime, identityProvider *
 	if err != nil {
 		r.Reporter.Errorf("Failed to delete '%s' user from htpasswd idp users list of cluster '%s': %s",
 			cadmin.ClusterAdminUsername, r.ClusterKey, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	users, err := r.OCMClient.GetHTPasswdUserList(clusterID, identityProvider.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to list htpasswd idp users of cluster '%s': %s",
 			r.ClusterKey, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	htpasswdIdentityProvider, ok := identityProvider.GetHtpasswd()
 	if !ok {
 		r.Reporter.Errorf("Failed to get htpasswd idp of cluster '%s': %s",
 			r.ClusterKey, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if users.Len() == 0 && htpasswdIdentityProvider.Username() == "" {
@@ -79,7 +78,7 @@ func (d *DeleteUserAdminFromIDP) deleteAdmin(r *rosa.Runtime, identityProvider *
 		if err != nil {
 			r.Reporter.Errorf("Failed to delete htpasswd idp '%s' of cluster '%s': %s",
 				identityProvider.ID(), r.ClusterKey, err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 }
@@ -105,13 +104,13 @@ func run(_ *cobra.Command, _ []string) {
 	cluster := r.FetchCluster()
 	if cluster.State() != cmv1.ClusterStateReady {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", r.ClusterKey)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if cluster.ExternalAuthConfig().Enabled() {
 		r.Reporter.Errorf(
 			"Deleting the 'cluster-admin' user is not supported for clusters with external authentication configured.")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Try to find the htpasswd identity provider:
@@ -119,12 +118,12 @@ func run(_ *cobra.Command, _ []string) {
 	clusterAdminIDP, _, err := cadmin.FindIDPWithAdmin(cluster, r)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if clusterAdminIDP == nil {
 		r.Reporter.Errorf("Cluster '%s' does not have ‘%s’ user", r.ClusterKey, cadmin.ClusterAdminUsername)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if confirm.Confirm("delete %s user on cluster %s", cadmin.ClusterAdminUsername, r.ClusterKey) {
@@ -134,7 +133,7 @@ func run(_ *cobra.Command, _ []string) {
 		err := r.OCMClient.DeleteUser(clusterID, admin.ClusterAdminGroupname, cadmin.ClusterAdminUsername)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		deletionStrategy := getAdminUserDeletionStrategy(r, clusterAdminIDP)
@@ -155,7 +154,7 @@ func wasAdminCreatedUsingOldROSA(r *rosa.Runtime, identityProvider *cmv1.Identit
 	htpasswdIdp, ok := identityProvider.GetHtpasswd()
 	if !ok {
 		r.Reporter.Errorf("Failed to get htpasswd idp for cluster '%s'", r.Cluster.ID())
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	return htpasswdIdp.Username() == cadmin.ClusterAdminUsername
 }
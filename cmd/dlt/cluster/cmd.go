@@ -18,7 +18,6 @@ package cluster
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
@@ -31,7 +30,9 @@ import (
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/wait"
 )
 
 var args struct {
@@ -46,7 +47,10 @@ var Cmd = &cobra.Command{
 	Short: "Delete cluster",
 	Long:  "Delete cluster.",
 	Example: `  # Delete a cluster named "mycluster"
-  rosa delete cluster --cluster=mycluster`,
+  rosa delete cluster --cluster=mycluster
+
+  # Delete a cluster and wait until it is uninstalled
+  rosa delete cluster --cluster=mycluster --wait --timeout 90m`,
 	Run:  run,
 	Args: cobra.NoArgs,
 }
@@ -74,6 +78,8 @@ func init() {
 		false,
 		"Watch cluster uninstallation logs.",
 	)
+
+	wait.AddFlags(flags)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -88,7 +94,7 @@ func run(_ *cobra.Command, _ []string) {
 	}
 
 	if !confirm.Confirm("delete cluster %s", clusterKey) {
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	cluster := r.FetchCluster()
@@ -96,7 +102,7 @@ func run(_ *cobra.Command, _ []string) {
 	err := handleClusterDelete(r, cluster, clusterKey, args.bestEffort)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if cluster.AWS().STS().RoleARN() != "" {
@@ -124,11 +130,17 @@ func run(_ *cobra.Command, _ []string) {
 		arguments.DisableRegionDeprecationWarning = true // disable region deprecation warning
 		uninstallLogs.Cmd.Run(uninstallLogs.Cmd, []string{clusterKey})
 		arguments.DisableRegionDeprecationWarning = false // enable region deprecation again
-	} else {
+	} else if !wait.Enabled() {
 		r.Reporter.Infof("To watch your cluster uninstallation logs, run 'rosa logs uninstall -c %s --watch'",
 			clusterKey,
 		)
 	}
+	if wait.Enabled() {
+		r.Reporter.Infof("Waiting for cluster '%s' to be uninstalled", clusterKey)
+		err = r.OCMClient.WaitForClusterDeletion(cluster.ID(), wait.Timeout())
+		wait.Check(r.Reporter, err)
+		r.Reporter.Infof("Cluster '%s' has been uninstalled", clusterKey)
+	}
 }
 
 func handleClusterDelete(r *rosa.Runtime, cluster *cmv1.Cluster, clusterKey string, bestEffort bool) error {
@@ -25,6 +25,7 @@ import (
 	"github.com/openshift/rosa/cmd/dlt/cluster"
 	"github.com/openshift/rosa/cmd/dlt/dnsdomains"
 	"github.com/openshift/rosa/cmd/dlt/externalauthprovider"
+	"github.com/openshift/rosa/cmd/dlt/hibernationschedule"
 	"github.com/openshift/rosa/cmd/dlt/idp"
 	"github.com/openshift/rosa/cmd/dlt/ingress"
 	"github.com/openshift/rosa/cmd/dlt/kubeletconfig"
@@ -33,6 +34,7 @@ import (
 	"github.com/openshift/rosa/cmd/dlt/oidcconfig"
 	"github.com/openshift/rosa/cmd/dlt/oidcprovider"
 	"github.com/openshift/rosa/cmd/dlt/operatorrole"
+	"github.com/openshift/rosa/cmd/dlt/orphans"
 	"github.com/openshift/rosa/cmd/dlt/service"
 	"github.com/openshift/rosa/cmd/dlt/tuningconfigs"
 	"github.com/openshift/rosa/cmd/dlt/upgrade"
@@ -71,6 +73,10 @@ func init() {
 	kubeletconfig := kubeletconfig.NewDeleteKubeletConfigCommand()
 	Cmd.AddCommand(kubeletconfig)
 	Cmd.AddCommand(externalauthprovider.Cmd)
+	hibernationScheduleCommand := hibernationschedule.NewDeleteHibernationScheduleCommand()
+	Cmd.AddCommand(hibernationScheduleCommand)
+	// Not globally available: the private key secrets of the OIDC configurations are regional
+	Cmd.AddCommand(orphans.NewDeleteOrphansCommand())
 
 	flags := Cmd.PersistentFlags()
 	arguments.AddProfileFlag(flags)
@@ -84,6 +90,7 @@ func init() {
 		service.Cmd, autoscalerCommand, idp.Cmd,
 		cluster.Cmd, dnsdomains.Cmd, externalauthprovider.Cmd,
 		kubeletconfig, machinepoolCommand, tuningconfigs.Cmd,
+		hibernationScheduleCommand,
 	}
 	arguments.MarkRegionDeprecated(Cmd, globallyAvailableCommands)
 }
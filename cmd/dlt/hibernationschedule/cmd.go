@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hibernationschedule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/hibernation"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use     = "hibernation-schedule"
+	short   = "Delete the hibernation schedule of a cluster"
+	long    = short + ". The cluster stays in its current state, hibernated or not."
+	example = `  # Delete the hibernation schedule of cluster 'foo'
+  rosa delete hibernation-schedule --cluster foo`
+)
+
+var aliases = []string{"hibernationschedule"}
+
+func NewDeleteHibernationScheduleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), DeleteHibernationScheduleRunner()),
+		Args:    cobra.NoArgs,
+	}
+	ocm.AddClusterFlag(cmd)
+	confirm.AddFlag(cmd.Flags())
+	return cmd
+}
+
+func DeleteHibernationScheduleRunner() rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
+		cluster, err := r.OCMClient.GetCluster(r.GetClusterKey(), r.Creator)
+		if err != nil {
+			return err
+		}
+		_, found, _ := hibernation.GetSchedule(cluster)
+		if !found {
+			return fmt.Errorf("Cluster '%s' doesn't have a hibernation schedule", r.GetClusterKey())
+		}
+		if !confirm.Confirm("delete the hibernation schedule of cluster '%s'", r.GetClusterKey()) {
+			return nil
+		}
+
+		err = r.OCMClient.UpdateClusterProperties(cluster.ID(), hibernation.RemoveSchedule(cluster))
+		if err != nil {
+			return fmt.Errorf("Failed to delete the hibernation schedule of cluster '%s': %w",
+				r.GetClusterKey(), err)
+		}
+		r.Reporter.Infof("Successfully deleted the hibernation schedule of cluster '%s'", r.GetClusterKey())
+		return nil
+	}
+}
@@ -0,0 +1,66 @@
+package hibernationschedule
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/properties"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Delete hibernation schedule", func() {
+	var t *TestingRuntime
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+		cmd := NewDeleteHibernationScheduleCommand()
+		Expect(cmd.Flag("yes").Value.Set("true")).To(Succeed())
+		DeferCleanup(cmd.Flag("yes").Value.Set, "false")
+	})
+
+	It("Correctly builds the command", func() {
+		cmd := NewDeleteHibernationScheduleCommand()
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Flags().Lookup("cluster")).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("yes")).NotTo(BeNil())
+	})
+
+	It("Fails if the cluster doesn't have a schedule", func() {
+		cluster := MockCluster(func(c *cmv1.ClusterBuilder) {})
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+		t.SetCluster("cluster", nil)
+
+		err := DeleteHibernationScheduleRunner()(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).To(MatchError("Cluster 'cluster' doesn't have a hibernation schedule"))
+	})
+
+	It("Removes the schedule and keeps the other properties", func() {
+		cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.ID("123").Properties(map[string]string{
+				"rosa_creator_arn":           "arn",
+				properties.HibernateSchedule: "0 19 * * 1-5",
+				properties.ResumeSchedule:    "0 7 * * 1-5",
+				properties.ScheduleTimezone:  "UTC",
+			})
+		})
+		t.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPatch, "/api/clusters_mgmt/v1/clusters/123"),
+				ghttp.VerifyJSON(`{"kind": "Cluster", "properties": {"rosa_creator_arn": "arn"}}`),
+				RespondWithJSON(http.StatusOK, `{"kind": "Cluster", "id": "123"}`),
+			),
+		)
+		t.SetCluster("cluster", nil)
+
+		err := DeleteHibernationScheduleRunner()(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(2))
+	})
+})
@@ -18,7 +18,6 @@ package idp
 
 import (
 	"fmt"
-	"os"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
@@ -26,6 +25,7 @@ import (
 	cadmin "github.com/openshift/rosa/cmd/create/admin"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -63,7 +63,7 @@ func run(_ *cobra.Command, argv []string) {
 
 	if cluster.ExternalAuthConfig().Enabled() {
 		r.Reporter.Errorf("Deleting IDP is not supported for clusters with external authentication configured.")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Try to find the identity provider:
@@ -71,7 +71,7 @@ func run(_ *cobra.Command, argv []string) {
 	idps, err := r.OCMClient.GetIdentityProviders(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get identity providers for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	var idp *cmv1.IdentityProvider
@@ -83,13 +83,13 @@ func run(_ *cobra.Command, argv []string) {
 	}
 	if idp == nil {
 		r.Reporter.Errorf("Failed to get identity provider '%s' for cluster '%s'", idpName, clusterKey)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if ocm.IdentityProviderType(idp) == ocm.HTPasswdIDPType {
 		clusterAdminIDP, _, err := cadmin.FindIDPWithAdmin(cluster, r)
 		if err != nil {
 			r.Reporter.Errorf(err.Error())
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		if clusterAdminIDP != nil && clusterAdminIDP.Name() == idp.Name() {
 			r.Reporter.Warnf("The cluster-admin user is contained in the HTPasswd IDP. Deleting the IDP will " +
@@ -102,7 +102,7 @@ func run(_ *cobra.Command, argv []string) {
 		if err != nil {
 			r.Reporter.Errorf("Failed to delete identity provider '%s' on cluster '%s': %s",
 				idpName, clusterKey, err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		r.Reporter.Infof("Successfully deleted identity provider '%s' from cluster '%s'", idpName, clusterKey)
 	}
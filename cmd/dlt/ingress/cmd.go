@@ -18,7 +18,6 @@ package ingress
 
 import (
 	"fmt"
-	"os"
 	"regexp"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
@@ -26,6 +25,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -68,7 +68,7 @@ func run(_ *cobra.Command, argv []string) {
 			"Ingress identifier '%s' isn't valid: it must contain between three and five lowercase letters or digits",
 			ingressID,
 		)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	clusterKey := r.GetClusterKey()
@@ -79,7 +79,7 @@ func run(_ *cobra.Command, argv []string) {
 	ingresses, err := r.OCMClient.GetIngresses(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get ingresses for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	var ingress *cmv1.Ingress
@@ -96,7 +96,7 @@ func run(_ *cobra.Command, argv []string) {
 	}
 	if ingress == nil {
 		r.Reporter.Errorf("Ingress '%s' does not exist on cluster '%s'", ingressID, clusterKey)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if confirm.Confirm("delete ingress %s on cluster %s", ingressID, clusterKey) {
@@ -105,7 +105,7 @@ func run(_ *cobra.Command, argv []string) {
 		if err != nil {
 			r.Reporter.Errorf("Failed to delete ingress '%s' on cluster '%s': %s",
 				ingress.ID(), clusterKey, err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		r.Reporter.Infof("Successfully deleted ingress '%s' from cluster '%s'", ingressID, clusterKey)
 	}
@@ -18,8 +18,6 @@ package oidcconfig
 
 import (
 	"fmt"
-	"os"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -34,6 +32,7 @@ import (
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	interactiveOidc "github.com/openshift/rosa/pkg/interactive/oidc"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -50,8 +49,7 @@ var Cmd = &cobra.Command{
 
 const (
 	//nolint
-	OidcConfigIdFlag          = "oidc-config-id"
-	prefixForPrivateKeySecret = "rosa-private-key-"
+	OidcConfigIdFlag = "oidc-config-id"
 )
 
 var args struct {
@@ -82,14 +80,14 @@ func run(cmd *cobra.Command, _ []string) {
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Get AWS region
 	region, err := aws.GetRegion(arguments.GetRegion())
 	if err != nil {
 		r.Reporter.Errorf("Error getting region: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	args.region = region
 
@@ -102,7 +100,7 @@ func run(cmd *cobra.Command, _ []string) {
 		mode, err = interactive.GetOptionMode(cmd, mode, "OIDC Config deletion mode")
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid OIDC provider creation mode: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -114,7 +112,7 @@ func run(cmd *cobra.Command, _ []string) {
 	oidcConfigStrategy, err := getOidcConfigStrategy(mode, oidcConfigInput)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	oidcConfigStrategy.execute(r)
 	arguments.DisableRegionDeprecationWarning = true // disable region deprecation warning
@@ -141,7 +139,7 @@ func buildOidcConfigInput(r *rosa.Runtime) OidcConfigInput {
 	oidcConfig, err := r.OCMClient.GetOidcConfig(args.oidcConfigId)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem retrieving the OIDC Config '%s': %v", args.oidcConfigId, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	secretArn := oidcConfig.SecretArn()
 	bucketName := ""
@@ -150,20 +148,12 @@ func buildOidcConfigInput(r *rosa.Runtime) OidcConfigInput {
 		if args.region != parsedSecretArn.Region {
 			r.Reporter.Errorf("Secret region '%s' differs from chosen region '%s', "+
 				"please run the command supplying region parameter.", parsedSecretArn.Region, args.region)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
-		secretResourceName, err := aws.GetResourceIdFromSecretArn(secretArn)
+		bucketName, err = aws.GetBucketNameFromSecretArn(secretArn)
 		if err != nil {
 			r.Reporter.Errorf("There was a problem parsing secret ARN '%s' : %v", secretArn, err)
-			os.Exit(1)
-		}
-		// The secret when creating from ROSA options has the following format
-		// rosa-private-key-<prefix>-oidc-<random-hash-length-4>-<random-aws-created-hash>
-		// The bucket is expected to be <prefix>-oidc-<random-hash-length-4>
-		bucketName = strings.TrimPrefix(secretResourceName, prefixForPrivateKeySecret)
-		index := strings.LastIndex(bucketName, "-")
-		if index != -1 {
-			bucketName = bucketName[:index]
+			reporter.Exit(1)
 		}
 	}
 
@@ -171,11 +161,11 @@ func buildOidcConfigInput(r *rosa.Runtime) OidcConfigInput {
 	hasClusterUsingOidcConfig, err := r.OCMClient.HasAClusterUsingOidcEndpointUrl(issuerUrl)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem checking if any clusters are using OIDC config '%s' : %v", issuerUrl, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if hasClusterUsingOidcConfig {
 		r.Reporter.Errorf("There are clusters using OIDC config '%s', can't delete the configuration", issuerUrl)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	return OidcConfigInput{
 		BucketName:          bucketName,
@@ -207,12 +197,12 @@ func (s *deleteUnmanagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) {
 	err := r.AWSClient.DeleteSecretInSecretsManager(privateKeySecretArn)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem deleting private key from secrets manager: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	err = r.AWSClient.DeleteS3Bucket(bucketName)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem deleting S3 bucket '%s': %s", bucketName, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if spin != nil {
 		spin.Stop()
@@ -227,13 +217,17 @@ type deleteUnmanagedOidcConfigManualStrategy struct {
 }
 
 func (s *deleteUnmanagedOidcConfigManualStrategy) execute(r *rosa.Runtime) {
+	fmt.Println(BuildCommands(s.oidcConfig.BucketName, s.oidcConfig.PrivateKeySecretArn, args.region))
+}
+
+// BuildCommands returns the AWS CLI commands that delete the private key secret and the S3 bucket of an
+// unmanaged OIDC configuration.
+func BuildCommands(bucketName string, privateKeySecretArn string, region string) string {
 	commands := []string{}
-	bucketName := s.oidcConfig.BucketName
-	privateKeySecretArn := s.oidcConfig.PrivateKeySecretArn
 	deleteSecretCommand := awscb.NewSecretsManagerCommandBuilder().
 		SetCommand(awscb.DeleteSecret).
 		AddParam(awscb.SecretID, privateKeySecretArn).
-		AddParam(awscb.Region, args.region).
+		AddParam(awscb.Region, region).
 		Build()
 	commands = append(commands, deleteSecretCommand)
 	emptyS3BucketCommand := awscb.NewS3CommandBuilder().
@@ -247,7 +241,7 @@ func (s *deleteUnmanagedOidcConfigManualStrategy) execute(r *rosa.Runtime) {
 		AddValueNoParam(fmt.Sprintf("s3://%s", bucketName)).
 		Build()
 	commands = append(commands, deleteS3BucketCommand)
-	fmt.Println(awscb.JoinCommands(commands))
+	return awscb.JoinCommands(commands)
 }
 
 type deleteManagedOidcConfigStrategy struct{}
@@ -0,0 +1,236 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphans
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/cmd/dlt/oidcconfig"
+	"github.com/openshift/rosa/cmd/dlt/oidcprovider"
+	"github.com/openshift/rosa/cmd/dlt/operatorrole"
+	"github.com/openshift/rosa/pkg/interactive"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/orphans"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "orphans"
+	short = "Delete AWS resources left behind by deleted clusters"
+	long  = "Delete the operator roles and OIDC providers that were created for clusters that no longer " +
+		"exist. The resources are listed, and deleted only after confirmation.\n\n" +
+		"Resources that aren't tagged with a cluster, like the operator roles and OIDC configurations " +
+		"created before the cluster, are only deleted with '--include-unused'.\n\n" +
+		"Only the clusters of the current OCM environment are considered, so check that the listed " +
+		"resources don't belong to clusters of other environments that share the AWS account."
+	example = `  # Delete the orphaned resources of the current AWS account
+  rosa delete orphans --mode auto
+
+  # Print the AWS commands that delete the orphaned resources
+  rosa delete orphans --mode manual
+
+  # Also delete the resources that no cluster uses
+  rosa delete orphans --mode auto --include-unused`
+
+	includeUnusedFlag = "include-unused"
+)
+
+var aliases = []string{"orphan"}
+
+func NewDeleteOrphansCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), DeleteOrphansRunner()),
+	}
+
+	cmd.Flags().Bool(
+		includeUnusedFlag,
+		false,
+		"Also delete the resources that no cluster uses but whose cluster isn't known to be deleted, "+
+			"including the OIDC configurations and operator roles created for future clusters.",
+	)
+	interactive.AddModeFlag(cmd)
+	confirm.AddFlag(cmd.Flags())
+	return cmd
+}
+
+func DeleteOrphansRunner() rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
+		mode, err := interactive.GetMode()
+		if err != nil {
+			return err
+		}
+		if mode == "" {
+			mode, err = interactive.GetOptionMode(command, mode, "Orphaned resources deletion mode")
+			if err != nil {
+				return fmt.Errorf("Expected a valid deletion mode: %v", err)
+			}
+		}
+
+		includeUnused, err := command.Flags().GetBool(includeUnusedFlag)
+		if err != nil {
+			return err
+		}
+		found, err := orphans.NewFinder(r.OCMClient, r.AWSClient, r.Creator.AccountID, includeUnused).Find()
+		if err != nil {
+			return err
+		}
+		if len(found) == 0 {
+			r.Reporter.Infof("There are no orphaned resources in AWS account '%s'", r.Creator.AccountID)
+			return nil
+		}
+		r.Reporter.Infof("Found the following orphaned resources in AWS account '%s':", r.Creator.AccountID)
+		err = orphans.NewTable(found).Print()
+		if err != nil {
+			return err
+		}
+		// Resources of clusters of other OCM environments look orphaned too, so name the environment
+		// that was checked before anything is deleted:
+		environment := r.OCMClient.GetConnectionURL()
+		r.Reporter.Warnf("Only the clusters of OCM environment '%s' were checked, resources used by "+
+			"clusters of other OCM environments that share the AWS account are listed too", environment)
+		if !confirm.Confirm("delete the %d orphaned resources listed above, which no cluster of OCM "+
+			"environment '%s' uses", len(found), environment) {
+			return nil
+		}
+
+		switch mode {
+		case interactive.ModeAuto:
+			r.OCMClient.LogEvent("ROSADeleteOrphansModeAuto", nil)
+			failed := 0
+			for _, orphan := range found {
+				r.Reporter.Infof("Deleting %s '%s'", orphan.Kind, orphan.ID)
+				err = deleteOrphan(r, orphan)
+				if err != nil {
+					r.Reporter.Warnf("Failed to delete %s '%s': %v", orphan.Kind, orphan.ID, err)
+					failed++
+				}
+			}
+			if failed > 0 {
+				return fmt.Errorf("Failed to delete %d of the %d orphaned resources", failed, len(found))
+			}
+			r.Reporter.Infof("Successfully deleted the orphaned resources")
+		case interactive.ModeManual:
+			r.OCMClient.LogEvent("ROSADeleteOrphansModeManual", nil)
+			commands, err := buildCommands(r, found)
+			if err != nil {
+				return err
+			}
+			if r.Reporter.IsTerminal() {
+				r.Reporter.Infof("Run the following commands to delete the orphaned resources:\n")
+			}
+			fmt.Println(commands)
+			// The OIDC configurations are unregistered from OCM right away, like 'rosa delete oidc-config'
+			// does in manual mode, but only once all the commands have been printed:
+			for _, orphan := range found {
+				if orphan.Kind != orphans.KindOidcConfig {
+					continue
+				}
+				err = r.OCMClient.DeleteOidcConfig(orphan.ID)
+				if err != nil {
+					return fmt.Errorf("Failed to unregister OIDC configuration '%s': %v", orphan.ID, err)
+				}
+			}
+		default:
+			return fmt.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
+		}
+		return nil
+	}
+}
+
+func deleteOrphan(r *rosa.Runtime, orphan orphans.Orphan) error {
+	switch orphan.Kind {
+	case orphans.KindOperatorRoles:
+		for _, role := range orphan.Resources {
+			err := r.AWSClient.DeleteOperatorRole(role, orphan.ManagedPolicies)
+			if err != nil {
+				return err
+			}
+		}
+	case orphans.KindOidcProvider:
+		return r.AWSClient.DeleteOpenIDConnectProvider(orphan.ID)
+	case orphans.KindOidcConfig:
+		if !orphan.Managed {
+			err := checkSecretRegion(r, orphan.SecretARN)
+			if err != nil {
+				return err
+			}
+			err = r.AWSClient.DeleteSecretInSecretsManager(orphan.SecretARN)
+			if err != nil {
+				return err
+			}
+			err = r.AWSClient.DeleteS3Bucket(orphan.BucketName)
+			if err != nil {
+				return err
+			}
+		}
+		return r.OCMClient.DeleteOidcConfig(orphan.ID)
+	}
+	return nil
+}
+
+// Returns the AWS commands that delete the orphans.
+func buildCommands(r *rosa.Runtime, found []orphans.Orphan) (string, error) {
+	commands := []string{}
+	for _, orphan := range found {
+		switch orphan.Kind {
+		case orphans.KindOperatorRoles:
+			policyMap, arbitraryPolicyMap, err := r.AWSClient.GetOperatorRolePolicies(orphan.Resources)
+			if err != nil {
+				return "", fmt.Errorf("There was an error getting the policies of the operator roles "+
+					"with prefix '%s': %v", orphan.ID, err)
+			}
+			commands = append(commands, operatorrole.BuildCommands(orphan.Resources, policyMap,
+				arbitraryPolicyMap, orphan.ManagedPolicies))
+		case orphans.KindOidcProvider:
+			commands = append(commands, oidcprovider.BuildCommand(orphan.ID))
+		case orphans.KindOidcConfig:
+			if !orphan.Managed {
+				parsedSecretArn, err := arn.Parse(orphan.SecretARN)
+				if err != nil {
+					return "", err
+				}
+				commands = append(commands, oidcconfig.BuildCommands(orphan.BucketName, orphan.SecretARN,
+					parsedSecretArn.Region))
+			}
+		}
+	}
+	return strings.Join(commands, "\n"), nil
+}
+
+// The secrets are regional, so the client must use the region of the secret to delete it.
+func checkSecretRegion(r *rosa.Runtime, secretARN string) error {
+	parsedSecretArn, err := arn.Parse(secretARN)
+	if err != nil {
+		return err
+	}
+	if parsedSecretArn.Region != r.AWSClient.GetRegion() {
+		return fmt.Errorf("the private key secret is in region '%s', run the command again with "+
+			"'--region %s' to delete it", parsedSecretArn.Region, parsedSecretArn.Region)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphans
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/interactive"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+const (
+	noClusters  = `{"kind": "ClusterList", "page": 1, "size": 0, "total": 0, "items": []}`
+	providerARN = "arn:aws:iam::123456789012:oidc-provider/oidc.example.com/abcd"
+	secretARN   = "arn:aws:secretsmanager:us-east-1:123456789012:secret:rosa-private-key-oidc-abcd-ZyXwV1"
+	oidcConfigs = `{"kind": "OidcConfigList", "page": 1, "size": 1, "total": 1, "items": [
+		{"id": "abcd", "issuer_url": "https://oidc.example.com/abcd", "secret_arn": "` + secretARN + `"}
+	]}`
+)
+
+var _ = Describe("Delete orphans", func() {
+	var t *TestingRuntime
+	var awsClient *aws.MockClient
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+		awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+		t.RosaRuntime.AWSClient = awsClient
+		t.RosaRuntime.Creator = &aws.Creator{AccountID: "123456789012"}
+		awsClient.EXPECT().ListOperatorRoles("", "", "").Return(map[string][]aws.OperatorRoleDetail{}, nil)
+		awsClient.EXPECT().ListOidcProviders("", nil).Return([]aws.OidcProviderOutput{{Arn: providerARN}}, nil)
+		t.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, noClusters),
+			RespondWithJSON(http.StatusOK, oidcConfigs),
+			RespondWithJSON(http.StatusOK, noClusters),
+		)
+	})
+
+	AfterEach(func() {
+		interactive.SetModeKey("")
+	})
+
+	run := func(mode string) error {
+		cmd := NewDeleteOrphansCommand()
+		Expect(cmd.Flags().Set("yes", "true")).To(Succeed())
+		Expect(cmd.Flags().Set("include-unused", "true")).To(Succeed())
+		interactive.SetModeKey(mode)
+		return DeleteOrphansRunner()(context.Background(), t.RosaRuntime, cmd, nil)
+	}
+
+	It("Deletes the orphaned resources in auto mode", func() {
+		awsClient.EXPECT().DeleteOpenIDConnectProvider(providerARN).Return(nil)
+		awsClient.EXPECT().GetRegion().Return("us-east-1")
+		awsClient.EXPECT().DeleteSecretInSecretsManager(secretARN).Return(nil)
+		awsClient.EXPECT().DeleteS3Bucket("oidc-abcd").Return(nil)
+		t.ApiServer.AppendHandlers(
+			// Event of the deletion mode:
+			RespondWithJSON(http.StatusCreated, "{}"),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodDelete, "/api/clusters_mgmt/v1/oidc_configs/abcd"),
+				ghttp.RespondWith(http.StatusNoContent, nil),
+			),
+		)
+		t.StdOutReader.Record()
+		Expect(run(interactive.ModeAuto)).To(Succeed())
+		stdout, _ := t.StdOutReader.Read()
+		Expect(stdout).To(ContainSubstring("Successfully deleted the orphaned resources"))
+	})
+
+	It("Continues and fails at the end when a resource can't be deleted", func() {
+		awsClient.EXPECT().DeleteOpenIDConnectProvider(providerARN).Return(fmt.Errorf("access denied"))
+		awsClient.EXPECT().GetRegion().Return("eu-west-1")
+		Expect(run(interactive.ModeAuto)).To(MatchError("Failed to delete 2 of the 2 orphaned resources"))
+	})
+
+	It("Prints the commands in manual mode", func() {
+		t.ApiServer.AppendHandlers(
+			// Event of the deletion mode:
+			RespondWithJSON(http.StatusCreated, "{}"),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodDelete, "/api/clusters_mgmt/v1/oidc_configs/abcd"),
+				ghttp.RespondWith(http.StatusNoContent, nil),
+			),
+		)
+		t.StdOutReader.Record()
+		Expect(run(interactive.ModeManual)).To(Succeed())
+		stdout, _ := t.StdOutReader.Read()
+		Expect(stdout).To(ContainSubstring("aws iam delete-open-id-connect-provider \\\n" +
+			"\t--open-id-connect-provider-arn " + providerARN))
+		Expect(stdout).To(ContainSubstring("aws s3 rb \\\n\ts3://oidc-abcd"))
+		Expect(stdout).To(ContainSubstring("--secret-id " + secretARN))
+	})
+})
@@ -18,7 +18,6 @@ package service
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	msv1 "github.com/openshift-online/ocm-sdk-go/servicemgmt/v1"
@@ -26,6 +25,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -62,11 +62,11 @@ func run(cmd *cobra.Command, _ []string) {
 	if args.ID == "" {
 		r.Reporter.Errorf("id not specified.")
 		cmd.Help()
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if !confirm.Confirm("delete service with id '%s'", args.ID) {
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	// First get the service to report additional resources
@@ -74,14 +74,14 @@ func run(cmd *cobra.Command, _ []string) {
 	service, err := r.OCMClient.GetManagedService(ocm.DescribeManagedServiceArgs{ID: args.ID})
 	if err != nil {
 		r.Reporter.Errorf("Failed to get Managed Service: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	r.Reporter.Debugf("Deleting service with id %q", args.ID)
 	_, err = r.OCMClient.DeleteManagedService(args)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	r.Reporter.Infof("Service %q will start uninstalling now", args.ID)
 
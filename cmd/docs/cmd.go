@@ -18,12 +18,12 @@ package docs
 
 import (
 	"fmt"
-	"os"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/cobra/doc"
 
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -82,7 +82,7 @@ func run(cmd *cobra.Command, _ []string) {
 	r := rosa.NewRuntime()
 	if err != nil {
 		r.Reporter.Errorf("Failed to generate documents: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	r.Reporter.Infof("Documents generated successfully on '%s'", args.dir)
@@ -18,7 +18,6 @@ package oc
 
 import (
 	"fmt"
-	"os"
 	"runtime"
 
 	"github.com/spf13/cobra"
@@ -56,7 +55,7 @@ func run(cmd *cobra.Command, argv []string) {
 	err := helper.Download(downloadURL, filename)
 	if err != nil {
 		reporter.Errorf("%s", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	reporter.Infof("Successfully downloaded %s", filename)
@@ -18,7 +18,6 @@ package rosa
 
 import (
 	"fmt"
-	"os"
 	"runtime"
 
 	"github.com/spf13/cobra"
@@ -54,7 +53,7 @@ func run(_ *cobra.Command, _ []string) {
 	err := helper.Download(downloadURL, filename)
 	if err != nil {
 		reporter.Errorf("%s", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	reporter.Infof("Successfully downloaded %s", filename)
@@ -34,6 +34,7 @@ import (
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -209,7 +210,7 @@ func run(cmd *cobra.Command, _ []string) {
 	expiration, err := validateExpiration()
 	if err != nil {
 		r.Reporter.Errorf(fmt.Sprintf("%s", err))
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if interactive.Enabled() {
@@ -260,7 +261,7 @@ func run(cmd *cobra.Command, _ []string) {
 			len(noProxySlice) > 0 ||
 			(additionalTrustBundleFile != nil && *additionalTrustBundleFile != "")) {
 		r.Reporter.Errorf("Cluster-wide proxy is not supported on clusters using the default VPC")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	var additionalAllowedPrincipals []string
@@ -283,7 +284,7 @@ func run(cmd *cobra.Command, _ []string) {
 	privateWarning, err = warnUserForOAuthHCPVisibility(r, clusterKey, cluster, privateWarning)
 	if err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if interactive.Enabled() {
 		privateValue, err = interactive.GetBool(interactive.Input{
@@ -293,13 +294,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid private value: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		private = &privateValue
 	} else if privateValue {
 		r.Reporter.Warnf("You are choosing to make your cluster API private. %s", privateWarning)
 		if !confirm.Confirm("set cluster '%s' as private", clusterKey) {
-			os.Exit(0)
+			reporter.Exit(0)
 		}
 	}
 
@@ -321,12 +322,12 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid disable-workload-monitoring value: %v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		disableWorkloadMonitoring = &disableWorkloadMonitoringValue
 	} else if disableWorkloadMonitoringValue {
 		if !confirm.Confirm("disable workload monitoring for your cluster %s", clusterKey) {
-			os.Exit(0)
+			reporter.Exit(0)
 		}
 	}
 
@@ -342,7 +343,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid proxy-enabled value: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		enableProxy = enableProxyValue
 	}
@@ -376,7 +377,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid http proxy: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		if len(httpProxyValue) == 0 {
@@ -394,7 +395,7 @@ func run(cmd *cobra.Command, _ []string) {
 		err = ocm.ValidateHTTPProxy(*httpProxy)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -418,7 +419,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid https proxy: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		if len(httpsProxyValue) == 0 {
 			//user skipped the prompt by pressing 'enter'
@@ -435,7 +436,7 @@ func run(cmd *cobra.Command, _ []string) {
 		err = interactive.IsURL(*httpsProxy)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -452,13 +453,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid set of no proxy domains/CIDR's: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		noProxySlice = helper.HandleEmptyStringOnSlice(strings.Split(noProxyInput, ","))
 	}
 	if isExpectedHTTPProxyOrHTTPSProxy(httpProxy, httpsProxy, noProxySlice, cluster) {
 		r.Reporter.Errorf("Expected at least one of the following: http-proxy, https-proxy")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if len(noProxySlice) > 0 {
@@ -469,13 +470,13 @@ func run(cmd *cobra.Command, _ []string) {
 		duplicate, found := aws.HasDuplicates(noProxySlice)
 		if found {
 			r.Reporter.Errorf("Invalid no-proxy list, duplicate key '%s' found", duplicate)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		for _, domain := range noProxySlice {
 			err := aws.UserNoProxyValidator(domain)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		}
 	}
@@ -493,7 +494,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid -update-additional-trust-bundle value: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		updateAdditionalTrustBundle = updateAdditionalTrustBundleValue
 	}
@@ -516,7 +517,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid additional trust bundle file name: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		if len(additionalTrustBundleFileValue) == 0 {
@@ -534,7 +535,7 @@ func run(cmd *cobra.Command, _ []string) {
 		err = ocm.ValidateAdditionalTrustBundle(*additionalTrustBundleFile)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -551,7 +552,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid update-additional-allowed-principals value: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		updateAdditionalAllowedPrincipals = updateAdditionalAllowedPrincipalsValue
 	}
@@ -563,7 +564,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for Additional Allowed Principal ARNs: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		additionalAllowedPrincipals = helper.HandleEmptyStringOnSlice(strings.Split(aapInputs, ","))
 	}
@@ -574,7 +575,7 @@ func run(cmd *cobra.Command, _ []string) {
 		} else {
 			if err := roles.ValidateAdditionalAllowedPrincipals(additionalAllowedPrincipals); err != nil {
 				r.Reporter.Errorf(err.Error())
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		}
 	}
@@ -583,13 +584,13 @@ func run(cmd *cobra.Command, _ []string) {
 	auditLogRole, err := setAuditLogForwarding(r, cmd, cluster, args.auditLogRoleARN)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if interactive.Enabled() && aws.IsHostedCP(cluster) {
 		auditLogRole, err = auditLogInteractivePrompt(r, cmd, cluster)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -621,7 +622,7 @@ func run(cmd *cobra.Command, _ []string) {
 				cert, err := os.ReadFile(*additionalTrustBundleFile)
 				if err != nil {
 					r.Reporter.Errorf("Failed to read additional trust bundle file: %s", err)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 				*clusterConfig.AdditionalTrustBundle = string(cert)
 			}
@@ -636,7 +637,7 @@ func run(cmd *cobra.Command, _ []string) {
 		cmd.Flags(), clusterRegistryConfigArgs, aws.IsHostedCP(cluster), cluster)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if clusterRegistryConfigArgs != nil {
 		allowedRegistries, blockedRegistries, insecureRegistries,
@@ -651,7 +652,7 @@ func run(cmd *cobra.Command, _ []string) {
 				"(all machinepool nodes will be recreated, following pod draining from each node). Do you want to proceed?"
 			if !confirm.ConfirmRaw(prompt) {
 				r.Reporter.Warnf("You have not changed any registry configuration -- exiting.")
-				os.Exit(0)
+				reporter.Exit(0)
 			}
 		}
 
@@ -663,7 +664,7 @@ func run(cmd *cobra.Command, _ []string) {
 			ca, err := clusterregistryconfig.BuildAdditionalTrustedCAFromInputFile(additionalTrustedCa)
 			if err != nil {
 				r.Reporter.Errorf("Failed to build the additional trusted ca from file %s, got error: %s", additionalTrustedCa, err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			clusterConfig.AdditionalTrustedCa = ca
 			clusterConfig.AdditionalTrustedCaFile = additionalTrustedCa
@@ -691,7 +692,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -700,12 +701,12 @@ func run(cmd *cobra.Command, _ []string) {
 		newDeleteProtection, err := cmv1.NewDeleteProtection().Enabled(deleteProtection).Build()
 		if err != nil {
 			r.Reporter.Errorf("Failed to build delete protection: %v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		if err := r.OCMClient.UpdateClusterDeletionProtection(cluster.ID(), newDeleteProtection); err != nil {
 			r.Reporter.Errorf("Failed to update cluster delete protection: %v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -713,7 +714,7 @@ func run(cmd *cobra.Command, _ []string) {
 	err = r.OCMClient.UpdateCluster(cluster.ID(), r.Creator, clusterConfig)
 	if err != nil {
 		r.Reporter.Errorf("Failed to update cluster: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	r.Reporter.Infof("Updated cluster '%s'", clusterKey)
 }
@@ -823,13 +824,13 @@ func confirmAuditLogForwarding(r *rosa.Runtime, auditLogArn *string) {
 	if *auditLogArn != "" {
 		r.Reporter.Warnf("You are choosing to enable audit log forwarding")
 		if !confirm.Confirm("enable audit log forwarding for cluster with the provided role arn '%s'", *auditLogArn) {
-			os.Exit(0)
+			reporter.Exit(0)
 		}
 		return
 	}
 	r.Reporter.Warnf("You are choosing to disable audit log forwarding.")
 	if !confirm.Confirm("disable audit log forwarding for cluster") {
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 }
 
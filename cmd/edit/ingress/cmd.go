@@ -18,7 +18,6 @@ package ingress
 
 import (
 	"fmt"
-	"os"
 	"reflect"
 	"regexp"
 	"strings"
@@ -33,6 +32,7 @@ import (
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/interactive/consts"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -162,7 +162,7 @@ func run(cmd *cobra.Command, argv []string) {
 			"Ingress identifier '%s' isn't valid: it must contain between three and five lowercase letters or digits",
 			ingressKey,
 		)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	clusterKey := r.GetClusterKey()
@@ -181,7 +181,7 @@ func run(cmd *cobra.Command, argv []string) {
 		hasLegacyIngressSupport, err = r.OCMClient.HasLegacyIngressSupport(cluster)
 		if err != nil {
 			r.Reporter.Errorf("There was a problem checking version compatibility: %v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -191,12 +191,12 @@ func run(cmd *cobra.Command, argv []string) {
 				"New ingress attributes %s can't be supplied for Hosted Control Plane clusters",
 				utils.SliceToSortedString(exclusivelyIngressV2Flags),
 			)
-			os.Exit(1)
+			reporter.Exit(1)
 		} else if hasLegacyIngressSupport {
 			r.Reporter.Errorf("New ingress attributes %s can't be supplied for legacy supported clusters."+
 				" For more information on how to be supported please check: %s",
 				utils.SliceToSortedString(exclusivelyIngressV2Flags), ingressV2DocLink)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -204,7 +204,7 @@ func run(cmd *cobra.Command, argv []string) {
 		r.Reporter.Errorf(
 			"Classic cluster '%s' is PrivateLink on legacy ingress support and does not allow updating ingresses",
 			clusterKey)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	var private *bool
@@ -218,7 +218,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid private value: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		private = &privArg
 	}
@@ -231,27 +231,27 @@ func run(cmd *cobra.Command, argv []string) {
 		err := r.OCMClient.UpdateCluster(clusterKey, r.Creator, clusterConfig)
 		if err != nil {
 			r.Reporter.Errorf("Failed to update cluster API on cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		r.Reporter.Infof("Updated ingress '%s' on cluster '%s'", ingressKey, clusterKey)
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	ingress, err := r.OCMClient.GetIngress(cluster.ID(), ingressKey)
 	if err != nil {
 		r.Reporter.Errorf("Failed to fetch ingress: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	var routeSelector *string
 	if cmd.Flags().Changed(routeSelectorFlag) || cmd.Flags().Changed(labelMatchFlag) {
 		if ocm.IsHyperShiftCluster(cluster) {
 			r.Reporter.Errorf("Updating route selectors is not supported for Hosted Control Plane clusters")
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		if ingress.Default() && hasLegacyIngressSupport {
 			r.Reporter.Errorf("Updating route selectors for default ingress is not allowed for legacy ingress support")
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		routeSelector = &args.routeSelector
 	} else if interactive.Enabled() && !ocm.IsHyperShiftCluster(cluster) &&
@@ -269,7 +269,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		routeSelector = &routeSelectorArg
 	}
@@ -278,11 +278,11 @@ func run(cmd *cobra.Command, argv []string) {
 	if cmd.Flags().Changed(lbTypeFlag) {
 		if ocm.IsHyperShiftCluster(cluster) {
 			r.Reporter.Errorf("Updating Load Balancer Type is not supported for Hosted Control Plane clusters")
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		if ocm.IsSts(cluster) && hasLegacyIngressSupport {
 			r.Reporter.Errorf("Updating Load Balancer Type is not supported for STS clusters on legacy ingress support")
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		lbType = &args.lbType
 	} else if interactive.Enabled() && (!ocm.IsHyperShiftCluster(cluster) &&
@@ -299,7 +299,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid Load Balancer type: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		lbType = &lbTypeArg
 	}
@@ -313,7 +313,7 @@ func run(cmd *cobra.Command, argv []string) {
 		if cmd.Flags().Changed(excludedNamespacesFlag) {
 			if ocm.IsHyperShiftCluster(cluster) {
 				r.Reporter.Errorf("Updating excluded namespace is not supported for Hosted Control Plane clusters")
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			excludedNamespaces = &args.excludedNamespaces
 		} else if isInteractiveEnabledAndNotHcp {
@@ -324,14 +324,14 @@ func run(cmd *cobra.Command, argv []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			excludedNamespaces = &excludedNamespacesArg
 		}
 		if cmd.Flags().Changed(wildcardPolicyFlag) {
 			if ocm.IsHyperShiftCluster(cluster) {
 				r.Reporter.Errorf("Updating Wildcard Policy is not supported for Hosted Control Plane clusters")
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			wildcardPolicy = &args.wildcardPolicy
 		} else if isInteractiveEnabledAndNotHcp {
@@ -343,7 +343,7 @@ func run(cmd *cobra.Command, argv []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid Wildcard Policy: %s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			wildcardPolicy = &wildcardPolicyArg
 		}
@@ -352,7 +352,7 @@ func run(cmd *cobra.Command, argv []string) {
 				r.Reporter.Errorf(
 					"Updating Namespace Ownership Policy is not supported for Hosted Control Plane clusters",
 				)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			namespaceOwnershipPolicy = &args.namespaceOwnershipPolicy
 		} else if isInteractiveEnabledAndNotHcp {
@@ -364,7 +364,7 @@ func run(cmd *cobra.Command, argv []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid Namespace Ownership Policy: %s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			namespaceOwnershipPolicy = &namespaceOwnershipPolicyArg
 		}
@@ -374,12 +374,12 @@ func run(cmd *cobra.Command, argv []string) {
 				r.Reporter.Errorf(
 					"Updating Cluster Component Routes is not supported for Hosted Control Plane clusters",
 				)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			componentRoutes, err = parseComponentRoutes(args.componentRoutes)
 			if err != nil {
 				r.Reporter.Errorf("An error occurred whilst parsing the supplied component routes: %s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		} else if isInteractiveEnabledAndNotHcp {
 			componentRoutes = map[string]*cmv1.ComponentRouteBuilder{}
@@ -400,7 +400,7 @@ func run(cmd *cobra.Command, argv []string) {
 						})
 						if err != nil {
 							r.Reporter.Errorf("Expected a valid component route '%s': %s", parameterName, err)
-							os.Exit(1)
+							reporter.Exit(1)
 						}
 						// TODO: use reflection, couldn't get it to work
 						if parameterName == hostnameParameter {
@@ -439,7 +439,7 @@ func run(cmd *cobra.Command, argv []string) {
 			routeSelectors, err = helper.GetRouteSelector(*routeSelector)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		}
 		ingressBuilder = ingressBuilder.RouteSelectors(routeSelectors)
@@ -472,7 +472,7 @@ func run(cmd *cobra.Command, argv []string) {
 	ingress, err = ingressBuilder.Build()
 	if err != nil {
 		r.Reporter.Errorf("Failed to create ingress for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	sameRouteSelectors := routeSelector == nil || reflect.DeepEqual(curRouteSelectors, ingress.RouteSelectors())
@@ -496,7 +496,7 @@ func run(cmd *cobra.Command, argv []string) {
 		sameExcludedNamespaces && sameWildcardPolicy && sameNamespaceOwnershipPolicy &&
 		sameComponentRoutes {
 		r.Reporter.Warnf("No need to update ingress as there are no changes")
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	r.Reporter.Debugf("Updating ingress '%s' on cluster '%s'", ingress.ID(), clusterKey)
@@ -504,7 +504,7 @@ func run(cmd *cobra.Command, argv []string) {
 	if err != nil {
 		r.Reporter.Errorf("Failed to update ingress '%s' on cluster '%s': %s",
 			ingress.ID(), clusterKey, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	r.Reporter.Infof("Updated ingress '%s' on cluster '%s'", ingress.ID(), clusterKey)
 }
@@ -26,6 +26,7 @@ import (
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/wait"
 )
 
 const (
@@ -37,7 +38,9 @@ const (
 	# Enable autoscaling and Set 3-5 replicas on machine pool 'mp1' on cluster 'mycluster'
 	rosa edit machinepool --enable-autoscaling --min-replicas=3 --max-replicas=5 --cluster=mycluster mp1
 	# Set the node drain grace period to 1 hour on machine pool 'mp1' on cluster 'mycluster'
-	rosa edit machinepool --node-drain-grace-period="1 hour" --cluster=mycluster mp1`
+	rosa edit machinepool --node-drain-grace-period="1 hour" --cluster=mycluster mp1
+	# Set 6 replicas on machine pool 'mp1' on cluster 'mycluster' and wait until they are running
+	rosa edit machinepool --replicas=6 --wait --cluster=mycluster mp1`
 )
 
 var (
@@ -161,6 +164,7 @@ func NewEditMachinePoolCommand() *cobra.Command {
 			"absolute number i.e. 1, or a percentage i.e. '20%'.",
 	)
 
+	wait.AddFlags(flags)
 	output.AddFlag(cmd)
 	ocm.AddClusterFlag(cmd)
 	return cmd
@@ -17,14 +17,14 @@ limitations under the License.
 package cluster
 
 import (
-	"os"
-
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/wait"
 )
 
 func GenerateCommand() *cobra.Command {
@@ -33,12 +33,16 @@ func GenerateCommand() *cobra.Command {
 		Short: "Hibernate cluster",
 		Long:  "Hibernate cluster.",
 		Example: `  # Hibernate the cluster
-  rosa hibernate cluster -c mycluster`,
+  rosa hibernate cluster -c mycluster
+
+  # Hibernate the cluster and wait until it is hibernating
+  rosa hibernate cluster -c mycluster --wait --timeout 30m`,
 		Run:  run,
 		Args: cobra.NoArgs,
 	}
 	ocm.AddClusterFlag(Cmd)
 	confirm.AddFlag(Cmd.Flags())
+	wait.AddFlags(Cmd.Flags())
 	return Cmd
 }
 
@@ -54,21 +58,26 @@ func run(_ *cobra.Command, _ []string) {
 		r.Reporter.Errorf("Hibernating a cluster is only supported for 'Ready' clusters."+
 			" Cluster '%s' is in '%s' state",
 			clusterKey, cluster.State())
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if !confirm.Yes() {
 		r.Reporter.Infof(limitedSupportWarning)
 		if !confirm.Prompt(false, confirmationPrompt) {
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
 	err := r.OCMClient.HibernateCluster(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to update cluster: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	r.Reporter.Infof(hibernationPeriodWarning)
+	if wait.Enabled() {
+		r.Reporter.Infof("Waiting for cluster '%s' to hibernate", clusterKey)
+		err = r.OCMClient.WaitForClusterState(cluster.ID(), cmv1.ClusterStateHibernating, wait.Timeout())
+		wait.Check(r.Reporter, err)
+	}
 	r.Reporter.Infof("Cluster '%s' is hibernating.", clusterKey)
 }
@@ -18,7 +18,6 @@ package addon
 
 import (
 	"fmt"
-	"os"
 	"regexp"
 	"strings"
 
@@ -36,7 +35,9 @@ import (
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/wait"
 )
 
 const (
@@ -55,7 +56,10 @@ var Cmd = &cobra.Command{
 	Short:   "Install add-ons on cluster",
 	Long:    "Install Red Hat managed add-ons on a cluster",
 	Example: `  # Add the CodeReady Workspaces add-on installation to the cluster
-  rosa install addon --cluster=mycluster codeready-workspaces`,
+  rosa install addon --cluster=mycluster codeready-workspaces
+
+  # Install the add-on and wait until it is ready
+  rosa install addon --cluster=mycluster codeready-workspaces --wait`,
 	Run:                run,
 	DisableFlagParsing: true,
 	Args: func(cmd *cobra.Command, argv []string) error {
@@ -89,6 +93,7 @@ func init() {
 	)
 
 	confirm.AddFlag(flags)
+	wait.AddFlags(flags)
 	ocm.AddClusterFlag(Cmd)
 }
 
@@ -106,7 +111,7 @@ func run(cmd *cobra.Command, argv []string) {
 	cluster := r.FetchCluster()
 	if cluster.State() != cmv1.ClusterStateReady {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	ensureAddonNotInstalled(r, cluster.ID(), addOnID)
@@ -114,7 +119,7 @@ func run(cmd *cobra.Command, argv []string) {
 	addOn, err := r.OCMClient.GetAddOn(addOnID)
 	if err != nil {
 		r.Reporter.Warnf("Failed to get add-on '%s'", addOnID)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Verify if addon requires STS authentication
@@ -124,7 +129,7 @@ func run(cmd *cobra.Command, argv []string) {
 	}
 
 	if !confirm.Confirm("install add-on '%s' on cluster '%s'", addOnID, clusterKey) {
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	if isSTS {
@@ -139,11 +144,11 @@ func run(cmd *cobra.Command, argv []string) {
 					err = createAddonRole(r, roleName, cr, cmd, cluster)
 					if err != nil {
 						r.Reporter.Errorf("%s", err)
-						os.Exit(1)
+						reporter.Exit(1)
 					}
 				} else {
 					r.Reporter.Errorf("%s", err)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 			}
 			// TODO : verify the role has the right permissions
@@ -156,13 +161,13 @@ func run(cmd *cobra.Command, argv []string) {
 				Build()
 			if err != nil {
 				r.Reporter.Errorf("Failed to build operator role '%s': %s", roleName, err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 
 			err = r.OCMClient.AddClusterOperatorRole(cluster, operatorRole)
 			if err != nil {
 				r.Reporter.Errorf("Failed to add operator role to cluster '%s': %s", clusterKey, err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		}
 	}
@@ -170,7 +175,7 @@ func run(cmd *cobra.Command, argv []string) {
 	addonParameters, err := r.OCMClient.GetAddOnParameters(cluster.ID(), addOnID)
 	if err != nil {
 		r.Reporter.Errorf("Failed to get add-on '%s' parameters: %v", addOnID, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	var addonArguments []ocm.AddOnParam
@@ -212,20 +217,20 @@ func run(cmd *cobra.Command, argv []string) {
 				val, err = interactive.GetAddonArgument(*param, param.DefaultValue())
 				if err != nil {
 					r.Reporter.Errorf("%s", err)
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 			}
 
 			val = strings.Trim(val, " ")
 			if len(options) > 0 && !helper.Contains(values, val) {
 				r.Reporter.Errorf("Expected %v to match one of the options /%v/", val, options)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			if val != "" && param.Validation() != "" {
 				isValid, err := regexp.MatchString(param.Validation(), val)
 				if err != nil || !isValid {
 					r.Reporter.Errorf("Expected %v to match /%s/", val, param.Validation())
-					os.Exit(1)
+					reporter.Exit(1)
 				}
 			}
 			addonArguments = append(addonArguments, ocm.AddOnParam{Key: param.ID(), Val: val})
@@ -249,7 +254,7 @@ func run(cmd *cobra.Command, argv []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid billing model: %s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		}
 	}
@@ -262,7 +267,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid account id: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -275,10 +280,17 @@ func run(cmd *cobra.Command, argv []string) {
 	err = r.OCMClient.InstallAddOn(cluster.ID(), addOnID, addonArguments, billing)
 	if err != nil {
 		r.Reporter.Errorf("Failed to add add-on installation '%s' for cluster '%s': %v", addOnID, clusterKey, err)
-		os.Exit(1)
+		reporter.Exit(1)
+	}
+	if wait.Enabled() {
+		r.Reporter.Infof("Waiting for add-on '%s' to be installed on cluster '%s'", addOnID, clusterKey)
+		err = r.OCMClient.WaitForAddOnInstallation(cluster.ID(), addOnID, wait.Timeout())
+		wait.Check(r.Reporter, err)
+		r.Reporter.Infof("Add-on '%s' is ready", addOnID)
+	} else {
+		r.Reporter.Infof("Add-on '%s' is now installing. To check the status run 'rosa list addons -c %s'",
+			addOnID, clusterKey)
 	}
-	r.Reporter.Infof("Add-on '%s' is now installing. To check the status run 'rosa list addons -c %s'",
-		addOnID, clusterKey)
 	if interactive.Enabled() {
 		r.Reporter.Infof("To install this addOn again in the future, you can run:\n   %s",
 			buildCommand(cluster.Name(), addOnID, addonArguments, billing))
@@ -289,11 +301,11 @@ func ensureAddonNotInstalled(r *rosa.Runtime, clusterID, addOnID string) {
 	installation, err := r.OCMClient.GetAddOnInstallation(clusterID, addOnID)
 	if err != nil && errors.GetType(err) != errors.NotFound {
 		r.Reporter.Errorf("An error occurred while trying to get addon installation : %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if installation != nil {
 		r.Reporter.Warnf("Addon '%s' is already installed on cluster '%s'", addOnID, clusterID)
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 }
 
@@ -15,7 +15,6 @@ package ocmrole
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -24,6 +23,7 @@ import (
 	"github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -74,13 +74,13 @@ func run(cmd *cobra.Command, argv []string) {
 	orgAccount, _, err := r.OCMClient.GetCurrentOrganization()
 	if err != nil {
 		r.Reporter.Errorf("Error getting organization account: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if args.organizationID != "" && orgAccount != args.organizationID {
 		r.Reporter.Errorf("Invalid organization ID '%s'. "+
 			"It doesn't match with the user session '%s'.", args.organizationID, orgAccount)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if r.Reporter.IsTerminal() {
@@ -106,30 +106,30 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid ocm role ARN to link to a current organization: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	if roleArn != "" {
 		err = aws.ARNValidator(roleArn)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid ocm role ARN to link to a current organization: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
 	role, err := r.AWSClient.GetRoleByARN(roleArn)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem checking if role '%s' exists: %v", roleArn, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if *role.Arn != roleArn {
 		r.Reporter.Errorf("The role with '%s' cannot be found", roleArn)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if !confirm.Prompt(true, "Link the '%s' role with organization '%s'?", roleArn, orgAccount) {
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	linked, err := r.OCMClient.LinkOrgToRole(orgAccount, roleArn)
@@ -148,15 +148,15 @@ func run(cmd *cobra.Command, argv []string) {
 				"Only organization member can run this command. "+
 				"Please ask someone with the organization member role to run the following command \n\n"+
 				"\t rosa link ocm-role --role-arn %s --organization-id %s", errMessage, roleArn, orgAccount)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		r.Reporter.Errorf("Unable to link role arn '%s' with the organization id : '%s' : %v",
 			roleArn, orgAccount, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if !linked {
 		r.Reporter.Infof("Role-arn '%s' is already linked with the organization account '%s'", roleArn, orgAccount)
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 	r.Reporter.Infof("Successfully linked role-arn '%s' with organization account '%s'", roleArn, orgAccount)
 }
@@ -0,0 +1,13 @@
+package accessrequest
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestListAccessRequests(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "List access requests suite")
+}
@@ -0,0 +1,258 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accessrequest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/accesstransparency/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use     = "access-requests"
+	short   = "List access requests"
+	long    = "List the requests of Red Hat SRE to access clusters, optionally filtered by cluster, status and expiry."
+	example = `  # List the access requests of all the clusters
+  rosa list access-requests
+
+  # List the pending access requests of cluster 'mycluster'
+  rosa list access-requests --cluster mycluster --status Pending
+
+  # List the access requests that expire in the next two hours
+  rosa list access-requests --expires-within 2h
+
+  # Watch for new pending access requests and notify the on-call engineer
+  rosa list access-requests --watch --exec ./notify.sh`
+
+	statusFlag        = "status"
+	expiresWithinFlag = "expires-within"
+	watchFlag         = "watch"
+	intervalFlag      = "interval"
+	execFlag          = "exec"
+
+	defaultInterval = 30 * time.Second
+)
+
+var aliases = []string{"access-request", "accessrequests", "accessrequest"}
+
+var states = []v1.AccessRequestState{
+	v1.AccessRequestStatePending,
+	v1.AccessRequestStateApproved,
+	v1.AccessRequestStateDenied,
+	v1.AccessRequestStateExpired,
+}
+
+type Options struct {
+	statuses      []string
+	expiresWithin time.Duration
+	watch         bool
+	interval      time.Duration
+	exec          string
+}
+
+func NewListAccessRequestsOptions() *Options {
+	return &Options{
+		interval: defaultInterval,
+	}
+}
+
+func NewListAccessRequestsCommand() *cobra.Command {
+	options := NewListAccessRequestsOptions()
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), ListAccessRequestsRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.StringSliceVar(
+		&options.statuses,
+		statusFlag,
+		nil,
+		fmt.Sprintf("List only the access requests with the given statuses. Allowed values are %s.", states),
+	)
+	flags.DurationVar(
+		&options.expiresWithin,
+		expiresWithinFlag,
+		0,
+		"List only the access requests that expire within the given time, for example '2h'.",
+	)
+	flags.BoolVar(
+		&options.watch,
+		watchFlag,
+		false,
+		"Keep checking for new pending access requests until interrupted, and report them when they "+
+			"show up.",
+	)
+	flags.DurationVar(
+		&options.interval,
+		intervalFlag,
+		defaultInterval,
+		"Time between checks for new pending access requests when using '--watch'.",
+	)
+	flags.StringVar(
+		&options.exec,
+		execFlag,
+		"",
+		"Command to run with the shell for each new pending access request when using '--watch'. "+
+			"It receives the access request as a JSON document in the standard input, and its "+
+			"identifier, cluster, requester, justification and deadline in the ROSA_ACCESS_REQUEST_ID, "+
+			"ROSA_ACCESS_REQUEST_CLUSTER_ID, ROSA_ACCESS_REQUEST_REQUESTED_BY, "+
+			"ROSA_ACCESS_REQUEST_JUSTIFICATION and ROSA_ACCESS_REQUEST_DEADLINE environment variables.",
+	)
+	ocm.AddOptionalClusterFlag(cmd)
+	output.AddFlag(cmd)
+	output.AddNoHeadersFlag(cmd)
+	return cmd
+}
+
+func ListAccessRequestsRunner(options *Options) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
+		statuses, err := validateOptions(options)
+		if err != nil {
+			return err
+		}
+
+		clusterID := ""
+		if command.Flags().Changed("cluster") {
+			cluster, err := r.OCMClient.GetCluster(r.GetClusterKey(), r.Creator)
+			if err != nil {
+				return err
+			}
+			clusterID = cluster.ID()
+		}
+
+		if options.watch {
+			return watch(ctx, r, clusterID, options)
+		}
+
+		accessRequests, err := r.OCMClient.ListAccessRequests(clusterID)
+		if err != nil {
+			return err
+		}
+		accessRequests = filter(accessRequests, statuses, options.expiresWithin, time.Now())
+
+		if output.HasFlag() {
+			return output.Print(accessRequests)
+		}
+		if len(accessRequests) == 0 {
+			r.Reporter.Infof("There are no access requests")
+			return nil
+		}
+		return newTable(accessRequests).Print()
+	}
+}
+
+// Checks the combination of flags and returns the statuses given by the user, in the same case used
+// by the API.
+func validateOptions(options *Options) ([]v1.AccessRequestState, error) {
+	statuses := []v1.AccessRequestState{}
+	for _, value := range options.statuses {
+		status := v1.AccessRequestState(cases.Title(language.English, cases.Compact).String(value))
+		valid := false
+		for _, state := range states {
+			valid = valid || status == state
+		}
+		if !valid {
+			return nil, fmt.Errorf("Invalid status '%s', should be one of %s", value, states)
+		}
+		statuses = append(statuses, status)
+	}
+	if options.expiresWithin < 0 {
+		return nil, fmt.Errorf("The '--%s' flag must be positive", expiresWithinFlag)
+	}
+	if options.watch {
+		if len(statuses) > 0 {
+			return nil, fmt.Errorf("The '--%s' flag can't be used with '--%s', which only reports "+
+				"pending access requests", statusFlag, watchFlag)
+		}
+		if output.HasFlag() {
+			return nil, fmt.Errorf("The '--output' flag can't be used with '--%s'", watchFlag)
+		}
+		if options.interval <= 0 {
+			return nil, fmt.Errorf("The '--%s' flag must be positive", intervalFlag)
+		}
+	} else if options.exec != "" {
+		return nil, fmt.Errorf("The '--%s' flag can only be used with '--%s'", execFlag, watchFlag)
+	}
+	return statuses, nil
+}
+
+// Returns the access requests that have one of the statuses, if any, and that expire before the given
+// time from now, if not zero.
+func filter(accessRequests []*v1.AccessRequest, statuses []v1.AccessRequestState,
+	expiresWithin time.Duration, now time.Time) []*v1.AccessRequest {
+	result := []*v1.AccessRequest{}
+	for _, accessRequest := range accessRequests {
+		if len(statuses) > 0 {
+			found := false
+			for _, status := range statuses {
+				found = found || accessRequest.Status().State() == status
+			}
+			if !found {
+				continue
+			}
+		}
+		if expiresWithin > 0 {
+			expiresAt := accessRequest.Status().ExpiresAt()
+			if expiresAt.IsZero() || expiresAt.Before(now) || expiresAt.After(now.Add(expiresWithin)) {
+				continue
+			}
+		}
+		result = append(result, accessRequest)
+	}
+	return result
+}
+
+func newTable(accessRequests []*v1.AccessRequest) *output.Table {
+	table := output.NewTable("ID", "CLUSTER ID", "STATUS", "REQUESTED BY", "CREATED", "EXPIRES").
+		Wide("DURATION", "SUPPORT CASE")
+	for _, accessRequest := range accessRequests {
+		table.AddRow(
+			accessRequest.ID(),
+			accessRequest.ClusterId(),
+			string(accessRequest.Status().State()),
+			accessRequest.RequestedBy(),
+			formatTime(accessRequest.CreatedAt()),
+			formatTime(accessRequest.Status().ExpiresAt()),
+			accessRequest.Duration(),
+			accessRequest.SupportCaseId(),
+		)
+	}
+	return table
+}
+
+func formatTime(value time.Time) string {
+	if value.IsZero() {
+		return ""
+	}
+	return value.Format(time.RFC3339)
+}
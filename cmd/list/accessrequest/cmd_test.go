@@ -0,0 +1,133 @@
+package accessrequest
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	v1 "github.com/openshift-online/ocm-sdk-go/accesstransparency/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var now = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func mockAccessRequest(id string, state v1.AccessRequestState, expiresAt time.Time) *v1.AccessRequest {
+	accessRequest, err := v1.NewAccessRequest().
+		ID(id).
+		ClusterId("123").
+		RequestedBy("sre@redhat.com").
+		Justification("Investigating an alert").
+		Duration("1h").
+		CreatedAt(now.Add(-time.Hour)).
+		Status(v1.NewAccessRequestStatus().State(state).ExpiresAt(expiresAt)).
+		Build()
+	Expect(err).NotTo(HaveOccurred())
+	return accessRequest
+}
+
+var _ = Describe("List access requests", func() {
+	var t *TestingRuntime
+	var options *Options
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+		options = NewListAccessRequestsOptions()
+		output.SetOutput("")
+	})
+
+	AfterEach(func() {
+		output.SetOutput("")
+	})
+
+	It("Correctly builds the command", func() {
+		cmd := NewListAccessRequestsCommand()
+		Expect(cmd.Use).To(Equal(use))
+		for _, name := range []string{"cluster", "status", "expires-within", "watch", "interval", "exec",
+			"output", "no-headers"} {
+			Expect(cmd.Flags().Lookup(name)).NotTo(BeNil(), name)
+		}
+	})
+
+	It("Lists the access requests", func() {
+		t.ApiServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/access_transparency/v1/access_requests"),
+				RespondWithJSON(http.StatusOK, FormatAccessRequestList([]*v1.AccessRequest{
+					mockAccessRequest("abc", v1.AccessRequestStatePending, now.Add(time.Hour)),
+				})),
+			),
+		)
+		t.StdOutReader.Record()
+		cmd := NewListAccessRequestsCommand()
+		err := ListAccessRequestsRunner(options)(context.Background(), t.RosaRuntime, cmd, nil)
+		Expect(err).NotTo(HaveOccurred())
+		stdout, _ := t.StdOutReader.Read()
+		Expect(stdout).To(Equal("" +
+			"ID   CLUSTER ID  STATUS   REQUESTED BY    CREATED               EXPIRES\n" +
+			"abc  123         Pending  sre@redhat.com  2024-05-01T11:00:00Z  2024-05-01T13:00:00Z\n"))
+	})
+
+	It("Rejects invalid statuses", func() {
+		options.statuses = []string{"waiting"}
+		_, err := validateOptions(options)
+		Expect(err).To(MatchError("Invalid status 'waiting', should be one of [Pending Approved Denied Expired]"))
+	})
+
+	It("Rejects '--exec' without '--watch'", func() {
+		options.exec = "true"
+		_, err := validateOptions(options)
+		Expect(err).To(MatchError("The '--exec' flag can only be used with '--watch'"))
+	})
+
+	It("Filters by status and expiry", func() {
+		statuses, err := validateOptions(&Options{statuses: []string{"pending", "approved"}})
+		Expect(err).NotTo(HaveOccurred())
+		accessRequests := []*v1.AccessRequest{
+			mockAccessRequest("pending-soon", v1.AccessRequestStatePending, now.Add(30*time.Minute)),
+			mockAccessRequest("pending-later", v1.AccessRequestStatePending, now.Add(3*time.Hour)),
+			mockAccessRequest("approved-soon", v1.AccessRequestStateApproved, now.Add(time.Hour)),
+			mockAccessRequest("denied-soon", v1.AccessRequestStateDenied, now.Add(time.Hour)),
+			mockAccessRequest("expired", v1.AccessRequestStateApproved, now.Add(-time.Hour)),
+		}
+		result := filter(accessRequests, statuses, 2*time.Hour, now)
+		ids := []string{}
+		for _, accessRequest := range result {
+			ids = append(ids, accessRequest.ID())
+		}
+		Expect(ids).To(Equal([]string{"pending-soon", "approved-soon"}))
+	})
+
+	It("Runs the hook once for each new pending access request", func() {
+		file := filepath.Join(GinkgoT().TempDir(), "hook.log")
+		options.watch = true
+		options.exec = "echo \"$ROSA_ACCESS_REQUEST_ID $ROSA_ACCESS_REQUEST_REQUESTED_BY\" >> " + file
+		list := func(accessRequests ...*v1.AccessRequest) http.HandlerFunc {
+			return RespondWithJSON(http.StatusOK, FormatAccessRequestList(accessRequests))
+		}
+		first := mockAccessRequest("first", v1.AccessRequestStatePending, now.Add(time.Hour))
+		second := mockAccessRequest("second", v1.AccessRequestStatePending, now.Add(time.Hour))
+		approved := mockAccessRequest("approved", v1.AccessRequestStateApproved, now.Add(time.Hour))
+		t.ApiServer.AppendHandlers(list(first, approved), list(second, first, approved))
+
+		seen := map[string]bool{}
+		t.StdOutReader.Record()
+		Expect(poll(t.RosaRuntime, "", options, seen, now)).To(Succeed())
+		Expect(poll(t.RosaRuntime, "", options, seen, now)).To(Succeed())
+		stdout, _ := t.StdOutReader.Read()
+		Expect(stdout).To(ContainSubstring("Access request 'first' for cluster '123' from 'sre@redhat.com' " +
+			"is pending: Investigating an alert"))
+		Expect(stdout).To(ContainSubstring("Access request 'second'"))
+
+		content, err := os.ReadFile(file)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("first sre@redhat.com\nsecond sre@redhat.com\n"))
+	})
+})
@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the '--watch' flag, which polls the access requests and runs
+// the '--exec' hook for each pending request the first time it sees it. Requests that are already
+// pending when the command starts are reported too, as they also need a decision.
+
+package accessrequest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"time"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/accesstransparency/v1"
+
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+func watch(ctx context.Context, r *rosa.Runtime, clusterID string, options *Options) error {
+	r.Reporter.Infof("Checking for new pending access requests every %s, press Ctrl+C to stop", options.interval)
+	seen := map[string]bool{}
+	for {
+		err := poll(r, clusterID, options, seen, time.Now())
+		if err != nil {
+			r.Reporter.Warnf("Failed to check the access requests: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(options.interval):
+		}
+	}
+}
+
+// Lists the pending access requests and reports the ones that aren't in the seen set, adding them to it.
+func poll(r *rosa.Runtime, clusterID string, options *Options, seen map[string]bool, now time.Time) error {
+	accessRequests, err := r.OCMClient.ListAccessRequests(clusterID)
+	if err != nil {
+		return err
+	}
+	accessRequests = filter(accessRequests, []v1.AccessRequestState{v1.AccessRequestStatePending},
+		options.expiresWithin, now)
+	// The list is sorted from the most recent, but new requests are reported in the order they were created:
+	for i := len(accessRequests) - 1; i >= 0; i-- {
+		accessRequest := accessRequests[i]
+		if seen[accessRequest.ID()] {
+			continue
+		}
+		seen[accessRequest.ID()] = true
+		r.Reporter.Infof("Access request '%s' for cluster '%s' from '%s' is pending: %s",
+			accessRequest.ID(), accessRequest.ClusterId(), accessRequest.RequestedBy(),
+			accessRequest.Justification())
+		if options.exec == "" {
+			continue
+		}
+		err = runHook(options.exec, accessRequest)
+		if err != nil {
+			r.Reporter.Warnf("Command '%s' failed for access request '%s': %v",
+				options.exec, accessRequest.ID(), err)
+		}
+	}
+	return nil
+}
+
+func runHook(command string, accessRequest *v1.AccessRequest) error {
+	var input bytes.Buffer
+	err := v1.MarshalAccessRequest(accessRequest, &input)
+	if err != nil {
+		return err
+	}
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+	cmd.Env = append(os.Environ(),
+		fmt.Sprintf("ROSA_ACCESS_REQUEST_ID=%s", accessRequest.ID()),
+		fmt.Sprintf("ROSA_ACCESS_REQUEST_CLUSTER_ID=%s", accessRequest.ClusterId()),
+		fmt.Sprintf("ROSA_ACCESS_REQUEST_REQUESTED_BY=%s", accessRequest.RequestedBy()),
+		fmt.Sprintf("ROSA_ACCESS_REQUEST_JUSTIFICATION=%s", accessRequest.Justification()),
+		fmt.Sprintf("ROSA_ACCESS_REQUEST_DEADLINE=%s", formatTime(accessRequest.DeadlineAt())),
+	)
+	cmd.Stdin = &input
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
@@ -17,9 +17,6 @@ limitations under the License.
 package accountroles
 
 import (
-	"fmt"
-	"os"
-	"text/tabwriter"
 	"time"
 
 	"github.com/briandowns/spinner"
@@ -27,6 +24,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -55,6 +53,7 @@ func init() {
 		"List only account-roles that are associated with the given version.",
 	)
 	output.AddFlag(Cmd)
+	output.AddNoHeadersFlag(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -64,14 +63,14 @@ func run(_ *cobra.Command, _ []string) {
 	versionList, err := ocm.GetVersionMinorList(r.OCMClient)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	_, err = r.OCMClient.ValidateVersion(args.version, versionList,
 		r.Cluster.Version().ChannelGroup(), r.Cluster.AWS().STS().RoleARN() == "", r.Cluster.Hypershift().Enabled())
 	if err != nil {
 		r.Reporter.Errorf("Version '%s' is invalid", args.version)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	var spin *spinner.Spinner
@@ -91,34 +90,30 @@ func run(_ *cobra.Command, _ []string) {
 
 	if err != nil {
 		r.Reporter.Errorf("Failed to get account roles: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if output.HasFlag() {
 		err = output.Print(accountRoles)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	if len(accountRoles) == 0 {
 		r.Reporter.Infof("No account roles available")
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(writer, "ROLE NAME\tROLE TYPE\tROLE ARN\tOPENSHIFT VERSION\tAWS Managed\n")
+	table := output.NewTable("ROLE NAME", "ROLE TYPE", "ROLE ARN", "OPENSHIFT VERSION", "AWS Managed")
 	for _, accountRole := range accountRoles {
 		awsManaged := "No"
 		if accountRole.ManagedPolicy {
 			awsManaged = "Yes"
 		}
-		fmt.Fprintf(
-			writer,
-			"%s\t%s\t%s\t%s\t%s\n",
+		table.AddRow(
 			accountRole.RoleName,
 			accountRole.RoleType,
 			accountRole.RoleARN,
@@ -126,5 +121,5 @@ func run(_ *cobra.Command, _ []string) {
 			awsManaged,
 		)
 	}
-	writer.Flush()
+	table.Print()
 }
@@ -17,15 +17,12 @@ limitations under the License.
 package addon
 
 import (
-	"fmt"
-	"os"
-	"text/tabwriter"
-
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -56,6 +53,7 @@ func init() {
 	)
 
 	output.AddFlag(Cmd)
+	output.AddNoHeadersFlag(Cmd)
 }
 
 // When no specific cluster id is provided by the user, this function lists all available AddOns
@@ -64,36 +62,35 @@ func listAllAddOns(r *rosa.Runtime) {
 	addOnResources, err := r.OCMClient.GetAvailableAddOns()
 	if err != nil {
 		r.Reporter.Errorf("Failed to fetch add-ons: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if output.HasFlag() {
 		err = output.Print(addOnResources)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	if len(addOnResources) == 0 {
 		r.Reporter.Infof("There are no add-ons available")
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(writer, "ID\t\tNAME\t\tAVAILABILITY\n")
+	table := output.NewTable("ID", "", "NAME", "", "AVAILABILITY").Wide("VERSION")
 	for _, addOnResource := range addOnResources {
 		availability := "unavailable"
 		if addOnResource.Available {
 			availability = "available"
 		}
-		fmt.Fprintf(writer, "%s\t\t%s\t\t%s\n", addOnResource.AddOn.ID(), addOnResource.AddOn.Name(), availability)
+		table.AddRow(addOnResource.AddOn.ID(), addOnResource.AddOn.Name(), availability,
+			addOnResource.AddOn.Version().ID())
 	}
-	writer.Flush()
+	table.Print()
 
-	os.Exit(0)
+	reporter.Exit(0)
 }
 
 // When the user specifies a clusterKey, this function lists the AddOns for that cluster
@@ -110,14 +107,14 @@ func listClusterAddOns(clusterKey string, r *rosa.Runtime) {
 				"must contain only letters, digits, dashes and underscores",
 			clusterKey,
 		)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	cluster := r.FetchCluster()
 	if cluster.State() != cmv1.ClusterStateReady &&
 		cluster.State() != cmv1.ClusterStateHibernating {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Load any existing Add-Ons for this cluster
@@ -125,30 +122,28 @@ func listClusterAddOns(clusterKey string, r *rosa.Runtime) {
 	clusterAddOns, err := r.OCMClient.GetClusterAddOns(cluster)
 	if err != nil {
 		r.Reporter.Errorf("Failed to get add-ons for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if output.HasFlag() {
 		err = output.Print(clusterAddOns)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	if len(clusterAddOns) == 0 {
 		r.Reporter.Infof("There are no add-ons installed on cluster '%s'", clusterKey)
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(writer, "ID\t\tNAME\t\tSTATE\n")
+	table := output.NewTable("ID", "", "NAME", "", "STATE")
 	for _, clusterAddOn := range clusterAddOns {
-		fmt.Fprintf(writer, "%s\t\t%s\t\t%s\n", clusterAddOn.ID, clusterAddOn.Name, clusterAddOn.State)
+		table.AddRow(clusterAddOn.ID, clusterAddOn.Name, clusterAddOn.State)
 	}
-	writer.Flush()
+	table.Print()
 }
 
 func run(_ *cobra.Command, _ []string) {
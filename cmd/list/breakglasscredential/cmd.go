@@ -2,14 +2,14 @@ package breakglasscredential
 
 import (
 	"fmt"
-	"os"
-	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/externalauthprovider"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -27,6 +27,7 @@ var Cmd = &cobra.Command{
 func init() {
 	ocm.AddClusterFlag(Cmd)
 	output.AddFlag(Cmd)
+	output.AddNoHeadersFlag(Cmd)
 }
 
 func run(cmd *cobra.Command, _ []string) {
@@ -35,7 +36,7 @@ func run(cmd *cobra.Command, _ []string) {
 	err := runWithRuntime(r, cmd)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 }
 
@@ -69,18 +70,18 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		return nil
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
-
-	fmt.Fprintf(writer, "ID\tUSERNAME\tSTATUS\n")
+	table := output.NewTable("ID", "USERNAME", "STATUS").Wide("EXPIRATION")
 	for _, credential := range breakGlassCredentials {
-		fmt.Fprintf(writer, "%s\t%s\t%s\n",
+		expiration := ""
+		if !credential.ExpirationTimestamp().IsZero() {
+			expiration = credential.ExpirationTimestamp().Format(time.RFC3339)
+		}
+		table.AddRow(
 			credential.ID(),
 			credential.Username(),
 			credential.Status(),
+			expiration,
 		)
 	}
-	writer.Flush()
-
-	return nil
+	return table.Print()
 }
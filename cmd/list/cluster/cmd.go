@@ -17,15 +17,13 @@ limitations under the License.
 package cluster
 
 import (
-	"fmt"
-	"os"
-	"text/tabwriter"
-
 	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -35,16 +33,34 @@ var Cmd = &cobra.Command{
 	Short:   "List clusters",
 	Long:    "List clusters.",
 	Example: `  # List all clusters
-  rosa list clusters`,
+  rosa list clusters
+
+  # List the ready hosted control plane clusters in a region
+  rosa list clusters --state ready --hosted-cp --cluster-region us-east-1
+
+  # List the ten newest 4.14 clusters
+  rosa list clusters --version 4.14 --order-by "creation_timestamp desc" --limit 10
+
+  # List the clusters that match a query
+  rosa list clusters --search "name like 'prod-%'"
+
+  # List the ID and version of all clusters without headers
+  rosa list clusters -o custom-columns=ID:.id,VERSION:.openshift_version --no-headers`,
 	Args: cobra.NoArgs,
 	Run:  run,
 }
 
-const clusterCount = 1000
-
 var args struct {
 	listAll        bool
 	accountRoleArn string
+	search         string
+	states         []string
+	version        string
+	hostedCP       bool
+	clusterRegion  string
+	labels         []string
+	orderBy        string
+	limit          int
 }
 
 func init() {
@@ -52,22 +68,55 @@ func init() {
 	flags.SortFlags = false
 
 	output.AddFlag(Cmd)
+	output.AddNoHeadersFlag(Cmd)
 	flags.BoolVarP(&args.listAll, "all", "a", false, "List all clusters across different AWS "+
 		"accounts under the same Red Hat organization")
 	flags.StringVar(&args.accountRoleArn, "account-role-arn", "", "List all clusters "+
 		"using the account role identified by the ARN")
+	flags.StringVar(&args.search, "search", "", "Only list the clusters that match the given query "+
+		"of the OCM search language, for example \"name like 'prod-%'\"")
+	flags.StringSliceVar(&args.states, "state", nil, "Only list the clusters in the given states, "+
+		"for example 'ready' or 'installing,error'")
+	flags.StringVar(&args.version, "version", "", "Only list the clusters with the given OpenShift "+
+		"version, or with a version starting with it, like '4.14'")
+	flags.BoolVar(&args.hostedCP, "hosted-cp", false, "Only list hosted control plane clusters, "+
+		"or classic clusters with '--hosted-cp=false'")
+	flags.StringVar(&args.clusterRegion, "cluster-region", "", "Only list the clusters in the given "+
+		"AWS region, for example 'us-east-1'")
+	flags.StringArrayVar(&args.labels, "label", nil, "Only list the clusters with the given "+
+		"'key=value' property, or with the 'key' property set. Can be repeated")
+	flags.StringVar(&args.orderBy, "order-by", "", "Order the clusters by the given fields, "+
+		"for example 'creation_timestamp desc'")
+	flags.IntVar(&args.limit, "limit", 0, "Maximum number of clusters to list, 0 lists all of them")
+}
+
+func listOptions(cmd *cobra.Command) ocm.ClusterListOptions {
+	options := ocm.ClusterListOptions{
+		Search:  args.search,
+		States:  args.states,
+		Version: args.version,
+		Region:  args.clusterRegion,
+		Labels:  args.labels,
+		OrderBy: args.orderBy,
+		Limit:   args.limit,
+	}
+	if cmd.Flags().Changed("hosted-cp") {
+		options.HostedCP = &args.hostedCP
+	}
+	return options
 }
 
-func listClustersUsingAccountRole(creator *aws.Creator, runtime *rosa.Runtime) ([]*v1.Cluster, error) {
+func listClustersUsingAccountRole(creator *aws.Creator, runtime *rosa.Runtime,
+	options ocm.ClusterListOptions) ([]*v1.Cluster, error) {
 	role, err := runtime.AWSClient.GetAccountRoleByArn(args.accountRoleArn)
 	if err != nil {
 		return []*v1.Cluster{}, err
 	}
 
-	return runtime.OCMClient.GetClustersUsingAccountRole(creator, role, clusterCount)
+	return runtime.OCMClient.ListClustersUsingAccountRole(creator, role, options)
 }
 
-func run(_ *cobra.Command, _ []string) {
+func run(cmd *cobra.Command, _ []string) {
 	r := rosa.NewRuntime().WithAWS().WithOCM()
 	defer r.Cleanup()
 
@@ -82,34 +131,33 @@ func run(_ *cobra.Command, _ []string) {
 	var clusters []*v1.Cluster
 	var err error
 
+	options := listOptions(cmd)
 	if args.accountRoleArn != "" {
-		clusters, err = listClustersUsingAccountRole(creator, r)
+		clusters, err = listClustersUsingAccountRole(creator, r, options)
 	} else {
-		clusters, err = r.OCMClient.GetClusters(creator, clusterCount)
+		clusters, err = r.OCMClient.ListClusters(creator, options)
 	}
 
 	if err != nil {
 		r.Reporter.Errorf("Failed to get clusters: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if output.HasFlag() {
 		err = output.Print(clusters)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	if len(clusters) == 0 {
 		r.Reporter.Infof("No clusters available")
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(writer, "ID\tNAME\tSTATE\tTOPOLOGY\n")
+	table := output.NewTable("ID", "NAME", "STATE", "TOPOLOGY").Wide("VERSION", "REGION")
 	for _, cluster := range clusters {
 		typeOutput := "Classic"
 		if cluster.AWS() != nil && cluster.AWS().STS() != nil && cluster.AWS().STS().Enabled() {
@@ -118,14 +166,14 @@ func run(_ *cobra.Command, _ []string) {
 		if cluster.Hypershift().Enabled() {
 			typeOutput = "Hosted CP"
 		}
-		fmt.Fprintf(
-			writer,
-			"%s\t%s\t%s\t%s\n",
+		table.AddRow(
 			cluster.ID(),
 			cluster.Name(),
 			cluster.State(),
 			typeOutput,
+			cluster.OpenshiftVersion(),
+			cluster.Region().ID(),
 		)
 	}
-	writer.Flush()
+	table.Print()
 }
@@ -19,6 +19,7 @@ package list
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/cmd/list/accessrequest"
 	"github.com/openshift/rosa/cmd/list/accountroles"
 	"github.com/openshift/rosa/cmd/list/addon"
 	"github.com/openshift/rosa/cmd/list/breakglasscredential"
@@ -26,6 +27,7 @@ import (
 	"github.com/openshift/rosa/cmd/list/dnsdomains"
 	"github.com/openshift/rosa/cmd/list/externalauthprovider"
 	"github.com/openshift/rosa/cmd/list/gates"
+	"github.com/openshift/rosa/cmd/list/hibernationschedule"
 	"github.com/openshift/rosa/cmd/list/idp"
 	"github.com/openshift/rosa/cmd/list/ingress"
 	"github.com/openshift/rosa/cmd/list/instancetypes"
@@ -35,6 +37,7 @@ import (
 	"github.com/openshift/rosa/cmd/list/oidcconfig"
 	"github.com/openshift/rosa/cmd/list/oidcprovider"
 	"github.com/openshift/rosa/cmd/list/operatorroles"
+	"github.com/openshift/rosa/cmd/list/orphans"
 	"github.com/openshift/rosa/cmd/list/region"
 	"github.com/openshift/rosa/cmd/list/rhRegion"
 	"github.com/openshift/rosa/cmd/list/service"
@@ -80,6 +83,12 @@ func init() {
 	Cmd.AddCommand(breakglasscredential.Cmd)
 	kubeletconfig := kubeletconfig.NewListKubeletConfigsCommand()
 	Cmd.AddCommand(kubeletconfig)
+	hibernationSchedulesCommand := hibernationschedule.NewListHibernationSchedulesCommand()
+	Cmd.AddCommand(hibernationSchedulesCommand)
+	accessRequestsCommand := accessrequest.NewListAccessRequestsCommand()
+	Cmd.AddCommand(accessRequestsCommand)
+	orphansCommand := orphans.NewListOrphansCommand()
+	Cmd.AddCommand(orphansCommand)
 	flags := Cmd.PersistentFlags()
 	arguments.AddProfileFlag(flags)
 	arguments.AddRegionFlag(flags)
@@ -87,13 +96,16 @@ func init() {
 	globallyAvailableCommands := []*cobra.Command{
 		accountroles.Cmd, userroles.Cmd,
 		ocmroles.Cmd, oidcconfig.Cmd,
-		oidcprovider.Cmd, cluster.Cmd,
+		// 'list clusters' isn't here because it uses the region to filter the clusters
+		oidcprovider.Cmd,
 		breakglasscredential.Cmd, addon.Cmd,
 		externalauthprovider.Cmd, dnsdomains.Cmd,
 		gates.Cmd, idp.Cmd, ingress.Cmd, machinePoolCommand,
 		operatorroles.Cmd, region.Cmd, rhRegion.Cmd,
 		service.Cmd, tuningconfigs.Cmd, upgrade.Cmd,
 		user.Cmd, version.Cmd, kubeletconfig,
+		hibernationSchedulesCommand, accessRequestsCommand,
+		orphansCommand,
 	}
 	arguments.MarkRegionDeprecated(Cmd, globallyAvailableCommands)
 }
@@ -17,14 +17,12 @@ limitations under the License.
 package dnsdomains
 
 import (
-	"fmt"
-	"os"
-	"text/tabwriter"
 	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -55,6 +53,7 @@ func init() {
 	)
 
 	output.AddFlag(Cmd)
+	output.AddNoHeadersFlag(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -69,38 +68,35 @@ func run(_ *cobra.Command, _ []string) {
 	dnsDomains, err := r.OCMClient.ListDNSDomains(search)
 	if err != nil {
 		r.Reporter.Errorf("Failed to list DNS Domains: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if output.HasFlag() {
 		err = output.Print(dnsDomains)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	if len(dnsDomains) == 0 {
 		r.Reporter.Infof("There are no DNS Domains for your organization")
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
-
-	fmt.Fprintf(writer, "ID\tCLUSTER ID\tRESERVED TIME\tUSER DEFINED\n")
+	table := output.NewTable("ID", "CLUSTER ID", "RESERVED TIME", "USER DEFINED")
 	for _, dnsdomain := range dnsDomains {
 		userDefind := "No"
 		if dnsdomain.UserDefined() {
 			userDefind = "Yes"
 		}
-		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n",
+		table.AddRow(
 			dnsdomain.ID(),
 			dnsdomain.Cluster().ID(),
 			dnsdomain.ReservedAtTimestamp().Format(time.RFC3339),
 			userDefind,
 		)
 	}
-	writer.Flush()
+	table.Print()
 }
@@ -18,14 +18,13 @@ package externalauthprovider
 
 import (
 	"fmt"
-	"os"
-	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/externalauthprovider"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -43,6 +42,7 @@ var Cmd = &cobra.Command{
 func init() {
 	ocm.AddClusterFlag(Cmd)
 	output.AddFlag(Cmd)
+	output.AddNoHeadersFlag(Cmd)
 }
 
 func run(cmd *cobra.Command, _ []string) {
@@ -51,7 +51,7 @@ func run(cmd *cobra.Command, _ []string) {
 	err := runWithRuntime(r, cmd)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 }
 
@@ -85,17 +85,12 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		return nil
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
-
-	fmt.Fprintf(writer, "NAME\tISSUER URL\n")
+	table := output.NewTable("NAME", "ISSUER URL")
 	for _, externalAuthProvider := range externalAuthProviders {
-		fmt.Fprintf(writer, "%s\t%s\n",
+		table.AddRow(
 			externalAuthProvider.ID(),
 			externalAuthProvider.Issuer().URL(),
 		)
 	}
-	writer.Flush()
-
-	return nil
+	return table.Print()
 }
@@ -18,9 +18,7 @@ package gates
 
 import (
 	"fmt"
-	"os"
 	"strings"
-	"text/tabwriter"
 
 	semver "github.com/hashicorp/go-version"
 	"github.com/nathan-fiscaletti/consolesize-go"
@@ -29,6 +27,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -86,6 +85,7 @@ func init() {
 	Cmd.MarkFlagRequired("version")
 
 	output.AddFlag(Cmd)
+	output.AddNoHeadersFlag(Cmd)
 }
 
 const (
@@ -116,7 +116,7 @@ func run(_ *cobra.Command, _ []string) {
 
 		if cluster.State() != v1.ClusterStateReady {
 			r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		upgradePolicyBuilder := v1.NewUpgradePolicy().
@@ -126,7 +126,7 @@ func run(_ *cobra.Command, _ []string) {
 		upgradePolicy, err := upgradePolicyBuilder.Build()
 		if err != nil {
 			r.Reporter.Errorf("Failed to schedule upgrade for cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		// check if the cluster upgrade requires gate agreements
@@ -134,7 +134,7 @@ func run(_ *cobra.Command, _ []string) {
 		if err != nil {
 			r.Reporter.Errorf("Failed to check for missing gate agreements upgrade for "+
 				"cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	} else {
 		// Query OCM for available OCP gates
@@ -144,33 +144,33 @@ func run(_ *cobra.Command, _ []string) {
 			versionGates, err = r.OCMClient.ListStsGates(version)
 			if err != nil {
 				r.Reporter.Errorf("Failed to fetch available %s gates for OCP version %s: %v", args.gate, args.version, err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		case GateOCP:
 			versionGates, err = r.OCMClient.ListOcpGates(version)
 			if err != nil {
 				r.Reporter.Errorf("Failed to fetch available %s gates for OCP version %s: %v", args.gate, args.version, err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		case "":
 			versionGates, err = r.OCMClient.ListAllOcpGates(version)
 			if err != nil {
 				r.Reporter.Errorf("Failed to fetch available %s gates for OCP version %s: %v", args.gate, args.version, err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		default:
 			r.Reporter.Errorf("Invalid gate. Allowed values are %s and \"\" for all", strings.Join(Gates, ","))
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		if err != nil {
 			r.Reporter.Errorf("Failed to fetch available OCP gates for OCP version %s: %v", err, args.version)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
 		if len(versionGates) == 0 {
 			r.Reporter.Warnf("There are no gates for OCP version %s", args.version)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -178,38 +178,32 @@ func run(_ *cobra.Command, _ []string) {
 		err = output.Print(versionGates)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
 	cols, _ := consolesize.GetConsoleSize()
 	descriptionSize := float64(cols) * 0.30
-	writer := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
-	fmt.Fprintln(writer, "Gate Description\tSTS\tOCP Version\tDocumentation URL\t")
-
+	table := output.NewTable("Gate Description", "STS", "OCP Version", "Documentation URL").Wide("ID")
 	for _, gate := range versionGates {
 		wrappedDescription := wordWrap(strings.TrimSuffix(gate.Description(), "\n"), int(descriptionSize))
 
 		for i, line := range strings.Split(wrappedDescription, "\n") {
 			if i == 0 {
-				fmt.Fprintf(writer,
-					"%s\t%t\t%s\t%s\t\n",
+				table.AddRow(
 					line,
 					gate.STSOnly(),
 					gate.VersionRawIDPrefix(),
 					gate.DocumentationURL(),
+					gate.ID(),
 				)
 			} else {
-				fmt.Fprintf(writer,
-					"%s\t \t \t \t\n",
-					line,
-				)
+				table.AddRow(line, " ", " ", " ", " ")
 			}
 		}
 	}
-	writer.Flush()
+	table.Print()
 }
 
 func parseMajorMinor(version string) (string, error) {
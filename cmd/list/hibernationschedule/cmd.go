@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hibernationschedule
+
+import (
+	"context"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/hibernation"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/properties"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use     = "hibernation-schedules"
+	short   = "List the hibernation schedules of the clusters"
+	long    = short
+	example = `  # List the hibernation schedules
+  rosa list hibernation-schedules`
+)
+
+var aliases = []string{"hibernation-schedule", "hibernationschedules", "hibernationschedule"}
+
+func NewListHibernationSchedulesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), ListHibernationSchedulesRunner()),
+	}
+
+	output.AddFlag(cmd)
+	output.AddNoHeadersFlag(cmd)
+	return cmd
+}
+
+func ListHibernationSchedulesRunner() rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
+		clusters, err := r.OCMClient.ListClusters(r.Creator, ocm.ClusterListOptions{
+			Labels: []string{properties.HibernateSchedule},
+		})
+		if err != nil {
+			return err
+		}
+		descriptions, err := describe(clusters, time.Now())
+		if err != nil {
+			return err
+		}
+
+		if output.HasFlag() {
+			return output.Print(descriptions)
+		}
+		if len(descriptions) == 0 {
+			r.Reporter.Infof("There are no hibernation schedules")
+			return nil
+		}
+		return hibernation.NewDescriptionTable(descriptions).Print()
+	}
+}
+
+func describe(clusters []*cmv1.Cluster, now time.Time) ([]*hibernation.Description, error) {
+	descriptions := []*hibernation.Description{}
+	for _, cluster := range clusters {
+		schedule, found, err := hibernation.GetSchedule(cluster)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			descriptions = append(descriptions, hibernation.NewDescription(cluster, schedule, now))
+		}
+	}
+	return descriptions, nil
+}
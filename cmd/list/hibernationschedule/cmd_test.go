@@ -0,0 +1,63 @@
+package hibernationschedule
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/properties"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("List hibernation schedules", func() {
+	var t *TestingRuntime
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+		output.SetOutput("")
+	})
+
+	AfterEach(func() {
+		output.SetOutput("")
+	})
+
+	It("Correctly builds the command", func() {
+		cmd := NewListHibernationSchedulesCommand()
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Flags().Lookup("output")).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("no-headers")).NotTo(BeNil())
+	})
+
+	It("Lists the clusters with a schedule", func() {
+		cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.ID("123").Name("foo").State(cmv1.ClusterStateHibernating).Properties(map[string]string{
+				properties.HibernateSchedule: "0 19 * * 1-5",
+				properties.ResumeSchedule:    "0 7 * * 1-5",
+				properties.ScheduleTimezone:  "UTC",
+			})
+		})
+		t.ApiServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				func(_ http.ResponseWriter, r *http.Request) {
+					Expect(r.URL.Query().Get("search")).To(
+						ContainSubstring("properties.rosa_hibernate_schedule != ''"))
+				},
+				RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})),
+			),
+		)
+
+		t.StdOutReader.Record()
+		err := ListHibernationSchedulesRunner()(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+		stdout, _ := t.StdOutReader.Read()
+		Expect(stdout).To(Equal("" +
+			"ID   NAME  STATE        HIBERNATE     RESUME       TIME ZONE\n" +
+			"123  foo   hibernating  0 19 * * 1-5  0 7 * * 1-5  UTC\n"))
+	})
+})
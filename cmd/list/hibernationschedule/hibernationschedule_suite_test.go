@@ -0,0 +1,13 @@
+package hibernationschedule
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestListHibernationSchedule(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "List Hibernation Schedule Suite")
+}
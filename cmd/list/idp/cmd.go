@@ -17,15 +17,12 @@ limitations under the License.
 package idp
 
 import (
-	"fmt"
-	"os"
-	"text/tabwriter"
-
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -43,6 +40,7 @@ var Cmd = &cobra.Command{
 func init() {
 	ocm.AddClusterFlag(Cmd)
 	output.AddFlag(Cmd)
+	output.AddNoHeadersFlag(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -55,12 +53,12 @@ func run(_ *cobra.Command, _ []string) {
 	if cluster.State() != cmv1.ClusterStateReady &&
 		cluster.State() != cmv1.ClusterStateHibernating {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if cluster.ExternalAuthConfig().Enabled() {
 		r.Reporter.Errorf("Listing identity providers is not supported for clusters with external authentication configured.")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Load any existing IDPs for this cluster
@@ -68,36 +66,39 @@ func run(_ *cobra.Command, _ []string) {
 	idps, err := r.OCMClient.GetIdentityProviders(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get identity providers for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if output.HasFlag() {
 		err = output.Print(idps)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	if len(idps) == 0 {
 		r.Reporter.Infof("There are no identity providers configured for cluster '%s'", clusterKey)
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	if len(idps) == 1 && !ocm.HasAuthURLSupport(idps[0]) {
-		fmt.Fprintf(writer, "NAME\t\tTYPE\n")
-	} else {
-		fmt.Fprintf(writer, "NAME\t\tTYPE\t\tAUTH URL\n")
+	showAuthURL := len(idps) != 1 || ocm.HasAuthURLSupport(idps[0])
+	table := output.NewTable("NAME", "", "TYPE")
+	if showAuthURL {
+		table = output.NewTable("NAME", "", "TYPE", "", "AUTH URL")
 	}
+	table.Wide("ID")
 	for _, idp := range idps {
 		oauthURL, err := ocm.GetOAuthURL(cluster, idp)
 		if err != nil {
 			r.Reporter.Warnf("Error building OAuth URL for %s: %v", idp.Name(), err)
 		}
-		fmt.Fprintf(writer, "%s\t\t%s\t\t%s\n", idp.Name(), ocm.IdentityProviderType(idp), oauthURL)
+		if showAuthURL {
+			table.AddRow(idp.Name(), ocm.IdentityProviderType(idp), oauthURL, idp.ID())
+		} else {
+			table.AddRow(idp.Name(), ocm.IdentityProviderType(idp), idp.ID())
+		}
 	}
-	writer.Flush()
+	table.Print()
 }
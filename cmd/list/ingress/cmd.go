@@ -18,9 +18,8 @@ package ingress
 
 import (
 	"fmt"
-	"os"
+	"sort"
 	"strings"
-	"text/tabwriter"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
@@ -28,6 +27,7 @@ import (
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -45,6 +45,7 @@ var Cmd = &cobra.Command{
 func init() {
 	ocm.AddClusterFlag(Cmd)
 	output.AddFlag(Cmd)
+	output.AddNoHeadersFlag(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -57,7 +58,7 @@ func run(_ *cobra.Command, _ []string) {
 	if cluster.State() != cmv1.ClusterStateReady &&
 		cluster.State() != cmv1.ClusterStateHibernating {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Load any existing ingresses for this cluster
@@ -65,32 +66,34 @@ func run(_ *cobra.Command, _ []string) {
 	ingresses, err := r.OCMClient.GetIngresses(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get ingresses for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if output.HasFlag() {
 		err = output.Print(ingresses)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	if len(ingresses) == 0 {
 		r.Reporter.Infof("There are no ingresses configured for cluster '%s'", clusterKey)
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 2, 4, 2, ' ', 0)
-
-	fmt.Fprintf(writer, "ID\tAPPLICATION ROUTER\tPRIVATE\tDEFAULT\tROUTE SELECTORS\tLB-TYPE"+
-		"\tEXCLUDED NAMESPACE\tWILDCARD POLICY\tNAMESPACE OWNERSHIP\n")
+	table := output.NewTable("ID", "APPLICATION ROUTER", "PRIVATE", "DEFAULT", "ROUTE SELECTORS", "LB-TYPE",
+		"EXCLUDED NAMESPACE", "WILDCARD POLICY", "NAMESPACE OWNERSHIP").Wide("COMPONENT ROUTES")
 	for _, ingress := range ingresses {
-		fmt.Fprintf(writer, "%s\thttps://%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+		componentRoutes := []string{}
+		for name := range ingress.ComponentRoutes() {
+			componentRoutes = append(componentRoutes, name)
+		}
+		sort.Strings(componentRoutes)
+		table.AddRow(
 			ingress.ID(),
-			ingress.DNSName(),
+			"https://"+ingress.DNSName(),
 			isPrivate(ingress.Listening()),
 			isDefault(ingress),
 			printRouteSelectors(ingress),
@@ -98,9 +101,10 @@ func run(_ *cobra.Command, _ []string) {
 			helper.SliceToSortedString(ingress.ExcludedNamespaces()),
 			ingress.RouteWildcardPolicy(),
 			ingress.RouteNamespaceOwnershipPolicy(),
+			strings.Join(componentRoutes, ", "),
 		)
 	}
-	writer.Flush()
+	table.Print()
 }
 
 func isPrivate(listeningMethod cmv1.ListeningMethod) string {
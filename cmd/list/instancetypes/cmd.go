@@ -18,8 +18,6 @@ package instancetypes
 
 import (
 	"fmt"
-	"os"
-	"text/tabwriter"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
@@ -31,6 +29,7 @@ import (
 	interactiveRoles "github.com/openshift/rosa/pkg/interactive/roles"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -94,6 +93,7 @@ func initFlags(cmd *cobra.Command) {
 
 	arguments.AddRegionFlag(flags)
 	output.AddFlag(cmd)
+	output.AddNoHeadersFlag(cmd)
 	confirm.AddFlag(flags)
 }
 
@@ -103,7 +103,7 @@ func run(cmd *cobra.Command, _ []string) {
 	err := runWithRuntime(r, cmd)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 }
 
@@ -172,25 +172,20 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		return fmt.Errorf("There are no machine types supported for your account. Contact Red Hat support.")
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(writer, "ID\tCATEGORY\tCPU_CORES\tMEMORY\n")
-
+	table := output.NewTable("ID", "CATEGORY", "CPU_CORES", "MEMORY").Wide("SIZE", "ARCHITECTURE")
 	for _, machine := range machineTypes.Items {
 		if !machine.Available {
 			continue
 		}
 		availableMachine := machine.MachineType
-		fmt.Fprintf(writer,
-			"%s\t%s\t%d\t%s\n",
+		table.AddRow(
 			availableMachine.ID(), availableMachine.Category(), int(availableMachine.CPU().Value()),
 			ByteCountIEC(int(availableMachine.Memory().Value()),
 				availableMachine.Memory().Unit()),
+			availableMachine.Size(), availableMachine.Architecture(),
 		)
 	}
-	writer.Flush()
-
-	return nil
+	return table.Print()
 }
 
 func ByteCountIEC(b int, uValue string) string {
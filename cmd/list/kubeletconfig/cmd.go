@@ -2,9 +2,6 @@ package kubeletconfig
 
 import (
 	"context"
-	"fmt"
-	"os"
-	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 
@@ -36,6 +33,7 @@ func NewListKubeletConfigsCommand() *cobra.Command {
 	}
 
 	output.AddFlag(cmd)
+	output.AddNoHeadersFlag(cmd)
 	ocm.AddClusterFlag(cmd)
 	return cmd
 }
@@ -60,9 +58,7 @@ func ListKubeletConfigRunner() rosa.CommandRunner {
 				return nil
 			}
 
-			writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
-			fmt.Fprint(writer, kubeletconfig.PrintKubeletConfigsForTabularOutput(kubeletConfigs))
-			return writer.Flush()
+			return kubeletconfig.PrintKubeletConfigsForTabularOutput(kubeletConfigs).Print()
 		}
 
 		return nil
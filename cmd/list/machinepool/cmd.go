@@ -53,6 +53,7 @@ func NewListMachinePoolCommand() *cobra.Command {
 	}
 
 	output.AddFlag(cmd)
+	output.AddNoHeadersFlag(cmd)
 	ocm.AddClusterFlag(cmd)
 	return cmd
 }
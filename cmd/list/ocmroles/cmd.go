@@ -18,8 +18,6 @@ package ocmroles
 
 import (
 	"fmt"
-	"os"
-	"text/tabwriter"
 	"time"
 
 	"github.com/briandowns/spinner"
@@ -28,6 +26,7 @@ import (
 	"github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -44,6 +43,7 @@ rosa list ocm-roles`,
 
 func init() {
 	output.AddFlag(Cmd)
+	output.AddNoHeadersFlag(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -67,26 +67,24 @@ func run(_ *cobra.Command, _ []string) {
 
 	if err != nil {
 		r.Reporter.Errorf("Failed to get ocm roles: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if output.HasFlag() {
 		err = output.Print(ocmRoles)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	if len(ocmRoles) == 0 {
 		r.Reporter.Infof("No ocm roles available")
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprint(writer, "ROLE NAME\tROLE ARN\tLINKED\tADMIN\tAWS Managed\n")
+	table := output.NewTable("ROLE NAME", "ROLE ARN", "LINKED", "ADMIN", "AWS Managed")
 	for _, ocmRole := range ocmRoles {
 		var awsManaged string
 		if ocmRole.ManagedPolicy {
@@ -94,10 +92,9 @@ func run(_ *cobra.Command, _ []string) {
 		} else {
 			awsManaged = "No"
 		}
-		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", ocmRole.RoleName, ocmRole.RoleARN, ocmRole.Linked, ocmRole.Admin,
-			awsManaged)
+		table.AddRow(ocmRole.RoleName, ocmRole.RoleARN, ocmRole.Linked, ocmRole.Admin, awsManaged)
 	}
-	writer.Flush()
+	table.Print()
 }
 
 func listOCMRoles(r *rosa.Runtime) ([]aws.Role, error) {
@@ -17,13 +17,10 @@ limitations under the License.
 package oidcconfig
 
 import (
-	"fmt"
-	"os"
-	"text/tabwriter"
-
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -40,6 +37,7 @@ var Cmd = &cobra.Command{
 
 func init() {
 	output.AddFlag(Cmd)
+	output.AddNoHeadersFlag(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -51,34 +49,31 @@ func run(_ *cobra.Command, _ []string) {
 	oidcConfigs, err := r.OCMClient.ListOidcConfigs(r.Creator.AccountID)
 	if err != nil {
 		r.Reporter.Errorf("Failed to list OIDC Configurations: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if output.HasFlag() {
 		err = output.Print(oidcConfigs)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	if len(oidcConfigs) == 0 {
 		r.Reporter.Infof("There are no OIDC Configurations for your organization")
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
-
-	fmt.Fprintf(writer, "ID\tMANAGED\tISSUER URL\tSECRET ARN\n")
+	table := output.NewTable("ID", "MANAGED", "ISSUER URL", "SECRET ARN")
 	for _, oidcConfig := range oidcConfigs {
-		fmt.Fprintf(writer, "%s\t%v\t%s\t%s\n",
+		table.AddRow(
 			oidcConfig.ID(),
 			oidcConfig.Managed(),
 			oidcConfig.IssuerUrl(),
 			oidcConfig.SecretArn(),
 		)
 	}
-	writer.Flush()
+	table.Print()
 }
@@ -18,8 +18,6 @@ package oidcprovider
 
 import (
 	"fmt"
-	"os"
-	"text/tabwriter"
 	"time"
 
 	"github.com/briandowns/spinner"
@@ -29,6 +27,7 @@ import (
 	"github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -51,6 +50,7 @@ func init() {
 	flags := Cmd.Flags()
 	flags.SortFlags = false
 	output.AddFlag(Cmd)
+	output.AddNoHeadersFlag(Cmd)
 	ocm.AddOptionalClusterFlag(Cmd)
 
 	flags.StringVarP(
@@ -86,7 +86,7 @@ func run(cmd *cobra.Command, _ []string) {
 		config, err = r.OCMClient.GetOidcConfig(args.oidcConfigId)
 		if err != nil {
 			r.Reporter.Errorf("Failed to get OIDC config: %v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	providers, err := r.AWSClient.ListOidcProviders(clusterId, config)
@@ -96,7 +96,7 @@ func run(cmd *cobra.Command, _ []string) {
 	}
 	if err != nil {
 		r.Reporter.Errorf("Failed to get OIDC providers: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	providersInUse := map[string]bool{}
@@ -104,14 +104,14 @@ func run(cmd *cobra.Command, _ []string) {
 		resourceName, err := aws.GetResourceIdFromOidcProviderARN(provider.Arn)
 		if err != nil {
 			r.Reporter.Errorf("%v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		has, err := r.OCMClient.
 			HasAClusterUsingOidcProvider(
 				fmt.Sprintf("https://%s", resourceName), r.Creator.AccountID)
 		if err != nil {
 			r.Reporter.Errorf("%v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		providersInUse[provider.Arn] = has
 	}
@@ -125,33 +125,29 @@ func run(cmd *cobra.Command, _ []string) {
 		err = output.Print(outList)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	if len(providers) == 0 {
 		r.Reporter.Infof("No OIDC providers available")
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(writer, "OIDC PROVIDER ARN\tCluster ID\tIn Use\n")
+	table := output.NewTable("OIDC PROVIDER ARN", "Cluster ID", "In Use")
 	for _, provider := range providers {
-		printProvider(writer, providersInUse, provider)
+		addProvider(table, providersInUse, provider)
 	}
-	writer.Flush()
+	table.Print()
 }
 
-func printProvider(writer *tabwriter.Writer, providersInUse map[string]bool, provider aws.OidcProviderOutput) {
+func addProvider(table *output.Table, providersInUse map[string]bool, provider aws.OidcProviderOutput) {
 	providerInUse := "No"
 	if ok := providersInUse[provider.Arn]; ok {
 		providerInUse = "Yes"
 	}
-	fmt.Fprintf(
-		writer,
-		"%s\t%s\t%v\n",
+	table.AddRow(
 		provider.Arn,
 		provider.ClusterId,
 		providerInUse,
@@ -18,8 +18,6 @@ package operatorroles
 
 import (
 	"fmt"
-	"os"
-	"text/tabwriter"
 	"time"
 
 	"github.com/briandowns/spinner"
@@ -30,6 +28,7 @@ import (
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -74,6 +73,7 @@ func init() {
 	interactive.AddFlag(flags)
 	ocm.AddOptionalClusterFlag(Cmd)
 	output.AddFlag(Cmd)
+	output.AddNoHeadersFlag(Cmd)
 }
 
 func run(cmd *cobra.Command, _ []string) {
@@ -83,14 +83,14 @@ func run(cmd *cobra.Command, _ []string) {
 	versionList, err := ocm.GetVersionMinorList(r.OCMClient)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	_, err = r.OCMClient.ValidateVersion(args.version, versionList,
 		r.Cluster.Version().ChannelGroup(), r.Cluster.AWS().STS().RoleARN() == "", r.Cluster.Hypershift().Enabled())
 	if err != nil {
 		r.Reporter.Errorf("Version '%s' is invalid", args.version)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	var spin *spinner.Spinner
@@ -109,7 +109,7 @@ func run(cmd *cobra.Command, _ []string) {
 		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
 		if err != nil {
 			r.Reporter.Errorf("Failed to get cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		clusterId = cluster.ID()
 		args.prefix = cluster.AWS().STS().OperatorRolePrefix()
@@ -125,7 +125,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if err != nil {
 		r.Reporter.Errorf("Failed to get operator roles: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if len(operatorsMap) == 0 {
@@ -136,11 +136,11 @@ func run(cmd *cobra.Command, _ []string) {
 		if args.prefix != "" {
 			if _, ok := operatorsMap[args.prefix]; !ok {
 				r.Reporter.Infof("No operator roles available for prefix '%s'", args.prefix)
-				os.Exit(0)
+				reporter.Exit(0)
 			}
 		}
 		r.Reporter.Infof(noOperatorRolesOutput)
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 	if output.HasFlag() {
 		var resource interface{} = operatorsMap
@@ -150,13 +150,11 @@ func run(cmd *cobra.Command, _ []string) {
 		err = output.Print(resource)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	if clusterId != "" {
 		for key, value := range operatorsMap {
 			if value[0].ClusterID == clusterId {
@@ -165,21 +163,16 @@ func run(cmd *cobra.Command, _ []string) {
 		}
 	}
 	if args.prefix == "" {
-		fmt.Fprintf(writer, "ROLE PREFIX\tAMOUNT IN BUNDLE\n")
+		table := output.NewTable("ROLE PREFIX", "AMOUNT IN BUNDLE")
 		for _, key := range prefixes {
-			fmt.Fprintf(
-				writer,
-				"%s\t%d\n",
-				key,
-				len(operatorsMap[key]),
-			)
+			table.AddRow(key, len(operatorsMap[key]))
 		}
-		writer.Flush()
+		table.Print()
 		if !interactive.Enabled() {
-			os.Exit(0)
+			reporter.Exit(0)
 		}
 		if !confirm.Prompt(true, "Would you like to detail a specific prefix") {
-			os.Exit(0)
+			reporter.Exit(0)
 		}
 		args.prefix, err = interactive.GetOption(interactive.Input{
 			Question: "Operator Role Prefix",
@@ -190,7 +183,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid OIDC Config ID: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	if args.prefix != "" {
@@ -201,17 +194,17 @@ func run(cmd *cobra.Command, _ []string) {
 					fmt.Sprintf("%s in version '%s'", noOperatorRolesPrefixOutput, args.version)
 			}
 			r.Reporter.Infof(noOperatorRolesPrefixOutput)
-			os.Exit(0)
+			reporter.Exit(0)
 		}
 		hasClusterUsingOperatorRolesPrefix, err := r.OCMClient.HasAClusterUsingOperatorRolesPrefix(args.prefix)
 		if err != nil {
 			r.Reporter.Errorf("There was a problem checking if any clusters"+
 				" are using Operator Roles Prefix '%s' : %v", args.prefix, err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
-		fmt.Fprintf(writer, "OPERATOR NAME\tOPERATOR NAMESPACE\tROLE NAME\t"+
-			"ROLE ARN\tCLUSTER ID\tVERSION\tPOLICIES\tAWS Managed\tIN USE\n")
+		table := output.NewTable("OPERATOR NAME", "OPERATOR NAMESPACE", "ROLE NAME",
+			"ROLE ARN", "CLUSTER ID", "VERSION", "POLICIES", "AWS Managed", "IN USE")
 		for _, operatorRole := range operatorsMap[args.prefix] {
 			awsManaged := "No"
 			inUse := "No"
@@ -221,9 +214,7 @@ func run(cmd *cobra.Command, _ []string) {
 			if hasClusterUsingOperatorRolesPrefix {
 				inUse = "Yes"
 			}
-			fmt.Fprintf(
-				writer,
-				"%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			table.AddRow(
 				operatorRole.OperatorName,
 				operatorRole.OperatorNamespace,
 				operatorRole.RoleName,
@@ -235,6 +226,6 @@ func run(cmd *cobra.Command, _ []string) {
 				inUse,
 			)
 		}
-		writer.Flush()
+		table.Print()
 	}
 }
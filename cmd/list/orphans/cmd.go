@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphans
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/orphans"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "orphans"
+	short = "List AWS resources left behind by deleted clusters"
+	long  = "List the operator roles and OIDC providers that were created for clusters that no longer " +
+		"exist, usually because the clusters were deleted without deleting them.\n\n" +
+		"Resources that aren't tagged with a cluster, like the operator roles and OIDC configurations " +
+		"created before the cluster, are only listed with '--include-unused'.\n\n" +
+		"Only the clusters of the current OCM environment are considered, so the resources of clusters " +
+		"of other environments that share the AWS account are listed too."
+	example = `  # List the orphaned resources of the current AWS account
+  rosa list orphans
+
+  # Also list the resources that no cluster uses
+  rosa list orphans --include-unused`
+
+	includeUnusedFlag = "include-unused"
+)
+
+var aliases = []string{"orphan"}
+
+func NewListOrphansCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), ListOrphansRunner()),
+	}
+
+	cmd.Flags().Bool(
+		includeUnusedFlag,
+		false,
+		"Also list the resources that no cluster uses but whose cluster isn't known to be deleted, "+
+			"including the OIDC configurations and operator roles created for future clusters.",
+	)
+	output.AddFlag(cmd)
+	output.AddNoHeadersFlag(cmd)
+	return cmd
+}
+
+func ListOrphansRunner() rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
+		r.Reporter.Debugf("Looking for orphaned resources in AWS account '%s'", r.Creator.AccountID)
+		includeUnused, err := command.Flags().GetBool(includeUnusedFlag)
+		if err != nil {
+			return err
+		}
+		found, err := orphans.NewFinder(r.OCMClient, r.AWSClient, r.Creator.AccountID, includeUnused).Find()
+		if err != nil {
+			return err
+		}
+		if output.HasFlag() {
+			return output.Print(found)
+		}
+		if len(found) == 0 {
+			r.Reporter.Infof("There are no orphaned resources in AWS account '%s'", r.Creator.AccountID)
+			return nil
+		}
+		return orphans.NewTable(found).Print()
+	}
+}
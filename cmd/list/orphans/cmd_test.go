@@ -0,0 +1,112 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphans
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+const (
+	noClusters  = `{"kind": "ClusterList", "page": 1, "size": 0, "total": 0, "items": []}`
+	noConfigs   = `{"kind": "OidcConfigList", "page": 1, "size": 0, "total": 0, "items": []}`
+	providerARN = "arn:aws:iam::123456789012:oidc-provider/oidc.example.com/abcd"
+)
+
+var _ = Describe("List orphans", func() {
+	var t *TestingRuntime
+	var awsClient *aws.MockClient
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+		awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+		t.RosaRuntime.AWSClient = awsClient
+		t.RosaRuntime.Creator = &aws.Creator{AccountID: "123456789012"}
+		output.SetOutput("")
+	})
+
+	AfterEach(func() {
+		output.SetOutput("")
+	})
+
+	It("Correctly builds the command", func() {
+		cmd := NewListOrphansCommand()
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Flags().Lookup("output")).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("no-headers")).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("include-unused")).NotTo(BeNil())
+	})
+
+	It("Lists the orphaned resources", func() {
+		awsClient.EXPECT().ListOperatorRoles("", "", "").Return(map[string][]aws.OperatorRoleDetail{
+			"prefix": {{RoleName: "prefix-openshift-ingress-operator-cloud-credentials", ClusterID: "123"}},
+		}, nil)
+		awsClient.EXPECT().ListOidcProviders("", nil).Return([]aws.OidcProviderOutput{{Arn: providerARN}}, nil)
+		t.ApiServer.AppendHandlers(
+			// The operator roles and the cluster that they are tagged with:
+			RespondWithJSON(http.StatusOK, noClusters),
+			RespondWithJSON(http.StatusOK, noClusters),
+			// The OIDC provider, which isn't tagged with a cluster:
+			RespondWithJSON(http.StatusOK, noClusters),
+		)
+		t.StdOutReader.Record()
+		err := ListOrphansRunner()(context.Background(), t.RosaRuntime, NewListOrphansCommand(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		stdout, _ := t.StdOutReader.Read()
+		Expect(stdout).To(ContainSubstring("operator-roles  prefix"))
+		Expect(stdout).To(ContainSubstring("123"))
+		Expect(stdout).To(ContainSubstring("cluster deleted"))
+		Expect(stdout).To(ContainSubstring("prefix-openshift-ingress-operator-cloud-credentials"))
+		Expect(stdout).NotTo(ContainSubstring(providerARN))
+	})
+
+	It("Lists the unused resources when requested", func() {
+		awsClient.EXPECT().ListOperatorRoles("", "", "").Return(map[string][]aws.OperatorRoleDetail{}, nil)
+		awsClient.EXPECT().ListOidcProviders("", nil).Return([]aws.OidcProviderOutput{{Arn: providerARN}}, nil)
+		t.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, noClusters),
+			RespondWithJSON(http.StatusOK, noConfigs),
+		)
+		cmd := NewListOrphansCommand()
+		Expect(cmd.Flags().Set("include-unused", "true")).To(Succeed())
+		t.StdOutReader.Record()
+		err := ListOrphansRunner()(context.Background(), t.RosaRuntime, cmd, nil)
+		Expect(err).NotTo(HaveOccurred())
+		stdout, _ := t.StdOutReader.Read()
+		Expect(stdout).To(ContainSubstring("oidc-provider  " + providerARN))
+		Expect(stdout).To(ContainSubstring("unused"))
+	})
+
+	It("Reports when there are no orphaned resources", func() {
+		awsClient.EXPECT().ListOperatorRoles("", "", "").Return(map[string][]aws.OperatorRoleDetail{}, nil)
+		awsClient.EXPECT().ListOidcProviders("", nil).Return([]aws.OidcProviderOutput{}, nil)
+		t.StdOutReader.Record()
+		err := ListOrphansRunner()(context.Background(), t.RosaRuntime, NewListOrphansCommand(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		stdout, _ := t.StdOutReader.Read()
+		Expect(stdout).To(ContainSubstring("There are no orphaned resources in AWS account '123456789012'"))
+	})
+})
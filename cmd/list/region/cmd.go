@@ -17,14 +17,11 @@ limitations under the License.
 package region
 
 import (
-	"fmt"
-	"os"
-	"text/tabwriter"
-
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -74,6 +71,7 @@ func init() {
 	)
 
 	output.AddFlag(Cmd)
+	output.AddNoHeadersFlag(Cmd)
 }
 
 func run(cmd *cobra.Command, _ []string) {
@@ -85,7 +83,7 @@ func run(cmd *cobra.Command, _ []string) {
 	regions, err := r.OCMClient.GetRegions(args.roleARN, args.externalID)
 	if err != nil {
 		r.Reporter.Errorf("Failed to fetch regions: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Filter out unwanted regions
@@ -111,29 +109,27 @@ func run(cmd *cobra.Command, _ []string) {
 		err = output.Print(availableRegions)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	if len(availableRegions) == 0 {
 		r.Reporter.Warnf("There are no regions available for this AWS account")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	headerFormat := "ID\t\tNAME\t\tMULTI-AZ SUPPORT\t\tHOSTED-CP SUPPORT\n"
-	fmt.Fprint(writer, headerFormat)
-
+	table := output.NewTable("ID", "", "NAME", "", "MULTI-AZ SUPPORT", "", "HOSTED-CP SUPPORT").
+		Wide("CCS ONLY", "GOVCLOUD")
 	for _, region := range availableRegions {
-		fmt.Fprintf(writer,
-			"%s\t\t%s\t\t%t\t\t%t\n",
+		table.AddRow(
 			region.ID(),
 			region.DisplayName(),
 			region.SupportsMultiAZ(),
 			region.SupportsHypershift(),
+			region.CCSOnly(),
+			region.GovCloud(),
 		)
 	}
-	writer.Flush()
+	table.Print()
 }
@@ -2,24 +2,22 @@ package rhRegion
 
 import (
 	"fmt"
-	"os"
+	"sort"
 	"strings"
-	"text/tabwriter"
 
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/config"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
-var (
-	writer = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.TabIndent)
-	args   struct {
-		discoveryURL string
-	}
-)
+var args struct {
+	discoveryURL string
+}
 
 var Cmd = NewRhRegionCommand()
 
@@ -44,6 +42,8 @@ ocm list rh-regions`,
 			"file or "+sdk.DefaultURL+" as a last resort. The value should be a complete URL "+
 			"or a valid URL alias: "+strings.Join(ocm.ValidOCMUrlAliases(), ", "),
 	)
+	output.AddFlag(Cmd)
+	output.AddNoHeadersFlag(Cmd)
 	return Cmd
 }
 
@@ -53,7 +53,7 @@ func run(cmd *cobra.Command, argv []string) {
 	err := ListRhRegions(args.discoveryURL, r)
 	if err != nil {
 		r.Reporter.Errorf("Failed to determine gateway URL: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 }
 
@@ -68,21 +68,31 @@ func ListRhRegions(discoveryURL string, r *rosa.Runtime) error {
 		return fmt.Errorf("Failed to determine gateway URL: %v", err)
 	}
 
-	fmt.Fprintf(writer, "Discovery URL: %s\n\n", gatewayURL)
 	regions, err := sdk.GetRhRegions(gatewayURL)
 	if err != nil {
 		return fmt.Errorf("Failed to get OCM regions: %v", err)
 	}
 
+	if output.HasFlag() {
+		return output.Print(regions)
+	}
+
+	fmt.Printf("Discovery URL: %s\n\n", gatewayURL)
+
 	// If there are no regions, print a warning message and return early
 	if len(regions) == 0 {
 		r.Reporter.Warnf("No regions found")
 		return nil
 	}
-	fmt.Fprintf(writer, "RH Region\t\tGateway URL\n")
-	for regionName, region := range regions {
-		fmt.Fprintf(writer, "%s\t\t%v\n", regionName, region.URL)
+	regionNames := make([]string, 0, len(regions))
+	for regionName := range regions {
+		regionNames = append(regionNames, regionName)
+	}
+	sort.Strings(regionNames)
+
+	table := output.NewTable("RH Region", "", "Gateway URL")
+	for _, regionName := range regionNames {
+		table.AddRow(regionName, regions[regionName].URL)
 	}
-	writer.Flush()
-	return nil
+	return table.Print()
 }
@@ -17,14 +17,11 @@ limitations under the License.
 package service
 
 import (
-	"fmt"
-	"os"
-	"text/tabwriter"
-
 	msv1 "github.com/openshift-online/ocm-sdk-go/servicemgmt/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -47,6 +44,7 @@ func init() {
 	flags.SortFlags = false
 
 	output.AddFlag(Cmd)
+	output.AddNoHeadersFlag(Cmd)
 }
 
 func run(cmd *cobra.Command, argv []string) {
@@ -60,7 +58,7 @@ func run(cmd *cobra.Command, argv []string) {
 	servicesList, err := r.OCMClient.ListManagedServices(1000)
 	if err != nil {
 		r.Reporter.Errorf("Failed to retrieve list of managed services: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if output.HasFlag() {
@@ -72,17 +70,15 @@ func run(cmd *cobra.Command, argv []string) {
 		output.Print(outList)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(writer, "SERVICE_ID\tSERVICE\tSERVICE_STATE\tCLUSTER_NAME\n")
+	table := output.NewTable("SERVICE_ID", "SERVICE", "SERVICE_STATE", "CLUSTER_NAME").Wide("CLUSTER_ID")
 	servicesList.Each(func(srv *msv1.ManagedService) bool {
-		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n",
-			srv.ID(), srv.Service(), srv.ServiceState(), srv.Cluster().Name())
+		table.AddRow(srv.ID(), srv.Service(), srv.ServiceState(), srv.Cluster().Name(), srv.Cluster().Id())
 		return true
 	})
-	writer.Flush()
+	table.Print()
 }
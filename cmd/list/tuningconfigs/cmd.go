@@ -17,15 +17,12 @@ limitations under the License.
 package tuningconfigs
 
 import (
-	"fmt"
-	"os"
-	"text/tabwriter"
-
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/input"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -43,6 +40,7 @@ var Cmd = &cobra.Command{
 func init() {
 	ocm.AddClusterFlag(Cmd)
 	output.AddFlag(Cmd)
+	output.AddNoHeadersFlag(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -59,32 +57,29 @@ func run(_ *cobra.Command, _ []string) {
 	tuningConfigs, err := r.OCMClient.GetTuningConfigs(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get tuning configs for cluster '%s': %v", cluster.ID(), err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if output.HasFlag() {
 		err = output.Print(tuningConfigs)
 		if err != nil {
 			r.Reporter.Errorf("%v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	if len(tuningConfigs) == 0 {
 		r.Reporter.Infof("There are no tuning configs for this cluster.")
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
-
-	fmt.Fprintf(writer, "ID\tNAME\n")
+	table := output.NewTable("ID", "NAME")
 	for _, tuningConfig := range tuningConfigs {
-		fmt.Fprintf(writer, "%s\t%s\n",
+		table.AddRow(
 			tuningConfig.ID(),
 			tuningConfig.Name(),
 		)
 	}
-	writer.Flush()
+	table.Print()
 }
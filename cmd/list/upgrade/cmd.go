@@ -18,10 +18,8 @@ package upgrade
 
 import (
 	"fmt"
-	"os"
 	"strconv"
 	"strings"
-	"text/tabwriter"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
@@ -30,6 +28,7 @@ import (
 	"github.com/openshift/rosa/pkg/machinepool"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -61,6 +60,7 @@ func init() {
 
 	confirm.AddFlag(flags)
 	output.AddFlag(Cmd)
+	output.AddNoHeadersFlag(Cmd)
 }
 
 func run(cmd *cobra.Command, _ []string) {
@@ -69,7 +69,7 @@ func run(cmd *cobra.Command, _ []string) {
 	err := runWithRuntime(r, cmd)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 }
 
@@ -132,10 +132,10 @@ func runWithRuntime(r *rosa.Runtime, _ *cobra.Command) error {
 		err := output.Print(availableUpgrades)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	latestRev := latestInCurrentMinor(ocm.GetVersionID(cluster), availableUpgrades)
@@ -155,9 +155,7 @@ func runWithRuntime(r *rosa.Runtime, _ *cobra.Command) error {
 		}
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(writer, "VERSION\tNOTES\n")
+	table := output.NewTable("VERSION", "NOTES")
 	for i, availableUpgrade := range availableUpgrades {
 		notes := make([]string, 0)
 		if i == 0 || availableUpgrade == latestRev {
@@ -181,10 +179,9 @@ func runWithRuntime(r *rosa.Runtime, _ *cobra.Command) error {
 				}
 			}
 		}
-		fmt.Fprintf(writer, "%s\t%s\n", availableUpgrade, strings.Join(notes, " - "))
+		table.AddRow(availableUpgrade, strings.Join(notes, " - "))
 	}
-	writer.Flush()
-	return nil
+	return table.Print()
 }
 
 func formatScheduledUpgrade(availableUpgrade string,
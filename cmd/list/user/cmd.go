@@ -17,11 +17,8 @@ limitations under the License.
 package user
 
 import (
-	"fmt"
-	"math"
-	"os"
+	"sort"
 	"strings"
-	"text/tabwriter"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
@@ -29,6 +26,7 @@ import (
 	"github.com/openshift/rosa/cmd/create/admin"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -46,6 +44,7 @@ var Cmd = &cobra.Command{
 func init() {
 	ocm.AddClusterFlag(Cmd)
 	output.AddFlag(Cmd)
+	output.AddNoHeadersFlag(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -58,12 +57,12 @@ func run(_ *cobra.Command, _ []string) {
 	if cluster.State() != cmv1.ClusterStateReady &&
 		cluster.State() != cmv1.ClusterStateHibernating {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if cluster.ExternalAuthConfig().Enabled() {
 		r.Reporter.Errorf("Listing cluster users is not supported for clusters with external authentication configured.")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	var clusterAdmins []*cmv1.User
@@ -73,14 +72,14 @@ func run(_ *cobra.Command, _ []string) {
 	clusterAdmins, err = r.OCMClient.GetUsers(cluster.ID(), admin.ClusterAdminGroupname)
 	if err != nil {
 		r.Reporter.Errorf("Failed to get cluster-admins for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Load dedicated-admins for this cluster
 	dedicatedAdmins, err := r.OCMClient.GetUsers(cluster.ID(), "dedicated-admins")
 	if err != nil {
 		r.Reporter.Errorf("Failed to get dedicated-admins for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if output.HasFlag() {
@@ -90,25 +89,22 @@ func run(_ *cobra.Command, _ []string) {
 		err := output.Print(userList)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	if len(clusterAdmins) == 0 && len(dedicatedAdmins) == 0 {
 		r.Reporter.Infof("There are no users configured for cluster '%s'", clusterKey)
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
-	longestUserId := 0.0
 	groups := make(map[string][]string)
 	for _, user := range clusterAdmins {
-		longestUserId = math.Max(longestUserId, float64(len(user.ID())))
 		groups[user.ID()] = []string{admin.ClusterAdminGroupname}
 	}
 	for _, user := range dedicatedAdmins {
-		longestUserId = math.Max(longestUserId, float64(len(user.ID())))
 		if _, ok := groups[user.ID()]; ok {
 			groups[user.ID()] = []string{admin.ClusterAdminGroupname, admin.DedicatedAdminGroupname}
 		} else {
@@ -116,12 +112,15 @@ func run(_ *cobra.Command, _ []string) {
 		}
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, int(longestUserId)+2, 4, 2, ' ', 0)
-	fmt.Fprintf(writer, "ID\tGROUPS\t\n")
+	userIDs := make([]string, 0, len(groups))
+	for u := range groups {
+		userIDs = append(userIDs, u)
+	}
+	sort.Strings(userIDs)
 
-	for u, r := range groups {
-		fmt.Fprintf(writer, "%s\t%s\t\n", u, strings.Join(r, ", "))
-		writer.Flush()
+	table := output.NewTable("ID", "GROUPS", "")
+	for _, u := range userIDs {
+		table.AddRow(u, strings.Join(groups[u], ", "))
 	}
+	table.Print()
 }
@@ -17,10 +17,8 @@ limitations under the License.
 package version
 
 import (
-	"fmt"
-	"os"
 	"strings"
-	"text/tabwriter"
+	"time"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
@@ -28,6 +26,7 @@ import (
 	"github.com/openshift/rosa/cmd/list/upgrade"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -61,6 +60,7 @@ func init() {
 		false,
 		"Lists only versions that are hosted-cp enabled")
 	output.AddFlag(Cmd)
+	output.AddNoHeadersFlag(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -77,7 +77,7 @@ func run(_ *cobra.Command, _ []string) {
 	versions, err := r.OCMClient.GetVersionsWithProduct(product, args.channelGroup, false)
 	if err != nil {
 		r.Reporter.Errorf("Failed to fetch versions: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	var availableVersions []*cmv1.Version
@@ -94,46 +94,49 @@ func run(_ *cobra.Command, _ []string) {
 		err = output.Print(availableVersions)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	if len(availableVersions) == 0 {
 		r.Reporter.Warnf("There are no OpenShift versions available")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	var table *output.Table
 	if isHostedCp {
 		r.Reporter.Infof("Hosted cluster upgrades are cluster-based. To list available upgrades for a cluster, "+
 			"please use '%s'", upgrade.Cmd.CommandPath())
-		fmt.Fprintf(writer, "VERSION\t\tDEFAULT\n")
+		table = output.NewTable("VERSION", "", "DEFAULT")
 	} else {
-		fmt.Fprintf(writer, "VERSION\t\tDEFAULT\t\tAVAILABLE UPGRADES\n")
+		table = output.NewTable("VERSION", "", "DEFAULT", "", "AVAILABLE UPGRADES")
 	}
+	table.Wide("END OF LIFE")
 
 	for _, version := range availableVersions {
 		isDefault := "no"
-		availableUpgrades := ""
+		endOfLife := ""
+		if !version.EndOfLifeTimestamp().IsZero() {
+			endOfLife = version.EndOfLifeTimestamp().Format(time.DateOnly)
+		}
 		if isHostedCp {
 			if version.HostedControlPlaneDefault() {
 				isDefault = "yes"
 			}
-		} else {
-			// classic clusters
-			if version.Default() {
-				isDefault = "yes"
-			}
-			availableUpgrades = strings.Join(version.AvailableUpgrades(), ", ")
+			table.AddRow(version.RawID(), isDefault, endOfLife)
+			continue
+		}
+		// classic clusters
+		if version.Default() {
+			isDefault = "yes"
 		}
-		fmt.Fprintf(writer,
-			"%s\t\t%s\t\t%s\n",
+		table.AddRow(
 			version.RawID(),
 			isDefault,
-			availableUpgrades,
+			strings.Join(version.AvailableUpgrades(), ", "),
+			endOfLife,
 		)
 	}
-	writer.Flush()
+	table.Print()
 }
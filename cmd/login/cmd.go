@@ -78,7 +78,10 @@ var Cmd = &cobra.Command{
 		"\t5. Configuration file\n"+
 		"\t6. Command-line prompt\n", uiTokenPage),
 	Example: fmt.Sprintf(`  # Login to the OpenShift API with an existing token generated from %s
-  rosa login --token=$OFFLINE_ACCESS_TOKEN`, uiTokenPage),
+  rosa login --token=$OFFLINE_ACCESS_TOKEN
+
+  # Login to the staging environment and save the credentials in the "staging" context
+  rosa login --env=staging --token=$STAGING_ACCESS_TOKEN --context=staging`, uiTokenPage),
 	Run:  run,
 	Args: cobra.NoArgs,
 }
@@ -173,7 +176,7 @@ func run(cmd *cobra.Command, argv []string) {
 	err := runWithRuntime(r, cmd, argv)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 }
 
@@ -198,13 +201,13 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
 	// Confirm that token is not passed with auth code flags
 	if (args.useAuthCode || args.useDeviceCode) && args.token != "" {
 		r.Reporter.Errorf("Token cannot be passed with '--use-auth-code' or '--use-device-code' commands")
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	// FedRAMP does not support oauth code flow login yet
 	if fedramp.HasFlag(cmd) && (args.useAuthCode || args.useDeviceCode) {
 		r.Reporter.Errorf("This login method is currently not supported with FedRAMP")
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	if args.useAuthCode {
@@ -261,7 +264,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
 	err = CheckAndLogIntoFedramp(fedramp.HasFlag(cmd), fedramp.HasAdminFlag(cmd), cfg, token, r)
 	if err != nil {
 		r.Reporter.Errorf("%s", err.Error())
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	haveReqs := token != "" || (args.clientID != "" && args.clientSecret != "")
@@ -310,7 +313,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
 	gatewayURL, err := ocm.ResolveGatewayUrl(env, cfg)
 	if err != nil {
 		r.Reporter.Errorf("Failed to resolve gateway URL: %v", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	var ok bool
@@ -368,7 +371,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
 		regValue, err := sdk.GetRhRegion(gatewayURL, args.rhRegion)
 		if err != nil {
 			r.Reporter.Errorf("Can't find region: %v", err)
-			os.Exit(1)
+			rprtr.Exit(1)
 		}
 		gatewayURL = fmt.Sprintf("https://%s", regValue.URL)
 	}
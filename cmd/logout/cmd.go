@@ -17,8 +17,6 @@ limitations under the License.
 package logout
 
 import (
-	"os"
-
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/config"
@@ -28,9 +26,10 @@ import (
 var Cmd = &cobra.Command{
 	Use:   "logout",
 	Short: "Log out",
-	Long:  "Log out, removing the configuration file.",
-	Run:   run,
-	Args:  cobra.NoArgs,
+	Long: "Log out, removing the configuration file. If the '--context' flag is used only the " +
+		"credentials of that context are removed.",
+	Run:  run,
+	Args: cobra.NoArgs,
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -39,6 +38,6 @@ func run(_ *cobra.Command, _ []string) {
 	err := config.Remove()
 	if err != nil {
 		reporter.Errorf("Failed to remove config file: %v", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 }
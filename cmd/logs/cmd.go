@@ -20,6 +20,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/cmd/logs/install"
+	"github.com/openshift/rosa/cmd/logs/service"
 	"github.com/openshift/rosa/cmd/logs/uninstall"
 	"github.com/openshift/rosa/pkg/arguments"
 )
@@ -27,23 +28,28 @@ import (
 var Cmd = &cobra.Command{
 	Use:     "logs",
 	Aliases: []string{"log"},
-	Short:   "Show installation or uninstallation logs for a cluster",
-	Long:    "Show installation or uninstallation logs for a cluster",
+	Short:   "Show installation, uninstallation or service logs for a cluster",
+	Long:    "Show installation, uninstallation or service logs for a cluster",
 	Example: `  # Show install logs for a cluster named 'mycluster'
   rosa logs install --cluster=mycluster
 
   # Show uninstall logs for a cluster named 'mycluster'
-  rosa logs uninstall --cluster=mycluster`,
+  rosa logs uninstall --cluster=mycluster
+
+  # Show service logs for a cluster named 'mycluster'
+  rosa logs service --cluster=mycluster`,
 	Args: cobra.NoArgs,
 }
 
 func init() {
 	Cmd.AddCommand(install.Cmd)
 	Cmd.AddCommand(uninstall.Cmd)
+	serviceCommand := service.NewServiceLogsCommand()
+	Cmd.AddCommand(serviceCommand)
 
 	flags := Cmd.PersistentFlags()
 	arguments.AddProfileFlag(flags)
 	arguments.AddRegionFlag(flags)
-	globallyAvailableCommands := []*cobra.Command{install.Cmd, uninstall.Cmd}
+	globallyAvailableCommands := []*cobra.Command{install.Cmd, uninstall.Cmd, serviceCommand}
 	arguments.MarkRegionDeprecated(Cmd, globallyAvailableCommands)
 }
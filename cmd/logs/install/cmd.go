@@ -19,7 +19,6 @@ package install
 import (
 	"fmt"
 	"os"
-	"strings"
 	"time"
 
 	"github.com/briandowns/spinner"
@@ -27,13 +26,17 @@ import (
 	"github.com/spf13/cobra"
 	errors "github.com/zgalor/weberr"
 
+	"github.com/openshift/rosa/pkg/logs"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
 var args struct {
-	tail  int
-	watch bool
+	tail       int
+	watch      bool
+	since      string
+	outputFile string
 }
 
 var Cmd = &cobra.Command{
@@ -44,7 +47,10 @@ var Cmd = &cobra.Command{
   rosa logs install mycluster --tail=100
 
   # Show install logs for a cluster using the --cluster flag
-  rosa logs install --cluster=mycluster`,
+  rosa logs install --cluster=mycluster
+
+  # Follow the install logs and save all of them to a file to attach to a support case
+  rosa logs install --cluster=mycluster --follow --output-file=install.log`,
 	Run:  run,
 	Args: cobra.MaximumNArgs(1),
 }
@@ -58,7 +64,7 @@ func init() {
 		&args.tail,
 		"tail",
 		2000,
-		"Number of lines to get from the end of the log.",
+		"Number of lines to show from the end of the log. Zero shows all the lines.",
 	)
 
 	flags.BoolVarP(
@@ -68,6 +74,29 @@ func init() {
 		false,
 		"After getting the logs, watch for changes.",
 	)
+
+	flags.BoolVarP(
+		&args.watch,
+		"follow",
+		"f",
+		false,
+		"After getting the logs, keep showing the new lines as they are written. Same as '--watch'.",
+	)
+
+	flags.StringVar(
+		&args.since,
+		"since",
+		"",
+		"Show only the lines logged after this time. It can be a duration, like '1h', or a "+
+			"time in RFC3339 format, like '2024-05-01T12:00:00Z'.",
+	)
+
+	flags.StringVar(
+		&args.outputFile,
+		"output-file",
+		"",
+		"Write the complete log to this file, regardless of '--tail' and '--since'.",
+	)
 }
 
 func run(cmd *cobra.Command, argv []string) {
@@ -78,7 +107,11 @@ func run(cmd *cobra.Command, argv []string) {
 	// We check the flag value this way to allow other commands to watch logs
 	watch := cmd.Flags().Lookup("watch").Value.String() == "true"
 
-	var err error
+	since, err := logs.ParseSince(args.since, time.Now())
+	if err != nil {
+		r.Reporter.Errorf("%v", err)
+		reporter.Exit(1)
+	}
 
 	// Allow the command to be called programmatically
 	if len(argv) == 1 && !cmd.Flag("cluster").Changed {
@@ -90,7 +123,7 @@ func run(cmd *cobra.Command, argv []string) {
 	cluster := r.FetchCluster()
 	if cluster.State() == cmv1.ClusterStateReady {
 		r.Reporter.Infof("Cluster '%s' has been successfully installed", clusterKey)
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	pendingMessage := fmt.Sprintf(
@@ -103,35 +136,52 @@ func run(cmd *cobra.Command, argv []string) {
 				"Cluster '%s' has been in %s state for too long. Please contact support",
 				clusterKey, cluster.State(),
 			)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		r.Reporter.Warnf(pendingMessage)
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	if cluster.State() == cmv1.ClusterStateUninstalling {
 		r.Reporter.Errorf("Cluster '%s' is in '%s' state and no installation logs are available",
 			clusterKey, cluster.State(),
 		)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Get logs from Hive
-	logs, err := r.OCMClient.GetInstallLogs(cluster.ID(), args.tail)
+	follower := logs.NewFollower(func(offset int) (*cmv1.Log, error) {
+		return r.OCMClient.GetInstallLogsFrom(cluster.ID(), offset)
+	}, os.Stdout).Tail(args.tail).Since(since)
+	if args.outputFile != "" {
+		file, err := os.Create(args.outputFile)
+		if err != nil {
+			r.Reporter.Errorf("Failed to create output file '%s': %v", args.outputFile, err)
+			reporter.Exit(1)
+		}
+		defer file.Close()
+		follower.File(file)
+	}
+	// The log is complete when it isn't watched or the cluster is already installed, so its last line is
+	// printed even if it doesn't end with a line break:
+	next := follower.Next
+	if !watch || cluster.State() == cmv1.ClusterStateReady {
+		next = follower.Last
+	}
+	_, err = next()
 	if err != nil {
 		if errors.GetType(err) == errors.NotFound {
 			r.Reporter.Infof(pendingMessage)
 		} else {
 			r.Reporter.Errorf("Failed to get logs for cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
-	printLog(logs, nil)
 
 	if watch {
 		if cluster.State() == cmv1.ClusterStateReady {
 			r.Reporter.Infof("Cluster '%s' is successfully installed", clusterKey)
-			os.Exit(0)
+			reporter.Exit(0)
 		}
 
 		var spin *spinner.Spinner
@@ -142,70 +192,42 @@ func run(cmd *cobra.Command, argv []string) {
 			spin.Start()
 		}
 
-		// Poll for changing logs:
-		response, err := r.OCMClient.PollInstallLogs(cluster.ID(), func(logResponse *cmv1.LogGetResponse) bool {
+		// Poll for new lines of the log. The state of the cluster is checked before getting them so that
+		// the last lines aren't lost, and the last one is printed even if it doesn't end with a line break:
+		for {
+			time.Sleep(logs.PollInterval)
+
 			state, _ := r.OCMClient.GetClusterState(cluster.ID())
+			next := follower.Next
+			if state == cmv1.ClusterStateError || state == cmv1.ClusterStateReady {
+				next = follower.Last
+			}
+			if spin != nil {
+				spin.Stop()
+			}
+			printed, err := next()
+			if err != nil && errors.GetType(err) != errors.NotFound {
+				r.Reporter.Errorf("Failed to watch logs for cluster '%s': %v", clusterKey, err)
+				reporter.Exit(1)
+			}
+			if spin != nil && printed == 0 {
+				spin.Restart()
+			}
+
 			if state == cmv1.ClusterStateError {
 				r.Reporter.Errorf("There was an error installing cluster '%s'", clusterKey)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			if state == cmv1.ClusterStateReady {
 				r.Reporter.Infof("Cluster '%s' is now ready", clusterKey)
-				os.Exit(0)
+				reporter.Exit(0)
 			}
-			printLog(logResponse.Body(), spin)
 
 			err = r.OCMClient.KeepTokensAlive()
 			if err != nil {
-				r.Reporter.Errorf(fmt.Sprintf("Failed to keep tokens alive for polling: %v", err))
-				os.Exit(1)
+				r.Reporter.Errorf("Failed to keep tokens alive for polling: %v", err)
+				reporter.Exit(1)
 			}
-
-			return false
-		})
-		if err != nil {
-			if errors.GetType(err) != errors.NotFound {
-				r.Reporter.Errorf(fmt.Sprintf("Failed to watch logs for cluster '%s': %v", clusterKey, err))
-				os.Exit(1)
-			}
-		}
-		printLog(response, spin)
-	}
-}
-
-var lastLine string
-
-// Print next log lines
-func printLog(logs *cmv1.Log, spin *spinner.Spinner) {
-	lines := findNextLines(logs)
-	if lines != "" {
-		fmt.Printf("%s\n", lines)
-		if spin != nil {
-			spin.Stop()
 		}
-	} else if spin != nil {
-		spin.Restart()
-	}
-}
-
-// Remove duplicate lines from the log poll response
-func findNextLines(logs *cmv1.Log) string {
-	lines := strings.Split(logs.Content(), "\n")
-	// Last element is always empty, remove it
-	if len(lines) > 0 {
-		lines = lines[:len(lines)-1]
-	}
-	// Find where the new logs and the last line overlap
-	for i, line := range lines {
-		if lastLine != "" && line == lastLine {
-			// Remove any duplicate lines
-			lines = lines[i+1:]
-			break
-		}
-	}
-	// Store the last log lne
-	if len(lines) > 0 {
-		lastLine = lines[len(lines)-1]
 	}
-	return strings.Join(lines, "\n")
 }
@@ -0,0 +1,251 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/logs"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "service"
+	short = "Show the service log of a cluster"
+	long  = "Show the entries that OCM and Red Hat SRE add to the service log of a cluster, like limited " +
+		"support notices, upgrade notifications and SRE actions."
+	example = `  # Show the service log of a cluster named 'mycluster'
+  rosa logs service --cluster mycluster
+
+  # Show the warnings and errors of the last day
+  rosa logs service --cluster mycluster --since 24h --severity Warning,Error
+
+  # Keep showing new entries as they are added
+  rosa logs service --cluster mycluster --follow`
+
+	followFlag = "follow"
+
+	pollInterval = 15 * time.Second
+)
+
+var aliases = []string{"service-log", "service-logs", "servicelog", "servicelogs"}
+
+type Options struct {
+	since       string
+	severities  []string
+	serviceName string
+	follow      bool
+}
+
+func NewServiceLogsOptions() *Options {
+	return &Options{}
+}
+
+func NewServiceLogsCommand() *cobra.Command {
+	options := NewServiceLogsOptions()
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), ServiceLogsRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(
+		&options.since,
+		"since",
+		"",
+		"Show only the entries logged after this time. It can be a duration, like '24h', or a "+
+			"time in RFC3339 format, like '2024-05-01T12:00:00Z'.",
+	)
+	flags.StringSliceVar(
+		&options.severities,
+		"severity",
+		nil,
+		fmt.Sprintf("Show only the entries with the given severities. Allowed values are %s.",
+			ocm.ServiceLogSeverities),
+	)
+	flags.StringVar(
+		&options.serviceName,
+		"service-name",
+		"",
+		"Show only the entries logged by the given service.",
+	)
+	flags.BoolVarP(
+		&options.follow,
+		followFlag,
+		"f",
+		false,
+		"After showing the entries, keep showing new entries as they are added until interrupted.",
+	)
+	ocm.AddClusterFlag(cmd)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func ServiceLogsRunner(options *Options) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
+		listOptions, err := newListOptions(options, time.Now())
+		if err != nil {
+			return err
+		}
+		if options.follow && output.HasFlag() && output.Output() != output.JSON {
+			return fmt.Errorf("The '--%s' flag can only be used with the '%s' output format",
+				followFlag, output.JSON)
+		}
+
+		cluster, err := r.OCMClient.GetCluster(r.GetClusterKey(), r.Creator)
+		if err != nil {
+			return err
+		}
+		entries, err := r.OCMClient.GetServiceLogs(cluster.ID(), listOptions)
+		if err != nil {
+			return fmt.Errorf("Failed to get the service log of cluster '%s': %w", r.ClusterKey, err)
+		}
+
+		if !options.follow {
+			if output.HasFlag() {
+				return output.Print(entries)
+			}
+			if len(entries) == 0 {
+				r.Reporter.Infof("There are no service log entries for cluster '%s'", r.ClusterKey)
+				return nil
+			}
+			for _, entry := range entries {
+				fmt.Print(formatEntry(entry))
+			}
+			return nil
+		}
+
+		follower := newFollower(listOptions)
+		for {
+			for _, entry := range follower.next(entries) {
+				err = printFollowed(entry)
+				if err != nil {
+					return err
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(pollInterval):
+			}
+			entries, err = r.OCMClient.GetServiceLogs(cluster.ID(), follower.options)
+			if err != nil {
+				r.Reporter.Warnf("Failed to get the service log of cluster '%s': %v", r.ClusterKey, err)
+				entries = nil
+			}
+		}
+	}
+}
+
+func newListOptions(options *Options, now time.Time) (ocm.ServiceLogListOptions, error) {
+	result := ocm.ServiceLogListOptions{
+		ServiceName: options.serviceName,
+	}
+	var err error
+	result.Since, err = logs.ParseSince(options.since, now)
+	if err != nil {
+		return result, err
+	}
+	for _, value := range options.severities {
+		severity := slv1.Severity(cases.Title(language.English, cases.Compact).String(value))
+		valid := false
+		for _, allowed := range ocm.ServiceLogSeverities {
+			valid = valid || severity == allowed
+		}
+		if !valid {
+			return result, fmt.Errorf("Invalid severity '%s', should be one of %s",
+				value, ocm.ServiceLogSeverities)
+		}
+		result.Severities = append(result.Severities, severity)
+	}
+	return result, nil
+}
+
+// follower keeps track of the entries already printed. The next query starts at the time of the last
+// entry, inclusive, so entries logged with the same time aren't lost, and the identifiers are used to
+// discard the ones already printed.
+type follower struct {
+	options ocm.ServiceLogListOptions
+	seen    map[string]bool
+}
+
+func newFollower(options ocm.ServiceLogListOptions) *follower {
+	return &follower{
+		options: options,
+		seen:    map[string]bool{},
+	}
+}
+
+// Returns the entries that weren't returned before, and moves the start of the next query.
+func (f *follower) next(entries []*slv1.LogEntry) []*slv1.LogEntry {
+	result := []*slv1.LogEntry{}
+	for _, entry := range entries {
+		if f.seen[entry.ID()] {
+			continue
+		}
+		f.seen[entry.ID()] = true
+		result = append(result, entry)
+		if entry.Timestamp().After(f.options.Since) {
+			f.options.Since = entry.Timestamp()
+		}
+	}
+	return result
+}
+
+// Prints an entry in follow mode, where the JSON output is a document per line so that it can be
+// processed while the command is running.
+func printFollowed(entry *slv1.LogEntry) error {
+	if output.Output() != output.JSON {
+		fmt.Print(formatEntry(entry))
+		return nil
+	}
+	var buffer bytes.Buffer
+	err := slv1.MarshalLogEntry(entry, &buffer)
+	if err != nil {
+		return err
+	}
+	fmt.Println(strings.TrimSpace(buffer.String()))
+	return nil
+}
+
+func formatEntry(entry *slv1.LogEntry) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("%s  %-7s  %s: %s\n",
+		entry.Timestamp().UTC().Format(time.RFC3339), entry.Severity(), entry.ServiceName(), entry.Summary()))
+	description := strings.TrimSpace(entry.Description())
+	if description != "" {
+		builder.WriteString("    " + strings.ReplaceAll(description, "\n", "\n    ") + "\n")
+	}
+	return builder.String()
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var now = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func mockEntry(id string, timestamp time.Time) *slv1.LogEntry {
+	entry, err := slv1.NewLogEntry().
+		ID(id).
+		Timestamp(timestamp).
+		Severity(slv1.SeverityWarning).
+		ServiceName("SREManualAction").
+		Summary("Cluster has limited support").
+		Description("The cluster is missing\nthe installer role.").
+		Build()
+	Expect(err).NotTo(HaveOccurred())
+	return entry
+}
+
+var _ = Describe("Service logs", func() {
+	var t *TestingRuntime
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+		output.SetOutput("")
+	})
+
+	AfterEach(func() {
+		output.SetOutput("")
+	})
+
+	It("Correctly builds the command", func() {
+		cmd := NewServiceLogsCommand()
+		Expect(cmd.Use).To(Equal(use))
+		for _, name := range []string{"cluster", "since", "severity", "service-name", "follow", "output"} {
+			Expect(cmd.Flags().Lookup(name)).NotTo(BeNil(), name)
+		}
+	})
+
+	It("Parses the since flag as a duration or a time", func() {
+		options, err := newListOptions(&Options{since: "2h"}, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(options.Since).To(Equal(now.Add(-2 * time.Hour)))
+		options, err = newListOptions(&Options{since: "2024-04-01T00:00:00Z"}, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(options.Since).To(Equal(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)))
+		_, err = newListOptions(&Options{since: "yesterday"}, now)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Validates the severities", func() {
+		options, err := newListOptions(&Options{severities: []string{"warning", "Error"}}, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(options.Severities).To(Equal([]slv1.Severity{slv1.SeverityWarning, slv1.SeverityError}))
+		_, err = newListOptions(&Options{severities: []string{"critical"}}, now)
+		Expect(err).To(MatchError("Invalid severity 'critical', should be one of [Debug Info Warning Error Fatal]"))
+	})
+
+	It("Prints the service log of the cluster", func() {
+		ocm.SetClusterKey("my-cluster")
+		cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.ID("123").Name("my-cluster")
+		})
+		t.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/service_logs/v1/clusters/cluster_logs"),
+				func(_ http.ResponseWriter, r *http.Request) {
+					Expect(r.URL.Query().Get("cluster_id")).To(Equal("123"))
+					Expect(r.URL.Query().Get("search")).To(Equal("service_name = 'SREManualAction'"))
+				},
+				RespondWithJSON(http.StatusOK, FormatLogEntryList([]*slv1.LogEntry{mockEntry("a", now)})),
+			),
+		)
+
+		t.StdOutReader.Record()
+		err := ServiceLogsRunner(&Options{serviceName: "SREManualAction"})(
+			context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		stdout, _ := t.StdOutReader.Read()
+		Expect(stdout).To(Equal("" +
+			"2024-05-01T12:00:00Z  Warning  SREManualAction: Cluster has limited support\n" +
+			"    The cluster is missing\n" +
+			"    the installer role.\n"))
+	})
+
+	It("Follows only the new entries", func() {
+		f := newFollower(ocm.ServiceLogListOptions{})
+		first := f.next([]*slv1.LogEntry{mockEntry("a", now), mockEntry("b", now.Add(time.Minute))})
+		Expect(first).To(HaveLen(2))
+		Expect(f.options.Since).To(Equal(now.Add(time.Minute)))
+		second := f.next([]*slv1.LogEntry{mockEntry("b", now.Add(time.Minute)), mockEntry("c", now.Add(time.Minute))})
+		Expect(second).To(HaveLen(1))
+		Expect(second[0].ID()).To(Equal("c"))
+	})
+})
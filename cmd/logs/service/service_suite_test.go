@@ -0,0 +1,13 @@
+package service
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestServiceLogs(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Service logs suite")
+}
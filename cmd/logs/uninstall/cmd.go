@@ -17,9 +17,7 @@ limitations under the License.
 package uninstall
 
 import (
-	"fmt"
 	"os"
-	"strings"
 	"time"
 
 	"github.com/briandowns/spinner"
@@ -27,13 +25,17 @@ import (
 	"github.com/spf13/cobra"
 	errors "github.com/zgalor/weberr"
 
+	"github.com/openshift/rosa/pkg/logs"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
 var args struct {
-	tail  int
-	watch bool
+	tail       int
+	watch      bool
+	since      string
+	outputFile string
 }
 
 var Cmd = &cobra.Command{
@@ -44,7 +46,10 @@ var Cmd = &cobra.Command{
   rosa logs uninstall mycluster --tail=100
 
   # Show uninstall logs for a cluster using the --cluster flag
-  rosa logs uninstall --cluster=mycluster`,
+  rosa logs uninstall --cluster=mycluster
+
+  # Follow the uninstall logs and save all of them to a file to attach to a support case
+  rosa logs uninstall --cluster=mycluster --follow --output-file=uninstall.log`,
 	Run:  run,
 	Args: cobra.MaximumNArgs(1),
 }
@@ -58,7 +63,7 @@ func init() {
 		&args.tail,
 		"tail",
 		2000,
-		"Number of lines to get from the end of the log.",
+		"Number of lines to show from the end of the log. Zero shows all the lines.",
 	)
 
 	flags.BoolVarP(
@@ -68,6 +73,29 @@ func init() {
 		false,
 		"After getting the logs, watch for changes.",
 	)
+
+	flags.BoolVarP(
+		&args.watch,
+		"follow",
+		"f",
+		false,
+		"After getting the logs, keep showing the new lines as they are written. Same as '--watch'.",
+	)
+
+	flags.StringVar(
+		&args.since,
+		"since",
+		"",
+		"Show only the lines logged after this time. It can be a duration, like '1h', or a "+
+			"time in RFC3339 format, like '2024-05-01T12:00:00Z'.",
+	)
+
+	flags.StringVar(
+		&args.outputFile,
+		"output-file",
+		"",
+		"Write the complete log to this file, regardless of '--tail' and '--since'.",
+	)
 }
 
 func run(cmd *cobra.Command, argv []string) {
@@ -78,7 +106,11 @@ func run(cmd *cobra.Command, argv []string) {
 	// We check the flag value this way to allow other commands to watch logs
 	watch := cmd.Flags().Lookup("watch").Value.String() == "true"
 
-	var err error
+	since, err := logs.ParseSince(args.since, time.Now())
+	if err != nil {
+		r.Reporter.Errorf("%v", err)
+		reporter.Exit(1)
+	}
 
 	// Allow the command to be called programmatically
 	if len(argv) == 1 && !cmd.Flag("cluster").Changed {
@@ -90,7 +122,7 @@ func run(cmd *cobra.Command, argv []string) {
 	cluster := r.FetchCluster()
 	if cluster.State() != cmv1.ClusterStateUninstalling && !watch {
 		r.Reporter.Warnf("Cluster '%s' is not currently uninstalling", clusterKey)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if cluster.State() == cmv1.ClusterStateInstalling ||
@@ -99,20 +131,37 @@ func run(cmd *cobra.Command, argv []string) {
 		r.Reporter.Errorf("Cluster '%s' is in '%s' state and no uninstallation logs are available",
 			clusterKey, cluster.State(),
 		)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Get logs from Hive
-	logs, err := r.OCMClient.GetUninstallLogs(cluster.ID(), args.tail)
+	follower := logs.NewFollower(func(offset int) (*cmv1.Log, error) {
+		return r.OCMClient.GetUninstallLogsFrom(cluster.ID(), offset)
+	}, os.Stdout).Tail(args.tail).Since(since)
+	if args.outputFile != "" {
+		file, err := os.Create(args.outputFile)
+		if err != nil {
+			r.Reporter.Errorf("Failed to create output file '%s': %v", args.outputFile, err)
+			reporter.Exit(1)
+		}
+		defer file.Close()
+		follower.File(file)
+	}
+	// The log is complete when it isn't watched, so its last line is printed even if it doesn't end with
+	// a line break:
+	next := follower.Next
+	if !watch {
+		next = follower.Last
+	}
+	_, err = next()
 	if err != nil {
 		if errors.GetType(err) == errors.NotFound {
 			r.Reporter.Warnf("Logs for cluster '%s' are not available", clusterKey)
 		} else {
 			r.Reporter.Errorf("Failed to get logs for cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
-	printLog(logs, nil)
 
 	if watch {
 		var spin *spinner.Spinner
@@ -123,66 +172,39 @@ func run(cmd *cobra.Command, argv []string) {
 			spin.Start()
 		}
 
-		// Poll for changing logs:
-		response, err := r.OCMClient.PollUninstallLogs(cluster.ID(), func(logResponse *cmv1.LogGetResponse) bool {
+		// Poll for new lines of the log. The state of the cluster is checked before getting them so that
+		// the last lines aren't lost, and the last one is printed even if it doesn't end with a line break:
+		for {
+			time.Sleep(logs.PollInterval)
+
 			state, err := r.OCMClient.GetClusterState(cluster.ID())
-			if err != nil || state == cmv1.ClusterState("") {
+			uninstalled := err != nil || state == cmv1.ClusterState("")
+			next := follower.Next
+			if uninstalled {
+				next = follower.Last
+			}
+			if spin != nil {
+				spin.Stop()
+			}
+			printed, err := next()
+			if err != nil && errors.GetType(err) != errors.NotFound {
+				r.Reporter.Errorf("Failed to watch logs for cluster '%s': %v", clusterKey, err)
+				reporter.Exit(1)
+			}
+			if spin != nil && printed == 0 {
+				spin.Restart()
+			}
+
+			if uninstalled {
 				r.Reporter.Infof("Cluster '%s' completed uninstallation", clusterKey)
-				os.Exit(0)
+				reporter.Exit(0)
 			}
-			printLog(logResponse.Body(), spin)
 
 			err = r.OCMClient.KeepTokensAlive()
 			if err != nil {
-				r.Reporter.Errorf(fmt.Sprintf("Failed to keep tokens alive for polling: %v", err))
-				os.Exit(1)
+				r.Reporter.Errorf("Failed to keep tokens alive for polling: %v", err)
+				reporter.Exit(1)
 			}
-
-			return false
-		})
-		if err != nil {
-			if errors.GetType(err) != errors.NotFound {
-				r.Reporter.Errorf(fmt.Sprintf("Failed to watch logs for cluster '%s': %v", clusterKey, err))
-				os.Exit(1)
-			}
-		}
-		printLog(response, spin)
-	}
-}
-
-var lastLine string
-
-// Print next log lines
-func printLog(logs *cmv1.Log, spin *spinner.Spinner) {
-	lines := findNextLines(logs)
-	if lines != "" {
-		fmt.Printf("%s\n", lines)
-		if spin != nil {
-			spin.Stop()
 		}
-	} else if spin != nil {
-		spin.Restart()
-	}
-}
-
-// Remove duplicate lines from the log poll response
-func findNextLines(logs *cmv1.Log) string {
-	lines := strings.Split(logs.Content(), "\n")
-	// Last element is always empty, remove it
-	if len(lines) > 0 {
-		lines = lines[:len(lines)-1]
-	}
-	// Find where the new logs and the last line overlap
-	for i, line := range lines {
-		if lastLine != "" && line == lastLine {
-			// Remove any duplicate lines
-			lines = lines[i+1:]
-			break
-		}
-	}
-	// Store the last log lne
-	if len(lines) > 0 {
-		lastLine = lines[len(lines)-1]
 	}
-	return strings.Join(lines, "\n")
 }
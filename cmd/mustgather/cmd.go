@@ -0,0 +1,336 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mustgather
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/info"
+	"github.com/openshift/rosa/pkg/mustgather"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "must-gather"
+	short = "Collect the information about a cluster needed by a support case"
+	long  = "Collect the information about a cluster needed by a support case in a compressed tarball.\n\n" +
+		"The tarball contains the cluster, its install and uninstall logs, the results of the inflight " +
+		"checks, the limited support reasons, the machine pools or node pools, the upgrade policies, the " +
+		"ingresses, the identity providers with their secrets redacted, the OIDC configuration and the " +
+		"policies attached to the account and operator roles. It also contains a manifest that lists the " +
+		"collected files and the errors hit while collecting the ones that are missing."
+	example = `  # Collect the information about a cluster named 'mycluster'
+  rosa must-gather --cluster mycluster
+
+  # Write the tarball to a specific file
+  rosa must-gather --cluster mycluster --output-file mycluster.tar.gz`
+
+	archiveSuffix = ".tar.gz"
+)
+
+var aliases = []string{"mustgather"}
+
+type Options struct {
+	outputFile string
+}
+
+func NewMustGatherOptions() *Options {
+	return &Options{}
+}
+
+func NewMustGatherCommand() *cobra.Command {
+	options := NewMustGatherOptions()
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), MustGatherRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(
+		&options.outputFile,
+		"output-file",
+		"",
+		"File where the tarball is written. The default is 'must-gather-<cluster>-<time>.tar.gz' in "+
+			"the current directory.",
+	)
+	ocm.AddClusterFlag(cmd)
+	arguments.AddProfileFlag(cmd.PersistentFlags())
+	arguments.AddRegionFlag(cmd.PersistentFlags())
+	return cmd
+}
+
+func MustGatherRunner(options *Options) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
+		cluster, err := r.OCMClient.GetCluster(r.GetClusterKey(), r.Creator)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		dir := fmt.Sprintf("must-gather-%s-%s", cluster.Name(), now.Format("20060102T150405Z"))
+		outputFile := options.outputFile
+		if outputFile == "" {
+			outputFile = dir + archiveSuffix
+		}
+		file, err := os.Create(outputFile)
+		if err != nil {
+			return fmt.Errorf("Failed to create output file '%s': %v", outputFile, err)
+		}
+		defer file.Close()
+
+		r.Reporter.Infof("Collecting the information about cluster '%s'", r.ClusterKey)
+		manifest, err := gather(r, cluster, file, dir, now)
+		if err != nil {
+			os.Remove(outputFile)
+			return err
+		}
+		for _, collectErr := range manifest.Errors {
+			r.Reporter.Warnf("Failed to collect %s: %s", collectErr.Description, collectErr.Error)
+		}
+		r.Reporter.Infof("Collected %d files for cluster '%s' in '%s'", len(manifest.Files), r.ClusterKey,
+			outputFile)
+		return nil
+	}
+}
+
+// Writes the bundle of the cluster to the given writer and returns its manifest.
+func gather(r *rosa.Runtime, cluster *cmv1.Cluster, writer io.Writer, dir string,
+	now time.Time) (mustgather.Manifest, error) {
+	bundle := mustgather.NewBundle(writer, dir, mustgather.Manifest{
+		ClusterID:   cluster.ID(),
+		ClusterName: cluster.Name(),
+		RosaVersion: info.DefaultVersion,
+		CollectedAt: now,
+	})
+	for _, item := range items(r, cluster) {
+		err := bundle.Add(item.name, item.description, item.collect)
+		if err != nil {
+			return bundle.Manifest(), err
+		}
+	}
+	err := bundle.Close()
+	return bundle.Manifest(), err
+}
+
+type item struct {
+	name        string
+	description string
+	collect     mustgather.CollectFunc
+}
+
+// Returns the files to collect for the cluster. Only the ones that apply to the topology of the cluster
+// are included, so that the errors of the manifest are real problems.
+func items(r *rosa.Runtime, cluster *cmv1.Cluster) []item {
+	id := cluster.ID()
+	hostedCP := ocm.IsHyperShiftCluster(cluster)
+	result := []item{
+		{"cluster.json", "the cluster", func() ([]byte, error) {
+			return mustgather.JSON(func(w io.Writer) error { return cmv1.MarshalCluster(cluster, w) })
+		}},
+		{"logs/install.log", "the install logs", func() ([]byte, error) {
+			log, err := r.OCMClient.GetInstallLogsFrom(id, 0)
+			return []byte(log.Content()), err
+		}},
+	}
+	if cluster.State() == cmv1.ClusterStateUninstalling {
+		result = append(result, item{"logs/uninstall.log", "the uninstall logs", func() ([]byte, error) {
+			log, err := r.OCMClient.GetUninstallLogsFrom(id, 0)
+			return []byte(log.Content()), err
+		}})
+	}
+	result = append(result,
+		item{"inflight_checks.json", "the inflight checks", func() ([]byte, error) {
+			checks, err := r.OCMClient.GetInflightChecks(id)
+			if err != nil {
+				return nil, err
+			}
+			return mustgather.JSON(func(w io.Writer) error { return cmv1.MarshalInflightCheckList(checks, w) })
+		}},
+		item{"limited_support_reasons.json", "the limited support reasons", func() ([]byte, error) {
+			reasons, err := r.OCMClient.GetLimitedSupportReasons(id)
+			if err != nil {
+				return nil, err
+			}
+			return mustgather.JSON(func(w io.Writer) error {
+				return cmv1.MarshalLimitedSupportReasonList(reasons, w)
+			})
+		}},
+	)
+	if hostedCP {
+		result = append(result,
+			item{"node_pools.json", "the node pools", func() ([]byte, error) {
+				nodePools, err := r.OCMClient.GetNodePools(id)
+				if err != nil {
+					return nil, err
+				}
+				return mustgather.JSON(func(w io.Writer) error { return cmv1.MarshalNodePoolList(nodePools, w) })
+			}},
+			item{"upgrade_policies.json", "the upgrade policies", func() ([]byte, error) {
+				policies, err := r.OCMClient.GetControlPlaneUpgradePolicies(id)
+				if err != nil {
+					return nil, err
+				}
+				return mustgather.JSON(func(w io.Writer) error {
+					return cmv1.MarshalControlPlaneUpgradePolicyList(policies, w)
+				})
+			}},
+		)
+	} else {
+		result = append(result,
+			item{"machine_pools.json", "the machine pools", func() ([]byte, error) {
+				machinePools, err := r.OCMClient.GetMachinePools(id)
+				if err != nil {
+					return nil, err
+				}
+				return mustgather.JSON(func(w io.Writer) error {
+					return cmv1.MarshalMachinePoolList(machinePools, w)
+				})
+			}},
+			item{"upgrade_policies.json", "the upgrade policies", func() ([]byte, error) {
+				policies, err := r.OCMClient.GetUpgradePolicies(id)
+				if err != nil {
+					return nil, err
+				}
+				return mustgather.JSON(func(w io.Writer) error { return cmv1.MarshalUpgradePolicyList(policies, w) })
+			}},
+		)
+	}
+	result = append(result,
+		item{"ingresses.json", "the ingresses", func() ([]byte, error) {
+			ingresses, err := r.OCMClient.GetIngresses(id)
+			if err != nil {
+				return nil, err
+			}
+			return mustgather.JSON(func(w io.Writer) error { return cmv1.MarshalIngressList(ingresses, w) })
+		}},
+		item{"identity_providers.json", "the identity providers", func() ([]byte, error) {
+			idps, err := r.OCMClient.GetIdentityProviders(id)
+			if err != nil {
+				return nil, err
+			}
+			content, err := mustgather.JSON(func(w io.Writer) error {
+				return cmv1.MarshalIdentityProviderList(idps, w)
+			})
+			if err != nil {
+				return nil, err
+			}
+			return mustgather.Redact(content, mustgather.SecretFields)
+		}},
+	)
+	if oidcConfigID := cluster.AWS().STS().OidcConfig().ID(); oidcConfigID != "" {
+		result = append(result, item{"oidc_config.json", "the OIDC configuration", func() ([]byte, error) {
+			oidcConfig, err := r.OCMClient.GetOidcConfig(oidcConfigID)
+			if err != nil {
+				return nil, err
+			}
+			return mustgather.JSON(func(w io.Writer) error { return cmv1.MarshalOidcConfig(oidcConfig, w) })
+		}})
+	}
+	for _, role := range roles(cluster) {
+		role := role
+		result = append(result, item{
+			name:        fmt.Sprintf("aws/roles/%s.json", role.name),
+			description: fmt.Sprintf("the policies of the %s role '%s'", role.kind, role.name),
+			collect: func() ([]byte, error) {
+				return rolePolicies(r.AWSClient, role)
+			},
+		})
+	}
+	return result
+}
+
+type role struct {
+	arn  string
+	name string
+	kind string
+}
+
+// Returns the account and operator roles used by an STS cluster.
+func roles(cluster *cmv1.Cluster) []role {
+	sts := cluster.AWS().STS()
+	arns := []struct{ arn, kind string }{
+		{sts.RoleARN(), "installer"},
+		{sts.SupportRoleARN(), "support"},
+		{sts.InstanceIAMRoles().MasterRoleARN(), "control plane"},
+		{sts.InstanceIAMRoles().WorkerRoleARN(), "worker"},
+	}
+	for _, operatorRole := range sts.OperatorIAMRoles() {
+		arns = append(arns, struct{ arn, kind string }{operatorRole.RoleARN(), "operator"})
+	}
+	result := []role{}
+	for _, item := range arns {
+		if item.arn == "" {
+			continue
+		}
+		name, err := aws.GetResourceIdFromARN(item.arn)
+		if err != nil {
+			name = strings.ReplaceAll(item.arn, "/", "_")
+		}
+		result = append(result, role{arn: item.arn, name: name, kind: item.kind})
+	}
+	return result
+}
+
+func rolePolicies(client aws.Client, role role) ([]byte, error) {
+	policies, err := client.GetAttachedPolicy(&role.name)
+	if err != nil {
+		return nil, err
+	}
+	type policy struct {
+		Name string `json:"name"`
+		ARN  string `json:"arn,omitempty"`
+		Type string `json:"type"`
+	}
+	document := struct {
+		ARN      string   `json:"arn"`
+		Type     string   `json:"type"`
+		Policies []policy `json:"attached_policies"`
+	}{
+		ARN:      role.arn,
+		Type:     role.kind,
+		Policies: []policy{},
+	}
+	for _, detail := range policies {
+		document.Policies = append(document.Policies, policy{
+			Name: detail.PolicyName,
+			ARN:  detail.PolicyArn,
+			Type: detail.PolicyType,
+		})
+	}
+	content, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(content, '\n'), nil
+}
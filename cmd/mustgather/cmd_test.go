@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mustgather
+
+import (
+	"bytes"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+const emptyList = `{"kind": "List", "page": 1, "size": 0, "total": 0, "items": []}`
+
+var _ = Describe("Must-gather", func() {
+	var t *TestingRuntime
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+	})
+
+	It("Correctly builds the command", func() {
+		cmd := NewMustGatherCommand()
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Flags().Lookup("cluster")).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("output-file")).NotTo(BeNil())
+	})
+
+	It("Collects the files of a classic cluster and records the errors", func() {
+		cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.State(cmv1.ClusterStateReady)
+		})
+		t.ApiServer.AppendHandlers(
+			// Install logs:
+			RespondWithJSON(http.StatusNotFound, `{"kind": "Error", "reason": "Log not found"}`),
+			// Inflight checks, limited support reasons, machine pools, upgrade policies, ingresses and
+			// identity providers:
+			RespondWithJSON(http.StatusOK, emptyList),
+			RespondWithJSON(http.StatusOK, emptyList),
+			RespondWithJSON(http.StatusOK, emptyList),
+			RespondWithJSON(http.StatusOK, emptyList),
+			RespondWithJSON(http.StatusOK, emptyList),
+			RespondWithJSON(http.StatusOK, emptyList),
+		)
+
+		var buffer bytes.Buffer
+		manifest, err := gather(t.RosaRuntime, cluster, &buffer, "must-gather", time.Now())
+		Expect(err).NotTo(HaveOccurred())
+		names := []string{}
+		for _, file := range manifest.Files {
+			names = append(names, file.Name)
+		}
+		Expect(names).To(Equal([]string{
+			"cluster.json",
+			"inflight_checks.json",
+			"limited_support_reasons.json",
+			"machine_pools.json",
+			"upgrade_policies.json",
+			"ingresses.json",
+			"identity_providers.json",
+		}))
+		Expect(manifest.Errors).To(HaveLen(1))
+		Expect(manifest.Errors[0].Name).To(Equal("logs/install.log"))
+		Expect(buffer.Len()).To(BeNumerically(">", 0))
+	})
+
+	It("Includes the node pools and roles of a hosted control plane cluster", func() {
+		cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.State(cmv1.ClusterStateUninstalling)
+			c.Hypershift(cmv1.NewHypershift().Enabled(true))
+			c.AWS(cmv1.NewAWS().STS(cmv1.NewSTS().
+				RoleARN("arn:aws:iam::123456789012:role/prefix-HCP-ROSA-Installer-Role").
+				SupportRoleARN("arn:aws:iam::123456789012:role/prefix-HCP-ROSA-Support-Role").
+				OidcConfig(cmv1.NewOidcConfig().ID("oidc")).
+				OperatorIAMRoles(cmv1.NewOperatorIAMRole().
+					RoleARN("arn:aws:iam::123456789012:role/op-openshift-ingress-operator-cloud-credentials"))))
+		})
+		names := []string{}
+		for _, item := range items(t.RosaRuntime, cluster) {
+			names = append(names, item.name)
+		}
+		Expect(names).To(Equal([]string{
+			"cluster.json",
+			"logs/install.log",
+			"logs/uninstall.log",
+			"inflight_checks.json",
+			"limited_support_reasons.json",
+			"node_pools.json",
+			"upgrade_policies.json",
+			"ingresses.json",
+			"identity_providers.json",
+			"oidc_config.json",
+			"aws/roles/prefix-HCP-ROSA-Installer-Role.json",
+			"aws/roles/prefix-HCP-ROSA-Support-Role.json",
+			"aws/roles/op-openshift-ingress-operator-cloud-credentials.json",
+		}))
+	})
+})
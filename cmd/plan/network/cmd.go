@@ -0,0 +1,208 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/network"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "network"
+	short = "Propose the networks of a new cluster in an existing VPC"
+	long  = "Propose the machine, service and pod CIDRs and the host prefix of a new cluster that is going " +
+		"to be created in the given subnets.\n\n" +
+		"The CIDR blocks of the VPC, its subnets and the destinations of its routes, for example to " +
+		"peered VPCs, transit gateways and VPN gateways, are read from AWS. The service and pod networks " +
+		"use the defaults when they are free, otherwise the first free private ranges are proposed. The " +
+		"host prefix is the longest one that gives each node room for the maximum number of pods. " +
+		"Overlaps with the ranges given with '--reserved-cidrs' are explained. Only AWS is used, no " +
+		"login to OpenShift Cluster Manager is needed."
+	example = `  # Propose the networks of a cluster with 10 nodes in two subnets
+  rosa plan network --subnet-ids subnet-0a1b,subnet-2c3d --nodes 10
+
+  # Avoid the ranges used by the corporate network
+  rosa plan network --subnet-ids subnet-0a1b --nodes 30 --reserved-cidrs 10.128.0.0/9,172.30.0.0/16`
+
+	subnetIDsFlag     = "subnet-ids"
+	nodesFlag         = "nodes"
+	maxPodsFlag       = "max-pods"
+	reservedCIDRsFlag = "reserved-cidrs"
+)
+
+type Options struct {
+	subnetIDs     []string
+	nodes         int
+	maxPods       int
+	reservedCIDRs []string
+}
+
+func NewPlanNetworkOptions() *Options {
+	return &Options{
+		maxPods: network.DefaultMaxPods,
+	}
+}
+
+func NewPlanNetworkCommand() *cobra.Command {
+	options := NewPlanNetworkOptions()
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(runtimeWithAWSOnly(), PlanNetworkRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.StringSliceVar(
+		&options.subnetIDs,
+		subnetIDsFlag,
+		nil,
+		"The subnet IDs where the cluster is going to be created.",
+	)
+	flags.IntVar(
+		&options.nodes,
+		nodesFlag,
+		0,
+		"Maximum number of compute nodes of the cluster.",
+	)
+	flags.IntVar(
+		&options.maxPods,
+		maxPodsFlag,
+		network.DefaultMaxPods,
+		"Maximum number of pods per node.",
+	)
+	flags.StringSliceVar(
+		&options.reservedCIDRs,
+		reservedCIDRsFlag,
+		nil,
+		"CIDRs that the cluster must not use, for example the ranges of the corporate network.",
+	)
+	arguments.AddProfileFlag(cmd.PersistentFlags())
+	arguments.AddRegionFlag(cmd.PersistentFlags())
+	output.AddFlag(cmd)
+	return cmd
+}
+
+// The plan only needs AWS, so unlike rosa.RuntimeWithAWS the region isn't validated with OCM.
+func runtimeWithAWSOnly() rosa.RuntimeVisitor {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) {
+		if r.AWSClient == nil {
+			r.AWSClient = aws.CreateNewClientOrExit(r.Logger, r.Reporter)
+		}
+	}
+}
+
+func PlanNetworkRunner(options *Options) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
+		if len(options.subnetIDs) == 0 {
+			return fmt.Errorf("The '--%s' flag is required", subnetIDsFlag)
+		}
+		if options.nodes < 1 {
+			return fmt.Errorf("The '--%s' flag must be positive", nodesFlag)
+		}
+
+		input, err := readVPC(r, options)
+		if err != nil {
+			return err
+		}
+		plan, err := network.Propose(*input)
+		if err != nil {
+			return err
+		}
+
+		if output.HasFlag() {
+			return output.Print(plan)
+		}
+		err = network.NewTable(plan).Print()
+		if err != nil {
+			return err
+		}
+		for _, warning := range plan.Warnings {
+			r.Reporter.Warnf("%s", warning)
+		}
+		if len(plan.Conflicts) > 0 {
+			fmt.Println()
+			err = network.NewConflictsTable(plan).Print()
+			if err != nil {
+				return err
+			}
+		}
+		fmt.Println()
+		r.Reporter.Infof("Use the following flags to create the cluster:\n\n%s", plan.Flags())
+		return nil
+	}
+}
+
+func readVPC(r *rosa.Runtime, options *Options) (*network.Input, error) {
+	subnets, err := r.AWSClient.ListSubnets(options.subnetIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get subnets: %v", err)
+	}
+	if len(subnets) != len(options.subnetIDs) {
+		return nil, fmt.Errorf("Found %d of the %d subnets", len(subnets), len(options.subnetIDs))
+	}
+	input := &network.Input{
+		Nodes:   options.nodes,
+		MaxPods: options.maxPods,
+	}
+	vpcID := awssdk.ToString(subnets[0].VpcId)
+	for _, subnet := range subnets {
+		if awssdk.ToString(subnet.VpcId) != vpcID {
+			return nil, fmt.Errorf("The subnets belong to different VPCs: '%s' and '%s'",
+				vpcID, awssdk.ToString(subnet.VpcId))
+		}
+		input.Subnets = append(input.Subnets, network.Network{
+			Source: network.SourceSubnet,
+			Name:   awssdk.ToString(subnet.SubnetId),
+			CIDR:   awssdk.ToString(subnet.CidrBlock),
+		})
+	}
+
+	input.VPCCIDRs, err = r.AWSClient.GetVPCCIDRs(vpcID)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the CIDR blocks of VPC '%s': %v", vpcID, err)
+	}
+	routes, err := r.AWSClient.ListVPCRoutes(vpcID)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the routes of VPC '%s': %v", vpcID, err)
+	}
+	for _, route := range routes {
+		input.Routes = append(input.Routes, network.Network{
+			Source: network.SourceRoute,
+			Name:   route.Target,
+			CIDR:   route.Destination,
+		})
+	}
+	for _, cidr := range options.reservedCIDRs {
+		input.Reserved = append(input.Reserved, network.Network{
+			Source: network.SourceReserved,
+			CIDR:   cidr,
+		})
+	}
+	return input, nil
+}
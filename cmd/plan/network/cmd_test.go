@@ -0,0 +1,114 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/network"
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Plan network", func() {
+	var (
+		t         *TestingRuntime
+		awsClient *aws.MockClient
+		options   *Options
+	)
+
+	subnet := func(id string, vpcID string, cidr string) ec2types.Subnet {
+		return ec2types.Subnet{
+			SubnetId:  awssdk.String(id),
+			VpcId:     awssdk.String(vpcID),
+			CidrBlock: awssdk.String(cidr),
+		}
+	}
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+		awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+		t.RosaRuntime.AWSClient = awsClient
+		options = NewPlanNetworkOptions()
+		options.subnetIDs = []string{"subnet-a", "subnet-b"}
+		options.nodes = 10
+		output.SetOutput("")
+	})
+
+	AfterEach(func() {
+		output.SetOutput("")
+	})
+
+	It("Correctly builds the command", func() {
+		cmd := NewPlanNetworkCommand()
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Flags().Lookup(maxPodsFlag).DefValue).To(Equal("250"))
+		Expect(cmd.Flags().Lookup(reservedCIDRsFlag)).NotTo(BeNil())
+	})
+
+	It("Requires the subnets and the nodes", func() {
+		options.subnetIDs = nil
+		err := PlanNetworkRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).To(MatchError("The '--subnet-ids' flag is required"))
+
+		options.subnetIDs = []string{"subnet-a"}
+		options.nodes = 0
+		err = PlanNetworkRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).To(MatchError("The '--nodes' flag must be positive"))
+	})
+
+	It("Fails if the subnets belong to different VPCs", func() {
+		awsClient.EXPECT().ListSubnets("subnet-a", "subnet-b").Return([]ec2types.Subnet{
+			subnet("subnet-a", "vpc-1", "10.0.0.0/24"),
+			subnet("subnet-b", "vpc-2", "10.0.1.0/24"),
+		}, nil)
+		err := PlanNetworkRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).To(MatchError("The subnets belong to different VPCs: 'vpc-1' and 'vpc-2'"))
+	})
+
+	It("Proposes networks that avoid the routes of the VPC", func() {
+		awsClient.EXPECT().ListSubnets("subnet-a", "subnet-b").Return([]ec2types.Subnet{
+			subnet("subnet-a", "vpc-1", "10.0.0.0/24"),
+			subnet("subnet-b", "vpc-1", "10.0.1.0/24"),
+		}, nil)
+		awsClient.EXPECT().GetVPCCIDRs("vpc-1").Return([]string{"10.0.0.0/16"}, nil)
+		awsClient.EXPECT().ListVPCRoutes("vpc-1").Return([]aws.Route{
+			{Destination: "172.30.0.0/16", Target: "tgw-1"},
+		}, nil)
+		output.SetOutput(output.JSON)
+		t.StdOutReader.Record()
+
+		err := PlanNetworkRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		stdout, _ := t.StdOutReader.Read()
+		plan := &network.Plan{}
+		Expect(json.Unmarshal([]byte(stdout), plan)).To(Succeed())
+		Expect(plan.MachineCIDR).To(Equal("10.0.0.0/16"))
+		Expect(plan.PodCIDR).To(Equal(network.DefaultPodCIDR))
+		Expect(plan.ServiceCIDR).To(Equal("10.1.0.0/16"))
+		Expect(plan.Conflicts).To(HaveLen(1))
+		Expect(plan.Conflicts[0].OverlapsWith.Name).To(Equal("tgw-1"))
+	})
+})
@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/cmd/plugin/list"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "plugin",
+	Short: "Manage the plugins of rosa",
+	Long: "Manage the plugins of rosa. A plugin is an executable in the PATH whose name starts with 'rosa-'. " +
+		"Running 'rosa foo bar' runs the plugin 'rosa-foo-bar', or 'rosa-foo' with the 'bar' argument, when " +
+		"'foo' isn't a builtin command.\n\n" +
+		"The OCM URL, a fresh access token, the login context, the AWS profile and region and the '--debug' " +
+		"and '--output' flags are passed to the plugin in the ROSA_OCM_URL, ROSA_TOKEN, ROSA_CONTEXT, " +
+		"AWS_PROFILE, AWS_REGION, ROSA_DEBUG and ROSA_OUTPUT environment variables, and the path of rosa in " +
+		"ROSA_BINARY.",
+	Args: cobra.NoArgs,
+}
+
+func init() {
+	Cmd.AddCommand(list.NewListPluginsCommand())
+}
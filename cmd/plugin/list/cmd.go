@@ -0,0 +1,75 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/plugin"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "list"
+	short = "List the plugins found in the PATH"
+	long  = "List the executables in the PATH whose name starts with 'rosa-', with the command that runs " +
+		"them. Plugins that can't be run, because they aren't executable, because another plugin with the " +
+		"same name comes first in the PATH or because a builtin command has the same name, are reported " +
+		"with a warning."
+	example = `  # List the plugins
+  rosa plugin list`
+)
+
+func NewListPluginsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(nil, ListPluginsRunner()),
+	}
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func ListPluginsRunner() rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
+		root := command.Root()
+		plugins := plugin.List(os.Getenv("PATH"), func(name string) bool {
+			return plugin.IsBuiltin(root, name)
+		})
+
+		if output.HasFlag() {
+			return output.Print(plugins)
+		}
+		if len(plugins) == 0 {
+			r.Reporter.Infof("There are no plugins in the PATH")
+			return nil
+		}
+		table := output.NewTable("NAME", "PATH", "WARNINGS")
+		for _, p := range plugins {
+			table.AddRow("rosa "+p.Name, p.Path, strings.Join(p.Warnings, ", "))
+		}
+		return table.Print()
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("List plugins", func() {
+	var (
+		t    *TestingRuntime
+		root *cobra.Command
+		cmd  *cobra.Command
+		dir  string
+	)
+
+	BeforeEach(func() {
+		if runtime.GOOS == "windows" {
+			Skip("The plugins of the test are shell scripts")
+		}
+		t = NewTestRuntime()
+		root = &cobra.Command{Use: "rosa"}
+		cmd = NewListPluginsCommand()
+		root.AddCommand(cmd)
+		dir = GinkgoT().TempDir()
+		GinkgoT().Setenv("PATH", dir)
+		output.SetOutput("")
+	})
+
+	AfterEach(func() {
+		output.SetOutput("")
+	})
+
+	It("Reports that there are no plugins", func() {
+		err := ListPluginsRunner()(context.Background(), t.RosaRuntime, cmd, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Lists the plugins with their warnings", func() {
+		Expect(os.WriteFile(filepath.Join(dir, "rosa-hello"), []byte("#!/bin/sh\n"), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "rosa-list"), []byte("#!/bin/sh\n"), 0755)).To(Succeed())
+
+		t.StdOutReader.Record()
+		err := ListPluginsRunner()(context.Background(), t.RosaRuntime, cmd, nil)
+		Expect(err).NotTo(HaveOccurred())
+		stdout, err := t.StdOutReader.Read()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stdout).To(ContainSubstring("rosa hello"))
+		Expect(stdout).To(ContainSubstring(filepath.Join(dir, "rosa-hello")))
+		Expect(stdout).To(ContainSubstring("overridden by the builtin command 'rosa list'"))
+	})
+})
@@ -0,0 +1,13 @@
+package breakglasscredential
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPruneBreakGlassCredentials(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Prune break glass credentials suite")
+}
@@ -0,0 +1,218 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package breakglasscredential
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/breakglasscredential"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/kubeconfig"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "break-glass-credentials"
+	short = "Remove the kubeconfig contexts of break glass credentials that can no longer be used"
+	long  = "Remove from the kubeconfig the contexts added by 'rosa use break-glass-credential' and " +
+		"'rosa create break-glass-credential --write-kubeconfig' whose credential has expired, has been " +
+		"revoked or no longer exists. Only the local kubeconfig is changed. When not logged in to OCM, " +
+		"only the contexts of expired credentials are removed. The credentials created in a different OCM " +
+		"environment than the current one are only removed once they have expired."
+	example = `  # Remove the contexts of break glass credentials that can no longer be used
+  rosa prune break-glass-credentials
+
+  # Remove them from a specific kubeconfig file without asking for confirmation
+  rosa prune break-glass-credentials --kubeconfig=/tmp/mycluster.kubeconfig --yes`
+
+	kubeconfigFlag = "kubeconfig"
+)
+
+var aliases = []string{"break-glass-credential", "breakglasscredential", "breakglasscredentials"}
+
+type Options struct {
+	kubeconfig string
+}
+
+func NewPruneBreakGlassCredentialsOptions() *Options {
+	return &Options{}
+}
+
+func NewPruneBreakGlassCredentialsCommand() *cobra.Command {
+	options := NewPruneBreakGlassCredentialsOptions()
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(nil, PruneBreakGlassCredentialsRunner(options)),
+	}
+	cmd.Flags().StringVar(
+		&options.kubeconfig,
+		kubeconfigFlag,
+		"",
+		"Path of the kubeconfig file to prune. Defaults to the file that kubectl uses: "+
+			"the first file of KUBECONFIG or ~/.kube/config.",
+	)
+	confirm.AddFlag(cmd.Flags())
+	return cmd
+}
+
+type staleEntry struct {
+	breakglasscredential.KubeconfigEntry
+	reason string
+}
+
+func PruneBreakGlassCredentialsRunner(options *Options) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
+		path, err := kubeconfig.Path(options.kubeconfig)
+		if err != nil {
+			return err
+		}
+		config, err := kubeconfig.Load(path)
+		if err != nil {
+			return err
+		}
+		entries, err := breakglasscredential.KubeconfigEntries(config)
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			r.Reporter.Infof("There are no break glass credentials in kubeconfig '%s'", path)
+			return nil
+		}
+
+		var stale []staleEntry
+		checker := &staleChecker{runtime: r, now: time.Now()}
+		for _, entry := range entries {
+			reason := checker.reason(entry)
+			if reason != "" {
+				stale = append(stale, staleEntry{KubeconfigEntry: entry, reason: reason})
+			}
+		}
+		if len(stale) == 0 {
+			r.Reporter.Infof("All the break glass credentials in kubeconfig '%s' can still be used", path)
+			return nil
+		}
+
+		table := output.NewTable("CONTEXT", "CLUSTER", "CREDENTIAL", "REASON")
+		for _, entry := range stale {
+			table.AddRow(entry.Context, entry.ClusterName, entry.ID, entry.reason)
+		}
+		err = table.Print()
+		if err != nil {
+			return err
+		}
+		if !confirm.Confirm("remove %d contexts from kubeconfig '%s'", len(stale), path) {
+			return nil
+		}
+
+		current := config.CurrentContext
+		for _, entry := range stale {
+			config.RemoveContext(entry.Context)
+		}
+		err = config.Save(path)
+		if err != nil {
+			return err
+		}
+		r.Reporter.Infof("Removed %d contexts from kubeconfig '%s'", len(stale), path)
+		if current != "" && config.CurrentContext == "" {
+			r.Reporter.Warnf("The current context '%s' was removed, select another one with "+
+				"'kubectl config use-context'", current)
+		}
+		return nil
+	}
+}
+
+// staleChecker finds the credentials that can no longer be used. It only connects to OCM for the credentials
+// that haven't expired yet, so that the expired ones are pruned even when the user isn't logged in.
+type staleChecker struct {
+	runtime *rosa.Runtime
+	now     time.Time
+	offline bool
+}
+
+// reason returns why the credential of a context can no longer be used, or an empty string if it can
+// still be used. Credentials that haven't expired yet are checked with OCM, as they may have been revoked,
+// but only when they were created in the OCM environment that the user is logged in to: the other
+// environments don't know about them.
+func (c *staleChecker) reason(entry breakglasscredential.KubeconfigEntry) string {
+	if entry.Expired(c.now) {
+		return "expired"
+	}
+	if !c.connect() {
+		return ""
+	}
+	r := c.runtime
+	if !sameURL(entry.OCMURL, r.OCMClient.GetConnectionURL()) {
+		r.Reporter.Debugf("Keeping context '%s', its break glass credential was created in OCM environment "+
+			"'%s' instead of '%s'", entry.Context, entry.OCMURL, r.OCMClient.GetConnectionURL())
+		return ""
+	}
+	credential, err := r.OCMClient.GetBreakGlassCredential(entry.ClusterID, entry.ID)
+	if errors.GetType(err) == errors.NotFound {
+		return "deleted"
+	}
+	if err != nil {
+		r.Reporter.Warnf("Failed to check break glass credential '%s' of context '%s', keeping it: %v",
+			entry.ID, entry.Context, err)
+		return ""
+	}
+	switch credential.Status() {
+	case cmv1.BreakGlassCredentialStatusRevoked, cmv1.BreakGlassCredentialStatusAwaitingRevocation:
+		return "revoked"
+	case cmv1.BreakGlassCredentialStatusExpired:
+		return "expired"
+	}
+	return ""
+}
+
+// sameURL tells if the URL recorded in a context is the one of the current OCM environment. Contexts that
+// don't record a URL can't be attributed to any environment.
+func sameURL(recorded string, current string) bool {
+	return recorded != "" && strings.TrimSuffix(recorded, "/") == strings.TrimSuffix(current, "/")
+}
+
+// Creates the OCM client the first time that it is needed, and returns false if it can't be created, for
+// example because the user isn't logged in.
+func (c *staleChecker) connect() bool {
+	if c.runtime.OCMClient != nil {
+		return true
+	}
+	if c.offline {
+		return false
+	}
+	client, err := ocm.NewClient().Logger(c.runtime.Logger).Build()
+	if err != nil {
+		c.offline = true
+		c.runtime.Reporter.Warnf("Only the expired break glass credentials are removed, as the other ones "+
+			"can't be checked with OCM: %v", err)
+		return false
+	}
+	c.runtime.OCMClient = client
+	return true
+}
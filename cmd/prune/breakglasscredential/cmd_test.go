@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package breakglasscredential
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/breakglasscredential"
+	"github.com/openshift/rosa/pkg/kubeconfig"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+const credentialKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: cluster
+  cluster:
+    server: https://api.mycluster.example.com:443
+users:
+- name: user
+  user:
+    token: secret
+contexts:
+- name: admin
+  context:
+    cluster: cluster
+    user: user
+current-context: admin
+`
+
+var _ = Describe("Prune break glass credentials", func() {
+	var (
+		t       *TestingRuntime
+		options *Options
+		cluster *cmv1.Cluster
+		ocmURL  string
+	)
+
+	credential := func(id string, status cmv1.BreakGlassCredentialStatus,
+		expiration time.Time) *cmv1.BreakGlassCredential {
+		credential, err := cmv1.NewBreakGlassCredential().ID(id).Status(status).
+			ExpirationTimestamp(expiration).Build()
+		Expect(err).NotTo(HaveOccurred())
+		return credential
+	}
+
+	write := func(credential *cmv1.BreakGlassCredential, use bool) {
+		_, _, err := breakglasscredential.WriteKubeconfig(
+			options.kubeconfig, ocmURL, cluster, credential, credentialKubeconfig, use)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	contexts := func() []string {
+		config, err := kubeconfig.Load(options.kubeconfig)
+		Expect(err).NotTo(HaveOccurred())
+		var names []string
+		for _, context := range config.Contexts {
+			names = append(names, context.Name)
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+		options = NewPruneBreakGlassCredentialsOptions()
+		options.kubeconfig = filepath.Join(GinkgoT().TempDir(), "config")
+		cluster = MockCluster(nil)
+		ocmURL = t.RosaRuntime.OCMClient.GetConnectionURL()
+
+		cmd := NewPruneBreakGlassCredentialsCommand()
+		Expect(cmd.Flags().Set("yes", "true")).To(Succeed())
+		DeferCleanup(func() {
+			Expect(cmd.Flags().Set("yes", "false")).To(Succeed())
+		})
+	})
+
+	It("Removes the contexts of the credentials that can no longer be used", func() {
+		future := time.Now().Add(time.Hour)
+		write(credential("expired", cmv1.BreakGlassCredentialStatusIssued, time.Now().Add(-time.Hour)), false)
+		write(credential("revoked", cmv1.BreakGlassCredentialStatusIssued, future), false)
+		write(credential("deleted", cmv1.BreakGlassCredentialStatusIssued, future), false)
+		write(credential("issued", cmv1.BreakGlassCredentialStatusIssued, future), true)
+
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatResource(
+			credential("revoked", cmv1.BreakGlassCredentialStatusRevoked, future))))
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusNotFound, "{}"))
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatResource(
+			credential("issued", cmv1.BreakGlassCredentialStatusIssued, future))))
+
+		err := PruneBreakGlassCredentialsRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(contexts()).To(Equal([]string{breakglasscredential.ContextName(MockClusterName, "issued")}))
+	})
+
+	It("Keeps the contexts of credentials that can't be checked", func() {
+		write(credential("issued", cmv1.BreakGlassCredentialStatusIssued, time.Now().Add(time.Hour)), false)
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusForbidden, "{}"))
+
+		err := PruneBreakGlassCredentialsRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(contexts()).To(HaveLen(1))
+	})
+
+	It("Keeps the contexts of credentials created in another OCM environment", func() {
+		ocmURL = "https://api.stage.openshift.com"
+		write(credential("other", cmv1.BreakGlassCredentialStatusIssued, time.Now().Add(time.Hour)), false)
+		write(credential("expired", cmv1.BreakGlassCredentialStatusIssued, time.Now().Add(-time.Hour)), false)
+
+		err := PruneBreakGlassCredentialsRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(contexts()).To(Equal([]string{breakglasscredential.ContextName(MockClusterName, "other")}))
+		Expect(t.ApiServer.ReceivedRequests()).To(BeEmpty())
+	})
+
+	It("Removes the expired credentials when not logged in", func() {
+		GinkgoT().Setenv("OCM_CONFIG", filepath.Join(GinkgoT().TempDir(), "ocm.json"))
+		GinkgoT().Setenv("OCM_KEYRING", "")
+		t.RosaRuntime.OCMClient = nil
+		write(credential("expired", cmv1.BreakGlassCredentialStatusIssued, time.Now().Add(-time.Hour)), false)
+		write(credential("issued", cmv1.BreakGlassCredentialStatusIssued, time.Now().Add(time.Hour)), true)
+
+		err := PruneBreakGlassCredentialsRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(contexts()).To(Equal([]string{breakglasscredential.ContextName(MockClusterName, "issued")}))
+		Expect(t.ApiServer.ReceivedRequests()).To(BeEmpty())
+	})
+
+	It("Doesn't create a missing kubeconfig", func() {
+		err := PruneBreakGlassCredentialsRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(options.kubeconfig).NotTo(BeAnExistingFile())
+	})
+})
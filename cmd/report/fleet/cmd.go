@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/fleet"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "fleet"
+	short = "Report the inventory and health of all the clusters"
+	long  = "Report the inventory and health of all the clusters that you can see, with a row per cluster " +
+		"that shows its version, the time until the end of life of the version, the available and " +
+		"pending upgrades, the limited support reasons, the version skew of the node pools, the versions " +
+		"of the account roles and the expiration.\n\n" +
+		"The details of several clusters are retrieved at the same time, up to the number given with " +
+		"'--concurrency'. Details that can't be retrieved are reported in the errors of the cluster."
+	example = `  # Show the report as a table
+  rosa report fleet
+
+  # Write the report as CSV
+  rosa report fleet --output csv > fleet.csv
+
+  # Write the report as an HTML page for a management review
+  rosa report fleet --output html > fleet.html`
+
+	concurrencyFlag = "concurrency"
+)
+
+type Options struct {
+	concurrency int
+}
+
+func NewFleetReportOptions() *Options {
+	return &Options{
+		concurrency: fleet.DefaultConcurrency,
+	}
+}
+
+func NewFleetReportCommand() *cobra.Command {
+	options := NewFleetReportOptions()
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), FleetReportRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.IntVar(
+		&options.concurrency,
+		concurrencyFlag,
+		fleet.DefaultConcurrency,
+		"Maximum number of clusters whose details are retrieved at the same time.",
+	)
+	arguments.AddProfileFlag(cmd.PersistentFlags())
+	arguments.AddRegionFlag(cmd.PersistentFlags())
+	output.AddFlagWithFormats(cmd, fleet.CSV, fleet.HTML)
+	output.AddNoHeadersFlag(cmd)
+	return cmd
+}
+
+func FleetReportRunner(options *Options) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
+		if options.concurrency < 1 {
+			return fmt.Errorf("The '--%s' flag must be positive", concurrencyFlag)
+		}
+
+		clusters, err := r.OCMClient.ListClusters(nil, ocm.ClusterListOptions{})
+		if err != nil {
+			return fmt.Errorf("Failed to get clusters: %v", err)
+		}
+		if len(clusters) == 0 && !output.HasFlag() {
+			r.Reporter.Infof("There are no clusters deployed")
+			return nil
+		}
+
+		now := time.Now()
+		r.Reporter.Debugf("Collecting the details of %d clusters", len(clusters))
+		collector := fleet.NewCollector(r.OCMClient, r.AWSClient, r.Creator.AccountID, options.concurrency, now)
+		rows := collector.Collect(clusters)
+
+		switch output.Output() {
+		case fleet.CSV:
+			return fleet.WriteCSV(os.Stdout, rows)
+		case fleet.HTML:
+			return fleet.WriteHTML(os.Stdout, rows, now)
+		}
+		if output.HasFlag() {
+			return output.Print(rows)
+		}
+		return fleet.NewTable(rows).Print()
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleet
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/fleet"
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Fleet report", func() {
+	var t *TestingRuntime
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+		output.SetOutput("")
+	})
+
+	AfterEach(func() {
+		output.SetOutput("")
+	})
+
+	It("Correctly builds the command", func() {
+		cmd := NewFleetReportCommand()
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Flags().Lookup(concurrencyFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("output").Usage).To(ContainSubstring("csv html"))
+	})
+
+	It("Fails if the concurrency isn't positive", func() {
+		options := NewFleetReportOptions()
+		options.concurrency = 0
+		err := FleetReportRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).To(MatchError("The '--concurrency' flag must be positive"))
+	})
+
+	It("Reports that there are no clusters", func() {
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{})))
+		err := FleetReportRunner(NewFleetReportOptions())(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Writes the CSV header without clusters", func() {
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{})))
+		output.SetOutput(fleet.CSV)
+		t.StdOutReader.Record()
+		err := FleetReportRunner(NewFleetReportOptions())(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		stdout, _ := t.StdOutReader.Read()
+		Expect(stdout).To(HavePrefix("cluster_id,cluster_name,"))
+	})
+})
@@ -0,0 +1,13 @@
+package fleet
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestFleetReport(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Fleet report suite")
+}
@@ -17,14 +17,14 @@ limitations under the License.
 package cluster
 
 import (
-	"os"
-
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/wait"
 )
 
 func GenerateCommand() *cobra.Command {
@@ -33,12 +33,16 @@ func GenerateCommand() *cobra.Command {
 		Short: "Resume cluster",
 		Long:  "Resume cluster.",
 		Example: `  # Resume the cluster
-  rosa resume cluster -c mycluster`,
+  rosa resume cluster -c mycluster
+
+  # Resume the cluster and wait until it is ready
+  rosa resume cluster -c mycluster --wait`,
 		Run:  run,
 		Args: cobra.NoArgs,
 	}
 	ocm.AddClusterFlag(Cmd)
 	confirm.AddFlag(Cmd.Flags())
+	wait.AddFlags(Cmd.Flags())
 	return Cmd
 }
 
@@ -53,15 +57,22 @@ func run(_ *cobra.Command, _ []string) {
 		r.Reporter.Errorf("Resuming a cluster from hibernation is only supported for clusters in "+
 			"'Hibernating' state. Cluster '%s' is in '%s' state",
 			clusterKey, cluster.State())
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if !confirm.Yes() && !confirm.Confirm("resume cluster %s", clusterKey) {
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	err := r.OCMClient.ResumeCluster(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to update cluster: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
+	}
+	if wait.Enabled() {
+		r.Reporter.Infof("Waiting for cluster '%s' to be ready", clusterKey)
+		err = r.OCMClient.WaitForClusterState(cluster.ID(), cmv1.ClusterStateReady, wait.Timeout())
+		wait.Check(r.Reporter, err)
+		r.Reporter.Infof("Cluster '%s' is ready.", clusterKey)
+		return
 	}
 	r.Reporter.Infof("Cluster '%s' is resuming.", clusterKey)
 }
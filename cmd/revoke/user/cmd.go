@@ -18,13 +18,13 @@ package user
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/cmd/create/idp"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -84,13 +84,13 @@ func run(_ *cobra.Command, argv []string) {
 			"Username '%s' isn't valid: it must contain only letters, digits, dashes and underscores",
 			username,
 		)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if username == idp.ClusterAdminUsername {
 		r.Reporter.Errorf("Username '%s' is reserved for `rosa create/delete admin` command. "+
 			"Run `rosa delete admin -c %s` to delete user '%s'",
 			idp.ClusterAdminUsername, clusterKey, idp.ClusterAdminUsername)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	role := argv[0]
@@ -109,7 +109,7 @@ func run(_ *cobra.Command, argv []string) {
 	}
 	if !isRoleValid {
 		r.Reporter.Errorf("Expected at least one of %s", validRoles)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	cluster := r.FetchCluster()
@@ -118,16 +118,16 @@ func run(_ *cobra.Command, argv []string) {
 	user, err := r.OCMClient.GetUser(cluster.ID(), role, username)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if user == nil {
 		r.Reporter.Warnf("Cannot find user '%s' with role '%s' on cluster '%s'", username, role, clusterKey)
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	if !confirm.Confirm("revoke role %s from user %s in cluster %s", role, username, clusterKey) {
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	r.Reporter.Debugf("Removing user '%s' from group '%s' in cluster '%s'", username, role, clusterKey)
@@ -135,7 +135,7 @@ func run(_ *cobra.Command, argv []string) {
 	if err != nil {
 		r.Reporter.Errorf("Failed to revoke '%s' from user '%s' in cluster '%s': %s",
 			role, username, clusterKey, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	r.Reporter.Infof("Revoked role '%s' from user '%s' on cluster '%s'", role, username, clusterKey)
 }
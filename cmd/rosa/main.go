@@ -23,7 +23,9 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/cmd/apply"
 	"github.com/openshift/rosa/cmd/attach"
+	"github.com/openshift/rosa/cmd/cache"
 	"github.com/openshift/rosa/cmd/completion"
 	"github.com/openshift/rosa/cmd/config"
 	"github.com/openshift/rosa/cmd/create"
@@ -42,21 +44,34 @@ import (
 	"github.com/openshift/rosa/cmd/login"
 	"github.com/openshift/rosa/cmd/logout"
 	"github.com/openshift/rosa/cmd/logs"
+	"github.com/openshift/rosa/cmd/mustgather"
+	"github.com/openshift/rosa/cmd/plan"
+	"github.com/openshift/rosa/cmd/plugin"
+	"github.com/openshift/rosa/cmd/prune"
 	"github.com/openshift/rosa/cmd/register"
+	"github.com/openshift/rosa/cmd/report"
 	"github.com/openshift/rosa/cmd/resume"
 	"github.com/openshift/rosa/cmd/revoke"
+	"github.com/openshift/rosa/cmd/runschedules"
 	"github.com/openshift/rosa/cmd/token"
 	"github.com/openshift/rosa/cmd/uninstall"
 	"github.com/openshift/rosa/cmd/unlink"
 	"github.com/openshift/rosa/cmd/upgrade"
+	"github.com/openshift/rosa/cmd/use"
 	"github.com/openshift/rosa/cmd/verify"
 	"github.com/openshift/rosa/cmd/version"
 	"github.com/openshift/rosa/cmd/whoami"
 	"github.com/openshift/rosa/pkg/arguments"
+	cachepkg "github.com/openshift/rosa/pkg/cache"
 	"github.com/openshift/rosa/pkg/color"
+	"github.com/openshift/rosa/pkg/dryrun"
+	"github.com/openshift/rosa/pkg/errorcode"
 	"github.com/openshift/rosa/pkg/info"
+	"github.com/openshift/rosa/pkg/output"
+	pluginpkg "github.com/openshift/rosa/pkg/plugin"
 	"github.com/openshift/rosa/pkg/reporter"
 	versionUtils "github.com/openshift/rosa/pkg/version"
+	"github.com/openshift/rosa/pkg/wait"
 )
 
 var root = &cobra.Command{
@@ -65,8 +80,9 @@ var root = &cobra.Command{
 	Long: "Command line tool for Red Hat OpenShift Service on AWS.\n" +
 		"For further documentation visit " +
 		"https://access.redhat.com/documentation/en-us/red_hat_openshift_service_on_aws\n",
-	PersistentPreRun: versionCheck,
-	Args:             cobra.NoArgs,
+	PersistentPreRun:  preRun,
+	PersistentPostRun: postRun,
+	Args:              cobra.NoArgs,
 }
 
 func init() {
@@ -74,8 +90,12 @@ func init() {
 	fs := root.PersistentFlags()
 	color.AddFlag(root)
 	arguments.AddDebugFlag(fs)
+	arguments.AddContextFlag(fs)
+	dryrun.AddFlag(fs)
+	cachepkg.AddFlags(fs)
 
 	// Register the subcommands:
+	root.AddCommand(cache.Cmd)
 	root.AddCommand(completion.Cmd)
 	root.AddCommand(create.Cmd)
 	root.AddCommand(describe.Cmd)
@@ -90,24 +110,37 @@ func init() {
 	root.AddCommand(login.Cmd)
 	root.AddCommand(logout.Cmd)
 	root.AddCommand(logs.Cmd)
+	root.AddCommand(plan.Cmd)
+	root.AddCommand(plugin.Cmd)
+	root.AddCommand(prune.Cmd)
 	root.AddCommand(register.Cmd)
+	root.AddCommand(report.Cmd)
 	root.AddCommand(revoke.Cmd)
 	root.AddCommand(uninstall.Cmd)
 	root.AddCommand(upgrade.Cmd)
+	root.AddCommand(use.Cmd)
 	root.AddCommand(verify.Cmd)
 	root.AddCommand(version.NewRosaVersionCommand())
 	root.AddCommand(whoami.Cmd)
 	root.AddCommand(hibernate.GenerateCommand())
 	root.AddCommand(resume.GenerateCommand())
+	root.AddCommand(runschedules.NewRunSchedulesCommand())
+	root.AddCommand(mustgather.NewMustGatherCommand())
 	root.AddCommand(link.Cmd)
 	root.AddCommand(unlink.Cmd)
 	root.AddCommand(token.Cmd)
 	root.AddCommand(config.Cmd)
 	root.AddCommand(attach.NewRosaAttachCommand())
 	root.AddCommand(detach.NewRosaDetachCommand())
+	root.AddCommand(apply.NewApplyCommand())
 }
 
 func main() {
+	// Run the plugin named by the arguments, if they don't name a builtin command:
+	if handled, code := pluginpkg.Dispatch(root, os.Args[1:]); handled {
+		os.Exit(code)
+	}
+
 	// Execute the root command:
 	root.SetArgs(os.Args[1:])
 	err := root.Execute()
@@ -115,7 +148,37 @@ func main() {
 		if !strings.Contains(err.Error(), "Did you mean this?") {
 			fmt.Fprintf(os.Stderr, "Failed to execute root command: %s\n", err)
 		}
-		os.Exit(1)
+		// Errors returned by the root command are always caused by invalid arguments or flags, as
+		// the commands report and exit themselves:
+		os.Exit(errorcode.InvalidInput.ExitCode())
+	}
+}
+
+func preRun(cmd *cobra.Command, argv []string) {
+	reporter.SetJSONErrors(output.Output() == output.JSON)
+	if dryrun.Enabled() {
+		// Commands usually exit themselves, after reporting an error or printing their result, so the
+		// plan is printed then too:
+		reporter.AddFlushHook(printPlan)
+	}
+	if err := wait.Validate(); err != nil {
+		reporter.CreateReporter().ExitWithError(err)
+	}
+	versionCheck(cmd, argv)
+}
+
+func postRun(_ *cobra.Command, _ []string) {
+	if dryrun.Enabled() {
+		printPlan()
+	}
+}
+
+func printPlan() {
+	// Don't mix the plan with the output of the command when it is meant to be parsed:
+	if output.HasFlag() {
+		dryrun.PrintPlan(os.Stderr)
+	} else {
+		dryrun.PrintPlan(os.Stdout)
 	}
 }
 
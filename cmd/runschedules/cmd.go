@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runschedules
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/hibernation"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/properties"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "run-schedules"
+	short = "Hibernate and resume clusters according to their hibernation schedules"
+	long  = "Hibernate and resume clusters according to their hibernation schedules.\n\n" +
+		"Each cluster is hibernated or resumed according to the last action of its schedule, so this " +
+		"command can run at any interval, for example every 15 minutes from cron or from a pod, and a " +
+		"missed run is applied by the next one. Each action is applied only once, so a cluster that is " +
+		"hibernated or resumed manually stays that way until the next action of its schedule. Clusters " +
+		"with an upgrade in progress, or with an upgrade scheduled before the next resume, aren't hibernated."
+	example = `  # Run the hibernation schedules of all the clusters
+  rosa run-schedules`
+)
+
+func NewRunSchedulesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), RunSchedulesRunner()),
+	}
+
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func RunSchedulesRunner() rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
+		// The schedules of all the clusters of the organization are run, not just the ones of the AWS
+		// account of the current credentials:
+		clusters, err := r.OCMClient.ListClusters(nil, ocm.ClusterListOptions{
+			Labels: []string{properties.HibernateSchedule},
+		})
+		if err != nil {
+			return err
+		}
+
+		results := hibernation.Run(r.OCMClient, clusters, time.Now())
+		if output.HasFlag() {
+			err = output.Print(results)
+		} else if len(results) == 0 {
+			r.Reporter.Infof("There are no hibernation schedules")
+		} else {
+			err = hibernation.NewResultTable(results).Print()
+		}
+		if err != nil {
+			return err
+		}
+
+		failed := 0
+		for _, result := range results {
+			if result.Err != nil {
+				failed++
+			}
+		}
+		if failed > 0 {
+			return fmt.Errorf("Failed to run the hibernation schedules of %d clusters", failed)
+		}
+		return nil
+	}
+}
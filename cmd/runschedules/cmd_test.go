@@ -0,0 +1,59 @@
+package runschedules
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/properties"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Run schedules", func() {
+	var t *TestingRuntime
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+		output.SetOutput("")
+	})
+
+	AfterEach(func() {
+		output.SetOutput("")
+	})
+
+	It("Correctly builds the command", func() {
+		cmd := NewRunSchedulesCommand()
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Args).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("output")).NotTo(BeNil())
+	})
+
+	It("Reports that there are no schedules", func() {
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{})))
+
+		err := RunSchedulesRunner()(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Fails if a schedule can't be run", func() {
+		cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.ID("123").Name("foo").Properties(map[string]string{
+				properties.HibernateSchedule: "at night",
+			})
+		})
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+		output.SetOutput("json")
+
+		t.StdOutReader.Record()
+		err := RunSchedulesRunner()(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).To(MatchError("Failed to run the hibernation schedules of 1 clusters"))
+		stdout, _ := t.StdOutReader.Read()
+		Expect(stdout).To(ContainSubstring(`"cluster_id": "123"`))
+		Expect(stdout).To(ContainSubstring(`"error": "Cluster 'foo' has an invalid hibernation schedule`))
+	})
+})
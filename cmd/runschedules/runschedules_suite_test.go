@@ -0,0 +1,13 @@
+package runschedules
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestRunSchedules(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Run Schedules Suite")
+}
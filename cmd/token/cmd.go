@@ -2,6 +2,7 @@ package token
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -11,29 +12,68 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/config"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
 var (
 	writer io.Writer = os.Stdout
 	args   struct {
-		header    bool
-		payload   bool
-		signature bool
-		refresh   bool
-		generate  bool
+		header         bool
+		payload        bool
+		signature      bool
+		refresh        bool
+		generate       bool
+		execCredential bool
 	}
 )
 
+const execCredentialAPIVersion = "client.authentication.k8s.io/v1"
+
+// execCredential is the object that client-go credential plugins print, see
+// https://kubernetes.io/docs/reference/config-api/client-authentication.v1/
+type execCredential struct {
+	Kind       string               `json:"kind"`
+	APIVersion string               `json:"apiVersion"`
+	Spec       execCredentialSpec   `json:"spec"`
+	Status     execCredentialStatus `json:"status"`
+}
+
+type execCredentialSpec struct {
+	Interactive bool `json:"interactive"`
+}
+
+type execCredentialStatus struct {
+	ExpirationTimestamp *time.Time `json:"expirationTimestamp,omitempty"`
+	Token               string     `json:"token"`
+}
+
 var Cmd = NewTokenCommand()
 
 func NewTokenCommand() *cobra.Command {
 	Cmd := &cobra.Command{
 		Use:   "token",
 		Short: "Generates a token",
-		Long:  "Uses the stored credentials to generate a token.",
-		Args:  cobra.NoArgs,
-		Run:   run,
+		Long: "Uses the stored credentials to generate a token.\n\n" +
+			"With '--exec-credential' the access token is printed as a Kubernetes client-go ExecCredential, so " +
+			"that kubeconfigs and other tools can use 'rosa token --exec-credential' as a credential plugin. " +
+			"The token is refreshed when needed and its expiration is taken from its claims.",
+		Example: `  # Print the current access token
+  rosa token
+
+  # Authenticate kubectl with the access token, adding this user to the kubeconfig
+  users:
+  - name: rosa
+    user:
+      exec:
+        apiVersion: client.authentication.k8s.io/v1
+        command: rosa
+        args:
+        - token
+        - --exec-credential
+        interactiveMode: Never`,
+		Args: cobra.NoArgs,
+		Run:  run,
 	}
 	flags := Cmd.Flags()
 	flags.BoolVar(
@@ -66,6 +106,13 @@ func NewTokenCommand() *cobra.Command {
 		false,
 		"Generate a new token.",
 	)
+	flags.BoolVar(
+		&args.execCredential,
+		"exec-credential",
+		false,
+		"Print the access token as a "+execCredentialAPIVersion+" ExecCredential, "+
+			"for use as a kubectl credential plugin.",
+	)
 	return Cmd
 }
 
@@ -76,7 +123,7 @@ func run(cmd *cobra.Command, argv []string) {
 	err := CreateToken(r)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 }
 
@@ -102,6 +149,10 @@ func CreateToken(r *rosa.Runtime) error {
 	if count > 1 {
 		return fmt.Errorf("Options '--payload', '--header', '--signature', and '--generate' are mutually exclusive")
 	}
+	if args.execCredential && (args.header || args.payload || args.signature || args.refresh) {
+		return fmt.Errorf("Option '--exec-credential' can't be used with '--payload', '--header', " +
+			"'--signature' or '--refresh'")
+	}
 
 	accessToken, refreshToken, err = getAccessTokens(r, args.generate)
 	if err != nil {
@@ -116,7 +167,7 @@ func CreateToken(r *rosa.Runtime) error {
 
 	// Parse the token:
 	parser := new(jwt.Parser)
-	_, parts, err := parser.ParseUnverified(selectedToken, jwt.MapClaims{})
+	parsed, parts, err := parser.ParseUnverified(selectedToken, jwt.MapClaims{})
 	if err != nil {
 		return fmt.Errorf("Can't parse token: %v", err)
 	}
@@ -135,7 +186,13 @@ func CreateToken(r *rosa.Runtime) error {
 	}
 
 	// Print the data:
-	if args.header {
+	if args.execCredential {
+		credential, err := newExecCredential(selectedToken, parsed.Claims.(jwt.MapClaims))
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(writer, "%s\n", credential)
+	} else if args.header {
 		fmt.Fprintf(writer, "%s\n", header)
 	} else if args.payload {
 		fmt.Fprintf(writer, "%s\n", payload)
@@ -160,6 +217,26 @@ func CreateToken(r *rosa.Runtime) error {
 	return nil
 }
 
+// newExecCredential returns the JSON of the ExecCredential of a token, expiring when the token expires.
+func newExecCredential(token string, claims jwt.MapClaims) ([]byte, error) {
+	credential := execCredential{
+		Kind:       "ExecCredential",
+		APIVersion: execCredentialAPIVersion,
+		Status: execCredentialStatus{
+			Token: token,
+		},
+	}
+	if exp, ok := claims["exp"].(float64); ok {
+		expiration := time.Unix(int64(exp), 0).UTC()
+		credential.Status.ExpirationTimestamp = &expiration
+	}
+	data, err := json.MarshalIndent(credential, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("Can't encode exec credential: %v", err)
+	}
+	return data, nil
+}
+
 func getAccessTokens(r *rosa.Runtime, generate bool) (string, string, error) {
 	var accessToken, refreshToken string
 	var err error
@@ -2,6 +2,7 @@ package token
 
 import (
 	"bytes"
+	"encoding/json"
 	"os"
 	"testing"
 	"time"
@@ -12,6 +13,7 @@ import (
 	. "github.com/openshift-online/ocm-sdk-go/testing"
 
 	"github.com/openshift/rosa/cmd/config/set"
+	"github.com/openshift/rosa/pkg/rosa"
 )
 
 func TestTokenCommand(t *testing.T) {
@@ -74,5 +76,34 @@ var _ = Describe("Token", Ordered, func() {
 			Cmd.Run(Cmd, []string{})
 			Expect(buf.String()).To(ContainSubstring(refreshToken))
 		})
+
+		It("Displays the access token as an exec credential", func() {
+			args.refresh = false
+			args.execCredential = true
+			defer func() {
+				args.execCredential = false
+			}()
+			Cmd.Run(Cmd, []string{})
+
+			var credential execCredential
+			Expect(json.Unmarshal(buf.Bytes(), &credential)).To(Succeed())
+			Expect(credential.Kind).To(Equal("ExecCredential"))
+			Expect(credential.APIVersion).To(Equal("client.authentication.k8s.io/v1"))
+			Expect(credential.Status.Token).To(Equal(accessToken))
+			Expect(credential.Status.ExpirationTimestamp).NotTo(BeNil())
+			Expect(*credential.Status.ExpirationTimestamp).To(
+				BeTemporally("~", time.Now().Add(10*time.Minute), time.Minute))
+		})
+
+		It("Refuses to display the refresh token as an exec credential", func() {
+			args.refresh = true
+			args.execCredential = true
+			defer func() {
+				args.refresh = false
+				args.execCredential = false
+			}()
+			err := CreateToken(rosa.NewRuntime())
+			Expect(err).To(MatchError(ContainSubstring("'--exec-credential' can't be used with")))
+		})
 	})
 })
@@ -18,13 +18,13 @@ package addon
 
 import (
 	"fmt"
-	"os"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -61,24 +61,24 @@ func run(_ *cobra.Command, argv []string) {
 	cluster := r.FetchCluster()
 	if cluster.State() != cmv1.ClusterStateReady {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	addOn, _ := r.OCMClient.GetAddOnInstallation(cluster.ID(), addOnID)
 	if addOn == nil {
 		r.Reporter.Warnf("Addon '%s' is not installed on cluster '%s'", addOnID, clusterKey)
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	if !confirm.Confirm("uninstall add-on '%s' from cluster '%s'", addOnID, clusterKey) {
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	r.Reporter.Debugf("Uninstalling add-on '%s' from cluster '%s'", addOnID, clusterKey)
 	err := r.OCMClient.UninstallAddOn(cluster.ID(), addOnID)
 	if err != nil {
 		r.Reporter.Errorf("Failed to remove add-on installation '%s' from cluster '%s': %s", addOnID, clusterKey, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	r.Reporter.Infof("Add-on '%s' is now uninstalling. To check the status run 'rosa list addons -c %s'",
 		addOnID, clusterKey)
@@ -14,7 +14,6 @@ limitations under the License.
 package ocmrole
 
 import (
-	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -23,6 +22,7 @@ import (
 	"github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -73,12 +73,12 @@ func run(cmd *cobra.Command, argv []string) {
 	orgID, _, err := r.OCMClient.GetCurrentOrganization()
 	if err != nil {
 		r.Reporter.Errorf("Error getting organization account: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if args.organizationID != "" && orgID != args.organizationID {
 		r.Reporter.Errorf("Invalid organization ID '%s'. "+
 			"It doesn't match with the user session '%s'.", args.organizationID, orgID)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if r.Reporter.IsTerminal() {
@@ -104,18 +104,18 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid ocm role ARN to unlink from the current organization: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	if roleArn != "" {
 		err = aws.ARNValidator(roleArn)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid ocm role ARN to unlink from the current organization: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	if !confirm.Prompt(true, "Unlink the '%s' role from organization '%s'?", roleArn, orgID) {
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	err = r.OCMClient.UnlinkOCMRoleFromOrg(orgID, roleArn)
@@ -124,11 +124,11 @@ func run(cmd *cobra.Command, argv []string) {
 			r.Reporter.Errorf("Only organization admin can run this command. "+
 				"Please ask someone with the organization admin role to run the following command \n\n"+
 				"\t rosa unlink ocm-role --role-arn %s --organization-id %s", roleArn, orgID)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		r.Reporter.Errorf("Unable to unlink role arn '%s' from the organization id : '%s' : %v",
 			roleArn, orgID, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	r.Reporter.Infof("Successfully unlinked role-arn '%s' from organization account '%s'", roleArn, orgID)
 }
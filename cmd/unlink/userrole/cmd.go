@@ -14,7 +14,6 @@ limitations under the License.
 package userrole
 
 import (
-	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -23,6 +22,7 @@ import (
 	"github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -76,7 +76,7 @@ func run(cmd *cobra.Command, argv []string) {
 		currentAccount, err := r.OCMClient.GetCurrentAccount()
 		if err != nil {
 			r.Reporter.Errorf("Error getting current account: %v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		accountID = currentAccount.ID()
 	}
@@ -104,18 +104,18 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid user role ARN to unlink from the current account: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	if roleArn != "" {
 		err = aws.ARNValidator(roleArn)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid user role ARN to unlink from the current account: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	if !confirm.Prompt(true, "Unlink the '%s' role from the current account '%s'?", roleArn, accountID) {
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	err = r.OCMClient.UnlinkUserRoleFromAccount(accountID, roleArn)
@@ -124,11 +124,11 @@ func run(cmd *cobra.Command, argv []string) {
 			r.Reporter.Errorf("Only organization admin or the user that owns this account '%s' can run this command. "+
 				"Please ask someone with adequate permissions to run the following command \n\n"+
 				"\t rosa unlink user-role --role-arn %s --account-id %s", accountID, roleArn, accountID)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		r.Reporter.Errorf("Unable to unlink role ARN '%s' from the account id : '%s' : %v",
 			roleArn, accountID, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	r.Reporter.Infof("Successfully unlinked role ARN '%s' from account '%s'", roleArn, accountID)
 }
@@ -18,7 +18,6 @@ package accountroles
 
 import (
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
@@ -108,7 +107,7 @@ func run(cmd *cobra.Command, _ []string) {
 	mode, err := interactive.GetMode()
 	if err != nil {
 		reporter.Errorf("%s", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 	prefix := args.prefix
 
@@ -118,13 +117,13 @@ func run(cmd *cobra.Command, _ []string) {
 	policyVersion, err := ocmClient.GetPolicyVersion(version, channelGroup)
 	if err != nil {
 		reporter.Errorf("Error getting version: %s", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	env, err := ocm.GetEnv()
 	if err != nil {
 		reporter.Errorf("Failed to determine OCM environment: %v", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	var role aws.AccountRole
@@ -143,32 +142,32 @@ func run(cmd *cobra.Command, _ []string) {
 			r.Reporter.Errorf("Failed to get classic account roles ARN: %v. "+
 				"To upgrade hosted CP account roles use the '--hosted-cp' flag", err)
 		}
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	managedPolicies, err := awsClient.HasManagedPolicies(roleARN)
 	if err != nil {
 		r.Reporter.Errorf("Failed to determine if the role has managed policies: %v", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	if managedPolicies {
 		hostedCPPolicies, err := awsClient.HasHostedCPPolicies(roleARN)
 		if err != nil {
 			r.Reporter.Errorf("Failed to determine if the role has hosted CP managed policies: %v", err)
-			os.Exit(1)
+			rprtr.Exit(1)
 		}
 
 		if hostedCPPolicies && !args.hostedCP {
 			r.Reporter.Errorf("Role with ARN '%s' has hosted CP managed policies, "+
 				"please run the command with the flag '--hosted-cp'", roleARN)
-			os.Exit(1)
+			rprtr.Exit(1)
 		}
 
 		err = roles.ValidateAccountRolesManagedPolicies(r, prefix, hostedCPPolicies)
 		if err != nil {
 			r.Reporter.Errorf("Failed while validating managed policies: %v", err)
-			os.Exit(1)
+			rprtr.Exit(1)
 		}
 
 		r.Reporter.Infof("Account roles with the prefix '%s' have attached managed policies. "+
@@ -179,7 +178,7 @@ func run(cmd *cobra.Command, _ []string) {
 	creator, err := awsClient.GetCreator()
 	if err != nil {
 		reporter.Errorf("Failed to get IAM credentials: %s", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	var spin *spinner.Spinner
@@ -195,7 +194,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if err != nil {
 		reporter.Errorf("%s", err)
 		LogError(roles.RosaUpgradeAccRolesModeAuto, ocmClient, policyVersion, err, reporter)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	if spin != nil {
@@ -204,13 +203,13 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if !isUpgradeNeedForAccountRolePolicies {
 		reporter.Infof("Account roles with the prefix '%s' are already up-to-date.", prefix)
-		os.Exit(0)
+		rprtr.Exit(0)
 	}
 
 	policyPath, err := getAccountPolicyPath(awsClient, prefix)
 	if err != nil {
 		reporter.Errorf("Error trying to determine the path for the account policies. Error: %v", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	// Determine if interactive mode is needed
@@ -222,14 +221,14 @@ func run(cmd *cobra.Command, _ []string) {
 		mode, err = interactive.GetOptionMode(cmd, mode, "Account role upgrade mode")
 		if err != nil {
 			reporter.Errorf("Expected a valid Account role upgrade mode: %s", err)
-			os.Exit(1)
+			rprtr.Exit(1)
 		}
 		interactive.SetModeKey(mode)
 	}
 	policies, err := ocmClient.GetPolicies("")
 	if err != nil {
 		reporter.Errorf("Expected a valid role creation mode: %s", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	switch mode {
@@ -241,7 +240,7 @@ func run(cmd *cobra.Command, _ []string) {
 			if err != nil {
 				LogError(roles.RosaUpgradeAccRolesModeAuto, ocmClient, policyVersion, err, reporter)
 				reporter.Errorf("Error upgrading the role polices: %s", err)
-				os.Exit(1)
+				rprtr.Exit(1)
 			}
 		}
 	case interactive.ModeManual:
@@ -249,7 +248,7 @@ func run(cmd *cobra.Command, _ []string) {
 			err = aws.GenerateAccountRolePolicyFiles(reporter, env, policies, false, aws.AccountRoles, creator.Partition)
 			if err != nil {
 				reporter.Errorf("There was an error generating the policy files: %s", err)
-				os.Exit(1)
+				rprtr.Exit(1)
 			}
 		}
 		if reporter.IsTerminal() {
@@ -263,7 +262,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	default:
 		reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 }
 
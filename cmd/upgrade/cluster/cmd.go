@@ -18,7 +18,6 @@ package cluster
 
 import (
 	"fmt"
-	"os"
 	"strconv"
 	"strings"
 
@@ -31,7 +30,9 @@ import (
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/wait"
 )
 
 var args struct {
@@ -134,6 +135,7 @@ func init() {
 	)
 
 	confirm.AddFlag(flags)
+	wait.AddFlags(flags)
 }
 
 func run(cmd *cobra.Command, _ []string) {
@@ -141,8 +143,7 @@ func run(cmd *cobra.Command, _ []string) {
 	defer r.Cleanup()
 	err := runWithRuntime(r, cmd)
 	if err != nil {
-		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		r.Reporter.ExitWithError(err)
 	}
 }
 
@@ -245,7 +246,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		mode, err = interactive.GetOptionMode(cmd, mode, "IAM Roles/Policies upgrade mode")
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid role upgrade mode: %v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 
@@ -318,6 +319,15 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		checkSTSRolesCompatibility(r, cluster, mode, version, clusterKey)
 	}
 
+	if currentUpgradeScheduling.AutomaticUpgrades && wait.Enabled() {
+		return fmt.Errorf("The '--wait' option can't be used with automatic upgrades")
+	}
+	if (currentUpgradeScheduling.ScheduleDate != "" || currentUpgradeScheduling.ScheduleTime != "") &&
+		wait.Enabled() {
+		return fmt.Errorf("The '--wait' option can't be used with '--schedule-date' and '--schedule-time', " +
+			"as the upgrade doesn't start till the scheduled time")
+	}
+
 	// Compute drain grace period config
 	var clusterSpec ocm.Spec
 	if isHypershift {
@@ -337,12 +347,12 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		}
 
 		if r.Reporter.IsTerminal() && !confirm.Confirm("upgrade cluster to version '%s'", version) {
-			os.Exit(0)
+			reporter.Exit(0)
 		}
 	} else {
 		if r.Reporter.IsTerminal() && !confirm.Confirm("schedule automatic cluster upgrades at '%s'",
 			currentUpgradeScheduling.Schedule) {
-			os.Exit(0)
+			reporter.Exit(0)
 		}
 	}
 
@@ -364,9 +374,38 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 	}
 
 	r.Reporter.Infof("Upgrade successfully scheduled for cluster '%s'", clusterKey)
+	if wait.Enabled() {
+		r.Reporter.Infof("Waiting for cluster '%s' to be upgraded to version '%s'", clusterKey, version)
+		err = waitForUpgrade(r, cluster, version)
+		if err != nil {
+			return err
+		}
+		r.Reporter.Infof("Cluster '%s' has been upgraded to version '%s'", clusterKey, version)
+	}
 	return nil
 }
 
+func waitForUpgrade(r *rosa.Runtime, cluster *cmv1.Cluster, version string) error {
+	if cluster.Hypershift().Enabled() {
+		upgradePolicy, err := r.OCMClient.GetControlPlaneScheduledUpgrade(cluster.ID())
+		if err != nil {
+			return err
+		}
+		if upgradePolicy == nil {
+			return fmt.Errorf("Failed to find the upgrade policy of cluster '%s'", cluster.ID())
+		}
+		return r.OCMClient.WaitForControlPlaneUpgrade(cluster.ID(), upgradePolicy.ID(), version, wait.Timeout())
+	}
+	upgradePolicy, _, err := r.OCMClient.GetScheduledUpgrade(cluster.ID())
+	if err != nil {
+		return err
+	}
+	if upgradePolicy == nil {
+		return fmt.Errorf("Failed to find the upgrade policy of cluster '%s'", cluster.ID())
+	}
+	return r.OCMClient.WaitForUpgrade(cluster.ID(), upgradePolicy.ID(), version, wait.Timeout())
+}
+
 func createUpgradePolicyHypershift(r *rosa.Runtime, clusterKey string,
 	cluster *cmv1.Cluster, version string, currentScheduling ocm.UpgradeScheduling) error {
 	upgradePolicyBuilder := cmv1.NewControlPlaneUpgradePolicy().UpgradeType(cmv1.UpgradeTypeControlPlane)
@@ -521,7 +560,7 @@ func buildNodeDrainGracePeriod(r *rosa.Runtime, cmd *cobra.Command, cluster *cmv
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid node drain grace period: %s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	isValidNodeDrainGracePeriod := false
@@ -534,13 +573,13 @@ func buildNodeDrainGracePeriod(r *rosa.Runtime, cmd *cobra.Command, cluster *cmv
 	if !isValidNodeDrainGracePeriod {
 		r.Reporter.Errorf("Expected a valid node drain grace period. Options are [%s]",
 			strings.Join(nodeDrainOptions, ", "))
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	nodeDrainParsed := strings.Split(nodeDrainGracePeriod, " ")
 	nodeDrainValue, err := strconv.ParseFloat(nodeDrainParsed[0], commonUtils.MaxByteSize)
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid node drain grace period: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if nodeDrainParsed[1] == "hours" || nodeDrainParsed[1] == "hour" {
 		nodeDrainValue = nodeDrainValue * 60
@@ -599,7 +638,7 @@ func checkGates(r *rosa.Runtime, cluster *cmv1.Cluster, gates []*cmv1.VersionGat
 			}
 			// for non sts gates we require user agreement
 			if !confirm.Prompt(true, "I acknowledge") {
-				os.Exit(0)
+				reporter.Exit(0)
 			} else {
 				r.Reporter.Infof("Gate %s acknowledged", gate.ID())
 			}
@@ -18,7 +18,6 @@ package machinepool
 
 import (
 	"fmt"
-	"os"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/pkg/errors"
@@ -30,6 +29,7 @@ import (
 	"github.com/openshift/rosa/pkg/machinepool"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/wait"
 )
 
 var args struct {
@@ -102,6 +102,7 @@ func init() {
 
 	confirm.AddFlag(flags)
 	interactive.AddFlag(flags)
+	wait.AddFlags(flags)
 }
 
 func run(cmd *cobra.Command, argv []string) {
@@ -109,8 +110,7 @@ func run(cmd *cobra.Command, argv []string) {
 	defer r.Cleanup()
 	err := runWithRuntime(r, cmd, argv)
 	if err != nil {
-		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		r.Reporter.ExitWithError(err)
 	}
 }
 
@@ -189,6 +189,15 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
 		}
 	}
 
+	if currentUpgradeScheduling.AutomaticUpgrades && wait.Enabled() {
+		return fmt.Errorf("The '--wait' option can't be used with automatic upgrades")
+	}
+	if (currentUpgradeScheduling.ScheduleDate != "" || currentUpgradeScheduling.ScheduleTime != "") &&
+		wait.Enabled() {
+		return fmt.Errorf("The '--wait' option can't be used with '--schedule-date' and '--schedule-time', " +
+			"as the upgrade doesn't start till the scheduled time")
+	}
+
 	// Check if any upgrade already exists
 	nodePool, exists, err := checkExistingUpgrades(r, clusterKey, cluster, machinePoolID)
 	if err != nil {
@@ -217,7 +226,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
 
 	// Schedule the built upgrade policy
 	r.Reporter.Debugf("Scheduling the upgrade policy")
-	_, err = r.OCMClient.ScheduleNodePoolUpgrade(cluster.ID(), machinePoolID, upgradePolicy)
+	scheduledPolicy, err := r.OCMClient.ScheduleNodePoolUpgrade(cluster.ID(), machinePoolID, upgradePolicy)
 	if err != nil {
 		return errors.Wrapf(err, "Failed to schedule upgrade for machine pool '%s' in cluster '%s'",
 			machinePoolID, clusterKey)
@@ -225,6 +234,17 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
 
 	r.Reporter.Infof("Upgrade successfully scheduled for the machine pool '%s' on cluster '%s'", machinePoolID,
 		clusterKey)
+	if wait.Enabled() {
+		r.Reporter.Infof("Waiting for machine pool '%s' to be upgraded to version '%s'", machinePoolID,
+			upgradePolicy.Version())
+		err = r.OCMClient.WaitForNodePoolUpgrade(cluster.ID(), machinePoolID, scheduledPolicy.ID(),
+			upgradePolicy.Version(), wait.Timeout())
+		if err != nil {
+			return err
+		}
+		r.Reporter.Infof("Machine pool '%s' has been upgraded to version '%s'", machinePoolID,
+			upgradePolicy.Version())
+	}
 	return nil
 }
 
@@ -238,6 +238,18 @@ var _ = Describe("Upgrade machine pool", func() {
 			Expect(stdout).To(ContainSubstring(
 				"Upgrade successfully scheduled for the machine pool 'nodepool85' on cluster 'cluster1'"))
 		})
+		It("Fails if waiting for an upgrade scheduled for later", func() {
+			args.scheduleTime = scheduleTime
+			args.scheduleDate = validScheduleDate
+			args.schedule = ""
+			Cmd.Flags().Set("interactive", "false")
+			Cmd.Flags().Set("wait", "true")
+			defer Cmd.Flags().Set("wait", "false")
+			testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, hypershiftClusterReady))
+			err := runWithRuntime(testRuntime.RosaRuntime, Cmd, []string{nodePoolName})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("The '--wait' option can't be used with '--schedule-date'"))
+		})
 	})
 })
 
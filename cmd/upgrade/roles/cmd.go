@@ -18,7 +18,6 @@ package roles
 
 import (
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
@@ -131,7 +130,7 @@ func run(cmd *cobra.Command, argv []string) {
 	mode, err := interactive.GetMode()
 	if err != nil {
 		reporter.Errorf("%s", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	clusterUpgradeVersion := args.clusterUpgradeVersion
@@ -139,22 +138,22 @@ func run(cmd *cobra.Command, argv []string) {
 	availableUpgrades, err := r.OCMClient.GetAvailableUpgrades(ocm.GetVersionID(cluster))
 	if err != nil {
 		r.Reporter.Errorf("Failed to find available upgrades: %v", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 	if len(availableUpgrades) == 0 {
 		r.Reporter.Warnf("There are no available upgrades")
-		os.Exit(0)
+		rprtr.Exit(0)
 	}
 	err = ocmClient.CheckUpgradeClusterVersion(availableUpgrades, clusterUpgradeVersion, cluster)
 	if err != nil {
 		reporter.Errorf("%v", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	env, err := ocm.GetEnv()
 	if err != nil {
 		reporter.Errorf("Failed to determine OCM environment: %v", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	managedPolicies := cluster.AWS().STS().ManagedPolicies()
@@ -162,13 +161,13 @@ func run(cmd *cobra.Command, argv []string) {
 	unifiedPath, err := aws.GetPathFromAccountRole(cluster, aws.AccountRoles[aws.InstallerAccountRole].Name)
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid path for '%s': %v", cluster.AWS().STS().RoleARN(), err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	credRequests, err := ocmClient.GetCredRequests(cluster.Hypershift().Enabled())
 	if err != nil {
 		r.Reporter.Errorf("Error getting operator credential request from OCM %s", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	// Determine if interactive mode is needed
@@ -181,7 +180,7 @@ func run(cmd *cobra.Command, argv []string) {
 		mode, err = interactive.GetOptionMode(cmd, mode, "Roles upgrade mode")
 		if err != nil {
 			r.Reporter.Errorf("expected a valid Account role upgrade mode: %s", err)
-			os.Exit(1)
+			rprtr.Exit(1)
 		}
 	}
 
@@ -190,7 +189,7 @@ func run(cmd *cobra.Command, argv []string) {
 		accountRolePrefix, err = aws.GetPrefixFromAccountRole(cluster, "Installer")
 		if err != nil {
 			r.Reporter.Errorf("Failed while trying to get account role prefix: '%v'", err)
-			os.Exit(1)
+			rprtr.Exit(1)
 		}
 
 		hostedCPPolicies := aws.IsHostedCPManagedPolicies(cluster)
@@ -198,20 +197,20 @@ func run(cmd *cobra.Command, argv []string) {
 		err = roles.ValidateAccountRolesManagedPolicies(r, accountRolePrefix, hostedCPPolicies)
 		if err != nil {
 			r.Reporter.Errorf("Failed while validating managed policies: %v", err)
-			os.Exit(1)
+			rprtr.Exit(1)
 		}
 		r.Reporter.Infof("Account roles with the prefix '%s' have attached managed policies.", accountRolePrefix)
 
 		policies, err := r.OCMClient.GetPolicies("OperatorRole")
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-			os.Exit(1)
+			rprtr.Exit(1)
 		}
 		err = roles.ValidateOperatorRolesManagedPolicies(r, cluster, credRequests, policies, mode,
 			accountRolePrefix, unifiedPath, clusterUpgradeVersion, hostedCPPolicies)
 		if err != nil {
 			r.Reporter.Errorf("Failed while validating managed policies: %v", err)
-			os.Exit(1)
+			rprtr.Exit(1)
 		}
 		r.Reporter.Infof("Cluster '%s' operator roles have attached managed policies. "+
 			"An upgrade isn't needed", cluster.Name())
@@ -224,19 +223,19 @@ func run(cmd *cobra.Command, argv []string) {
 	policyVersion, err = ocmClient.GetPolicyVersion(policyVersion, channelGroup)
 	if err != nil {
 		reporter.Errorf("Error getting version: %s", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	err = checkPolicyAndClusterVersionCompatibility(policyVersion, clusterUpgradeVersion)
 	if err != nil {
 		reporter.Errorf("%v", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	creator, err := awsClient.GetCreator()
 	if err != nil {
 		reporter.Errorf("Failed to get IAM credentials: %s", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	var spin *spinner.Spinner
@@ -260,7 +259,7 @@ func run(cmd *cobra.Command, argv []string) {
 	if err != nil {
 		reporter.Errorf("%s", err)
 		LogError(roles.RosaUpgradeAccRolesModeAuto, ocmClient, policyVersion, err, reporter)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	if spin != nil {
@@ -273,13 +272,13 @@ func run(cmd *cobra.Command, argv []string) {
 			reporter.Debugf(err.Error())
 		} else {
 			reporter.Errorf("Failed to get rolePolicyBinding: %s", err)
-			os.Exit(1)
+			rprtr.Exit(1)
 		}
 	} else {
 		err = rolepolicybindings.CheckRolePolicyBindingStatus(rolePolicyBindings)
 		if err != nil {
 			reporter.Errorf("Error in rolePolicyBinding: %s", err)
-			os.Exit(1)
+			rprtr.Exit(1)
 		}
 	}
 
@@ -294,7 +293,7 @@ func run(cmd *cobra.Command, argv []string) {
 		accountRolePolicies, err := ocmClient.GetPolicies("")
 		if err != nil {
 			reporter.Errorf("Expected a valid role creation mode: %s", err)
-			os.Exit(1)
+			rprtr.Exit(1)
 		}
 
 		switch mode {
@@ -320,10 +319,10 @@ func run(cmd *cobra.Command, argv []string) {
 						if reporter.IsTerminal() {
 							reporter.Infof(generateClusterUpgradeInfo(clusterKey, args.clusterUpgradeVersion, mode))
 						}
-						os.Exit(1)
+						rprtr.Exit(1)
 					}
 					reporter.Errorf("Error upgrading the account role policies: %s", err)
-					os.Exit(1)
+					rprtr.Exit(1)
 				}
 			}
 		case interactive.ModeManual:
@@ -332,7 +331,7 @@ func run(cmd *cobra.Command, argv []string) {
 					aws.AccountRoles, creator.Partition)
 				if err != nil {
 					reporter.Errorf("There was an error generating the policy files: %s", err)
-					os.Exit(1)
+					rprtr.Exit(1)
 				}
 			}
 			if reporter.IsTerminal() {
@@ -352,13 +351,13 @@ func run(cmd *cobra.Command, argv []string) {
 			)
 			if err != nil {
 				reporter.Errorf("%s", err)
-				os.Exit(1)
+				rprtr.Exit(1)
 			}
 
 			fmt.Println(commands)
 		default:
 			reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-			os.Exit(1)
+			rprtr.Exit(1)
 		}
 	}
 
@@ -375,13 +374,13 @@ func run(cmd *cobra.Command, argv []string) {
 	if !hasOperatorRoles || len(operatorRoles) == 0 {
 		r.Reporter.Errorf("Cluster '%s' doesn't have any operator roles associated with it",
 			r.ClusterKey)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	operatorRolePolicyPrefix, err := aws.GetOperatorRolePolicyPrefixFromCluster(cluster, r.AWSClient)
 	if err != nil {
 		r.Reporter.Errorf("Error getting operator role policy prefix: %s", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	isOperatorPolicyUpgradeNeeded := false
@@ -395,13 +394,13 @@ func run(cmd *cobra.Command, argv []string) {
 	)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	missingRolesInCS, err := ocmClient.FindMissingOperatorRolesForUpgrade(cluster, clusterUpgradeVersion)
 	if err != nil {
 		r.Reporter.Errorf("Error finding operator roles for upgrade '%s'", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	if spin != nil {
@@ -416,13 +415,13 @@ func run(cmd *cobra.Command, argv []string) {
 		if args.isInvokedFromClusterUpgrade {
 			return
 		}
-		os.Exit(0)
+		rprtr.Exit(0)
 	}
 
 	operatorRolePolicies, err := ocmClient.GetPolicies("OperatorRole")
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	if isOperatorPolicyUpgradeNeeded {
@@ -443,7 +442,7 @@ func run(cmd *cobra.Command, argv []string) {
 			if args.isInvokedFromClusterUpgrade && reporter.IsTerminal() {
 				reporter.Infof(generateClusterUpgradeInfo(clusterKey, args.clusterUpgradeVersion, mode))
 			}
-			os.Exit(1)
+			rprtr.Exit(1)
 		}
 	}
 
@@ -454,7 +453,7 @@ func run(cmd *cobra.Command, argv []string) {
 			exists, _, err := r.AWSClient.CheckRoleExists(roleName)
 			if err != nil {
 				r.Reporter.Errorf("Error when detecting checking missing operator IAM roles %s", err)
-				os.Exit(1)
+				rprtr.Exit(1)
 			}
 			if !exists {
 				err = createOperatorRole(
@@ -472,7 +471,7 @@ func run(cmd *cobra.Command, argv []string) {
 					if args.isInvokedFromClusterUpgrade && reporter.IsTerminal() {
 						reporter.Infof(generateClusterUpgradeInfo(clusterKey, args.clusterUpgradeVersion, mode))
 					}
-					os.Exit(1)
+					rprtr.Exit(1)
 				}
 				createdMissingRoles++
 			}
@@ -515,7 +514,7 @@ func run(cmd *cobra.Command, argv []string) {
 		r.Reporter.Infof("Run the following command to continue scheduling cluster upgrade"+
 			" once account and operator roles have been upgraded : \n\n"+
 			"\trosa upgrade cluster --cluster %s\n", cluster.ID())
-		os.Exit(0)
+		rprtr.Exit(0)
 	}
 }
 
@@ -1052,7 +1051,7 @@ func createOperatorRole(
 		fmt.Println(commands)
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 	return nil
 }
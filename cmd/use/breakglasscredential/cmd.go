@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package breakglasscredential
+
+import (
+	"context"
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/breakglasscredential"
+	"github.com/openshift/rosa/pkg/externalauthprovider"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "break-glass-credential"
+	short = "Use a break glass credential with kubectl"
+	long  = "Add a break glass credential as a context of the kubeconfig and make it the current context. " +
+		"The context records when the credential expires, so that 'rosa prune break-glass-credentials' " +
+		"can remove it once it can no longer be used. Without an ID the issued credential of the cluster " +
+		"that expires last is used."
+	example = `  # Use the break glass credential with ID "12345" of the cluster named "mycluster"
+  rosa use break-glass-credential 12345 --cluster=mycluster
+
+  # Use the issued break glass credential of the cluster that expires last
+  rosa use break-glass-credential --cluster=mycluster
+
+  # Add the credential to a specific kubeconfig file
+  rosa use break-glass-credential 12345 --cluster=mycluster --kubeconfig=/tmp/mycluster.kubeconfig`
+
+	kubeconfigFlag = "kubeconfig"
+)
+
+var aliases = []string{"break-glass-credentials", "breakglasscredential", "breakglasscredentials"}
+
+type Options struct {
+	kubeconfig string
+}
+
+func NewUseBreakGlassCredentialOptions() *Options {
+	return &Options{}
+}
+
+func NewUseBreakGlassCredentialCommand() *cobra.Command {
+	options := NewUseBreakGlassCredentialOptions()
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.MaximumNArgs(1),
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), UseBreakGlassCredentialRunner(options)),
+	}
+	ocm.AddClusterFlag(cmd)
+	cmd.Flags().StringVar(
+		&options.kubeconfig,
+		kubeconfigFlag,
+		"",
+		"Path of the kubeconfig file to add the context to. Defaults to the file that kubectl uses: "+
+			"the first file of KUBECONFIG or ~/.kube/config.",
+	)
+	return cmd
+}
+
+func UseBreakGlassCredentialRunner(options *Options) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
+		clusterKey := r.GetClusterKey()
+		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+		if err != nil {
+			return err
+		}
+		err = externalauthprovider.NewExternalAuthService(r.OCMClient).
+			IsExternalAuthProviderSupported(cluster, clusterKey)
+		if err != nil {
+			return err
+		}
+
+		var credential *cmv1.BreakGlassCredential
+		if len(args) == 1 {
+			credential, err = r.OCMClient.GetBreakGlassCredential(cluster.ID(), args[0])
+			if err != nil {
+				return err
+			}
+		} else {
+			credentials, err := r.OCMClient.GetBreakGlassCredentials(cluster.ID())
+			if err != nil {
+				return fmt.Errorf("failed to get break glass credentials for cluster '%s': %v", clusterKey, err)
+			}
+			credential = latestIssued(credentials)
+			if credential == nil {
+				return fmt.Errorf("there are no issued break glass credentials for cluster '%s', "+
+					"create one with 'rosa create break-glass-credential -c %s'", clusterKey, clusterKey)
+			}
+		}
+
+		switch credential.Status() {
+		case cmv1.BreakGlassCredentialStatusRevoked, cmv1.BreakGlassCredentialStatusAwaitingRevocation:
+			return fmt.Errorf("break glass credential '%s' for cluster '%s' has been revoked",
+				credential.ID(), clusterKey)
+		case cmv1.BreakGlassCredentialStatusExpired:
+			return fmt.Errorf("break glass credential '%s' for cluster '%s' is expired", credential.ID(), clusterKey)
+		}
+		if credential.Kubeconfig() == "" {
+			return fmt.Errorf("break glass credential '%s' for cluster '%s' is not ready yet, "+
+				"wait a few minutes for it to be fully ready", credential.ID(), clusterKey)
+		}
+
+		name, path, err := breakglasscredential.WriteKubeconfig(
+			options.kubeconfig, r.OCMClient.GetConnectionURL(), cluster, credential, credential.Kubeconfig(), true)
+		if err != nil {
+			return err
+		}
+		r.Reporter.Infof("Switched to context '%s' of kubeconfig '%s'", name, path)
+		if expiration, ok := credential.GetExpirationTimestamp(); ok {
+			r.Reporter.Infof("The credential expires at %s", expiration.Format("Jan _2 2006 15:04:05 MST"))
+		}
+		return nil
+	}
+}
+
+// latestIssued returns the issued credential that expires last.
+func latestIssued(credentials []*cmv1.BreakGlassCredential) *cmv1.BreakGlassCredential {
+	var result *cmv1.BreakGlassCredential
+	for _, credential := range credentials {
+		if credential.Status() != cmv1.BreakGlassCredentialStatusIssued {
+			continue
+		}
+		if result == nil || credential.ExpirationTimestamp().After(result.ExpirationTimestamp()) {
+			result = credential
+		}
+	}
+	return result
+}
@@ -0,0 +1,141 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package breakglasscredential
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/breakglasscredential"
+	"github.com/openshift/rosa/pkg/kubeconfig"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+const credentialKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: cluster
+  cluster:
+    server: https://api.mycluster.example.com:443
+users:
+- name: user
+  user:
+    token: secret
+contexts:
+- name: admin
+  context:
+    cluster: cluster
+    user: user
+current-context: admin
+`
+
+var _ = Describe("Use break glass credential", func() {
+	var (
+		t        *TestingRuntime
+		options  *Options
+		clusters string
+	)
+
+	expiration := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	credential := func(id string, status cmv1.BreakGlassCredentialStatus, expiration time.Time,
+		kubeconfig string) *cmv1.BreakGlassCredential {
+		credential, err := cmv1.NewBreakGlassCredential().ID(id).Status(status).
+			ExpirationTimestamp(expiration).Kubeconfig(kubeconfig).Build()
+		Expect(err).NotTo(HaveOccurred())
+		return credential
+	}
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+		options = NewUseBreakGlassCredentialOptions()
+		options.kubeconfig = filepath.Join(GinkgoT().TempDir(), "config")
+		clusters = FormatClusterList([]*cmv1.Cluster{MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.State(cmv1.ClusterStateReady)
+			c.Hypershift(cmv1.NewHypershift().Enabled(true))
+			c.ExternalAuthConfig(cmv1.NewExternalAuthConfig().Enabled(true))
+		})})
+	})
+
+	It("Correctly builds the command", func() {
+		cmd := NewUseBreakGlassCredentialCommand()
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Flags().Lookup("cluster")).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(kubeconfigFlag)).NotTo(BeNil())
+	})
+
+	It("Switches to the context of the given credential", func() {
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, clusters))
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatResource(
+			credential("abc", cmv1.BreakGlassCredentialStatusIssued, expiration, credentialKubeconfig))))
+
+		err := UseBreakGlassCredentialRunner(options)(context.Background(), t.RosaRuntime, nil, []string{"abc"})
+		Expect(err).NotTo(HaveOccurred())
+
+		config, err := kubeconfig.Load(options.kubeconfig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.CurrentContext).To(Equal(breakglasscredential.ContextName(MockClusterName, "abc")))
+		entries, err := breakglasscredential.KubeconfigEntries(config)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].ExpirationTimestamp).To(Equal(expiration))
+	})
+
+	It("Uses the issued credential that expires last", func() {
+		credentials := []*cmv1.BreakGlassCredential{
+			credential("first", cmv1.BreakGlassCredentialStatusIssued, expiration, credentialKubeconfig),
+			credential("revoked", cmv1.BreakGlassCredentialStatusRevoked, expiration.Add(2*time.Hour), ""),
+			credential("last", cmv1.BreakGlassCredentialStatusIssued, expiration.Add(time.Hour), credentialKubeconfig),
+		}
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, clusters))
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+			FormatList(credentials, cmv1.MarshalBreakGlassCredentialList, "BreakGlassCredentialList")))
+
+		err := UseBreakGlassCredentialRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		config, err := kubeconfig.Load(options.kubeconfig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.CurrentContext).To(Equal(breakglasscredential.ContextName(MockClusterName, "last")))
+	})
+
+	It("Fails if the credential has been revoked", func() {
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, clusters))
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatResource(
+			credential("abc", cmv1.BreakGlassCredentialStatusRevoked, expiration, ""))))
+
+		err := UseBreakGlassCredentialRunner(options)(context.Background(), t.RosaRuntime, nil, []string{"abc"})
+		Expect(err).To(MatchError("break glass credential 'abc' for cluster 'cluster1' has been revoked"))
+		Expect(options.kubeconfig).NotTo(BeAnExistingFile())
+	})
+
+	It("Fails if the credential isn't ready", func() {
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, clusters))
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatResource(
+			credential("abc", cmv1.BreakGlassCredentialStatusCreated, expiration, ""))))
+
+		err := UseBreakGlassCredentialRunner(options)(context.Background(), t.RosaRuntime, nil, []string{"abc"})
+		Expect(err).To(MatchError(ContainSubstring("is not ready yet")))
+	})
+})
@@ -23,6 +23,7 @@ import (
 	"github.com/openshift/rosa/cmd/verify/oc"
 	"github.com/openshift/rosa/cmd/verify/permissions"
 	"github.com/openshift/rosa/cmd/verify/quota"
+	"github.com/openshift/rosa/cmd/verify/roles"
 	"github.com/openshift/rosa/cmd/verify/rosa"
 )
 
@@ -38,5 +39,6 @@ func init() {
 	Cmd.AddCommand(oc.Cmd)
 	Cmd.AddCommand(permissions.Cmd)
 	Cmd.AddCommand(quota.Cmd)
+	Cmd.AddCommand(roles.NewVerifyRolesCommand())
 	Cmd.AddCommand(rosa.NewVerifyRosaCommand())
 }
@@ -18,38 +18,95 @@ package permissions
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	rprtr "github.com/openshift/rosa/pkg/reporter"
+	"github.com/openshift/rosa/pkg/roles"
 	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/simulation"
 )
 
 var Cmd = &cobra.Command{
 	Use:     "permissions",
 	Aliases: []string{"scp"},
-	Short:   "Verify AWS permissions are ok for non-STS cluster install",
-	Long:    "Verify AWS permissions needed to create a non-STS cluster are configured as expected",
+	Short:   "Verify AWS permissions are ok for cluster install",
+	Long: "Verify AWS permissions needed to create a non-STS cluster are configured as expected.\n\n" +
+		"With '--sts', simulate the actions that the account roles with the given prefix, or the account " +
+		"and operator roles of a cluster, need. The simulation takes into account the policies attached " +
+		"to the roles, their permissions boundaries and the service control policies of the " +
+		"organization, and reports the allowed and denied actions of each role. Actions with " +
+		"wildcards can't be simulated and are skipped, and actions that are only allowed with conditions " +
+		"or on specific resources are reported as not simulated. The command exits with a non-zero code " +
+		"when an action is denied.",
 	Example: `  # Verify AWS permissions are configured correctly
   rosa verify permissions
 
   # Verify AWS permissions in a different region
-  rosa verify permissions --region=us-west-2`,
+  rosa verify permissions --region=us-west-2
+
+  # Verify that the account roles with the prefix 'ManagedOpenShift' are allowed what they need
+  rosa verify permissions --sts --prefix ManagedOpenShift
+
+  # Verify the account and operator roles of a cluster, showing only the denied actions
+  rosa verify permissions --sts --cluster mycluster --denied-only`,
 	Run:  run,
 	Args: cobra.NoArgs,
 }
 
+var args struct {
+	sts        bool
+	prefix     string
+	hostedCP   bool
+	deniedOnly bool
+}
+
 func init() {
 	flags := Cmd.Flags()
 
+	flags.BoolVar(
+		&args.sts,
+		"sts",
+		false,
+		"Simulate the actions needed by the account and operator roles of STS clusters.",
+	)
+	flags.StringVarP(
+		&args.prefix,
+		"prefix",
+		"p",
+		"",
+		"Prefix of the account roles to verify. Requires '--sts'.",
+	)
+	flags.BoolVar(
+		&args.hostedCP,
+		"hosted-cp",
+		false,
+		"Verify the hosted control plane account roles with the prefix.",
+	)
+	flags.BoolVar(
+		&args.deniedOnly,
+		"denied-only",
+		false,
+		"Only show the denied actions.",
+	)
+	ocm.AddOptionalClusterFlag(Cmd)
+	output.AddFlag(Cmd)
 	arguments.AddProfileFlag(flags)
 	arguments.AddRegionFlag(flags)
 }
 
-func run(_ *cobra.Command, _ []string) {
+func run(cmd *cobra.Command, _ []string) {
+	hasCluster := cmd.Flags().Changed("cluster")
+	if !args.sts && (hasCluster || args.prefix != "" || args.hostedCP) {
+		_ = rprtr.CreateReporter().Errorf("The '--prefix', '--cluster' and '--hosted-cp' flags require '--sts'")
+		rprtr.Exit(1)
+	}
+
 	r := rosa.NewRuntime().WithOCM()
 	defer r.Cleanup()
 
@@ -57,7 +114,7 @@ func run(_ *cobra.Command, _ []string) {
 	region, err := aws.GetRegion(arguments.GetRegion())
 	if err != nil {
 		r.Reporter.Errorf("Error getting region: %v", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	// Create the AWS client:
@@ -71,7 +128,16 @@ func run(_ *cobra.Command, _ []string) {
 			r.OCMClient.LogEvent("ROSAInitCredentialsSTS", nil)
 		}
 		r.Reporter.Errorf("Error creating AWS client: %v", err)
-		os.Exit(1)
+		rprtr.Exit(1)
+	}
+
+	if args.sts {
+		err = runSTS(r, hasCluster)
+		if err != nil {
+			r.Reporter.Errorf("%v", err)
+			rprtr.Exit(1)
+		}
+		return
 	}
 
 	r.Reporter.Infof("Verifying permissions for non-STS clusters")
@@ -79,7 +145,7 @@ func run(_ *cobra.Command, _ []string) {
 	policies, err := r.OCMClient.GetPolicies("OSDSCPPolicy")
 	if err != nil {
 		r.Reporter.Errorf("Failed to get 'osdscppolicy' for '%s': %v", aws.AdminUserName, err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 	ok, err := r.AWSClient.ValidateSCP(nil, policies)
 	if err != nil {
@@ -88,10 +154,10 @@ func run(_ *cobra.Command, _ []string) {
 			"SCP is not preventing this account from performing the required checks")
 		if strings.Contains(err.Error(), "Throttling: Rate exceeded") {
 			r.Reporter.Errorf("Throttling: Rate exceeded. Please wait 3-5 minutes before retrying.")
-			os.Exit(1)
+			rprtr.Exit(1)
 		}
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 	if !ok {
 		r.OCMClient.LogEvent("ROSAVerifyPermissionsSCPInvalid", nil)
@@ -99,3 +165,106 @@ func run(_ *cobra.Command, _ []string) {
 	}
 	r.Reporter.Infof("AWS SCP policies ok")
 }
+
+func runSTS(r *rosa.Runtime, hasCluster bool) error {
+	if hasCluster == (args.prefix != "") {
+		return fmt.Errorf("Either '--prefix' or '--cluster' must be specified with '--sts'")
+	}
+	if hasCluster && args.hostedCP {
+		return fmt.Errorf("The '--hosted-cp' flag can only be used with '--prefix'")
+	}
+	var err error
+	r.Creator, err = r.AWSClient.GetCreator()
+	if err != nil {
+		return fmt.Errorf("Failed to get IAM credentials: %v", err)
+	}
+	policies, err := r.OCMClient.GetPolicies("")
+	if err != nil {
+		return fmt.Errorf("Failed to get the policy templates: %v", err)
+	}
+
+	var roles []simulation.Role
+	if hasCluster {
+		roles, err = clusterRoles(r)
+	} else {
+		roles, err = accountRoles(r)
+	}
+	if err != nil {
+		return err
+	}
+
+	if r.Reporter.IsTerminal() && !output.HasFlag() {
+		r.Reporter.Infof("Simulating the permissions of %d roles...", len(roles))
+	}
+	simulator := simulation.NewSimulator(r.AWSClient, policies)
+	results := make([]simulation.Result, len(roles))
+	for i, role := range roles {
+		r.Reporter.Debugf("Simulating the permissions of role '%s'", role.Name)
+		results[i] = simulator.Simulate(role)
+	}
+
+	if output.HasFlag() {
+		err = output.Print(results)
+	} else {
+		err = simulation.NewTable(results, args.deniedOnly).Print()
+	}
+	if err != nil {
+		return err
+	}
+
+	denied := 0
+	failed := 0
+	notSimulated := 0
+	for _, result := range results {
+		denied += result.Denied()
+		notSimulated += len(result.NotSimulated)
+		if result.Error != "" {
+			failed++
+		}
+	}
+	if denied > 0 {
+		r.OCMClient.LogEvent("ROSAVerifyPermissionsSTSDenied", nil)
+		return fmt.Errorf("%d actions needed by the roles are denied", denied)
+	}
+	if failed > 0 {
+		return fmt.Errorf("Failed to simulate the permissions of %d roles", failed)
+	}
+	if !output.HasFlag() {
+		r.Reporter.Infof("All the actions needed by the roles are allowed")
+		if notSimulated > 0 {
+			r.Reporter.Warnf("%d actions are only allowed with conditions or on specific resources, "+
+				"and weren't simulated", notSimulated)
+		}
+	}
+	return nil
+}
+
+func accountRoles(r *rosa.Runtime) ([]simulation.Role, error) {
+	managedPolicies, err := roles.HasManagedPolicies(r.AWSClient, args.prefix, args.hostedCP)
+	if err != nil {
+		return nil, err
+	}
+	return simulation.AccountRoles(args.prefix, args.hostedCP, managedPolicies), nil
+}
+
+func clusterRoles(r *rosa.Runtime) ([]simulation.Role, error) {
+	cluster, err := r.OCMClient.GetCluster(r.GetClusterKey(), r.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.AWS().STS().RoleARN() == "" {
+		return nil, fmt.Errorf("Cluster '%s' doesn't use STS, run the command without '--sts' instead",
+			r.ClusterKey)
+	}
+	prefix, err := aws.GetPrefixFromInstallerAccountRole(cluster)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the account role prefix of cluster '%s': %v", r.ClusterKey, err)
+	}
+	result := simulation.AccountRoles(prefix, aws.IsHostedCPManagedPolicies(cluster),
+		cluster.AWS().STS().ManagedPolicies())
+	credRequests, err := r.OCMClient.GetCredRequests(cluster.Hypershift().Enabled())
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the operator credential requests: %v", err)
+	}
+	return append(result, simulation.OperatorRoles(cluster, credRequests)...), nil
+}
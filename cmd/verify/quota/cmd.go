@@ -18,45 +18,135 @@ package quota
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clusterspec"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/quota"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
 var Cmd = &cobra.Command{
 	Use:   "quota",
 	Short: "Verify AWS quota is ok for cluster install",
-	Long:  "Verify AWS quota needed to create a cluster is configured as expected",
+	Long: "Verify AWS quota needed to create a cluster is configured as expected.\n\n" +
+		"When the clusters that are going to be created are described, with a cluster spec file or with " +
+		"the '--compute-machine-type', '--replicas', '--multi-az', '--hosted-cp' and '--count' flags, the " +
+		"vCPUs of the instance families, elastic IPs, NAT gateways and EBS storage that they need are " +
+		"compared with the quotas and the current usage of the account, and the headroom of each quota is " +
+		"reported.",
 	Example: `  # Verify AWS quotas are configured correctly
   rosa verify quota
 
   # Verify AWS quotas in a different region
-  rosa verify quota --region=us-west-2`,
+  rosa verify quota --region=us-west-2
+
+  # Verify that there is room for the cluster described in a spec file
+  rosa verify quota --from-file cluster.yaml
+
+  # Verify that there is room for 3 multi-AZ clusters with 6 m5.2xlarge workers each
+  rosa verify quota --compute-machine-type m5.2xlarge --replicas 6 --multi-az --count 3`,
 	Args: cobra.NoArgs,
 	Run:  run,
 }
 
+var args struct {
+	fromFile    string
+	machineType string
+	replicas    int
+	multiAZ     bool
+	hostedCP    bool
+	count       int
+}
+
+// Flags that describe the planned clusters. When none of them is given the fixed set of quotas is
+// verified instead.
+var planFlags = []string{
+	clusterspec.FromFileFlag,
+	"compute-machine-type",
+	"replicas",
+	"multi-az",
+	"hosted-cp",
+	"count",
+}
+
 func init() {
 	flags := Cmd.Flags()
 
+	flags.StringVar(
+		&args.fromFile,
+		clusterspec.FromFileFlag,
+		"",
+		"Path of the spec file of the cluster that is going to be created. The flags below override the "+
+			"values of the file.",
+	)
+	flags.StringVar(
+		&args.machineType,
+		"compute-machine-type",
+		"",
+		fmt.Sprintf("Instance type of the compute nodes (default \"%s\").", quota.DefaultMachineType),
+	)
+	flags.IntVar(
+		&args.replicas,
+		"replicas",
+		0,
+		"Number of compute nodes of each cluster. Defaults to the number of nodes that 'rosa create "+
+			"cluster' uses.",
+	)
+	flags.BoolVar(
+		&args.multiAZ,
+		"multi-az",
+		false,
+		"Deploy the clusters to multiple availability zones.",
+	)
+	flags.BoolVar(
+		&args.hostedCP,
+		"hosted-cp",
+		false,
+		"Use hosted control planes, which don't run in the account.",
+	)
+	flags.IntVar(
+		&args.count,
+		"count",
+		1,
+		"Number of clusters that are going to be created.",
+	)
+	output.AddFlag(Cmd)
 	arguments.AddRegionFlag(flags)
 	arguments.AddProfileFlag(flags)
 }
 
-func run(_ *cobra.Command, _ []string) {
+func run(cmd *cobra.Command, _ []string) {
 	r := rosa.NewRuntime().WithOCM()
 	defer r.Cleanup()
 
+	var plan *quota.Plan
+	for _, flag := range planFlags {
+		if cmd.Flags().Changed(flag) {
+			var err error
+			plan, err = buildPlan(cmd)
+			if err != nil {
+				r.Reporter.Errorf("%v", err)
+				reporter.Exit(1)
+			}
+			break
+		}
+	}
+
 	// Get AWS region
-	region, err := aws.GetRegion(arguments.GetRegion())
+	regionFlag := arguments.GetRegion()
+	if regionFlag == "" && plan != nil {
+		regionFlag = plan.Region
+	}
+	region, err := aws.GetRegion(regionFlag)
 	if err != nil {
 		r.Reporter.Errorf("Error getting region: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Create the AWS client:
@@ -70,7 +160,16 @@ func run(_ *cobra.Command, _ []string) {
 			r.OCMClient.LogEvent("ROSAInitCredentialsSTS", nil)
 		}
 		r.Reporter.Errorf("Error creating AWS client: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
+	}
+
+	if plan != nil {
+		err = verifyPlan(r, plan)
+		if err != nil {
+			r.Reporter.Errorf("%v", err)
+			reporter.Exit(1)
+		}
+		return
 	}
 
 	if r.Reporter.IsTerminal() {
@@ -81,7 +180,7 @@ func run(_ *cobra.Command, _ []string) {
 		r.OCMClient.LogEvent("ROSAVerifyQuotaInsufficient", nil)
 		r.Reporter.Errorf("Insufficient AWS quotas")
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if r.Reporter.IsTerminal() {
 		r.Reporter.Infof("AWS quota ok. " +
@@ -89,3 +188,64 @@ func run(_ *cobra.Command, _ []string) {
 			"https://docs.openshift.com/rosa/rosa_getting_started/rosa-required-aws-service-quotas.html")
 	}
 }
+
+func buildPlan(cmd *cobra.Command) (*quota.Plan, error) {
+	plan := quota.NewPlan()
+	if args.fromFile != "" {
+		spec, err := clusterspec.Load(args.fromFile)
+		if err != nil {
+			return nil, err
+		}
+		plan, err = quota.PlanFromSpec(spec)
+		if err != nil {
+			return nil, err
+		}
+	}
+	flags := cmd.Flags()
+	if flags.Changed("compute-machine-type") {
+		plan.MachineType = args.machineType
+	}
+	if flags.Changed("replicas") {
+		plan.Replicas = args.replicas
+	}
+	if flags.Changed("multi-az") {
+		plan.MultiAZ = args.multiAZ
+	}
+	if flags.Changed("hosted-cp") {
+		plan.HostedCP = args.hostedCP
+	}
+	plan.Count = args.count
+	return plan, plan.Validate()
+}
+
+func verifyPlan(r *rosa.Runtime, plan *quota.Plan) error {
+	if r.Reporter.IsTerminal() && !output.HasFlag() {
+		r.Reporter.Infof("Verifying the AWS quota needed by %d clusters...", plan.Count)
+	}
+	checks, err := quota.NewChecker(r.AWSClient).Check(plan)
+	if err != nil {
+		return err
+	}
+	if output.HasFlag() {
+		err = output.Print(checks)
+	} else {
+		err = quota.NewTable(checks).Print()
+	}
+	if err != nil {
+		return err
+	}
+	insufficient := 0
+	for _, check := range checks {
+		if !check.IsSufficient() {
+			insufficient++
+		}
+	}
+	if insufficient > 0 {
+		r.OCMClient.LogEvent("ROSAVerifyQuotaInsufficient", nil)
+		return fmt.Errorf("%d AWS quotas are insufficient for the planned clusters", insufficient)
+	}
+	if r.Reporter.IsTerminal() && !output.HasFlag() {
+		r.Reporter.Infof("AWS quota ok for the planned clusters")
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roles
+
+import (
+	"context"
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/drift"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	rosaRoles "github.com/openshift/rosa/pkg/roles"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "roles"
+	short = "Verify that the policies of the account and operator roles haven't been changed"
+	long  = "Compare the live trust and permission policies of the account roles with the given prefix, " +
+		"or of the account and operator roles of a cluster, with the policies that ROSA would create. " +
+		"The actions, resources and trust policy principals that were added or removed are reported " +
+		"for each role.\n\n" +
+		"The command exits with a non-zero code when a role drifted, so it can be used in CI. Use " +
+		"'--repair' to replace the policies that drifted with the expected ones. The permission " +
+		"policies managed by AWS aren't compared."
+	example = `  # Verify the account roles with the prefix 'ManagedOpenShift'
+  rosa verify roles --prefix ManagedOpenShift
+
+  # Verify the account and operator roles of a cluster
+  rosa verify roles --cluster mycluster
+
+  # Repair the roles of a cluster that drifted
+  rosa verify roles --cluster mycluster --repair`
+)
+
+type Options struct {
+	prefix   string
+	hostedCP bool
+	repair   bool
+}
+
+func NewVerifyRolesOptions() *Options {
+	return &Options{}
+}
+
+func NewVerifyRolesCommand() *cobra.Command {
+	options := NewVerifyRolesOptions()
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), VerifyRolesRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(
+		&options.prefix,
+		"prefix",
+		"p",
+		"",
+		"Prefix of the account roles to verify.",
+	)
+	flags.BoolVar(
+		&options.hostedCP,
+		"hosted-cp",
+		false,
+		"Verify the hosted control plane account roles with the prefix.",
+	)
+	flags.BoolVar(
+		&options.repair,
+		"repair",
+		false,
+		"Replace the policies that drifted with the expected ones.",
+	)
+	ocm.AddOptionalClusterFlag(cmd)
+	output.AddFlag(cmd)
+	confirm.AddFlag(flags)
+	arguments.AddProfileFlag(flags)
+	arguments.AddRegionFlag(flags)
+	return cmd
+}
+
+func VerifyRolesRunner(options *Options) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
+		hasCluster := command.Flags().Changed("cluster")
+		if hasCluster == (options.prefix != "") {
+			return fmt.Errorf("Either '--prefix' or '--cluster' must be specified")
+		}
+		if hasCluster && options.hostedCP {
+			return fmt.Errorf("The '--hosted-cp' flag can only be used with '--prefix'")
+		}
+
+		policies, err := r.OCMClient.GetPolicies("")
+		if err != nil {
+			return fmt.Errorf("Failed to get the policy templates: %v", err)
+		}
+		var roles []drift.Role
+		if hasCluster {
+			roles, err = clusterRoles(r, policies)
+		} else {
+			roles, err = accountRoles(r, policies, options.prefix, options.hostedCP)
+		}
+		if err != nil {
+			return err
+		}
+
+		verifier := drift.NewVerifier(r.AWSClient)
+		results := make([]drift.Result, len(roles))
+		for i, role := range roles {
+			r.Reporter.Debugf("Verifying role '%s'", role.Name)
+			results[i] = verifier.Verify(role)
+		}
+
+		if output.HasFlag() {
+			err = output.Print(results)
+		} else {
+			err = drift.NewTable(results).Print()
+		}
+		if err != nil {
+			return err
+		}
+
+		drifted := 0
+		for i, result := range results {
+			if !result.HasDrift() {
+				continue
+			}
+			if options.repair && result.Error == "" &&
+				confirm.Prompt(true, "Repair the policies of role '%s'?", result.RoleName) {
+				err = verifier.Repair(roles[i], result)
+				if err == nil {
+					r.Reporter.Infof("Repaired the policies of role '%s'", result.RoleName)
+					continue
+				}
+				r.Reporter.Warnf("%v", err)
+			}
+			drifted++
+		}
+		if drifted > 0 {
+			return fmt.Errorf("%d of the %d roles don't match the expected policies", drifted, len(results))
+		}
+		if !output.HasFlag() {
+			r.Reporter.Infof("All the roles match the expected policies")
+		}
+		return nil
+	}
+}
+
+func accountRoles(r *rosa.Runtime, policies map[string]*cmv1.AWSSTSPolicy, prefix string,
+	hostedCP bool) ([]drift.Role, error) {
+	env, err := ocm.GetEnv()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to determine OCM environment: %v", err)
+	}
+	managedPolicies, err := rosaRoles.HasManagedPolicies(r.AWSClient, prefix, hostedCP)
+	if err != nil {
+		return nil, err
+	}
+	return drift.AccountRoles(policies, prefix, r.Creator.Partition, env, hostedCP, managedPolicies), nil
+}
+
+func clusterRoles(r *rosa.Runtime, policies map[string]*cmv1.AWSSTSPolicy) ([]drift.Role, error) {
+	cluster, err := r.OCMClient.GetCluster(r.GetClusterKey(), r.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.AWS().STS().RoleARN() == "" {
+		return nil, fmt.Errorf("Cluster '%s' doesn't use STS, so it has no account and operator roles",
+			r.ClusterKey)
+	}
+	prefix, err := aws.GetPrefixFromInstallerAccountRole(cluster)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the account role prefix of cluster '%s': %v", r.ClusterKey, err)
+	}
+	env, err := ocm.GetEnv()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to determine OCM environment: %v", err)
+	}
+	hostedCPPolicies := aws.IsHostedCPManagedPolicies(cluster)
+	result := drift.AccountRoles(policies, prefix, r.Creator.Partition, env, hostedCPPolicies,
+		cluster.AWS().STS().ManagedPolicies())
+
+	credRequests, err := r.OCMClient.GetCredRequests(cluster.Hypershift().Enabled())
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the operator credential requests: %v", err)
+	}
+	operatorRoles, err := drift.OperatorRoles(cluster, credRequests, policies, r.Creator.Partition,
+		r.Creator.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	return append(result, operatorRoles...), nil
+}
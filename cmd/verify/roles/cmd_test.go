@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roles
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Verify roles", func() {
+	var t *TestingRuntime
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+	})
+
+	It("Correctly builds the command", func() {
+		cmd := NewVerifyRolesCommand()
+		Expect(cmd.Use).To(Equal(use))
+		for _, name := range []string{"prefix", "cluster", "hosted-cp", "repair", "output", "yes"} {
+			Expect(cmd.Flags().Lookup(name)).NotTo(BeNil(), name)
+		}
+	})
+
+	It("Requires either a prefix or a cluster", func() {
+		cmd := NewVerifyRolesCommand()
+		err := VerifyRolesRunner(NewVerifyRolesOptions())(context.Background(), t.RosaRuntime, cmd, nil)
+		Expect(err).To(MatchError("Either '--prefix' or '--cluster' must be specified"))
+
+		Expect(cmd.Flags().Set("cluster", "mycluster")).To(Succeed())
+		err = VerifyRolesRunner(&Options{prefix: "prefix"})(context.Background(), t.RosaRuntime, cmd, nil)
+		Expect(err).To(MatchError("Either '--prefix' or '--cluster' must be specified"))
+	})
+
+	It("Only accepts '--hosted-cp' with a prefix", func() {
+		cmd := NewVerifyRolesCommand()
+		Expect(cmd.Flags().Set("cluster", "mycluster")).To(Succeed())
+		err := VerifyRolesRunner(&Options{hostedCP: true})(context.Background(), t.RosaRuntime, cmd, nil)
+		Expect(err).To(MatchError("The '--hosted-cp' flag can only be used with '--prefix'"))
+	})
+})
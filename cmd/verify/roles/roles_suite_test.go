@@ -0,0 +1,13 @@
+package roles
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestVerifyRoles(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Verify roles suite")
+}
@@ -18,7 +18,6 @@ package whoami
 
 import (
 	"fmt"
-	"os"
 	"sort"
 
 	amsv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
@@ -30,6 +29,7 @@ import (
 	"github.com/openshift/rosa/pkg/object"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -57,29 +57,29 @@ func run(_ *cobra.Command, _ []string) {
 	awsRegion, err := aws.GetRegion("")
 	if err != nil {
 		r.Reporter.Errorf("Error getting AWS region: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	// Load the configuration file:
 	cfg, err := config.Load()
 	if err != nil {
 		r.Reporter.Errorf("Failed to load config file: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if cfg == nil {
 		r.Reporter.Errorf("User is not logged in to OCM")
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	// Verify configuration file:
 	loggedIn, err := cfg.Armed()
 	if err != nil {
 		r.Reporter.Errorf("Failed to verify configuration: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if !loggedIn {
 		r.Reporter.Errorf("User is not logged in to OCM")
-		os.Exit(0)
+		reporter.Exit(0)
 	}
 
 	// Create a connection to OCM:
@@ -89,7 +89,7 @@ func run(_ *cobra.Command, _ []string) {
 		Build()
 	if err != nil {
 		r.Reporter.Errorf("Failed to create OCM connection: %v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	defer r.Cleanup()
 
@@ -97,14 +97,14 @@ func run(_ *cobra.Command, _ []string) {
 	account, err := r.OCMClient.GetCurrentAccount()
 	if err != nil {
 		r.Reporter.Errorf("Failed to get current account: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 
 	if account == nil {
 		account, err = getAccountDataFromToken(cfg)
 		if err != nil {
 			r.Reporter.Errorf("Failed to get account data from token: %v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	outputObject := object.Object{
@@ -127,7 +127,7 @@ func run(_ *cobra.Command, _ []string) {
 		err = output.Print(outputObject)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 		return
 	}
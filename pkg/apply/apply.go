@@ -0,0 +1,207 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+var actionSymbols = map[Action]string{
+	ActionCreate: "+",
+	ActionUpdate: "~",
+	ActionDelete: "-",
+}
+
+// Change is a single operation needed to reach the desired state.
+type Change struct {
+	Action Action
+	Kind   string
+	Name   string
+	Fields []FieldDiff
+
+	current object
+	spec    map[string]interface{}
+}
+
+func (c *Change) Key() string {
+	return fmt.Sprintf("%s/%s", c.Kind, c.Name)
+}
+
+// Plan is the ordered list of changes needed to reach the desired state.
+type Plan struct {
+	Changes []Change
+
+	// Warnings about resources that differ from the desired state but can't be changed
+	Warnings []string
+}
+
+// Deletions returns the changes that delete resources.
+func (p *Plan) Deletions() []Change {
+	result := []Change{}
+	for _, change := range p.Changes {
+		if change.Action == ActionDelete {
+			result = append(result, change)
+		}
+	}
+	return result
+}
+
+// NewPlan compares the desired resources with the current state of the cluster. Resources that exist
+// in the cluster but not in the desired state are only deleted when 'prune' is true, and only for the
+// kinds that appear in the desired state.
+func NewPlan(ctx context.Context, client *ocm.Client, clusterID string, resources []Document,
+	prune bool) (*Plan, error) {
+	plan := &Plan{}
+
+	// Group the documents by kind, keeping the order of the file
+	kinds := []string{}
+	byKind := map[string][]Document{}
+	for _, doc := range resources {
+		if _, ok := byKind[doc.Kind]; !ok {
+			kinds = append(kinds, doc.Kind)
+		}
+		byKind[doc.Kind] = append(byKind[doc.Kind], doc)
+	}
+
+	deletions := []Change{}
+	for _, kind := range kinds {
+		h := handlers[kind]
+		current, err := h.list(ctx, client, clusterID)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get current %s resources: %v", kind, err)
+		}
+
+		desired := map[string]bool{}
+		for _, doc := range byKind[kind] {
+			desired[doc.Metadata.Name] = true
+			existing, ok := current[doc.Metadata.Name]
+			if !ok && h.create == nil {
+				return nil, fmt.Errorf("%s doesn't exist and resources of kind '%s' can't be created",
+					doc.Key(), kind)
+			}
+			if !ok {
+				plan.Changes = append(plan.Changes, Change{
+					Action: ActionCreate,
+					Kind:   kind,
+					Name:   doc.Metadata.Name,
+					spec:   doc.Spec,
+				})
+				continue
+			}
+			if h.update == nil {
+				continue
+			}
+			diffs := diffFields("", existing.fields, doc.Spec)
+			if len(diffs) == 0 {
+				continue
+			}
+			plan.Changes = append(plan.Changes, Change{
+				Action:  ActionUpdate,
+				Kind:    kind,
+				Name:    doc.Metadata.Name,
+				Fields:  diffs,
+				current: existing,
+				spec:    doc.Spec,
+			})
+		}
+
+		if !prune {
+			continue
+		}
+		names := make([]string, 0, len(current))
+		for name := range current {
+			if !desired[name] {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if h.delete == nil {
+				plan.Warnings = append(plan.Warnings,
+					fmt.Sprintf("%s/%s is not in the desired state but can't be deleted individually", kind, name))
+				continue
+			}
+			deletions = append(deletions, Change{
+				Action:  ActionDelete,
+				Kind:    kind,
+				Name:    name,
+				current: current[name],
+			})
+		}
+	}
+
+	// Deletions go last so that replacements are created before the old resources are removed
+	plan.Changes = append(plan.Changes, deletions...)
+	return plan, nil
+}
+
+// Execute applies the changes of the plan in order, stopping at the first failure. The callback is
+// invoked after every successful change.
+func (p *Plan) Execute(ctx context.Context, client *ocm.Client, clusterID string,
+	done func(change Change)) error {
+	for _, change := range p.Changes {
+		h := handlers[change.Kind]
+		var err error
+		switch change.Action {
+		case ActionCreate:
+			var spec []byte
+			spec, err = json.Marshal(change.spec)
+			if err == nil {
+				err = h.create(ctx, client, clusterID, change.Name, spec)
+			}
+		case ActionUpdate:
+			var spec []byte
+			spec, err = json.Marshal(change.spec)
+			if err == nil {
+				err = h.update(ctx, client, clusterID, change.current, change.Name, spec)
+			}
+		case ActionDelete:
+			err = h.delete(ctx, client, clusterID, change.current)
+		}
+		if err != nil {
+			return fmt.Errorf("Failed to %s %s: %v", change.Action, change.Key(), err)
+		}
+		if done != nil {
+			done(change)
+		}
+	}
+	return nil
+}
+
+// Print writes the plan as a diff, one line per resource followed by the changed fields.
+func (p *Plan) Print(w io.Writer) {
+	for _, change := range p.Changes {
+		fmt.Fprintf(w, "%s %s\n", actionSymbols[change.Action], change.Key())
+		for _, field := range change.Fields {
+			fmt.Fprintf(w, "    %s\n", field)
+		}
+	}
+}
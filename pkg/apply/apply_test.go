@@ -0,0 +1,215 @@
+package apply
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+const desiredState = `
+apiVersion: rosa.openshift.io/v1alpha1
+kind: ClusterSpec
+spec:
+  name: mycluster
+  region: us-east-1
+---
+# Worker pool
+apiVersion: rosa.openshift.io/v1alpha1
+kind: MachinePool
+metadata:
+  name: workers
+spec:
+  instance_type: m5.xlarge
+  replicas: 3
+  labels:
+    team: a
+---
+apiVersion: rosa.openshift.io/v1alpha1
+kind: ClusterAutoscaler
+spec:
+  max_pod_grace_period: 600
+`
+
+var _ = Describe("Desired state", func() {
+	It("Parses a multi-document file", func() {
+		state, err := Parse([]byte(desiredState))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state.Cluster).NotTo(BeNil())
+		Expect(state.Cluster.Spec.Name).To(Equal("mycluster"))
+		Expect(state.Resources).To(HaveLen(2))
+		Expect(state.Resources[0].Key()).To(Equal("MachinePool/workers"))
+		Expect(state.Resources[0].Spec["replicas"]).To(Equal(float64(3)))
+		Expect(state.Resources[1].Key()).To(Equal("ClusterAutoscaler/cluster"))
+	})
+
+	DescribeTable("Rejects invalid documents",
+		func(data string, message string) {
+			_, err := Parse([]byte(data))
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("empty file", "# nothing\n---\n", "no documents found"),
+		Entry("unknown kind", "apiVersion: rosa.openshift.io/v1alpha1\nkind: Foo\nmetadata:\n  name: a\n",
+			"unsupported kind 'Foo'"),
+		Entry("wrong apiVersion", "apiVersion: v1\nkind: MachinePool\nmetadata:\n  name: a\n",
+			"unsupported apiVersion 'v1'"),
+		Entry("missing name", "apiVersion: rosa.openshift.io/v1alpha1\nkind: MachinePool\n",
+			"'metadata.name' is required"),
+		Entry("named singleton",
+			"apiVersion: rosa.openshift.io/v1alpha1\nkind: ClusterAutoscaler\nmetadata:\n  name: a\n",
+			"'ClusterAutoscaler' is a singleton"),
+		Entry("duplicate",
+			"apiVersion: rosa.openshift.io/v1alpha1\nkind: MachinePool\nmetadata:\n  name: a\n---\n"+
+				"apiVersion: rosa.openshift.io/v1alpha1\nkind: MachinePool\nmetadata:\n  name: a\n",
+			"'MachinePool/a' is defined more than once"),
+		Entry("unknown field",
+			"apiVersion: rosa.openshift.io/v1alpha1\nkind: MachinePool\nmetadata:\n  name: a\nfoo: bar\n",
+			"unknown field"),
+	)
+})
+
+var _ = Describe("Diff", func() {
+	It("Only compares the desired fields", func() {
+		current := map[string]interface{}{
+			"id":       "workers",
+			"replicas": float64(2),
+			"labels":   map[string]interface{}{"team": "a"},
+			"href":     "/api/clusters_mgmt/v1/clusters/123/machine_pools/workers",
+		}
+		desired := map[string]interface{}{
+			"replicas":      float64(3),
+			"labels":        map[string]interface{}{"team": "a", "env": "prod"},
+			"client_secret": "secret",
+		}
+		diffs := diffFields("", current, desired)
+		Expect(diffs).To(HaveLen(2))
+		Expect(diffs[0].String()).To(Equal("labels.env: <unset> -> prod"))
+		Expect(diffs[1].String()).To(Equal("replicas: 2 -> 3"))
+	})
+})
+
+var _ = Describe("Cluster warnings", func() {
+	It("Reports the cluster fields that differ", func() {
+		state, err := Parse([]byte(desiredState))
+		Expect(err).NotTo(HaveOccurred())
+		state.Cluster.Spec.Tags = map[string]string{"team": "a"}
+		cluster, err := cmv1.NewCluster().
+			Name("othername").
+			Region(cmv1.NewCloudRegion().ID("us-west-2")).
+			AWS(cmv1.NewAWS().Tags(map[string]string{"team": "a"})).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		warnings, err := ClusterWarnings(state.Cluster, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(ConsistOf(
+			"ClusterSpec/mycluster field region: us-west-2 -> us-east-1 isn't reconciled, " +
+				"use 'rosa edit cluster' to change it",
+		))
+	})
+})
+
+var _ = Describe("Plan", func() {
+	var t *TestingRuntime
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+	})
+
+	It("Creates, updates and prunes machine pools", func() {
+		workers, err := cmv1.NewMachinePool().ID("workers").InstanceType("m5.xlarge").Replicas(2).Build()
+		Expect(err).NotTo(HaveOccurred())
+		old, err := cmv1.NewMachinePool().ID("old").InstanceType("m5.xlarge").Replicas(1).Build()
+		Expect(err).NotTo(HaveOccurred())
+		t.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, FormatMachinePoolList([]*cmv1.MachinePool{old, workers})))
+
+		state, err := Parse([]byte(`
+apiVersion: rosa.openshift.io/v1alpha1
+kind: MachinePool
+metadata:
+  name: workers
+spec:
+  replicas: 3
+---
+apiVersion: rosa.openshift.io/v1alpha1
+kind: MachinePool
+metadata:
+  name: gpu
+spec:
+  instance_type: g4dn.xlarge
+  replicas: 1
+`))
+		Expect(err).NotTo(HaveOccurred())
+
+		plan, err := NewPlan(context.Background(), t.RosaRuntime.OCMClient, MockClusterID, state.Resources, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.Warnings).To(BeEmpty())
+		Expect(plan.Deletions()).To(HaveLen(1))
+
+		out := &bytes.Buffer{}
+		plan.Print(out)
+		Expect(out.String()).To(Equal("~ MachinePool/workers\n" +
+			"    replicas: 2 -> 3\n" +
+			"+ MachinePool/gpu\n" +
+			"- MachinePool/old\n"))
+
+		t.ApiServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPatch, MockClusterHREF+"/machine_pools/workers"),
+				ghttp.VerifyJSON(`{"kind": "MachinePool", "id": "workers", "replicas": 3}`),
+				RespondWithJSON(http.StatusOK, FormatResource(workers)),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, MockClusterHREF+"/machine_pools"),
+				ghttp.VerifyJSON(`{"kind": "MachinePool", "id": "gpu", "instance_type": "g4dn.xlarge", "replicas": 1}`),
+				RespondWithJSON(http.StatusCreated, FormatResource(workers)),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodDelete, MockClusterHREF+"/machine_pools/old"),
+				ghttp.RespondWith(http.StatusNoContent, nil),
+			),
+		)
+		applied := []string{}
+		err = plan.Execute(context.Background(), t.RosaRuntime.OCMClient, MockClusterID, func(change Change) {
+			applied = append(applied, change.Key())
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applied).To(Equal([]string{"MachinePool/workers", "MachinePool/gpu", "MachinePool/old"}))
+	})
+
+	It("Keeps resources that are not in the file without prune", func() {
+		workers, err := cmv1.NewMachinePool().ID("workers").Replicas(3).Build()
+		Expect(err).NotTo(HaveOccurred())
+		old, err := cmv1.NewMachinePool().ID("old").Replicas(1).Build()
+		Expect(err).NotTo(HaveOccurred())
+		t.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, FormatMachinePoolList([]*cmv1.MachinePool{old, workers})))
+
+		state, err := Parse([]byte("apiVersion: rosa.openshift.io/v1alpha1\nkind: MachinePool\n" +
+			"metadata:\n  name: workers\nspec:\n  replicas: 3\n"))
+		Expect(err).NotTo(HaveOccurred())
+
+		plan, err := NewPlan(context.Background(), t.RosaRuntime.OCMClient, MockClusterID, state.Resources, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.Changes).To(BeEmpty())
+	})
+
+	It("Fails when a resource that can't be created is missing", func() {
+		t.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, FormatIngressList([]*cmv1.Ingress{})))
+
+		state, err := Parse([]byte("apiVersion: rosa.openshift.io/v1alpha1\nkind: Ingress\n" +
+			"metadata:\n  name: abcd\nspec:\n  private: true\n"))
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = NewPlan(context.Background(), t.RosaRuntime.OCMClient, MockClusterID, state.Resources, false)
+		Expect(err).To(MatchError(ContainSubstring("Ingress/abcd doesn't exist")))
+	})
+})
@@ -0,0 +1,63 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apply
+
+import (
+	"encoding/json"
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/clusterspec"
+)
+
+// ClusterWarnings compares the cluster level fields of the given spec with the cluster. Those fields
+// aren't reconciled, so the differences are returned as warnings instead of changes.
+func ClusterWarnings(desired *clusterspec.ClusterSpec, cluster *cmv1.Cluster) ([]string, error) {
+	desiredFields, err := specFields(desired.Spec)
+	if err != nil {
+		return nil, err
+	}
+	currentFields, err := specFields(clusterspec.FromCluster(cluster).Spec)
+	if err != nil {
+		return nil, err
+	}
+	// The name is only used to select the cluster:
+	delete(desiredFields, "name")
+
+	warnings := []string{}
+	for _, diff := range diffFields("", currentFields, desiredFields) {
+		warnings = append(warnings, fmt.Sprintf(
+			"%s/%s field %s isn't reconciled, use 'rosa edit cluster' to change it",
+			clusterspec.Kind, desired.Spec.Name, diff))
+	}
+	return warnings, nil
+}
+
+// specFields returns the fields of the spec as they are written in a spec file.
+func specFields(spec clusterspec.Spec) (map[string]interface{}, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{}
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
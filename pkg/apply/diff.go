@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apply
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Fields that OCM accepts but never returns, so they can't be compared with the current state
+var writeOnlyFields = map[string]bool{
+	"client_secret": true,
+	"bind_password": true,
+	"password":      true,
+	"users":         true,
+}
+
+// FieldDiff is a single field whose current value differs from the desired one.
+type FieldDiff struct {
+	Path    string
+	Current interface{}
+	Desired interface{}
+}
+
+func (f FieldDiff) String() string {
+	return fmt.Sprintf("%s: %s -> %s", f.Path, formatValue(f.Current), formatValue(f.Desired))
+}
+
+// diffFields returns the fields of the desired object that differ from the current object. Fields
+// that are only present in the current object are ignored, as OCM fills in defaults for them.
+func diffFields(prefix string, current, desired map[string]interface{}) []FieldDiff {
+	keys := make([]string, 0, len(desired))
+	for k := range desired {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	diffs := []FieldDiff{}
+	for _, k := range keys {
+		if writeOnlyFields[k] {
+			continue
+		}
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		d := desired[k]
+		c, ok := current[k]
+		dm, dIsMap := d.(map[string]interface{})
+		cm, cIsMap := c.(map[string]interface{})
+		switch {
+		case dIsMap && (cIsMap || !ok):
+			diffs = append(diffs, diffFields(path, cm, dm)...)
+		case !reflect.DeepEqual(c, d):
+			diffs = append(diffs, FieldDiff{Path: path, Current: c, Desired: d})
+		}
+	}
+	return diffs
+}
+
+func formatValue(value interface{}) string {
+	if value == nil {
+		return "<unset>"
+	}
+	if s, ok := value.(string); ok {
+		return s
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
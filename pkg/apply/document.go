@@ -0,0 +1,178 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apply reconciles the sub-resources of a cluster with a desired state file, as used by
+// 'rosa apply'.
+//
+// A desired state file is a multi-document YAML or JSON stream. Each document has the same envelope as
+// a cluster spec file and a 'spec' that uses the OCM API representation of the resource, which is what
+// 'rosa describe <resource> --output json' prints. Only the fields present in the spec are compared
+// with the current state and sent to OCM.
+package apply
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift/rosa/pkg/clusterspec"
+)
+
+const (
+	APIVersion = clusterspec.APIVersion
+
+	KindMachinePool          = "MachinePool"
+	KindNodePool             = "NodePool"
+	KindIdentityProvider     = "IdentityProvider"
+	KindIngress              = "Ingress"
+	KindKubeletConfig        = "KubeletConfig"
+	KindTuningConfig         = "TuningConfig"
+	KindClusterAutoscaler    = "ClusterAutoscaler"
+	KindExternalAuthProvider = "ExternalAuthProvider"
+	KindBreakGlassCredential = "BreakGlassCredential"
+)
+
+var documentSeparator = regexp.MustCompile(`(?m)^---[ \t]*$`)
+
+// Document is a single resource of a desired state file.
+type Document struct {
+	APIVersion string                 `json:"apiVersion"`
+	Kind       string                 `json:"kind"`
+	Metadata   Metadata               `json:"metadata"`
+	Spec       map[string]interface{} `json:"spec"`
+}
+
+// Metadata identifies the resource a document describes.
+type Metadata struct {
+	Name string `json:"name"`
+}
+
+// DesiredState is the parsed content of a desired state file.
+type DesiredState struct {
+	// Cluster is the cluster spec document, if the file contains one
+	Cluster *clusterspec.ClusterSpec
+
+	// Resources are the sub-resource documents in the order in which they appear in the file
+	Resources []Document
+}
+
+// Load reads and validates a desired state file.
+func Load(path string) (*DesiredState, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read desired state file '%s': %v", path, err)
+	}
+	state, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid desired state file '%s': %v", path, err)
+	}
+	return state, nil
+}
+
+// Parse decodes and validates a multi-document desired state.
+func Parse(data []byte) (*DesiredState, error) {
+	state := &DesiredState{}
+	seen := map[string]bool{}
+	for i, raw := range documentSeparator.Split(string(data), -1) {
+		if isEmptyDocument(raw) {
+			continue
+		}
+		header := struct {
+			Kind string `json:"kind"`
+		}{}
+		if err := yaml.Unmarshal([]byte(raw), &header); err != nil {
+			return nil, fmt.Errorf("document %d: %v", i+1, err)
+		}
+
+		if header.Kind == clusterspec.Kind {
+			if state.Cluster != nil {
+				return nil, fmt.Errorf("document %d: only one '%s' document is allowed", i+1, clusterspec.Kind)
+			}
+			spec, err := clusterspec.Parse([]byte(raw))
+			if err != nil {
+				return nil, fmt.Errorf("document %d: %v", i+1, err)
+			}
+			state.Cluster = spec
+			continue
+		}
+
+		doc := Document{}
+		if err := yaml.UnmarshalStrict([]byte(raw), &doc); err != nil {
+			return nil, fmt.Errorf("document %d: %v", i+1, err)
+		}
+		if err := validateDocument(&doc); err != nil {
+			return nil, fmt.Errorf("document %d: %v", i+1, err)
+		}
+		key := doc.Key()
+		if seen[key] {
+			return nil, fmt.Errorf("document %d: '%s' is defined more than once", i+1, key)
+		}
+		seen[key] = true
+		state.Resources = append(state.Resources, doc)
+	}
+	if state.Cluster == nil && len(state.Resources) == 0 {
+		return nil, fmt.Errorf("no documents found")
+	}
+	return state, nil
+}
+
+// Key returns the '<kind>/<name>' identifier of the document.
+func (d *Document) Key() string {
+	return fmt.Sprintf("%s/%s", d.Kind, d.Metadata.Name)
+}
+
+func validateDocument(doc *Document) error {
+	if doc.APIVersion != APIVersion {
+		return fmt.Errorf("unsupported apiVersion '%s', expected '%s'", doc.APIVersion, APIVersion)
+	}
+	h, ok := handlers[doc.Kind]
+	if !ok {
+		return fmt.Errorf("unsupported kind '%s', expected one of %s", doc.Kind, Kinds())
+	}
+	if h.singleton {
+		if doc.Metadata.Name != "" && doc.Metadata.Name != singletonName {
+			return fmt.Errorf("'%s' is a singleton, 'metadata.name' must be empty or '%s'", doc.Kind, singletonName)
+		}
+		doc.Metadata.Name = singletonName
+	}
+	if doc.Metadata.Name == "" {
+		return fmt.Errorf("'metadata.name' is required for kind '%s'", doc.Kind)
+	}
+	if doc.Spec == nil {
+		doc.Spec = map[string]interface{}{}
+	}
+	// Round trip through JSON so that values compare equal to the ones returned by OCM
+	data, err := json.Marshal(doc.Spec)
+	if err != nil {
+		return err
+	}
+	doc.Spec = map[string]interface{}{}
+	return json.Unmarshal(data, &doc.Spec)
+}
+
+func isEmptyDocument(raw string) bool {
+	for _, line := range strings.Split(raw, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			return false
+		}
+	}
+	return true
+}
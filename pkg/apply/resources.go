@@ -0,0 +1,357 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apply
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"sort"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+// Name used for kinds that exist at most once per cluster
+const singletonName = "cluster"
+
+// object is the current state of a resource in OCM.
+type object struct {
+	id     string
+	fields map[string]interface{}
+}
+
+// handler knows how to read and modify the resources of one kind. A nil 'create', 'update' or 'delete'
+// function means that resources of the kind can't be created, modified or deleted individually.
+type handler struct {
+	singleton bool
+	list      func(ctx context.Context, c *ocm.Client, clusterID string) (map[string]object, error)
+	create    func(ctx context.Context, c *ocm.Client, clusterID string, name string, spec []byte) error
+	update    func(ctx context.Context, c *ocm.Client, clusterID string, current object, name string,
+		spec []byte) error
+	delete func(ctx context.Context, c *ocm.Client, clusterID string, current object) error
+}
+
+var handlers = map[string]*handler{
+	KindMachinePool: {
+		list: func(ctx context.Context, c *ocm.Client, clusterID string) (map[string]object, error) {
+			items, err := c.GetMachinePools(clusterID)
+			if err != nil {
+				return nil, err
+			}
+			return collect(items, func(i *cmv1.MachinePool) string { return i.ID() },
+				func(i *cmv1.MachinePool) string { return i.ID() }, cmv1.MarshalMachinePool)
+		},
+		create: func(ctx context.Context, c *ocm.Client, clusterID string, name string, spec []byte) error {
+			item, err := decode(spec, map[string]interface{}{"id": name}, cmv1.UnmarshalMachinePool)
+			if err == nil {
+				_, err = c.CreateMachinePool(clusterID, item)
+			}
+			return err
+		},
+		update: func(ctx context.Context, c *ocm.Client, clusterID string, current object, name string,
+			spec []byte) error {
+			item, err := decode(spec, map[string]interface{}{"id": current.id}, cmv1.UnmarshalMachinePool)
+			if err == nil {
+				_, err = c.UpdateMachinePool(clusterID, item)
+			}
+			return err
+		},
+		delete: func(ctx context.Context, c *ocm.Client, clusterID string, current object) error {
+			return c.DeleteMachinePool(clusterID, current.id)
+		},
+	},
+	KindNodePool: {
+		list: func(ctx context.Context, c *ocm.Client, clusterID string) (map[string]object, error) {
+			items, err := c.GetNodePools(clusterID)
+			if err != nil {
+				return nil, err
+			}
+			return collect(items, func(i *cmv1.NodePool) string { return i.ID() },
+				func(i *cmv1.NodePool) string { return i.ID() }, cmv1.MarshalNodePool)
+		},
+		create: func(ctx context.Context, c *ocm.Client, clusterID string, name string, spec []byte) error {
+			item, err := decode(spec, map[string]interface{}{"id": name}, cmv1.UnmarshalNodePool)
+			if err == nil {
+				_, err = c.CreateNodePool(clusterID, item)
+			}
+			return err
+		},
+		update: func(ctx context.Context, c *ocm.Client, clusterID string, current object, name string,
+			spec []byte) error {
+			item, err := decode(spec, map[string]interface{}{"id": current.id}, cmv1.UnmarshalNodePool)
+			if err == nil {
+				_, err = c.UpdateNodePool(clusterID, item)
+			}
+			return err
+		},
+		delete: func(ctx context.Context, c *ocm.Client, clusterID string, current object) error {
+			return c.DeleteNodePool(clusterID, current.id)
+		},
+	},
+	KindIdentityProvider: {
+		list: func(ctx context.Context, c *ocm.Client, clusterID string) (map[string]object, error) {
+			items, err := c.GetIdentityProviders(clusterID)
+			if err != nil {
+				return nil, err
+			}
+			return collect(items, func(i *cmv1.IdentityProvider) string { return i.Name() },
+				func(i *cmv1.IdentityProvider) string { return i.ID() }, cmv1.MarshalIdentityProvider)
+		},
+		create: func(ctx context.Context, c *ocm.Client, clusterID string, name string, spec []byte) error {
+			item, err := decode(spec, map[string]interface{}{"name": name}, cmv1.UnmarshalIdentityProvider)
+			if err == nil {
+				_, err = c.CreateIdentityProvider(clusterID, item)
+			}
+			return err
+		},
+		update: func(ctx context.Context, c *ocm.Client, clusterID string, current object, name string,
+			spec []byte) error {
+			item, err := decode(spec, map[string]interface{}{"id": current.id, "name": name},
+				cmv1.UnmarshalIdentityProvider)
+			if err == nil {
+				_, err = c.UpdateIdentityProvider(clusterID, item)
+			}
+			return err
+		},
+		delete: func(ctx context.Context, c *ocm.Client, clusterID string, current object) error {
+			return c.DeleteIdentityProvider(clusterID, current.id)
+		},
+	},
+	KindIngress: {
+		// Ingresses are identified by their ID, or 'default' for the default ingress. New ingresses get a
+		// generated ID, so they can't be created from a desired state file.
+		list: func(ctx context.Context, c *ocm.Client, clusterID string) (map[string]object, error) {
+			items, err := c.GetIngresses(clusterID)
+			if err != nil {
+				return nil, err
+			}
+			return collect(items, func(i *cmv1.Ingress) string {
+				if i.Default() {
+					return "default"
+				}
+				return i.ID()
+			}, func(i *cmv1.Ingress) string { return i.ID() }, cmv1.MarshalIngress)
+		},
+		update: func(ctx context.Context, c *ocm.Client, clusterID string, current object, name string,
+			spec []byte) error {
+			item, err := decode(spec, map[string]interface{}{"id": current.id}, cmv1.UnmarshalIngress)
+			if err == nil {
+				_, err = c.UpdateIngress(clusterID, item)
+			}
+			return err
+		},
+		delete: func(ctx context.Context, c *ocm.Client, clusterID string, current object) error {
+			return c.DeleteIngress(clusterID, current.id)
+		},
+	},
+	KindKubeletConfig: {
+		list: func(ctx context.Context, c *ocm.Client, clusterID string) (map[string]object, error) {
+			items, err := c.ListKubeletConfigs(ctx, clusterID)
+			if err != nil {
+				return nil, err
+			}
+			return collect(items, func(i *cmv1.KubeletConfig) string { return i.Name() },
+				func(i *cmv1.KubeletConfig) string { return i.ID() }, cmv1.MarshalKubeletConfig)
+		},
+		create: func(ctx context.Context, c *ocm.Client, clusterID string, name string, spec []byte) error {
+			item, err := decode(spec, nil, cmv1.UnmarshalKubeletConfig)
+			if err == nil {
+				_, err = c.CreateKubeletConfig(clusterID, ocm.KubeletConfigArgs{
+					Name:         name,
+					PodPidsLimit: item.PodPidsLimit(),
+				})
+			}
+			return err
+		},
+		update: func(ctx context.Context, c *ocm.Client, clusterID string, current object, name string,
+			spec []byte) error {
+			item, err := decode(spec, nil, cmv1.UnmarshalKubeletConfig)
+			if err == nil {
+				_, err = c.UpdateKubeletConfig(ctx, clusterID, current.id, ocm.KubeletConfigArgs{
+					Name:         name,
+					PodPidsLimit: item.PodPidsLimit(),
+				})
+			}
+			return err
+		},
+		delete: func(ctx context.Context, c *ocm.Client, clusterID string, current object) error {
+			name, _ := current.fields["name"].(string)
+			return c.DeleteKubeletConfigByName(ctx, clusterID, name)
+		},
+	},
+	KindTuningConfig: {
+		list: func(ctx context.Context, c *ocm.Client, clusterID string) (map[string]object, error) {
+			items, err := c.GetTuningConfigs(clusterID)
+			if err != nil {
+				return nil, err
+			}
+			return collect(items, func(i *cmv1.TuningConfig) string { return i.Name() },
+				func(i *cmv1.TuningConfig) string { return i.ID() }, cmv1.MarshalTuningConfig)
+		},
+		create: func(ctx context.Context, c *ocm.Client, clusterID string, name string, spec []byte) error {
+			item, err := decode(spec, map[string]interface{}{"name": name}, cmv1.UnmarshalTuningConfig)
+			if err == nil {
+				_, err = c.CreateTuningConfig(clusterID, item)
+			}
+			return err
+		},
+		update: func(ctx context.Context, c *ocm.Client, clusterID string, current object, name string,
+			spec []byte) error {
+			item, err := decode(spec, map[string]interface{}{"id": current.id, "name": name},
+				cmv1.UnmarshalTuningConfig)
+			if err == nil {
+				_, err = c.UpdateTuningConfig(clusterID, item)
+			}
+			return err
+		},
+		delete: func(ctx context.Context, c *ocm.Client, clusterID string, current object) error {
+			return c.DeleteTuningConfig(clusterID, current.id)
+		},
+	},
+	KindClusterAutoscaler: {
+		singleton: true,
+		list: func(ctx context.Context, c *ocm.Client, clusterID string) (map[string]object, error) {
+			item, err := c.GetClusterAutoscaler(clusterID)
+			if err != nil || item == nil {
+				return map[string]object{}, err
+			}
+			return collect([]*cmv1.ClusterAutoscaler{item},
+				func(i *cmv1.ClusterAutoscaler) string { return singletonName },
+				func(i *cmv1.ClusterAutoscaler) string { return singletonName }, cmv1.MarshalClusterAutoscaler)
+		},
+		create: func(ctx context.Context, c *ocm.Client, clusterID string, name string, spec []byte) error {
+			item, err := decode(spec, nil, cmv1.UnmarshalClusterAutoscaler)
+			if err == nil {
+				_, err = c.PostClusterAutoscaler(clusterID, item)
+			}
+			return err
+		},
+		update: func(ctx context.Context, c *ocm.Client, clusterID string, current object, name string,
+			spec []byte) error {
+			item, err := decode(spec, nil, cmv1.UnmarshalClusterAutoscaler)
+			if err == nil {
+				_, err = c.PatchClusterAutoscaler(clusterID, item)
+			}
+			return err
+		},
+		delete: func(ctx context.Context, c *ocm.Client, clusterID string, current object) error {
+			return c.DeleteClusterAutoscaler(clusterID)
+		},
+	},
+	KindExternalAuthProvider: {
+		list: func(ctx context.Context, c *ocm.Client, clusterID string) (map[string]object, error) {
+			items, err := c.GetExternalAuths(clusterID)
+			if err != nil {
+				return nil, err
+			}
+			return collect(items, func(i *cmv1.ExternalAuth) string { return i.ID() },
+				func(i *cmv1.ExternalAuth) string { return i.ID() }, cmv1.MarshalExternalAuth)
+		},
+		create: func(ctx context.Context, c *ocm.Client, clusterID string, name string, spec []byte) error {
+			item, err := decode(spec, map[string]interface{}{"id": name}, cmv1.UnmarshalExternalAuth)
+			if err == nil {
+				_, err = c.CreateExternalAuth(clusterID, item)
+			}
+			return err
+		},
+		update: func(ctx context.Context, c *ocm.Client, clusterID string, current object, name string,
+			spec []byte) error {
+			item, err := decode(spec, map[string]interface{}{"id": current.id}, cmv1.UnmarshalExternalAuth)
+			if err == nil {
+				_, err = c.UpdateExternalAuth(clusterID, item)
+			}
+			return err
+		},
+		delete: func(ctx context.Context, c *ocm.Client, clusterID string, current object) error {
+			return c.DeleteExternalAuth(clusterID, current.id)
+		},
+	},
+	KindBreakGlassCredential: {
+		// Break glass credentials are identified by their username. They are immutable and can only be
+		// revoked all at once, so existing credentials are never updated or pruned.
+		list: func(ctx context.Context, c *ocm.Client, clusterID string) (map[string]object, error) {
+			items, err := c.GetBreakGlassCredentials(clusterID)
+			if err != nil {
+				return nil, err
+			}
+			active := []*cmv1.BreakGlassCredential{}
+			for _, item := range items {
+				switch item.Status() {
+				case cmv1.BreakGlassCredentialStatusCreated, cmv1.BreakGlassCredentialStatusIssued:
+					active = append(active, item)
+				}
+			}
+			return collect(active, func(i *cmv1.BreakGlassCredential) string { return i.Username() },
+				func(i *cmv1.BreakGlassCredential) string { return i.ID() }, cmv1.MarshalBreakGlassCredential)
+		},
+		create: func(ctx context.Context, c *ocm.Client, clusterID string, name string, spec []byte) error {
+			item, err := decode(spec, map[string]interface{}{"username": name}, cmv1.UnmarshalBreakGlassCredential)
+			if err == nil {
+				_, err = c.CreateBreakGlassCredential(clusterID, item)
+			}
+			return err
+		},
+	},
+}
+
+// Kinds returns the sorted list of kinds supported in desired state files.
+func Kinds() []string {
+	kinds := make([]string, 0, len(handlers))
+	for kind := range handlers {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// collect converts OCM objects into their JSON representation, keyed by the name used in documents.
+func collect[T any](items []*T, name func(*T) string, id func(*T) string,
+	marshal func(*T, io.Writer) error) (map[string]object, error) {
+	result := map[string]object{}
+	for _, item := range items {
+		var b bytes.Buffer
+		if err := marshal(item, &b); err != nil {
+			return nil, err
+		}
+		fields := map[string]interface{}{}
+		if err := json.Unmarshal(b.Bytes(), &fields); err != nil {
+			return nil, err
+		}
+		result[name(item)] = object{id: id(item), fields: fields}
+	}
+	return result, nil
+}
+
+// decode builds an OCM object from the JSON spec of a document, overriding the identifying fields.
+func decode[T any](spec []byte, identity map[string]interface{},
+	unmarshal func(interface{}) (*T, error)) (*T, error) {
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(spec, &fields); err != nil {
+		return nil, err
+	}
+	for k, v := range identity {
+		fields[k] = v
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshal(data)
+}
@@ -29,6 +29,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/aws/profile"
 	"github.com/openshift/rosa/pkg/aws/region"
+	"github.com/openshift/rosa/pkg/config"
 	"github.com/openshift/rosa/pkg/debug"
 )
 
@@ -294,6 +295,11 @@ func AddDebugFlag(fs *pflag.FlagSet) {
 	debug.AddFlag(fs)
 }
 
+// AddContextFlag adds the '--context' flag to the given set of command line flags.
+func AddContextFlag(fs *pflag.FlagSet) {
+	config.AddContextFlag(fs)
+}
+
 // AddProfileFlag adds the '--profile' flag to the given set of command line flags.
 func AddProfileFlag(fs *pflag.FlagSet) {
 	profile.AddFlag(fs)
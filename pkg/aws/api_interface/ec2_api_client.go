@@ -39,6 +39,24 @@ type Ec2ApiClient interface {
 	DescribeInstanceTypeOfferings(ctx context.Context,
 		params *ec2.DescribeInstanceTypeOfferingsInput, optFns ...func(*ec2.Options),
 	) (*ec2.DescribeInstanceTypeOfferingsOutput, error)
+
+	DescribeInstanceTypes(ctx context.Context, params *ec2.DescribeInstanceTypesInput, optFns ...func(*ec2.Options),
+	) (*ec2.DescribeInstanceTypesOutput, error)
+
+	DescribeInstances(ctx context.Context, params *ec2.DescribeInstancesInput, optFns ...func(*ec2.Options),
+	) (*ec2.DescribeInstancesOutput, error)
+
+	DescribeAddresses(ctx context.Context, params *ec2.DescribeAddressesInput, optFns ...func(*ec2.Options),
+	) (*ec2.DescribeAddressesOutput, error)
+
+	DescribeNatGateways(ctx context.Context, params *ec2.DescribeNatGatewaysInput, optFns ...func(*ec2.Options),
+	) (*ec2.DescribeNatGatewaysOutput, error)
+
+	DescribeVolumes(ctx context.Context, params *ec2.DescribeVolumesInput, optFns ...func(*ec2.Options),
+	) (*ec2.DescribeVolumesOutput, error)
+
+	DescribeVpcs(ctx context.Context, params *ec2.DescribeVpcsInput, optFns ...func(*ec2.Options),
+	) (*ec2.DescribeVpcsOutput, error)
 }
 
 // interface guard to ensure that all methods defined in the Ec2ApiClient
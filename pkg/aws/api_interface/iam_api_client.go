@@ -152,6 +152,10 @@ type IamApiClient interface {
 		params *iam.PutRolePolicyInput, optFns ...func(*iam.Options),
 	) (*iam.PutRolePolicyOutput, error)
 
+	SimulatePrincipalPolicy(ctx context.Context,
+		params *iam.SimulatePrincipalPolicyInput, optFns ...func(*iam.Options),
+	) (*iam.SimulatePrincipalPolicyOutput, error)
+
 	TagPolicy(ctx context.Context,
 		params *iam.TagPolicyInput, optFns ...func(*iam.Options),
 	) (*iam.TagPolicyOutput, error)
@@ -22,7 +22,6 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"os"
 	"strings"
 	"time"
 
@@ -55,6 +54,7 @@ import (
 	"github.com/openshift/rosa/pkg/aws/profile"
 	regionflag "github.com/openshift/rosa/pkg/aws/region"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/dryrun"
 	"github.com/openshift/rosa/pkg/fedramp"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/info"
@@ -110,8 +110,11 @@ type Client interface {
 	AccessKeyGetter
 	GetCreator() (*Creator, error)
 	ValidateSCP(*string, map[string]*cmv1.AWSSTSPolicy) (bool, error)
+	SimulatePermissions(principalARN string, actions []string) ([]SimulationResult, error)
 	ListSubnets(subnetIds ...string) ([]ec2types.Subnet, error)
 	GetSubnetAvailabilityZone(subnetID string) (string, error)
+	GetVPCCIDRs(vpcID string) ([]string, error)
+	ListVPCRoutes(vpcID string) ([]Route, error)
 	GetAvailabilityZoneType(availabilityZoneName string) (string, error)
 	GetVPCSubnets(subnetID string) ([]ec2types.Subnet, error)
 	GetVPCPrivateSubnets(subnetID string) ([]ec2types.Subnet, error)
@@ -123,6 +126,8 @@ type Client interface {
 		version string, tagList map[string]string, path string, managedPolicies bool) (string, error)
 	ValidateRoleNameAvailable(name string) (err error)
 	PutRolePolicy(roleName string, policyName string, policy string) error
+	UpdateTrustPolicy(roleName string, policy string) error
+	UpdatePolicyDocument(policyArn string, document string) error
 	ForceEnsurePolicy(policyArn string, document string, version string, tagList map[string]string,
 		path string) (string, error)
 	EnsurePolicy(policyArn string, document string, version string, tagList map[string]string,
@@ -213,6 +218,12 @@ type Client interface {
 	GetSecurityGroupIds(vpcId string) ([]ec2types.SecurityGroup, error)
 	FetchPublicSubnetMap(subnets []ec2types.Subnet) (map[string]bool, error)
 	GetIAMServiceQuota(quotaCode string) (*servicequotas.GetServiceQuotaOutput, error)
+	GetServiceQuotaValue(serviceCode string, quotaCode string) (float64, error)
+	GetInstanceTypeVCPUs(instanceTypes []string) (map[string]int, error)
+	GetRunningInstanceVCPUs() (map[string]int, error)
+	CountElasticIPs() (int, error)
+	CountNatGatewaysByZone() (map[string]int, error)
+	GetVolumeStorage(volumeType string) (int, error)
 	GetAccountRoleDefaultPolicy(roleName string, prefix string) (string, error)
 	GetOperatorRoleDefaultPolicy(roleName string) (string, error)
 }
@@ -251,8 +262,7 @@ func CreateNewClientOrExit(logger *logrus.Logger, reporter *reporter.Object) Cli
 		Logger(logger).
 		Build()
 	if err != nil {
-		reporter.Errorf("Failed to create AWS client: %v", err)
-		os.Exit(1)
+		reporter.ExitWithError(fmt.Errorf("Failed to create AWS client: %w", err))
 	}
 
 	return awsClient
@@ -351,8 +361,15 @@ func (b *ClientBuilder) BuildSessionWithOptionsCredentials(value *AccessKey,
 }
 
 func (b *ClientBuilder) BuildSessionWithOptions(logLevel aws.ClientLogMode) (aws.Config, error) {
+	// The responses played back from a cassette don't need real credentials, so that they can be
+	// played back without an AWS account:
+	var credentialsProvider aws.CredentialsProvider
+	if logging.ReplayDir() != "" {
+		credentialsProvider = credentials.NewStaticCredentialsProvider("replay", "replay", "")
+	}
 	cfg, err := config.LoadDefaultConfig(context.TODO(),
 		config.WithSharedConfigProfile(profile.Profile()),
+		config.WithCredentialsProvider(credentialsProvider),
 		config.WithRegion(*b.region),
 		config.WithHTTPClient(awshttp.NewBuildableClient().WithTransportOptions()),
 		config.WithClientLogMode(logLevel),
@@ -378,6 +395,40 @@ func (b *ClientBuilder) BuildSessionWithOptions(logLevel aws.ClientLogMode) (aws
 	return cfg, nil
 }
 
+// cassetteHTTPClient returns an HTTP client that saves the requests sent with the given client and
+// the responses received to the AWS cassette, or that plays them back from it. The given client is
+// returned unchanged if the requests aren't being recorded or played back.
+func (b *ClientBuilder) cassetteHTTPClient(client aws.HTTPClient) (aws.HTTPClient, error) {
+	cassette, err := logging.OpenCassette("aws")
+	if err != nil || cassette == nil {
+		return client, err
+	}
+	recorder, err := logging.NewRoundTripper().
+		Logger(b.logger).
+		Redact("AccessKeyId").
+		Redact("SecretAccessKey").
+		Redact("SessionToken").
+		Redact("SecretString").
+		Redact("SecretBinary").
+		Redact("Password").
+		Cassette(cassette).
+		Next(&httpClientTransport{client: client}).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+	return recorder, nil
+}
+
+// httpClientTransport sends the requests of a round tripper with the HTTP client of the AWS SDK.
+type httpClientTransport struct {
+	client aws.HTTPClient
+}
+
+func (t *httpClientTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	return t.client.Do(request)
+}
+
 func (b *ClientBuilder) BuildSession() (aws.Config, error) {
 	var logLevel aws.ClientLogMode
 	logLevel = 0
@@ -439,6 +490,23 @@ func (b *ClientBuilder) Build() (Client, error) {
 	}
 	iamCfg.Region = IAMServiceRegion
 
+	// The requests and responses are saved to a cassette, or played back from it, when the
+	// ROSA_RECORD or ROSA_REPLAY environment variable is set:
+	cfg.HTTPClient, err = b.cassetteHTTPClient(cfg.HTTPClient)
+	if err != nil {
+		return nil, err
+	}
+	iamCfg.HTTPClient, err = b.cassetteHTTPClient(iamCfg.HTTPClient)
+	if err != nil {
+		return nil, err
+	}
+
+	// In dry run mode the requests that would change something are recorded instead of sent:
+	if dryrun.Enabled() {
+		cfg.HTTPClient = dryrun.NewAWSHTTPClient(cfg.HTTPClient)
+		iamCfg.HTTPClient = dryrun.NewAWSHTTPClient(iamCfg.HTTPClient)
+	}
+
 	// Create and populate the object:
 	c := &awsClient{
 		cfg:                 cfg,
@@ -455,10 +523,13 @@ func (b *ClientBuilder) Build() (Client, error) {
 		useLocalCredentials: b.useLocalCredentials,
 	}
 
-	_, root, err := getClientDetails(c)
+	user, root, err := getClientDetails(c)
 	if err != nil {
 		return nil, err
 	}
+	if dryrun.Enabled() {
+		dryrun.SetAccountID(aws.ToString(user.Account))
+	}
 
 	if root {
 		return nil, errors.New("using a root account is not supported, please use an IAM user instead")
@@ -541,6 +612,80 @@ func (c *awsClient) ListSubnets(subnetIds ...string) ([]ec2types.Subnet, error)
 	})
 }
 
+// GetVPCCIDRs returns the IPv4 CIDR blocks associated to the VPC.
+func (c *awsClient) GetVPCCIDRs(vpcID string) ([]string, error) {
+	res, err := c.ec2Client.DescribeVpcs(context.Background(), &ec2.DescribeVpcsInput{
+		VpcIds: []string{vpcID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Vpcs) < 1 {
+		return nil, fmt.Errorf("failed to get VPC with ID '%s'", vpcID)
+	}
+	cidrs := []string{}
+	for _, association := range res.Vpcs[0].CidrBlockAssociationSet {
+		if association.CidrBlockState != nil &&
+			association.CidrBlockState.State != ec2types.VpcCidrBlockStateCodeAssociated {
+			continue
+		}
+		cidrs = append(cidrs, aws.ToString(association.CidrBlock))
+	}
+	return cidrs, nil
+}
+
+// ListVPCRoutes returns the IPv4 routes of the route tables of the VPC that go out of it, for example to
+// peered VPCs, transit gateways or VPN gateways. Local routes and default routes are skipped.
+func (c *awsClient) ListVPCRoutes(vpcID string) ([]Route, error) {
+	res, err := c.ec2Client.DescribeRouteTables(context.Background(), &ec2.DescribeRouteTablesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{vpcID},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	routes := []Route{}
+	seen := map[Route]bool{}
+	for _, routeTable := range res.RouteTables {
+		for _, route := range routeTable.Routes {
+			destination := aws.ToString(route.DestinationCidrBlock)
+			target := routeTarget(route)
+			if destination == "" || destination == "0.0.0.0/0" || target == "local" {
+				continue
+			}
+			r := Route{Destination: destination, Target: target}
+			if !seen[r] {
+				seen[r] = true
+				routes = append(routes, r)
+			}
+		}
+	}
+	return routes, nil
+}
+
+func routeTarget(route ec2types.Route) string {
+	for _, target := range []*string{
+		route.VpcPeeringConnectionId,
+		route.TransitGatewayId,
+		route.GatewayId,
+		route.NatGatewayId,
+		route.NetworkInterfaceId,
+		route.InstanceId,
+		route.LocalGatewayId,
+		route.CarrierGatewayId,
+		route.CoreNetworkArn,
+	} {
+		if aws.ToString(target) != "" {
+			return aws.ToString(target)
+		}
+	}
+	return ""
+}
+
 func (c *awsClient) GetSubnetAvailabilityZone(subnetID string) (string, error) {
 	res, err := c.ec2Client.DescribeSubnets(
 		context.Background(),
@@ -697,6 +842,12 @@ func (c *awsClient) getSubnetIDs(describeSubnetsInput *ec2.DescribeSubnetsInput)
 	return res.Subnets, nil
 }
 
+// Route is a route of a VPC to a network outside of it.
+type Route struct {
+	Destination string `json:"destination"`
+	Target      string `json:"target"`
+}
+
 type Creator struct {
 	ARN        string
 	AccountID  string
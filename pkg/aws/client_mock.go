@@ -133,6 +133,36 @@ func (mr *MockClientMockRecorder) CheckStackReadyOrNotExisting(stackName any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckStackReadyOrNotExisting", reflect.TypeOf((*MockClient)(nil).CheckStackReadyOrNotExisting), stackName)
 }
 
+// CountElasticIPs mocks base method.
+func (m *MockClient) CountElasticIPs() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountElasticIPs")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountElasticIPs indicates an expected call of CountElasticIPs.
+func (mr *MockClientMockRecorder) CountElasticIPs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountElasticIPs", reflect.TypeOf((*MockClient)(nil).CountElasticIPs))
+}
+
+// CountNatGatewaysByZone mocks base method.
+func (m *MockClient) CountNatGatewaysByZone() (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountNatGatewaysByZone")
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountNatGatewaysByZone indicates an expected call of CountNatGatewaysByZone.
+func (mr *MockClientMockRecorder) CountNatGatewaysByZone() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountNatGatewaysByZone", reflect.TypeOf((*MockClient)(nil).CountNatGatewaysByZone))
+}
+
 // CreateOpenIDConnectProvider mocks base method.
 func (m *MockClient) CreateOpenIDConnectProvider(issuerURL, thumbprint, clusterID string) (string, error) {
 	m.ctrl.T.Helper()
@@ -752,6 +782,21 @@ func (mr *MockClientMockRecorder) GetInstanceProfilesForRole(role any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceProfilesForRole", reflect.TypeOf((*MockClient)(nil).GetInstanceProfilesForRole), role)
 }
 
+// GetInstanceTypeVCPUs mocks base method.
+func (m *MockClient) GetInstanceTypeVCPUs(instanceTypes []string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceTypeVCPUs", instanceTypes)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceTypeVCPUs indicates an expected call of GetInstanceTypeVCPUs.
+func (mr *MockClientMockRecorder) GetInstanceTypeVCPUs(instanceTypes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceTypeVCPUs", reflect.TypeOf((*MockClient)(nil).GetInstanceTypeVCPUs), instanceTypes)
+}
+
 // GetLocalAWSAccessKeys mocks base method.
 func (m *MockClient) GetLocalAWSAccessKeys() (*AccessKey, error) {
 	m.ctrl.T.Helper()
@@ -917,6 +962,21 @@ func (mr *MockClientMockRecorder) GetRoleByName(roleName any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleByName", reflect.TypeOf((*MockClient)(nil).GetRoleByName), roleName)
 }
 
+// GetRunningInstanceVCPUs mocks base method.
+func (m *MockClient) GetRunningInstanceVCPUs() (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRunningInstanceVCPUs")
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRunningInstanceVCPUs indicates an expected call of GetRunningInstanceVCPUs.
+func (mr *MockClientMockRecorder) GetRunningInstanceVCPUs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRunningInstanceVCPUs", reflect.TypeOf((*MockClient)(nil).GetRunningInstanceVCPUs))
+}
+
 // GetSecurityGroupIds mocks base method.
 func (m *MockClient) GetSecurityGroupIds(vpcId string) ([]types.SecurityGroup, error) {
 	m.ctrl.T.Helper()
@@ -932,6 +992,21 @@ func (mr *MockClientMockRecorder) GetSecurityGroupIds(vpcId any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityGroupIds", reflect.TypeOf((*MockClient)(nil).GetSecurityGroupIds), vpcId)
 }
 
+// GetServiceQuotaValue mocks base method.
+func (m *MockClient) GetServiceQuotaValue(serviceCode string, quotaCode string) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceQuotaValue", serviceCode, quotaCode)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceQuotaValue indicates an expected call of GetServiceQuotaValue.
+func (mr *MockClientMockRecorder) GetServiceQuotaValue(serviceCode, quotaCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceQuotaValue", reflect.TypeOf((*MockClient)(nil).GetServiceQuotaValue), serviceCode, quotaCode)
+}
+
 // GetSubnetAvailabilityZone mocks base method.
 func (m *MockClient) GetSubnetAvailabilityZone(subnetID string) (string, error) {
 	m.ctrl.T.Helper()
@@ -947,6 +1022,21 @@ func (mr *MockClientMockRecorder) GetSubnetAvailabilityZone(subnetID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetAvailabilityZone", reflect.TypeOf((*MockClient)(nil).GetSubnetAvailabilityZone), subnetID)
 }
 
+// GetVPCCIDRs mocks base method.
+func (m *MockClient) GetVPCCIDRs(vpcID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVPCCIDRs", vpcID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVPCCIDRs indicates an expected call of GetVPCCIDRs.
+func (mr *MockClientMockRecorder) GetVPCCIDRs(vpcID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPCCIDRs", reflect.TypeOf((*MockClient)(nil).GetVPCCIDRs), vpcID)
+}
+
 // GetVPCPrivateSubnets mocks base method.
 func (m *MockClient) GetVPCPrivateSubnets(subnetID string) ([]types.Subnet, error) {
 	m.ctrl.T.Helper()
@@ -977,6 +1067,21 @@ func (mr *MockClientMockRecorder) GetVPCSubnets(subnetID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPCSubnets", reflect.TypeOf((*MockClient)(nil).GetVPCSubnets), subnetID)
 }
 
+// GetVolumeStorage mocks base method.
+func (m *MockClient) GetVolumeStorage(volumeType string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeStorage", volumeType)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeStorage indicates an expected call of GetVolumeStorage.
+func (mr *MockClientMockRecorder) GetVolumeStorage(volumeType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeStorage", reflect.TypeOf((*MockClient)(nil).GetVolumeStorage), volumeType)
+}
+
 // HasHostedCPPolicies mocks base method.
 func (m *MockClient) HasHostedCPPolicies(roleARN string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -1296,6 +1401,21 @@ func (mr *MockClientMockRecorder) ListUserRoles() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserRoles", reflect.TypeOf((*MockClient)(nil).ListUserRoles))
 }
 
+// ListVPCRoutes mocks base method.
+func (m *MockClient) ListVPCRoutes(vpcID string) ([]Route, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVPCRoutes", vpcID)
+	ret0, _ := ret[0].([]Route)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVPCRoutes indicates an expected call of ListVPCRoutes.
+func (mr *MockClientMockRecorder) ListVPCRoutes(vpcID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCRoutes", reflect.TypeOf((*MockClient)(nil).ListVPCRoutes), vpcID)
+}
+
 // PutPublicReadObjectInS3Bucket mocks base method.
 func (m *MockClient) PutPublicReadObjectInS3Bucket(bucketName string, body io.ReadSeeker, key string) error {
 	m.ctrl.T.Helper()
@@ -1324,6 +1444,21 @@ func (mr *MockClientMockRecorder) PutRolePolicy(roleName, policyName, policy any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRolePolicy", reflect.TypeOf((*MockClient)(nil).PutRolePolicy), roleName, policyName, policy)
 }
 
+// SimulatePermissions mocks base method.
+func (m *MockClient) SimulatePermissions(principalARN string, actions []string) ([]SimulationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulatePermissions", principalARN, actions)
+	ret0, _ := ret[0].([]SimulationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulatePermissions indicates an expected call of SimulatePermissions.
+func (mr *MockClientMockRecorder) SimulatePermissions(principalARN, actions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulatePermissions", reflect.TypeOf((*MockClient)(nil).SimulatePermissions), principalARN, actions)
+}
+
 // TagUserRegion mocks base method.
 func (m *MockClient) TagUserRegion(username, region string) error {
 	m.ctrl.T.Helper()
@@ -1338,6 +1473,20 @@ func (mr *MockClientMockRecorder) TagUserRegion(username, region any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagUserRegion", reflect.TypeOf((*MockClient)(nil).TagUserRegion), username, region)
 }
 
+// UpdatePolicyDocument mocks base method.
+func (m *MockClient) UpdatePolicyDocument(policyArn, document string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePolicyDocument", policyArn, document)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePolicyDocument indicates an expected call of UpdatePolicyDocument.
+func (mr *MockClientMockRecorder) UpdatePolicyDocument(policyArn, document any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePolicyDocument", reflect.TypeOf((*MockClient)(nil).UpdatePolicyDocument), policyArn, document)
+}
+
 // UpdateTag mocks base method.
 func (m *MockClient) UpdateTag(roleName, defaultPolicyVersion string) error {
 	m.ctrl.T.Helper()
@@ -1352,6 +1501,20 @@ func (mr *MockClientMockRecorder) UpdateTag(roleName, defaultPolicyVersion any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTag", reflect.TypeOf((*MockClient)(nil).UpdateTag), roleName, defaultPolicyVersion)
 }
 
+// UpdateTrustPolicy mocks base method.
+func (m *MockClient) UpdateTrustPolicy(roleName, policy string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTrustPolicy", roleName, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTrustPolicy indicates an expected call of UpdateTrustPolicy.
+func (mr *MockClientMockRecorder) UpdateTrustPolicy(roleName, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTrustPolicy", reflect.TypeOf((*MockClient)(nil).UpdateTrustPolicy), roleName, policy)
+}
+
 // ValidateAccountRoleVersionCompatibility mocks base method.
 func (m *MockClient) ValidateAccountRoleVersionCompatibility(roleName, roleType, minVersion string) (bool, error) {
 	m.ctrl.T.Helper()
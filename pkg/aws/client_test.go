@@ -752,3 +752,69 @@ var _ = Describe("Client", func() {
 		)
 	})
 })
+
+var _ = Describe("VPC networks", func() {
+	var (
+		client     awsClient
+		mockEC2API *mocks.MockEc2ApiClient
+	)
+
+	BeforeEach(func() {
+		mockEC2API = mocks.NewMockEc2ApiClient(gomock.NewController(GinkgoT()))
+		client = awsClient{
+			ec2Client: mockEC2API,
+		}
+	})
+
+	It("Returns the associated CIDR blocks of the VPC", func() {
+		mockEC2API.EXPECT().DescribeVpcs(gomock.Any(), gomock.Any()).Return(&ec2.DescribeVpcsOutput{
+			Vpcs: []ec2types.Vpc{{
+				CidrBlockAssociationSet: []ec2types.VpcCidrBlockAssociation{
+					{
+						CidrBlock:      awsSdk.String("10.0.0.0/16"),
+						CidrBlockState: &ec2types.VpcCidrBlockState{State: ec2types.VpcCidrBlockStateCodeAssociated},
+					},
+					{
+						CidrBlock:      awsSdk.String("10.1.0.0/16"),
+						CidrBlockState: &ec2types.VpcCidrBlockState{State: ec2types.VpcCidrBlockStateCodeDisassociated},
+					},
+				},
+			}},
+		}, nil)
+
+		cidrs, err := client.GetVPCCIDRs("vpc-1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cidrs).To(Equal([]string{"10.0.0.0/16"}))
+	})
+
+	It("Returns the routes that leave the VPC once", func() {
+		route := func(destination string, gateway string, peering string) ec2types.Route {
+			return ec2types.Route{
+				DestinationCidrBlock:   awsSdk.String(destination),
+				GatewayId:              awsSdk.String(gateway),
+				VpcPeeringConnectionId: awsSdk.String(peering),
+			}
+		}
+		mockEC2API.EXPECT().DescribeRouteTables(gomock.Any(), gomock.Any()).Return(&ec2.DescribeRouteTablesOutput{
+			RouteTables: []ec2types.RouteTable{
+				{Routes: []ec2types.Route{
+					route("10.0.0.0/16", "local", ""),
+					route("0.0.0.0/0", "igw-1", ""),
+					route("192.168.0.0/16", "", "pcx-1"),
+				}},
+				{Routes: []ec2types.Route{
+					route("10.0.0.0/16", "local", ""),
+					route("192.168.0.0/16", "", "pcx-1"),
+					route("172.16.0.0/12", "vgw-1", ""),
+				}},
+			},
+		}, nil)
+
+		routes, err := client.ListVPCRoutes("vpc-1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(routes).To(Equal([]Route{
+			{Destination: "192.168.0.0/16", Target: "pcx-1"},
+			{Destination: "172.16.0.0/12", Target: "vgw-1"},
+		}))
+	})
+})
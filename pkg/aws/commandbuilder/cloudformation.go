@@ -0,0 +1,248 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commandbuilder
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type cfnTemplate struct {
+	AWSTemplateFormatVersion string                  `json:"AWSTemplateFormatVersion"`
+	Description              string                  `json:"Description"`
+	Metadata                 *cfnMetadata            `json:"Metadata,omitempty"`
+	Resources                map[string]*cfnResource `json:"Resources"`
+}
+
+type cfnMetadata struct {
+	// Commands for the resources that can't be expressed in the template
+	ManualCommands []string `json:"ManualCommands"`
+}
+
+type cfnResource struct {
+	Type       string                 `json:"Type"`
+	Properties map[string]interface{} `json:"Properties"`
+}
+
+type cfnTag struct {
+	Key   string `json:"Key"`
+	Value string `json:"Value"`
+}
+
+type cfnRenderer struct {
+	template *cfnTemplate
+	roles    map[string]string
+	policies map[string]string
+	buckets  map[string]string
+	manual   []string
+
+	// Managed policies can't be tagged in a template, so they are tagged with a command
+	policyOps  []*Operation
+	policyARNs map[string]string
+}
+
+func renderCloudFormation(ops []*Operation) (string, error) {
+	c := &cfnRenderer{
+		template: &cfnTemplate{
+			AWSTemplateFormatVersion: "2010-09-09",
+			Description:              "AWS resources for Red Hat OpenShift Service on AWS",
+			Resources:                map[string]*cfnResource{},
+		},
+		roles:      map[string]string{},
+		policies:   map[string]string{},
+		buckets:    map[string]string{},
+		policyARNs: map[string]string{},
+	}
+	for _, op := range ops {
+		ok, err := c.add(op)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			c.manual = append(c.manual, op.String())
+		}
+	}
+	for _, op := range c.policyOps {
+		arn, ok := c.policyARNs[op.Params[PolicyName]]
+		if !ok {
+			arn = policyARN(op)
+		}
+		c.manual = append(c.manual, NewIAMCommandBuilder().
+			SetCommand(TagPolicy).
+			AddParam(PolicyArn, arn).
+			AddTags(op.Tags).
+			Build())
+	}
+	if len(c.manual) > 0 {
+		c.template.Description += ". Run the commands in 'Metadata.ManualCommands' after creating the stack"
+		c.template.Metadata = &cfnMetadata{ManualCommands: c.manual}
+	}
+
+	data, err := json.MarshalIndent(c.template, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (c *cfnRenderer) newResource(kind string, name string) (string, *cfnResource) {
+	base := cfnName(name)
+	id := base
+	for i := 2; c.template.Resources[id] != nil; i++ {
+		id = fmt.Sprintf("%s%d", base, i)
+	}
+	r := &cfnResource{Type: kind, Properties: map[string]interface{}{}}
+	c.template.Resources[id] = r
+	return id, r
+}
+
+// Adds the resources of an operation, returning false if the operation can't be expressed.
+func (c *cfnRenderer) add(op *Operation) (bool, error) {
+	p := op.Params
+	switch {
+	case op.Service == IAM && op.Command == CreateRole:
+		document, err := readDocument(p[AssumeRolePolicyDocument])
+		if err != nil {
+			return false, err
+		}
+		id, r := c.newResource("AWS::IAM::Role", p[RoleName])
+		setProperty(r, "RoleName", p[RoleName])
+		setProperty(r, "Path", p[Path])
+		setProperty(r, "PermissionsBoundary", p[PermissionsBoundary])
+		r.Properties["AssumeRolePolicyDocument"] = document
+		setTags(r, op.Tags)
+		c.roles[p[RoleName]] = id
+	case op.Service == IAM && op.Command == CreatePolicy:
+		document, err := readDocument(p[PolicyDocument])
+		if err != nil {
+			return false, err
+		}
+		id, r := c.newResource("AWS::IAM::ManagedPolicy", p[PolicyName])
+		setProperty(r, "ManagedPolicyName", p[PolicyName])
+		setProperty(r, "Path", p[Path])
+		r.Properties["PolicyDocument"] = document
+		c.policies[p[PolicyName]] = id
+		if len(op.Tags) > 0 {
+			c.policyOps = append(c.policyOps, op)
+		}
+	case op.Service == IAM && op.Command == AttachRolePolicy:
+		c.policyARNs[arnName(p[PolicyArn])] = p[PolicyArn]
+		id, ok := c.roles[p[RoleName]]
+		if !ok {
+			return false, nil
+		}
+		var policy interface{} = p[PolicyArn]
+		if policyID, ok := c.policies[arnName(p[PolicyArn])]; ok {
+			policy = map[string]string{"Ref": policyID}
+		}
+		r := c.template.Resources[id]
+		arns, _ := r.Properties["ManagedPolicyArns"].([]interface{})
+		r.Properties["ManagedPolicyArns"] = append(arns, policy)
+	case op.Service == IAM && op.Command == CreateOpenIdConnectProvider:
+		_, r := c.newResource("AWS::IAM::OIDCProvider", strings.TrimPrefix(p[Url], "https://"))
+		setProperty(r, "Url", p[Url])
+		r.Properties["ClientIdList"] = splitList(p[ClientIdList])
+		r.Properties["ThumbprintList"] = splitList(p[ThumbprintList])
+		setTags(r, op.Tags)
+	case op.Service == S3Api && op.Command == CreateBucket:
+		id, r := c.newResource("AWS::S3::Bucket", p[Bucket])
+		setProperty(r, "BucketName", p[Bucket])
+		c.buckets[p[Bucket]] = id
+	case op.Service == S3Api && op.Command == PutBucketTagging:
+		id, ok := c.buckets[p[Bucket]]
+		if !ok {
+			return false, nil
+		}
+		setTags(c.template.Resources[id], op.Tags)
+	case op.Service == S3Api && op.Command == PutPublicAccessBlock:
+		id, ok := c.buckets[p[Bucket]]
+		if !ok {
+			return false, nil
+		}
+		settings := p[PublicAccessBlockConfiguration]
+		c.template.Resources[id].Properties["PublicAccessBlockConfiguration"] = map[string]bool{
+			"BlockPublicAcls":       parseBool(settings, "BlockPublicAcls"),
+			"IgnorePublicAcls":      parseBool(settings, "IgnorePublicAcls"),
+			"BlockPublicPolicy":     parseBool(settings, "BlockPublicPolicy"),
+			"RestrictPublicBuckets": parseBool(settings, "RestrictPublicBuckets"),
+		}
+	case op.Service == S3Api && op.Command == PutBucketPolicy:
+		document, err := readDocument(p[Policy])
+		if err != nil {
+			return false, err
+		}
+		_, r := c.newResource("AWS::S3::BucketPolicy", p[Bucket]+"-policy")
+		if id, ok := c.buckets[p[Bucket]]; ok {
+			r.Properties["Bucket"] = map[string]string{"Ref": id}
+		} else {
+			r.Properties["Bucket"] = p[Bucket]
+		}
+		r.Properties["PolicyDocument"] = document
+	default:
+		// Objects can't be uploaded by a template, and secrets are kept out of it so that the private
+		// key isn't stored in the template
+		return false, nil
+	}
+	return true, nil
+}
+
+// Computes the ARN of the policy created by an operation when no attachment gives it, with a shell
+// variable in place of the account ID.
+func policyARN(op *Operation) string {
+	path := op.Params[Path]
+	if path == "" {
+		path = "/"
+	}
+	return fmt.Sprintf("arn:aws:iam::${AWS_ACCOUNT_ID}:policy%s%s", path, op.Params[PolicyName])
+}
+
+func setProperty(r *cfnResource, key string, value string) {
+	if value != "" {
+		r.Properties[key] = value
+	}
+}
+
+func setTags(r *cfnResource, tags map[string]string) {
+	if len(tags) == 0 {
+		return
+	}
+	result := make([]cfnTag, 0, len(tags))
+	for _, k := range sortedKeys(tags) {
+		result = append(result, cfnTag{Key: k, Value: tags[k]})
+	}
+	r.Properties["Tags"] = result
+}
+
+var cfnNameSeparators = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// Turns an AWS resource name into a CloudFormation logical ID, which can only contain letters and digits.
+func cfnName(name string) string {
+	var b strings.Builder
+	for _, part := range cfnNameSeparators.Split(name, -1) {
+		if part == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	result := b.String()
+	if result == "" || (result[0] >= '0' && result[0] <= '9') {
+		result = "Resource" + result
+	}
+	return result
+}
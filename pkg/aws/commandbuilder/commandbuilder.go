@@ -96,7 +96,12 @@ type CommandBuilder struct {
 	command  Command
 	params   []string
 	tags     map[string]string
+	tagging  map[string]string
 	redirect string
+
+	// Values of the parameters and arguments, without the shell quoting, used to build the operation
+	values    map[Param]string
+	arguments []string
 }
 
 func (b *CommandBuilder) SetService(awsService Service) *CommandBuilder {
@@ -112,6 +117,17 @@ func (b *CommandBuilder) SetCommand(awsCommand Command) *CommandBuilder {
 func (b *CommandBuilder) AddParam(awsParam Param, value string) *CommandBuilder {
 	if value != "" {
 		b.params = append(b.params, createParamString(awsParam, value))
+		b.setValue(awsParam, value)
+	}
+	return b
+}
+
+// AddQuotedParam adds a parameter whose value is quoted in the shell command, for values that contain
+// spaces.
+func (b *CommandBuilder) AddQuotedParam(awsParam Param, value string) *CommandBuilder {
+	if value != "" {
+		b.params = append(b.params, createParamString(awsParam, fmt.Sprintf("\"%s\"", value)))
+		b.setValue(awsParam, value)
 	}
 	return b
 }
@@ -126,13 +142,27 @@ func (b *CommandBuilder) AddTags(value map[string]string) *CommandBuilder {
 	return b
 }
 
+// AddTagging adds the tags of an S3 bucket or object, written as a tag set for 'put-bucket-tagging' and
+// as a query for the other commands.
+func (b *CommandBuilder) AddTagging(value map[string]string) *CommandBuilder {
+	if b.tagging == nil {
+		b.tagging = make(map[string]string, len(value))
+	}
+	for k, v := range value {
+		b.tagging[k] = v
+	}
+	return b
+}
+
 func (b *CommandBuilder) AddValueNoParam(value string) *CommandBuilder {
 	b.params = append(b.params, fmt.Sprintf("\t%s", value))
+	b.arguments = append(b.arguments, value)
 	return b
 }
 
 func (b *CommandBuilder) AddParamNoValue(awsParam Param) *CommandBuilder {
 	b.params = append(b.params, fmt.Sprintf("\t--%s", awsParam))
+	b.setValue(awsParam, "")
 	return b
 }
 
@@ -153,12 +183,16 @@ func (b *CommandBuilder) Build() string {
 	}
 
 	paramsString := ""
+	params := b.params
 	if len(b.tags) != 0 {
-		b.AddParam(Tags, createTags(b.tags))
+		params = append(params, createParamString(Tags, createTags(b.tags)))
 	}
-	if len(b.params) != 0 {
-		sort.Strings(b.params)
-		paramsString = strings.Join(b.params, ParamNewLineSeparator)
+	if len(b.tagging) != 0 {
+		params = append(params, createParamString(Tagging, createTagging(b.command, b.tagging)))
+	}
+	if len(params) != 0 {
+		sort.Strings(params)
+		paramsString = strings.Join(params, ParamNewLineSeparator)
 	}
 
 	redirectString := ""
@@ -175,6 +209,34 @@ func (b *CommandBuilder) Build() string {
 	)
 }
 
+// BuildOperation returns the operation of the command, with the values of its parameters and tags, so
+// that it can also be rendered in the formats that aren't shell commands.
+func (b *CommandBuilder) BuildOperation() *Operation {
+	op := &Operation{
+		Service:   b.service,
+		Command:   b.command,
+		Params:    map[Param]string{},
+		Arguments: b.arguments,
+		shell:     b.Build(),
+	}
+	for name, value := range b.values {
+		op.Params[name] = value
+	}
+	if len(b.tags) != 0 {
+		op.Tags = b.tags
+	} else if len(b.tagging) != 0 {
+		op.Tags = b.tagging
+	}
+	return op
+}
+
+func (b *CommandBuilder) setValue(awsParam Param, value string) {
+	if b.values == nil {
+		b.values = map[Param]string{}
+	}
+	b.values[awsParam] = value
+}
+
 func NewIAMCommandBuilder() *CommandBuilder {
 	return &CommandBuilder{service: IAM}
 }
@@ -210,6 +272,27 @@ func createTags(m map[string]string) string {
 	return strings.Join(keys, " ")
 }
 
+// createTagging writes the tags of an S3 bucket as a tag set, and the tags of an S3 object as a query.
+func createTagging(command Command, m map[string]string) string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if command == PutBucketTagging {
+			pairs = append(pairs, fmt.Sprintf("{Key=%s,Value=%s}", k, m[k]))
+		} else {
+			pairs = append(pairs, fmt.Sprintf("%s=%s", k, m[k]))
+		}
+	}
+	if command == PutBucketTagging {
+		return fmt.Sprintf("'TagSet=[%s]'", strings.Join(pairs, ","))
+	}
+	return fmt.Sprintf("'%s'", strings.Join(pairs, "&"))
+}
+
 func JoinCommands(commands []string) string {
 	return strings.Join(commands, "\n\n")
 }
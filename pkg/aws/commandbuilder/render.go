@@ -0,0 +1,183 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commandbuilder
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Format is the way the commands of the manual mode are written.
+type Format string
+
+const (
+	// FormatShell writes the AWS CLI commands, this is the default
+	FormatShell Format = "shell"
+	// FormatTerraform writes the resources in HCL for the Terraform AWS provider
+	FormatTerraform Format = "terraform"
+	// FormatCloudFormation writes a CloudFormation template in JSON
+	FormatCloudFormation Format = "cloudformation"
+	// FormatJSON writes the commands as a structured JSON plan
+	FormatJSON Format = "json"
+)
+
+var Formats = []string{
+	string(FormatShell),
+	string(FormatTerraform),
+	string(FormatCloudFormation),
+	string(FormatJSON),
+}
+
+const filePrefix = "file://"
+
+// Operation is a single command of the manual mode, built with a CommandBuilder. Commands that don't
+// call the AWS CLI, like removing temporary files, only have the shell command.
+type Operation struct {
+	Service   Service           `json:"service"`
+	Command   Command           `json:"command"`
+	Params    map[Param]string  `json:"parameters,omitempty"`
+	Arguments []string          `json:"arguments,omitempty"`
+	Tags      map[string]string `json:"tags,omitempty"`
+
+	shell string
+}
+
+// NewShellOperation returns the operation of a shell command that doesn't call the AWS CLI.
+func NewShellOperation(command string) *Operation {
+	return &Operation{shell: command}
+}
+
+// String returns the shell command of the operation.
+func (op *Operation) String() string {
+	return op.shell
+}
+
+// isAWS returns true if the operation calls the AWS CLI.
+func (op *Operation) isAWS() bool {
+	return op.Service != ""
+}
+
+// JoinOperations returns the shell commands of the given operations.
+func JoinOperations(ops []*Operation) string {
+	commands := make([]string, 0, len(ops))
+	for _, op := range ops {
+		commands = append(commands, op.String())
+	}
+	return JoinCommands(commands)
+}
+
+// ParseFormat validates the name of a manual mode format. An empty name is the shell format.
+func ParseFormat(value string) (Format, error) {
+	if value == "" {
+		return FormatShell, nil
+	}
+	for _, format := range Formats {
+		if value == format {
+			return Format(value), nil
+		}
+	}
+	return "", fmt.Errorf("Invalid manual format '%s'. Allowed values are %s", value, Formats)
+}
+
+// RenderCommands writes the given operations in the requested format. Resources that can't be expressed
+// in the format are kept as AWS CLI commands: as comments for Terraform and as template metadata for
+// CloudFormation. Operations that don't call the AWS CLI are only written in the shell format.
+func RenderCommands(format Format, operations []*Operation) (string, error) {
+	if format == FormatShell || format == "" {
+		return JoinOperations(operations), nil
+	}
+	ops := []*Operation{}
+	for _, op := range operations {
+		if op.isAWS() {
+			ops = append(ops, op)
+		}
+	}
+	switch format {
+	case FormatTerraform:
+		return renderTerraform(ops), nil
+	case FormatCloudFormation:
+		return renderCloudFormation(ops)
+	case FormatJSON:
+		data, err := json.MarshalIndent(map[string]interface{}{"operations": ops}, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+	return "", fmt.Errorf("Invalid manual format '%s'. Allowed values are %s", format, Formats)
+}
+
+// Returns the path of a 'file://' parameter, or false if the value isn't a file reference.
+func filePath(value string) (string, bool) {
+	if strings.HasPrefix(value, filePrefix) {
+		return strings.TrimPrefix(value, filePrefix), true
+	}
+	if strings.HasPrefix(value, "./") {
+		return value, true
+	}
+	return "", false
+}
+
+// Reads a document referenced by a 'file://' parameter. Documents that are JSON are decoded so that
+// they are embedded as objects.
+func readDocument(value string) (interface{}, error) {
+	path, ok := filePath(value)
+	if !ok {
+		return value, nil
+	}
+	// #nosec G304
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read '%s': %v", path, err)
+	}
+	var document interface{}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return string(data), nil
+	}
+	return document, nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Returns the name at the end of an ARN, for example the policy name of a policy ARN.
+func arnName(arn string) string {
+	return arn[strings.LastIndex(arn, "/")+1:]
+}
+
+func splitList(value string) []string {
+	return strings.Fields(value)
+}
+
+func parseBool(settings string, key string) bool {
+	for _, pair := range strings.Split(settings, ",") {
+		k, v, _ := strings.Cut(pair, "=")
+		if k == key {
+			return v == "true"
+		}
+	}
+	return false
+}
@@ -0,0 +1,172 @@
+package commandbuilder_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/openshift/rosa/pkg/aws/commandbuilder"
+)
+
+var _ = Describe("Render", func() {
+	var commands []*Operation
+
+	BeforeEach(func() {
+		dir := GinkgoT().TempDir()
+		cwd, err := os.Getwd()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.Chdir(dir)).To(Succeed())
+		DeferCleanup(os.Chdir, cwd)
+		Expect(os.WriteFile(filepath.Join(dir, "sts_installer_trust_policy.json"),
+			[]byte(`{"Version": "2012-10-17"}`), 0600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "sts_installer_permission_policy.json"),
+			[]byte(`{"Version": "2012-10-17", "Statement": []}`), 0600)).To(Succeed())
+
+		tags := map[string]string{"red-hat-managed": "true", "rosa_role_type": "installer"}
+		commands = []*Operation{
+			NewIAMCommandBuilder().
+				SetCommand(CreateRole).
+				AddParam(RoleName, "ManagedOpenShift-Installer-Role").
+				AddParam(AssumeRolePolicyDocument, "file://sts_installer_trust_policy.json").
+				AddParam(PermissionsBoundary, "arn:aws:iam::123456789012:policy/boundary").
+				AddParam(Path, "/rosa/").
+				AddTags(tags).
+				BuildOperation(),
+			NewIAMCommandBuilder().
+				SetCommand(CreatePolicy).
+				AddParam(PolicyName, "ManagedOpenShift-Installer-Role-Policy").
+				AddParam(PolicyDocument, "file://sts_installer_permission_policy.json").
+				AddParam(Path, "/rosa/").
+				AddTags(tags).
+				BuildOperation(),
+			NewIAMCommandBuilder().
+				SetCommand(AttachRolePolicy).
+				AddParam(RoleName, "ManagedOpenShift-Installer-Role").
+				AddParam(PolicyArn, "arn:aws:iam::123456789012:policy/rosa/ManagedOpenShift-Installer-Role-Policy").
+				BuildOperation(),
+			NewShellOperation("rm sts_installer_trust_policy.json"),
+			NewS3ApiCommandBuilder().
+				SetCommand(PutObject).
+				AddParam(Body, "./jwks.json").
+				AddParam(Bucket, "oidc-bucket").
+				AddParam(Key, "keys.json").
+				BuildOperation(),
+		}
+	})
+
+	It("Builds the operations from the values of the parameters", func() {
+		op := commands[0]
+		Expect(op.Service).To(Equal(IAM))
+		Expect(op.Command).To(Equal(CreateRole))
+		Expect(op.Params).To(HaveKeyWithValue(Path, "/rosa/"))
+		Expect(op.Tags).To(Equal(map[string]string{"red-hat-managed": "true", "rosa_role_type": "installer"}))
+
+		op = NewSecretsManagerCommandBuilder().
+			SetCommand(CreateSecret).
+			AddParam(Name, "secret").
+			AddQuotedParam(Description, "Secret for oidc-bucket").
+			AddTags(map[string]string{"red-hat-managed": "true"}).
+			BuildOperation()
+		Expect(op.Params).To(HaveKeyWithValue(Description, "Secret for oidc-bucket"))
+		Expect(op.String()).To(ContainSubstring(`--description "Secret for oidc-bucket"`))
+
+		op = NewS3ApiCommandBuilder().
+			SetCommand(PutBucketTagging).
+			AddParam(Bucket, "oidc-bucket").
+			AddTagging(map[string]string{"red-hat-managed": "true"}).
+			BuildOperation()
+		Expect(op.Tags).To(Equal(map[string]string{"red-hat-managed": "true"}))
+		Expect(op.Params).NotTo(HaveKey(Tagging))
+		Expect(op.String()).To(ContainSubstring("--tagging 'TagSet=[{Key=red-hat-managed,Value=true}]'"))
+
+		op = NewS3ApiCommandBuilder().
+			SetCommand(PutObject).
+			AddParam(Key, "keys.json").
+			AddTagging(map[string]string{"red-hat-managed": "true"}).
+			BuildOperation()
+		Expect(op.String()).To(ContainSubstring("--tagging 'red-hat-managed=true'"))
+	})
+
+	It("Keeps the shell commands by default", func() {
+		output, err := RenderCommands(FormatShell, commands)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(Equal(JoinOperations(commands)))
+		Expect(output).To(ContainSubstring("rm sts_installer_trust_policy.json"))
+	})
+
+	It("Renders Terraform resources", func() {
+		output, err := RenderCommands(FormatTerraform, commands)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(ContainSubstring(`resource "aws_iam_role" "managedopenshift_installer_role" {
+  name = "ManagedOpenShift-Installer-Role"
+  path = "/rosa/"
+  assume_role_policy = file("${path.module}/sts_installer_trust_policy.json")
+  permissions_boundary = "arn:aws:iam::123456789012:policy/boundary"
+  tags = {
+    "red-hat-managed" = "true"
+    "rosa_role_type" = "installer"
+  }
+}`))
+		Expect(output).To(ContainSubstring(`resource "aws_iam_role_policy_attachment" ` +
+			`"managedopenshift_installer_role_managedopenshift_installer_role_policy" {
+  role = aws_iam_role.managedopenshift_installer_role.name
+  policy_arn = aws_iam_policy.managedopenshift_installer_role_policy.arn
+}`))
+		Expect(output).To(ContainSubstring(`resource "aws_s3_object" "oidc_bucket_keys_json" {
+  bucket = "oidc-bucket"
+  key = "keys.json"
+  source = "${path.module}/jwks.json"
+}`))
+		Expect(output).NotTo(ContainSubstring("rm "))
+	})
+
+	It("Renders a CloudFormation template", func() {
+		output, err := RenderCommands(FormatCloudFormation, commands)
+		Expect(err).NotTo(HaveOccurred())
+		template := map[string]interface{}{}
+		Expect(json.Unmarshal([]byte(output), &template)).To(Succeed())
+
+		resources := template["Resources"].(map[string]interface{})
+		role := resources["ManagedOpenShiftInstallerRole"].(map[string]interface{})
+		Expect(role["Type"]).To(Equal("AWS::IAM::Role"))
+		properties := role["Properties"].(map[string]interface{})
+		Expect(properties["Path"]).To(Equal("/rosa/"))
+		Expect(properties["PermissionsBoundary"]).To(Equal("arn:aws:iam::123456789012:policy/boundary"))
+		Expect(properties["AssumeRolePolicyDocument"]).To(Equal(map[string]interface{}{"Version": "2012-10-17"}))
+		Expect(properties["ManagedPolicyArns"]).To(Equal([]interface{}{
+			map[string]interface{}{"Ref": "ManagedOpenShiftInstallerRolePolicy"},
+		}))
+		Expect(properties["Tags"]).To(HaveLen(2))
+
+		manual := template["Metadata"].(map[string]interface{})["ManualCommands"].([]interface{})
+		Expect(manual).To(HaveLen(2))
+		Expect(manual[0]).To(ContainSubstring("aws s3api put-object"))
+		Expect(manual[1]).To(ContainSubstring("aws iam tag-policy"))
+		Expect(manual[1]).To(ContainSubstring(
+			"--policy-arn arn:aws:iam::123456789012:policy/rosa/ManagedOpenShift-Installer-Role-Policy"))
+	})
+
+	It("Renders a JSON plan", func() {
+		output, err := RenderCommands(FormatJSON, commands)
+		Expect(err).NotTo(HaveOccurred())
+		plan := struct {
+			Operations []Operation `json:"operations"`
+		}{}
+		Expect(json.Unmarshal([]byte(output), &plan)).To(Succeed())
+		Expect(plan.Operations).To(HaveLen(4))
+		Expect(plan.Operations[2].Command).To(Equal(AttachRolePolicy))
+		Expect(plan.Operations[0].Params).To(HaveKeyWithValue(PermissionsBoundary,
+			"arn:aws:iam::123456789012:policy/boundary"))
+	})
+
+	It("Validates the format", func() {
+		_, err := ParseFormat("yaml")
+		Expect(err).To(MatchError(ContainSubstring("Invalid manual format 'yaml'")))
+		format, err := ParseFormat("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(format).To(Equal(FormatShell))
+	})
+})
@@ -0,0 +1,233 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commandbuilder
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type tfResource struct {
+	kind  string
+	name  string
+	attrs [][2]string
+	tags  map[string]string
+}
+
+func (r *tfResource) set(key string, value string) *tfResource {
+	r.attrs = append(r.attrs, [2]string{key, value})
+	return r
+}
+
+// Sets a string attribute, skipping empty values so that the provider defaults are used.
+func (r *tfResource) setString(key string, value string) *tfResource {
+	if value == "" {
+		return r
+	}
+	return r.set(key, hclString(value))
+}
+
+func (r *tfResource) ref(attribute string) string {
+	return fmt.Sprintf("%s.%s.%s", r.kind, r.name, attribute)
+}
+
+type tfRenderer struct {
+	resources []*tfResource
+	names     map[string]bool
+	roles     map[string]*tfResource
+	policies  map[string]*tfResource
+	buckets   map[string]*tfResource
+	manual    []string
+}
+
+func renderTerraform(ops []*Operation) string {
+	t := &tfRenderer{
+		names:    map[string]bool{},
+		roles:    map[string]*tfResource{},
+		policies: map[string]*tfResource{},
+		buckets:  map[string]*tfResource{},
+	}
+	for _, op := range ops {
+		if !t.add(op) {
+			t.manual = append(t.manual, op.String())
+		}
+	}
+
+	var b strings.Builder
+	for _, r := range t.resources {
+		fmt.Fprintf(&b, "resource %q %q {\n", r.kind, r.name)
+		for _, attr := range r.attrs {
+			fmt.Fprintf(&b, "  %s = %s\n", attr[0], attr[1])
+		}
+		if len(r.tags) > 0 {
+			b.WriteString("  tags = {\n")
+			for _, k := range sortedKeys(r.tags) {
+				fmt.Fprintf(&b, "    %s = %s\n", hclString(k), hclString(r.tags[k]))
+			}
+			b.WriteString("  }\n")
+		}
+		b.WriteString("}\n\n")
+	}
+	if len(t.manual) > 0 {
+		b.WriteString("# The following commands can't be expressed as Terraform resources, run them manually:\n")
+		for _, command := range t.manual {
+			for _, line := range strings.Split(command, "\n") {
+				fmt.Fprintf(&b, "# %s\n", line)
+			}
+			b.WriteString("\n")
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func (t *tfRenderer) newResource(kind string, name string) *tfResource {
+	base := tfName(name)
+	unique := base
+	for i := 2; t.names[kind+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", base, i)
+	}
+	t.names[kind+"."+unique] = true
+	r := &tfResource{kind: kind, name: unique}
+	t.resources = append(t.resources, r)
+	return r
+}
+
+// Adds the resources of an operation, returning false if the operation can't be expressed.
+func (t *tfRenderer) add(op *Operation) bool {
+	p := op.Params
+	switch {
+	case op.Service == IAM && op.Command == CreateRole:
+		r := t.newResource("aws_iam_role", p[RoleName])
+		r.setString("name", p[RoleName]).
+			setString("path", p[Path]).
+			set("assume_role_policy", tfDocument(p[AssumeRolePolicyDocument])).
+			setString("permissions_boundary", p[PermissionsBoundary])
+		r.tags = op.Tags
+		t.roles[p[RoleName]] = r
+	case op.Service == IAM && op.Command == CreatePolicy:
+		r := t.newResource("aws_iam_policy", p[PolicyName])
+		r.setString("name", p[PolicyName]).
+			setString("path", p[Path]).
+			set("policy", tfDocument(p[PolicyDocument]))
+		r.tags = op.Tags
+		t.policies[p[PolicyName]] = r
+	case op.Service == IAM && op.Command == AttachRolePolicy:
+		role := hclString(p[RoleName])
+		if r, ok := t.roles[p[RoleName]]; ok {
+			role = r.ref("name")
+		}
+		policy := hclString(p[PolicyArn])
+		if r, ok := t.policies[arnName(p[PolicyArn])]; ok {
+			policy = r.ref("arn")
+		}
+		t.newResource("aws_iam_role_policy_attachment", p[RoleName]+"_"+arnName(p[PolicyArn])).
+			set("role", role).
+			set("policy_arn", policy)
+	case op.Service == IAM && op.Command == CreateOpenIdConnectProvider:
+		r := t.newResource("aws_iam_openid_connect_provider", strings.TrimPrefix(p[Url], "https://"))
+		r.setString("url", p[Url]).
+			set("client_id_list", hclList(splitList(p[ClientIdList]))).
+			set("thumbprint_list", hclList(splitList(p[ThumbprintList])))
+		r.tags = op.Tags
+	case op.Service == S3Api && op.Command == CreateBucket:
+		r := t.newResource("aws_s3_bucket", p[Bucket])
+		r.setString("bucket", p[Bucket])
+		t.buckets[p[Bucket]] = r
+	case op.Service == S3Api && op.Command == PutBucketTagging:
+		r, ok := t.buckets[p[Bucket]]
+		if !ok {
+			return false
+		}
+		r.tags = op.Tags
+	case op.Service == S3Api && op.Command == PutPublicAccessBlock:
+		settings := p[PublicAccessBlockConfiguration]
+		t.newResource("aws_s3_bucket_public_access_block", p[Bucket]).
+			set("bucket", t.bucketRef(p[Bucket])).
+			set("block_public_acls", strconv.FormatBool(parseBool(settings, "BlockPublicAcls"))).
+			set("ignore_public_acls", strconv.FormatBool(parseBool(settings, "IgnorePublicAcls"))).
+			set("block_public_policy", strconv.FormatBool(parseBool(settings, "BlockPublicPolicy"))).
+			set("restrict_public_buckets", strconv.FormatBool(parseBool(settings, "RestrictPublicBuckets")))
+	case op.Service == S3Api && op.Command == PutBucketPolicy:
+		t.newResource("aws_s3_bucket_policy", p[Bucket]).
+			set("bucket", t.bucketRef(p[Bucket])).
+			set("policy", tfDocument(p[Policy]))
+	case op.Service == S3Api && op.Command == PutObject:
+		path, _ := filePath(p[Body])
+		r := t.newResource("aws_s3_object", p[Bucket]+"_"+p[Key])
+		r.set("bucket", t.bucketRef(p[Bucket])).
+			setString("key", p[Key]).
+			set("source", tfPath(path))
+		r.tags = op.Tags
+	case op.Service == SM && op.Command == CreateSecret:
+		secret := t.newResource("aws_secretsmanager_secret", p[Name])
+		secret.setString("name", p[Name]).
+			setString("description", p[Description])
+		secret.tags = op.Tags
+		t.newResource("aws_secretsmanager_secret_version", p[Name]).
+			set("secret_id", secret.ref("id")).
+			set("secret_string", tfDocument(p[SecretString]))
+	default:
+		return false
+	}
+	return true
+}
+
+func (t *tfRenderer) bucketRef(bucket string) string {
+	if r, ok := t.buckets[bucket]; ok {
+		return r.ref("id")
+	}
+	return hclString(bucket)
+}
+
+var tfNameInvalidChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// Turns an AWS resource name into a Terraform resource name.
+func tfName(name string) string {
+	result := strings.Trim(tfNameInvalidChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if result == "" || (result[0] >= '0' && result[0] <= '9') {
+		result = "r_" + result
+	}
+	return result
+}
+
+// Returns the expression for a document parameter, reading 'file://' references with the 'file' function.
+func tfDocument(value string) string {
+	if path, ok := filePath(value); ok {
+		return fmt.Sprintf("file(%s)", tfPath(path))
+	}
+	return hclString(value)
+}
+
+func tfPath(path string) string {
+	return fmt.Sprintf(`"${path.module}/%s"`, strings.TrimPrefix(path, "./"))
+}
+
+func hclString(value string) string {
+	quoted := strconv.Quote(value)
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	return strings.ReplaceAll(quoted, "%{", "%%{")
+}
+
+func hclList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = hclString(value)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
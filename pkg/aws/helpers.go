@@ -3,7 +3,6 @@ package aws
 import (
 	"context"
 	"fmt"
-	"os"
 	"path/filepath"
 	"reflect"
 	"regexp"
@@ -184,16 +183,16 @@ func GetAWSClientForUserRegion(reporter *rprtr.Object, logger *logrus.Logger,
 	awsRegionInUserConfig, err := GetRegion(arguments.GetRegion())
 	if err != nil {
 		reporter.Errorf("Error getting region: %v", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 	if awsRegionInUserConfig == "" {
 		reporter.Errorf("AWS Region not set")
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 	if !helper.Contains(supportedRegions, awsRegionInUserConfig) {
 		reporter.Errorf("Unsupported region '%s', available regions: %s",
 			awsRegionInUserConfig, helper.SliceToSortedString(supportedRegions))
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 
 	// Create the AWS client:
@@ -204,7 +203,7 @@ func GetAWSClientForUserRegion(reporter *rprtr.Object, logger *logrus.Logger,
 		Build()
 	if err != nil {
 		reporter.Errorf("Error creating aws client for stack validation: %v", err)
-		os.Exit(1)
+		rprtr.Exit(1)
 	}
 	regionUsedForInit, err := client.GetClusterRegionTagForUser(AdminUserName)
 	if err != nil || regionUsedForInit == "" {
@@ -215,7 +214,7 @@ func GetAWSClientForUserRegion(reporter *rprtr.Object, logger *logrus.Logger,
 		if !helper.Contains(supportedRegions, regionUsedForInit) {
 			reporter.Errorf("Unsupported region '%s', available regions: %s",
 				regionUsedForInit, helper.SliceToSortedString(supportedRegions))
-			os.Exit(1)
+			rprtr.Exit(1)
 		}
 		// Create the AWS client with the region used in the init
 		//So we can check for the stack in that region
@@ -226,7 +225,7 @@ func GetAWSClientForUserRegion(reporter *rprtr.Object, logger *logrus.Logger,
 			Build()
 		if err != nil {
 			reporter.Errorf("Error creating aws client for stack validation: %v", err)
-			os.Exit(1)
+			rprtr.Exit(1)
 		}
 		return awsClient
 	}
@@ -891,6 +890,28 @@ func GetResourceIdFromSecretArn(secretArn string) (string, error) {
 	return parsedARN.Resource[index+1:], nil
 }
 
+// PrivateKeySecretPrefix is the prefix of the secrets that contain the private keys of the OIDC
+// configurations created by ROSA.
+const PrivateKeySecretPrefix = "rosa-private-key-"
+
+// GetBucketNameFromSecretArn returns the name of the S3 bucket of an unmanaged OIDC configuration from
+// the ARN of its private key secret.
+// The secret when creating from ROSA options has the following format
+// rosa-private-key-<prefix>-oidc-<random-hash-length-4>-<random-aws-created-hash>
+// The bucket is expected to be <prefix>-oidc-<random-hash-length-4>
+func GetBucketNameFromSecretArn(secretArn string) (string, error) {
+	secretResourceName, err := GetResourceIdFromSecretArn(secretArn)
+	if err != nil {
+		return "", err
+	}
+	bucketName := strings.TrimPrefix(secretResourceName, PrivateKeySecretPrefix)
+	index := strings.LastIndex(bucketName, "-")
+	if index != -1 {
+		bucketName = bucketName[:index]
+	}
+	return bucketName, nil
+}
+
 func FindOperatorRoleNameBySTSOperator(cluster *cmv1.Cluster, operator *cmv1.STSOperator) (string, bool) {
 	for _, role := range cluster.AWS().STS().OperatorIAMRoles() {
 		if role.Namespace() == operator.Namespace() && role.Name() == operator.Name() {
@@ -246,3 +246,17 @@ var _ = Describe("UserTagDuplicateValidator", func() {
 		})
 	})
 })
+
+var _ = Describe("GetBucketNameFromSecretArn", func() {
+	It("should return the bucket name without the prefix and the random suffix", func() {
+		bucketName, err := GetBucketNameFromSecretArn(
+			"arn:aws:secretsmanager:us-east-1:123456789012:secret:rosa-private-key-oidc-abcd-ZyXwV1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bucketName).To(Equal("oidc-abcd"))
+	})
+
+	It("should fail for an invalid ARN", func() {
+		_, err := GetBucketNameFromSecretArn("invalid")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 )
 
@@ -79,3 +81,60 @@ func (c *awsClient) ValidateSCP(target *string, policies map[string]*cmv1.AWSSTS
 
 	return true, nil
 }
+
+// SimulationResult is the result of the simulation of an action for a principal.
+type SimulationResult struct {
+	Action   string
+	Decision string
+
+	// True when the action is denied by a service control policy of the organization:
+	DeniedByOrganizations bool
+
+	// True when the action is denied by the permissions boundary of the principal:
+	DeniedByPermissionsBoundary bool
+}
+
+func (r SimulationResult) IsAllowed() bool {
+	return r.Decision == string(iamtypes.PolicyEvaluationDecisionTypeAllowed)
+}
+
+// SimulatePermissions simulates the actions for the principal, taking into account the policies attached to
+// it, its permissions boundary and the service control policies of the organization. The actions are
+// simulated on all resources and with the region as the only context key, so actions that are only
+// allowed on specific resources or with other conditions are denied.
+func (c *awsClient) SimulatePermissions(principalARN string, actions []string) ([]SimulationResult, error) {
+	input := &iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: aws.String(principalARN),
+		ActionNames:     actions,
+		ContextEntries: []iamtypes.ContextEntry{
+			{
+				ContextKeyName:   aws.String("aws:RequestedRegion"),
+				ContextKeyType:   iamtypes.ContextKeyTypeEnumStringList,
+				ContextKeyValues: []string{c.GetRegion()},
+			},
+		},
+	}
+	results := []SimulationResult{}
+	paginator := iam.NewSimulatePrincipalPolicyPaginator(c.iamClient, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("Error simulating policy: %v", err)
+		}
+		for _, evaluation := range output.EvaluationResults {
+			result := SimulationResult{
+				Action:   aws.ToString(evaluation.EvalActionName),
+				Decision: string(evaluation.EvalDecision),
+			}
+			if evaluation.OrganizationsDecisionDetail != nil {
+				result.DeniedByOrganizations = !evaluation.OrganizationsDecisionDetail.AllowedByOrganizations
+			}
+			if evaluation.PermissionsBoundaryDecisionDetail != nil {
+				result.DeniedByPermissionsBoundary =
+					!evaluation.PermissionsBoundaryDecisionDetail.AllowedByPermissionsBoundary
+			}
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
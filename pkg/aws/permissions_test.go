@@ -0,0 +1,65 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws/mocks"
+)
+
+var _ = Describe("SimulatePermissions", func() {
+	var (
+		client     awsClient
+		mockIamAPI *mocks.MockIamApiClient
+	)
+
+	BeforeEach(func() {
+		mockIamAPI = mocks.NewMockIamApiClient(gomock.NewController(GinkgoT()))
+		client = awsClient{
+			iamClient: mockIamAPI,
+			cfg:       aws.Config{Region: "us-east-1"},
+		}
+	})
+
+	It("Reports the denials by service control policies and permissions boundaries", func() {
+		roleARN := "arn:aws:iam::123456789012:role/prefix-Installer-Role"
+		mockIamAPI.EXPECT().SimulatePrincipalPolicy(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ interface{}, input *iam.SimulatePrincipalPolicyInput,
+				_ ...func(*iam.Options)) (*iam.SimulatePrincipalPolicyOutput, error) {
+				Expect(aws.ToString(input.PolicySourceArn)).To(Equal(roleARN))
+				Expect(input.ActionNames).To(Equal([]string{"ec2:DescribeRegions", "s3:GetObject"}))
+				Expect(input.ContextEntries[0].ContextKeyValues).To(Equal([]string{"us-east-1"}))
+				return &iam.SimulatePrincipalPolicyOutput{
+					EvaluationResults: []iamtypes.EvaluationResult{
+						{
+							EvalActionName: aws.String("ec2:DescribeRegions"),
+							EvalDecision:   iamtypes.PolicyEvaluationDecisionTypeAllowed,
+						},
+						{
+							EvalActionName: aws.String("s3:GetObject"),
+							EvalDecision:   iamtypes.PolicyEvaluationDecisionTypeImplicitDeny,
+							OrganizationsDecisionDetail: &iamtypes.OrganizationsDecisionDetail{
+								AllowedByOrganizations: false,
+							},
+							PermissionsBoundaryDecisionDetail: &iamtypes.PermissionsBoundaryDecisionDetail{
+								AllowedByPermissionsBoundary: true,
+							},
+						},
+					},
+				}, nil
+			})
+
+		results, err := client.SimulatePermissions(roleARN, []string{"ec2:DescribeRegions", "s3:GetObject"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(Equal([]SimulationResult{
+			{Action: "ec2:DescribeRegions", Decision: "allowed"},
+			{Action: "s3:GetObject", Decision: "implicitDeny", DeniedByOrganizations: true},
+		}))
+		Expect(results[0].IsAllowed()).To(BeTrue())
+		Expect(results[1].IsAllowed()).To(BeFalse())
+	})
+})
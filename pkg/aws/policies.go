@@ -304,6 +304,32 @@ func (c *awsClient) PutRolePolicy(roleName string, policyName string, policy str
 	return nil
 }
 
+// UpdateTrustPolicy replaces the trust policy of the role with the given document.
+func (c *awsClient) UpdateTrustPolicy(roleName string, policy string) error {
+	_, err := c.iamClient.UpdateAssumeRolePolicy(context.Background(), &iam.UpdateAssumeRolePolicyInput{
+		RoleName:       aws.String(roleName),
+		PolicyDocument: aws.String(policy),
+	})
+	return err
+}
+
+// UpdatePolicyDocument creates a new default version of the policy with the given document, without
+// changing its tags.
+func (c *awsClient) UpdatePolicyDocument(policyArn string, document string) error {
+	// Since there is a limit to how many versions a policy can have, we delete all non-default
+	// policy versions from the list, thus making space for the new one.
+	err := c.deletePolicyVersions(policyArn)
+	if err != nil {
+		return err
+	}
+	_, err = c.iamClient.CreatePolicyVersion(context.Background(), &iam.CreatePolicyVersionInput{
+		PolicyArn:      aws.String(policyArn),
+		PolicyDocument: aws.String(document),
+		SetAsDefault:   true,
+	})
+	return err
+}
+
 func (c *awsClient) ForceEnsurePolicy(policyArn string, document string,
 	version string, tagList map[string]string, path string) (string, error) {
 	return c.ensurePolicyHelper(policyArn, document, version, tagList, path, true)
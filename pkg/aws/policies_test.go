@@ -813,3 +813,44 @@ var _ = Describe("doesPolicyHaveTags", func() {
 		Expect(result).To(BeTrue())
 	})
 })
+
+var _ = Describe("UpdatePolicyDocument", func() {
+	var (
+		client     awsClient
+		mockIamAPI *mocks.MockIamApiClient
+		mockCtrl   *gomock.Controller
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockIamAPI = mocks.NewMockIamApiClient(mockCtrl)
+		client = awsClient{
+			iamClient: mockIamAPI,
+		}
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("Deletes the old versions and creates a new default version", func() {
+		policyArn := "arn:aws:iam::123456789012:policy/prefix-Installer-Role-Policy"
+		mockIamAPI.EXPECT().ListPolicyVersions(gomock.Any(), gomock.Any()).Return(&iam.ListPolicyVersionsOutput{
+			Versions: []iamtypes.PolicyVersion{
+				{VersionId: aws.String("v1"), IsDefaultVersion: false},
+				{VersionId: aws.String("v2"), IsDefaultVersion: true},
+			},
+		}, nil)
+		mockIamAPI.EXPECT().DeletePolicyVersion(gomock.Any(), &iam.DeletePolicyVersionInput{
+			PolicyArn: aws.String(policyArn),
+			VersionId: aws.String("v1"),
+		}).Return(&iam.DeletePolicyVersionOutput{}, nil)
+		mockIamAPI.EXPECT().CreatePolicyVersion(gomock.Any(), &iam.CreatePolicyVersionInput{
+			PolicyArn:      aws.String(policyArn),
+			PolicyDocument: aws.String("{}"),
+			SetAsDefault:   true,
+		}).Return(&iam.CreatePolicyVersionOutput{}, nil)
+
+		Expect(client.UpdatePolicyDocument(policyArn, "{}")).To(Succeed())
+	})
+})
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -44,6 +45,9 @@ type PolicyStatement struct {
 	// you do not include this element, then the resource to which the action applies is the
 	// resource to which the policy is attached.
 	Resource interface{} `json:"Resource,omitempty"`
+	// Include the conditions under which the statement applies, indexed by operator and then by
+	// condition key (i.e. StringEquals, aws:RequestedRegion).
+	Condition map[string]map[string]interface{} `json:"Condition,omitempty"`
 }
 
 type PolicyStatementPrincipal struct {
@@ -89,6 +93,95 @@ func (p *PolicyStatement) GetAWSPrincipals() []string {
 	return awsArr
 }
 
+// GetActions returns the actions of the statement, whether they are a single string or a list.
+func (p *PolicyStatement) GetActions() []string {
+	return toStringList(p.Action)
+}
+
+// GetResources returns the resources of the statement, whether they are a single string or a list.
+func (p *PolicyStatement) GetResources() []string {
+	return toStringList(p.Resource)
+}
+
+// GetConditions returns the conditions of the statement in the form 'operator:key=value1,value2',
+// sorted so that equivalent statements return the same list.
+func (p *PolicyStatement) GetConditions() []string {
+	conditions := []string{}
+	for operator, keys := range p.Condition {
+		for key, value := range keys {
+			values := toStringList(value)
+			if values == nil {
+				values = []string{fmt.Sprint(value)}
+			}
+			values = append([]string{}, values...)
+			sort.Strings(values)
+			conditions = append(conditions, fmt.Sprintf("%s:%s=%s", operator, key, strings.Join(values, ",")))
+		}
+	}
+	sort.Strings(conditions)
+	return conditions
+}
+
+// GetPrincipals returns all the principals of the statement prefixed with their type, for example
+// 'Service:ec2.amazonaws.com'.
+func (p *PolicyStatement) GetPrincipals() []string {
+	principals := []string{}
+	if p.Principal == nil {
+		return principals
+	}
+	for _, service := range p.Principal.Service {
+		principals = append(principals, "Service:"+service)
+	}
+	for _, awsPrincipal := range p.GetAWSPrincipals() {
+		principals = append(principals, "AWS:"+awsPrincipal)
+	}
+	if p.Principal.Federated != "" {
+		principals = append(principals, "Federated:"+p.Principal.Federated)
+	}
+	return principals
+}
+
+// UnmarshalJSON accepts the principals written by the AWS console, where the wildcard principal is a
+// single string and a single service isn't wrapped in a list.
+func (p *PolicyStatementPrincipal) UnmarshalJSON(data []byte) error {
+	var wildcard string
+	if json.Unmarshal(data, &wildcard) == nil {
+		p.AWS = wildcard
+		return nil
+	}
+	principal := struct {
+		Service   interface{} `json:"Service,omitempty"`
+		AWS       interface{} `json:"AWS,omitempty"`
+		Federated string      `json:"Federated,omitempty"`
+	}{}
+	err := json.Unmarshal(data, &principal)
+	if err != nil {
+		return err
+	}
+	p.Service = toStringList(principal.Service)
+	p.AWS = principal.AWS
+	p.Federated = principal.Federated
+	return nil
+}
+
+func toStringList(value interface{}) []string {
+	switch value := value.(type) {
+	case string:
+		return []string{value}
+	case []string:
+		return value
+	case []interface{}:
+		result := []string{}
+		for _, el := range value {
+			if s, ok := el.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
 // AllowActions adds a statement to a policy allowing the provided actions for all Resources.
 // If you need a more compilex statement it is better to construct it manually.
 func (p *PolicyDocument) AllowActions(actions ...string) {
@@ -0,0 +1,55 @@
+package aws
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PolicyStatement", func() {
+	It("Returns the actions and resources written as a string or a list", func() {
+		policy, err := ParsePolicyDocument(`{"Version": "2012-10-17", "Statement": [
+			{"Effect": "Allow", "Action": "ec2:DescribeRegions", "Resource": "*"},
+			{"Effect": "Allow", "Action": ["s3:GetObject", "s3:PutObject"],
+				"Resource": ["arn:aws:s3:::a", "arn:aws:s3:::b"]}
+		]}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Statement[0].GetActions()).To(Equal([]string{"ec2:DescribeRegions"}))
+		Expect(policy.Statement[0].GetResources()).To(Equal([]string{"*"}))
+		Expect(policy.Statement[1].GetActions()).To(Equal([]string{"s3:GetObject", "s3:PutObject"}))
+		Expect(policy.Statement[1].GetResources()).To(Equal([]string{"arn:aws:s3:::a", "arn:aws:s3:::b"}))
+	})
+
+	It("Returns the principals of every type", func() {
+		policy, err := ParsePolicyDocument(`{"Version": "2012-10-17", "Statement": [
+			{"Effect": "Allow", "Action": "sts:AssumeRole", "Principal": {"Service": "ec2.amazonaws.com"}},
+			{"Effect": "Allow", "Action": "sts:AssumeRole", "Principal": {
+				"AWS": ["arn:aws:iam::123456789012:root"],
+				"Federated": "arn:aws:iam::123456789012:oidc-provider/oidc.example.com/abcd"
+			}},
+			{"Effect": "Allow", "Action": "sts:AssumeRole", "Principal": "*"}
+		]}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Statement[0].GetPrincipals()).To(Equal([]string{"Service:ec2.amazonaws.com"}))
+		Expect(policy.Statement[1].GetPrincipals()).To(Equal([]string{
+			"AWS:arn:aws:iam::123456789012:root",
+			"Federated:arn:aws:iam::123456789012:oidc-provider/oidc.example.com/abcd",
+		}))
+		Expect(policy.Statement[2].GetPrincipals()).To(Equal([]string{"AWS:*"}))
+	})
+
+	It("Returns the conditions sorted", func() {
+		policy, err := ParsePolicyDocument(`{"Version": "2012-10-17", "Statement": [
+			{"Effect": "Allow", "Action": "ec2:RunInstances", "Resource": "*", "Condition": {
+				"StringEquals": {"aws:RequestedRegion": ["us-west-2", "us-east-1"]},
+				"Bool": {"aws:SecureTransport": true}
+			}},
+			{"Effect": "Allow", "Action": "ec2:DescribeRegions", "Resource": "*"}
+		]}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Statement[0].GetConditions()).To(Equal([]string{
+			"Bool:aws:SecureTransport=true",
+			"StringEquals:aws:RequestedRegion=us-east-1,us-west-2",
+		}))
+		Expect(policy.Statement[1].GetConditions()).To(BeEmpty())
+	})
+})
@@ -6,6 +6,8 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
 	servicequotastypes "github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
 )
@@ -162,3 +164,159 @@ func (c *awsClient) GetIAMServiceQuota(quotaCode string) (
 		QuotaCode:   aws.String(quotaCode),
 	})
 }
+
+// GetServiceQuotaValue returns the value of the quota applied to the account.
+func (c *awsClient) GetServiceQuotaValue(serviceCode string, quotaCode string) (float64, error) {
+	output, err := c.serviceQuotasClient.GetServiceQuota(context.Background(), &servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("Error getting AWS service quota %s %s: %v", serviceCode, quotaCode, err)
+	}
+	if output.Quota == nil || output.Quota.Value == nil {
+		return 0, fmt.Errorf("AWS service quota %s %s has no value", serviceCode, quotaCode)
+	}
+	return *output.Quota.Value, nil
+}
+
+// GetInstanceTypeVCPUs returns the number of vCPUs of each of the instance types.
+func (c *awsClient) GetInstanceTypeVCPUs(instanceTypes []string) (map[string]int, error) {
+	input := &ec2.DescribeInstanceTypesInput{}
+	for _, instanceType := range instanceTypes {
+		input.InstanceTypes = append(input.InstanceTypes, ec2types.InstanceType(instanceType))
+	}
+	result := map[string]int{}
+	paginator := ec2.NewDescribeInstanceTypesPaginator(c.ec2Client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("Error describing instance types: %v", err)
+		}
+		for _, instanceType := range output.InstanceTypes {
+			if instanceType.VCpuInfo == nil {
+				continue
+			}
+			result[string(instanceType.InstanceType)] = int(aws.ToInt32(instanceType.VCpuInfo.DefaultVCpus))
+		}
+	}
+	return result, nil
+}
+
+// GetRunningInstanceVCPUs returns the number of vCPUs used by the pending and running instances of
+// each instance type.
+func (c *awsClient) GetRunningInstanceVCPUs() (map[string]int, error) {
+	input := &ec2.DescribeInstancesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("instance-state-name"),
+				Values: []string{"pending", "running"},
+			},
+		},
+	}
+	result := map[string]int{}
+	paginator := ec2.NewDescribeInstancesPaginator(c.ec2Client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("Error describing instances: %v", err)
+		}
+		for _, reservation := range output.Reservations {
+			for _, instance := range reservation.Instances {
+				if instance.CpuOptions == nil {
+					continue
+				}
+				vcpus := aws.ToInt32(instance.CpuOptions.CoreCount) * aws.ToInt32(instance.CpuOptions.ThreadsPerCore)
+				result[string(instance.InstanceType)] += int(vcpus)
+			}
+		}
+	}
+	return result, nil
+}
+
+// CountElasticIPs returns the number of elastic IP addresses allocated for VPCs.
+func (c *awsClient) CountElasticIPs() (int, error) {
+	output, err := c.ec2Client.DescribeAddresses(context.Background(), &ec2.DescribeAddressesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("domain"),
+				Values: []string{string(ec2types.DomainTypeVpc)},
+			},
+		},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("Error describing addresses: %v", err)
+	}
+	return len(output.Addresses), nil
+}
+
+// CountNatGatewaysByZone returns the number of pending and available NAT gateways in each
+// availability zone.
+func (c *awsClient) CountNatGatewaysByZone() (map[string]int, error) {
+	input := &ec2.DescribeNatGatewaysInput{
+		Filter: []ec2types.Filter{
+			{
+				Name:   aws.String("state"),
+				Values: []string{string(ec2types.NatGatewayStatePending), string(ec2types.NatGatewayStateAvailable)},
+			},
+		},
+	}
+	natGatewaySubnetIDs := []string{}
+	subnetIDs := []string{}
+	seen := map[string]bool{}
+	paginator := ec2.NewDescribeNatGatewaysPaginator(c.ec2Client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("Error describing NAT gateways: %v", err)
+		}
+		for _, natGateway := range output.NatGateways {
+			subnetID := aws.ToString(natGateway.SubnetId)
+			natGatewaySubnetIDs = append(natGatewaySubnetIDs, subnetID)
+			if !seen[subnetID] {
+				seen[subnetID] = true
+				subnetIDs = append(subnetIDs, subnetID)
+			}
+		}
+	}
+	result := map[string]int{}
+	if len(subnetIDs) == 0 {
+		return result, nil
+	}
+	subnets, err := c.ListSubnets(subnetIDs...)
+	if err != nil {
+		return nil, err
+	}
+	zones := map[string]string{}
+	for _, subnet := range subnets {
+		zones[aws.ToString(subnet.SubnetId)] = aws.ToString(subnet.AvailabilityZone)
+	}
+	for _, subnetID := range natGatewaySubnetIDs {
+		result[zones[subnetID]]++
+	}
+	return result, nil
+}
+
+// GetVolumeStorage returns the storage, in GiB, of the volumes of the given type.
+func (c *awsClient) GetVolumeStorage(volumeType string) (int, error) {
+	input := &ec2.DescribeVolumesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("volume-type"),
+				Values: []string{volumeType},
+			},
+		},
+	}
+	result := 0
+	paginator := ec2.NewDescribeVolumesPaginator(c.ec2Client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.Background())
+		if err != nil {
+			return 0, fmt.Errorf("Error describing volumes: %v", err)
+		}
+		for _, volume := range output.Volumes {
+			result += int(aws.ToInt32(volume.Size))
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,99 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	servicequotastypes "github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws/mocks"
+)
+
+var _ = Describe("Quota usage", func() {
+	var (
+		client               awsClient
+		mockEC2API           *mocks.MockEc2ApiClient
+		mockServiceQuotasAPI *mocks.MockServiceQuotasApiClient
+	)
+
+	BeforeEach(func() {
+		mockCtrl := gomock.NewController(GinkgoT())
+		mockEC2API = mocks.NewMockEc2ApiClient(mockCtrl)
+		mockServiceQuotasAPI = mocks.NewMockServiceQuotasApiClient(mockCtrl)
+		client = awsClient{
+			ec2Client:           mockEC2API,
+			serviceQuotasClient: mockServiceQuotasAPI,
+		}
+	})
+
+	It("Returns the value of a quota", func() {
+		mockServiceQuotasAPI.EXPECT().GetServiceQuota(gomock.Any(), &servicequotas.GetServiceQuotaInput{
+			ServiceCode: aws.String("ec2"),
+			QuotaCode:   aws.String("L-1216C47A"),
+		}).Return(&servicequotas.GetServiceQuotaOutput{
+			Quota: &servicequotastypes.ServiceQuota{Value: aws.Float64(640)},
+		}, nil)
+
+		value, err := client.GetServiceQuotaValue("ec2", "L-1216C47A")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(Equal(640.0))
+	})
+
+	It("Adds up the vCPUs of the running instances of each type", func() {
+		instance := func(instanceType ec2types.InstanceType, cores int32) ec2types.Instance {
+			return ec2types.Instance{
+				InstanceType: instanceType,
+				CpuOptions:   &ec2types.CpuOptions{CoreCount: aws.Int32(cores), ThreadsPerCore: aws.Int32(2)},
+			}
+		}
+		mockEC2API.EXPECT().DescribeInstances(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+			&ec2.DescribeInstancesOutput{
+				Reservations: []ec2types.Reservation{
+					{Instances: []ec2types.Instance{instance("m5.xlarge", 2), instance("m5.xlarge", 2)}},
+					{Instances: []ec2types.Instance{instance("g4dn.xlarge", 2)}},
+				},
+			}, nil)
+
+		vcpus, err := client.GetRunningInstanceVCPUs()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vcpus).To(Equal(map[string]int{"m5.xlarge": 8, "g4dn.xlarge": 4}))
+	})
+
+	It("Counts the NAT gateways of each availability zone", func() {
+		mockEC2API.EXPECT().DescribeNatGateways(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+			&ec2.DescribeNatGatewaysOutput{
+				NatGateways: []ec2types.NatGateway{
+					{SubnetId: aws.String("subnet-a")},
+					{SubnetId: aws.String("subnet-a")},
+					{SubnetId: aws.String("subnet-b")},
+				},
+			}, nil)
+		mockEC2API.EXPECT().DescribeSubnets(gomock.Any(), &ec2.DescribeSubnetsInput{
+			SubnetIds: []string{"subnet-a", "subnet-b"},
+		}, gomock.Any()).Return(&ec2.DescribeSubnetsOutput{
+			Subnets: []ec2types.Subnet{
+				{SubnetId: aws.String("subnet-a"), AvailabilityZone: aws.String("us-east-1a")},
+				{SubnetId: aws.String("subnet-b"), AvailabilityZone: aws.String("us-east-1b")},
+			},
+		}, nil)
+
+		zones, err := client.CountNatGatewaysByZone()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(zones).To(Equal(map[string]int{"us-east-1a": 2, "us-east-1b": 1}))
+	})
+
+	It("Adds up the storage of the volumes", func() {
+		mockEC2API.EXPECT().DescribeVolumes(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+			&ec2.DescribeVolumesOutput{
+				Volumes: []ec2types.Volume{{Size: aws.Int32(300)}, {Size: aws.Int32(120)}},
+			}, nil)
+
+		storage, err := client.GetVolumeStorage("gp3")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(storage).To(Equal(420))
+	})
+})
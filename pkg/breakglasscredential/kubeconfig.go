@@ -0,0 +1,97 @@
+package breakglasscredential
+
+import (
+	"fmt"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/kubeconfig"
+)
+
+// KubeconfigExtension is the name of the context extension that records the break glass credential that a
+// context of the kubeconfig was created from.
+const KubeconfigExtension = "rosa.openshift.io/break-glass-credential"
+
+// KubeconfigEntry is a context of the kubeconfig created from a break glass credential.
+type KubeconfigEntry struct {
+	Context             string    `json:"-"`
+	ClusterID           string    `json:"clusterID"`
+	ClusterName         string    `json:"clusterName"`
+	ID                  string    `json:"id"`
+	ExpirationTimestamp time.Time `json:"expirationTimestamp"`
+	OCMURL              string    `json:"ocmURL,omitempty"`
+}
+
+// Expired tells if the credential of the context has expired at the given time.
+func (e *KubeconfigEntry) Expired(now time.Time) bool {
+	return !e.ExpirationTimestamp.IsZero() && !now.Before(e.ExpirationTimestamp)
+}
+
+// ContextName is the name of the kubeconfig context of a break glass credential.
+func ContextName(clusterName string, credentialID string) string {
+	return fmt.Sprintf("%s-break-glass-%s", clusterName, credentialID)
+}
+
+// WriteKubeconfig merges the kubeconfig of a break glass credential into the kubeconfig file at the given
+// path, as a context that records the expiration of the credential and the URL of the OCM environment it
+// was created in. When use is true the context also becomes the current context. It returns the name of the
+// context and the path of the file.
+func WriteKubeconfig(path string, ocmURL string, cluster *cmv1.Cluster, credential *cmv1.BreakGlassCredential,
+	content string, use bool) (string, string, error) {
+	path, err := kubeconfig.Path(path)
+	if err != nil {
+		return "", "", err
+	}
+	source, err := kubeconfig.Parse([]byte(content))
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse the kubeconfig of break glass credential '%s': %v",
+			credential.ID(), err)
+	}
+	config, err := kubeconfig.Load(path)
+	if err != nil {
+		return "", "", err
+	}
+
+	name := ContextName(cluster.Name(), credential.ID())
+	extension, err := kubeconfig.NewExtension(KubeconfigExtension, KubeconfigEntry{
+		ClusterID:           cluster.ID(),
+		ClusterName:         cluster.Name(),
+		ID:                  credential.ID(),
+		ExpirationTimestamp: credential.ExpirationTimestamp(),
+		OCMURL:              ocmURL,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	err = config.Merge(source, name, extension)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to merge the kubeconfig of break glass credential '%s': %v",
+			credential.ID(), err)
+	}
+	if use {
+		config.CurrentContext = name
+	}
+	err = config.Save(path)
+	if err != nil {
+		return "", "", err
+	}
+	return name, path, nil
+}
+
+// KubeconfigEntries returns the contexts of the kubeconfig that were created from break glass credentials.
+func KubeconfigEntries(config *kubeconfig.Config) ([]KubeconfigEntry, error) {
+	var entries []KubeconfigEntry
+	for i := range config.Contexts {
+		var entry KubeconfigEntry
+		found, err := config.Contexts[i].Extension(KubeconfigExtension, &entry)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			entry.Context = config.Contexts[i].Name
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
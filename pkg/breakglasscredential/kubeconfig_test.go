@@ -0,0 +1,74 @@
+package breakglasscredential
+
+import (
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/kubeconfig"
+)
+
+const credentialKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: cluster
+  cluster:
+    server: https://api.mycluster.example.com:443
+users:
+- name: user
+  user:
+    client-certificate-data: Y2VydA==
+    client-key-data: a2V5
+contexts:
+- name: admin
+  context:
+    cluster: cluster
+    user: user
+current-context: admin
+`
+
+var _ = Describe("Kubeconfig", func() {
+	expiration := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	It("Writes the credential as a context that records its expiration", func() {
+		cluster, err := cmv1.NewCluster().ID("123").Name("mycluster").Build()
+		Expect(err).NotTo(HaveOccurred())
+		credential, err := cmv1.NewBreakGlassCredential().ID("abc").ExpirationTimestamp(expiration).Build()
+		Expect(err).NotTo(HaveOccurred())
+		path := filepath.Join(GinkgoT().TempDir(), "config")
+
+		name, written, err := WriteKubeconfig(path, "https://api.openshift.com", cluster, credential, credentialKubeconfig, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(name).To(Equal("mycluster-break-glass-abc"))
+		Expect(written).To(Equal(path))
+
+		config, err := kubeconfig.Load(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.CurrentContext).To(BeEmpty())
+		Expect(KubeconfigEntries(config)).To(Equal([]KubeconfigEntry{{
+			Context:             "mycluster-break-glass-abc",
+			ClusterID:           "123",
+			ClusterName:         "mycluster",
+			ID:                  "abc",
+			ExpirationTimestamp: expiration,
+			OCMURL:              "https://api.openshift.com",
+		}}))
+
+		_, _, err = WriteKubeconfig(path, "https://api.openshift.com", cluster, credential, credentialKubeconfig, true)
+		Expect(err).NotTo(HaveOccurred())
+		config, err = kubeconfig.Load(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.CurrentContext).To(Equal("mycluster-break-glass-abc"))
+		Expect(config.Contexts).To(HaveLen(1))
+	})
+
+	It("Tells if the credential of a context has expired", func() {
+		entry := KubeconfigEntry{ExpirationTimestamp: expiration}
+		Expect(entry.Expired(expiration.Add(-time.Second))).To(BeFalse())
+		Expect(entry.Expired(expiration)).To(BeTrue())
+		Expect((&KubeconfigEntry{}).Expired(expiration)).To(BeFalse())
+	})
+})
@@ -0,0 +1,242 @@
+package cache
+
+import (
+	"encoding/gob"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift/rosa/pkg/info"
+)
+
+const (
+	// CatalogGobName is the file, next to the cache of the versions of the client, that keeps the OCM
+	// catalog data.
+	CatalogGobName = "rosa-catalog-cache.gob"
+
+	// catalogFormat changes when the layout of the file changes. Files written with another format, or by
+	// another version of the client, are discarded, as the responses they contain may be parsed differently.
+	catalogFormat = "1"
+)
+
+// CatalogKind is a kind of OCM catalog data, with the API path it is read from and how long it is cached.
+type CatalogKind struct {
+	Name string
+	Path string
+	TTL  time.Duration
+}
+
+// CatalogKinds are the kinds of OCM catalog data that are cached. They change rarely, and are read by many
+// commands.
+var CatalogKinds = []CatalogKind{
+	{Name: "versions", Path: "/api/clusters_mgmt/v1/versions", TTL: time.Hour},
+	{Name: "regions", Path: "/api/clusters_mgmt/v1/cloud_providers", TTL: 24 * time.Hour},
+	{Name: "machine-types", Path: "/api/clusters_mgmt/v1/machine_types", TTL: 24 * time.Hour},
+	{Name: "policies", Path: "/api/clusters_mgmt/v1/aws_inquiries/sts_policies", TTL: 6 * time.Hour},
+	{Name: "credential-requests", Path: "/api/clusters_mgmt/v1/aws_inquiries/sts_credential_requests",
+		TTL: 6 * time.Hour},
+	{Name: "addons", Path: "/api/clusters_mgmt/v1/addons", TTL: 6 * time.Hour},
+}
+
+// FindCatalogKind returns the kind of OCM catalog data read from the given API path.
+func FindCatalogKind(path string) (CatalogKind, bool) {
+	for _, kind := range CatalogKinds {
+		if path == kind.Path || strings.HasPrefix(path, kind.Path+"/") {
+			return kind, true
+		}
+	}
+	return CatalogKind{}, false
+}
+
+// CatalogKindNames returns the names of the kinds of OCM catalog data.
+func CatalogKindNames() []string {
+	names := make([]string, 0, len(CatalogKinds))
+	for _, kind := range CatalogKinds {
+		names = append(names, kind.Name)
+	}
+	return names
+}
+
+// CatalogEntry is a response of OCM kept in the cache. Responses are cached separately for each OCM URL and
+// organization.
+type CatalogEntry struct {
+	URL          string
+	Organization string
+	Kind         string
+	Path         string
+	ContentType  string
+	Body         []byte
+}
+
+func init() {
+	gob.Register(CatalogEntry{})
+}
+
+// CatalogKey is the key of the cached response to a request.
+func CatalogKey(url string, organization string, path string) string {
+	return fmt.Sprintf("%s %s %s", url, organization, path)
+}
+
+type catalogFile struct {
+	Format  string
+	Version string
+	Items   map[string]Item
+}
+
+// CatalogCache is the persistent cache of the OCM catalog data.
+type CatalogCache struct {
+	path  string
+	mu    sync.Mutex
+	cache RosaCache
+}
+
+// DefaultCatalogPath returns the file of the OCM catalog cache, in the directory of the cache of the versions
+// of the client.
+func DefaultCatalogPath() (string, error) {
+	versionsPath, err := NewRosaCache(RosaCacheSpec{}).Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(versionsPath), CatalogGobName), nil
+}
+
+// LoadCatalogCache reads the OCM catalog cache from the given file. A missing file, or one that can't be
+// used by this version of the client, is an empty cache.
+func LoadCatalogCache(path string) (*CatalogCache, error) {
+	c := &CatalogCache{
+		path:  path,
+		cache: NewRosaCache(RosaCacheSpec{}),
+	}
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error opening cache file: %v", err)
+	}
+	defer file.Close()
+
+	var data catalogFile
+	if gob.NewDecoder(file).Decode(&data) != nil {
+		return c, nil
+	}
+	if data.Format != catalogFormat || data.Version != info.DefaultVersion {
+		return c, nil
+	}
+	for key, item := range data.Items {
+		if _, ok := item.Object.(CatalogEntry); ok && !item.Expired() {
+			c.cache.Set(key, item.Object, item.Expiration)
+		}
+	}
+	return c, nil
+}
+
+func (c *CatalogCache) Path() string {
+	return c.path
+}
+
+// Get returns the cached response with the given key, if it hasn't expired.
+func (c *CatalogCache) Get(key string) (CatalogEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	object, found := c.cache.Get(key)
+	if !found {
+		return CatalogEntry{}, false
+	}
+	entry, ok := object.(CatalogEntry)
+	return entry, ok
+}
+
+// Set caches a response until the given time, and saves the cache.
+func (c *CatalogCache) Set(key string, entry CatalogEntry, expiration time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.Set(key, entry, expiration)
+	return c.save()
+}
+
+// Items returns the cached responses that haven't expired, sorted by OCM URL, organization, kind and path.
+func (c *CatalogCache) Items() []Item {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	items := make([]Item, 0)
+	for _, item := range c.cache.Items() {
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		a, b := items[i].Object.(CatalogEntry), items[j].Object.(CatalogEntry)
+		if a.URL != b.URL {
+			return a.URL < b.URL
+		}
+		if a.Organization != b.Organization {
+			return a.Organization < b.Organization
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Path < b.Path
+	})
+	return items
+}
+
+// Clear removes the cached responses of the given kind, or all of them if the kind is empty, and returns
+// how many were removed.
+func (c *CatalogCache) Clear(kind string) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	items := c.cache.Items()
+	cache := NewRosaCache(RosaCacheSpec{})
+	removed := 0
+	for key, item := range items {
+		if kind == "" || item.Object.(CatalogEntry).Kind == kind {
+			removed++
+			continue
+		}
+		cache.Set(key, item.Object, item.Expiration)
+	}
+	c.cache = cache
+	if kind == "" {
+		err := os.Remove(c.path)
+		if err != nil && !os.IsNotExist(err) {
+			return 0, fmt.Errorf("error removing cache file: %v", err)
+		}
+		return removed, nil
+	}
+	return removed, c.save()
+}
+
+// save writes the cache to a temporary file that then replaces the cache file, so that other processes never
+// read a partially written cache.
+func (c *CatalogCache) save() error {
+	err := os.MkdirAll(filepath.Dir(c.path), 0700)
+	if err != nil {
+		return fmt.Errorf("error creating cache directory: %v", err)
+	}
+	file, err := os.CreateTemp(filepath.Dir(c.path), CatalogGobName+".*")
+	if err != nil {
+		return fmt.Errorf("error creating cache file: %v", err)
+	}
+	defer os.Remove(file.Name())
+
+	data := catalogFile{
+		Format:  catalogFormat,
+		Version: info.DefaultVersion,
+		Items:   c.cache.Items(),
+	}
+	err = gob.NewEncoder(file).Encode(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("error encoding cache: %v", err)
+	}
+	err = os.Rename(file.Name(), c.path)
+	if err != nil {
+		return fmt.Errorf("error writing cache file: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package cache
+
+import (
+	"encoding/gob"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CatalogCache", func() {
+	var path string
+
+	entry := func(kind string, path string) CatalogEntry {
+		return CatalogEntry{
+			URL:          "https://api.openshift.com",
+			Organization: "123",
+			Kind:         kind,
+			Path:         path,
+			Body:         []byte(`{"items":[]}`),
+		}
+	}
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), CatalogGobName)
+	})
+
+	It("Finds the kind of the catalog paths", func() {
+		kind, ok := FindCatalogKind("/api/clusters_mgmt/v1/versions/openshift-v4.15.0")
+		Expect(ok).To(BeTrue())
+		Expect(kind.Name).To(Equal("versions"))
+		_, ok = FindCatalogKind("/api/clusters_mgmt/v1/versionsx")
+		Expect(ok).To(BeFalse())
+		_, ok = FindCatalogKind("/api/clusters_mgmt/v1/clusters")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Keeps the responses in the cache file", func() {
+		catalog, err := LoadCatalogCache(path)
+		Expect(err).NotTo(HaveOccurred())
+		_, found := catalog.Get("key")
+		Expect(found).To(BeFalse())
+
+		expiration := time.Now().Add(time.Hour).Round(0)
+		Expect(catalog.Set("key", entry("versions", "/versions"), expiration)).To(Succeed())
+		Expect(catalog.Set("expired", entry("versions", "/old"), time.Now().Add(-time.Hour))).To(Succeed())
+
+		catalog, err = LoadCatalogCache(path)
+		Expect(err).NotTo(HaveOccurred())
+		cached, found := catalog.Get("key")
+		Expect(found).To(BeTrue())
+		Expect(cached).To(Equal(entry("versions", "/versions")))
+		_, found = catalog.Get("expired")
+		Expect(found).To(BeFalse())
+		Expect(catalog.Items()).To(HaveLen(1))
+		Expect(catalog.Items()[0].Expiration).To(BeTemporally("==", expiration))
+	})
+
+	It("Discards the cache files written by other versions", func() {
+		file, err := os.Create(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gob.NewEncoder(file).Encode(catalogFile{
+			Format:  catalogFormat,
+			Version: "0.0.1",
+			Items: map[string]Item{
+				"key": {Object: entry("versions", "/versions"), Expiration: time.Now().Add(time.Hour)},
+			},
+		})).To(Succeed())
+		Expect(file.Close()).To(Succeed())
+
+		catalog, err := LoadCatalogCache(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(catalog.Items()).To(BeEmpty())
+	})
+
+	It("Ignores cache files that can't be decoded", func() {
+		Expect(os.WriteFile(path, []byte("not a cache"), 0600)).To(Succeed())
+		catalog, err := LoadCatalogCache(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(catalog.Items()).To(BeEmpty())
+	})
+
+	It("Clears the responses of a kind or all of them", func() {
+		catalog, err := LoadCatalogCache(path)
+		Expect(err).NotTo(HaveOccurred())
+		expiration := time.Now().Add(time.Hour)
+		Expect(catalog.Set("versions", entry("versions", "/versions"), expiration)).To(Succeed())
+		Expect(catalog.Set("regions", entry("regions", "/regions"), expiration)).To(Succeed())
+		Expect(catalog.Set("addons", entry("addons", "/addons"), expiration)).To(Succeed())
+
+		Expect(catalog.Clear("versions")).To(Equal(1))
+		catalog, err = LoadCatalogCache(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(catalog.Items()).To(HaveLen(2))
+		Expect(catalog.Items()[0].Object.(CatalogEntry).Kind).To(Equal("addons"))
+
+		Expect(catalog.Clear("")).To(Equal(2))
+		Expect(path).NotTo(BeAnExistingFile())
+	})
+})
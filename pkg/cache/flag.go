@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	// EnvVar is the environment variable that enables the persistent cache of the OCM catalog.
+	EnvVar = "ROSA_CACHE"
+
+	NoCacheFlagName      = "no-cache"
+	RefreshCacheFlagName = "refresh-cache"
+)
+
+var (
+	noCache      bool
+	refreshCache bool
+)
+
+// AddFlags adds the flags that control the persistent cache to the given set of command line flags.
+func AddFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&noCache,
+		NoCacheFlagName,
+		false,
+		"Don't use the cache of OCM catalog data enabled with the "+EnvVar+" environment variable.",
+	)
+	flags.BoolVar(
+		&refreshCache,
+		RefreshCacheFlagName,
+		false,
+		"Fetch the OCM catalog data again and update the cache with it.",
+	)
+}
+
+// Enabled returns true if the OCM catalog data should be read from and written to the persistent cache.
+// The cache is enabled by setting the ROSA_CACHE environment variable to true, or by '--refresh-cache'.
+func Enabled() bool {
+	if noCache {
+		return false
+	}
+	if refreshCache {
+		return true
+	}
+	enabled, _ := strconv.ParseBool(os.Getenv(EnvVar))
+	return enabled
+}
+
+// Refresh returns true if the cached OCM catalog data should be replaced instead of used.
+func Refresh() bool {
+	return refreshCache
+}
+
+func SetFlags(noCacheValue bool, refreshCacheValue bool) {
+	noCache = noCacheValue
+	refreshCache = refreshCacheValue
+}
@@ -0,0 +1,127 @@
+package cache
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// OCMTransport answers the requests of the OCM connection for catalog data from the persistent cache, and
+// caches the responses to the ones it can't answer. Only GET requests are cached, and only once the
+// organization of the user is known.
+type OCMTransport struct {
+	path         string
+	refresh      bool
+	organization string
+	cache        *CatalogCache
+	once         sync.Once
+	mu           sync.RWMutex
+}
+
+// NewOCMTransport returns the cache transport for the OCM connection, reading and writing the cache file at
+// the given path. When refresh is true the cached responses are replaced instead of used.
+func NewOCMTransport(path string, refresh bool) *OCMTransport {
+	return &OCMTransport{
+		path:    path,
+		refresh: refresh,
+	}
+}
+
+// SetOrganization sets the organization of the user, that the cached responses are kept separately for.
+func (t *OCMTransport) SetOrganization(organization string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.organization = organization
+}
+
+// Wrap is the transport wrapper to add to the OCM connection.
+func (t *OCMTransport) Wrap(next http.RoundTripper) http.RoundTripper {
+	return &ocmRoundTripper{transport: t, next: next}
+}
+
+func (t *OCMTransport) getOrganization() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.organization
+}
+
+// load reads the cache the first time it is needed. The cache is disabled if it can't be read.
+func (t *OCMTransport) load() *CatalogCache {
+	t.once.Do(func() {
+		t.cache, _ = LoadCatalogCache(t.path)
+	})
+	return t.cache
+}
+
+type ocmRoundTripper struct {
+	transport *OCMTransport
+	next      http.RoundTripper
+}
+
+func (r *ocmRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.Method != http.MethodGet {
+		return r.next.RoundTrip(request)
+	}
+	kind, ok := FindCatalogKind(request.URL.Path)
+	if !ok {
+		return r.next.RoundTrip(request)
+	}
+	organization := r.transport.getOrganization()
+	if organization == "" {
+		return r.next.RoundTrip(request)
+	}
+	cache := r.transport.load()
+	if cache == nil {
+		return r.next.RoundTrip(request)
+	}
+
+	url := request.URL.Scheme + "://" + request.URL.Host
+	key := CatalogKey(url, organization, request.URL.RequestURI())
+	if !r.transport.refresh {
+		if entry, found := cache.Get(key); found {
+			return cachedResponse(request, entry), nil
+		}
+	}
+
+	response, err := r.next.RoundTrip(request)
+	if err != nil || response.StatusCode != http.StatusOK {
+		return response, err
+	}
+	body, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(body))
+
+	// The cache only saves time, failing to write it doesn't fail the request:
+	_ = cache.Set(key, CatalogEntry{
+		URL:          url,
+		Organization: organization,
+		Kind:         kind.Name,
+		Path:         request.URL.RequestURI(),
+		ContentType:  response.Header.Get("Content-Type"),
+		Body:         body,
+	}, time.Now().Add(kind.TTL))
+	return response, nil
+}
+
+func cachedResponse(request *http.Request, entry CatalogEntry) *http.Response {
+	header := http.Header{}
+	if entry.ContentType != "" {
+		header.Set("Content-Type", entry.ContentType)
+	}
+	return &http.Response{
+		Status:        http.StatusText(http.StatusOK),
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(entry.Body)),
+		ContentLength: int64(len(entry.Body)),
+		Request:       request,
+	}
+}
@@ -0,0 +1,126 @@
+package cache
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OCM transport", func() {
+	var (
+		server   *httptest.Server
+		requests atomic.Int32
+		status   int
+		path     string
+	)
+
+	get := func(client *http.Client, method string, path string) (int, string) {
+		request, err := http.NewRequest(method, server.URL+path, nil)
+		Expect(err).NotTo(HaveOccurred())
+		response, err := client.Do(request)
+		Expect(err).NotTo(HaveOccurred())
+		defer response.Body.Close()
+		body, err := io.ReadAll(response.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return response.StatusCode, string(body)
+	}
+
+	newClient := func(refresh bool, organization string) *http.Client {
+		transport := NewOCMTransport(path, refresh)
+		transport.SetOrganization(organization)
+		return &http.Client{Transport: transport.Wrap(http.DefaultTransport)}
+	}
+
+	BeforeEach(func() {
+		requests.Store(0)
+		status = http.StatusOK
+		path = filepath.Join(GinkgoT().TempDir(), CatalogGobName)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			count := requests.Add(1)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(strings.Repeat("x", int(count))))
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	It("Answers the catalog requests from the cache", func() {
+		client := newClient(false, "123")
+		code, body := get(client, http.MethodGet, "/api/clusters_mgmt/v1/versions?page=1")
+		Expect(code).To(Equal(http.StatusOK))
+		Expect(body).To(Equal("x"))
+		_, body = get(client, http.MethodGet, "/api/clusters_mgmt/v1/versions?page=1")
+		Expect(body).To(Equal("x"))
+
+		// A new process reads the cache file:
+		_, body = get(newClient(false, "123"), http.MethodGet, "/api/clusters_mgmt/v1/versions?page=1")
+		Expect(body).To(Equal("x"))
+		Expect(requests.Load()).To(Equal(int32(1)))
+	})
+
+	It("Keeps the responses of each organization separately", func() {
+		get(newClient(false, "123"), http.MethodGet, "/api/clusters_mgmt/v1/versions")
+		_, body := get(newClient(false, "456"), http.MethodGet, "/api/clusters_mgmt/v1/versions")
+		Expect(body).To(Equal("xx"))
+	})
+
+	It("Replaces the cached responses when refreshing", func() {
+		get(newClient(false, "123"), http.MethodGet, "/api/clusters_mgmt/v1/versions")
+		_, body := get(newClient(true, "123"), http.MethodGet, "/api/clusters_mgmt/v1/versions")
+		Expect(body).To(Equal("xx"))
+		_, body = get(newClient(false, "123"), http.MethodGet, "/api/clusters_mgmt/v1/versions")
+		Expect(body).To(Equal("xx"))
+	})
+
+	It("Sends the other requests to the server", func() {
+		client := newClient(false, "123")
+		get(client, http.MethodGet, "/api/clusters_mgmt/v1/clusters")
+		get(client, http.MethodGet, "/api/clusters_mgmt/v1/clusters")
+		get(client, http.MethodPost, "/api/clusters_mgmt/v1/cloud_providers/aws/available_regions")
+		get(client, http.MethodPost, "/api/clusters_mgmt/v1/cloud_providers/aws/available_regions")
+		Expect(requests.Load()).To(Equal(int32(4)))
+
+		client = newClient(false, "")
+		get(client, http.MethodGet, "/api/clusters_mgmt/v1/versions")
+		get(client, http.MethodGet, "/api/clusters_mgmt/v1/versions")
+		Expect(requests.Load()).To(Equal(int32(6)))
+	})
+
+	It("Doesn't cache failed requests", func() {
+		status = http.StatusForbidden
+		client := newClient(false, "123")
+		code, _ := get(client, http.MethodGet, "/api/clusters_mgmt/v1/versions")
+		Expect(code).To(Equal(http.StatusForbidden))
+		status = http.StatusOK
+		_, body := get(client, http.MethodGet, "/api/clusters_mgmt/v1/versions")
+		Expect(body).To(Equal("xx"))
+	})
+})
+
+var _ = Describe("Flags", func() {
+	AfterEach(func() {
+		SetFlags(false, false)
+	})
+
+	It("Enables the cache with the environment variable", func() {
+		GinkgoT().Setenv(EnvVar, "")
+		Expect(Enabled()).To(BeFalse())
+		GinkgoT().Setenv(EnvVar, "true")
+		Expect(Enabled()).To(BeTrue())
+		SetFlags(true, false)
+		Expect(Enabled()).To(BeFalse())
+	})
+
+	It("Enables the cache when refreshing it", func() {
+		GinkgoT().Setenv(EnvVar, "")
+		SetFlags(false, true)
+		Expect(Enabled()).To(BeTrue())
+		Expect(Refresh()).To(BeTrue())
+	})
+})
@@ -0,0 +1,287 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterspec
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// Tags that are added by ROSA itself and must not be replayed as user tags
+var managedTagPrefixes = []string{"red-hat-", "sigs.k8s.io/"}
+
+// Properties that are added by ROSA itself, like the version of the CLI or the hibernation schedule, and
+// must not be replayed as user properties
+const managedPropertyPrefix = "rosa_"
+
+// FromCluster builds the spec that recreates the given cluster. Values that OCM does not return,
+// such as the cluster admin password or the contents of trust bundles, are not included, nor is the
+// mode used to create the operator roles, as they already exist. The default ingress and the cluster
+// autoscaler are separate resources, use SetDefaultIngress and SetClusterAutoscaler to add them.
+func FromCluster(cluster *cmv1.Cluster) *ClusterSpec {
+	s := Spec{
+		Name:                      cluster.Name(),
+		DomainPrefix:              cluster.DomainPrefix(),
+		Region:                    cluster.Region().ID(),
+		Version:                   cluster.Version().RawID(),
+		HostedCP:                  cluster.Hypershift().Enabled(),
+		MultiAZ:                   cluster.MultiAZ() && !cluster.Hypershift().Enabled(),
+		BillingAccount:            cluster.AWS().BillingAccountID(),
+		DisableWorkloadMonitoring: cluster.DisableUserWorkloadMonitoring(),
+		Ec2MetadataHttpTokens:     string(cluster.AWS().Ec2MetadataHttpTokens()),
+		Tags:                      userTags(cluster.AWS().Tags()),
+		Properties:                userProperties(cluster.Properties()),
+	}
+	if channelGroup := cluster.Version().ChannelGroup(); channelGroup != "" && channelGroup != "stable" {
+		s.ChannelGroup = channelGroup
+	}
+
+	sts := cluster.AWS().STS()
+	isSTS := sts.RoleARN() != ""
+	s.STS = &isSTS
+	if isSTS {
+		s.Roles = &Roles{
+			RoleARN:             sts.RoleARN(),
+			ExternalID:          sts.ExternalID(),
+			SupportRoleARN:      sts.SupportRoleARN(),
+			WorkerRoleARN:       sts.InstanceIAMRoles().WorkerRoleARN(),
+			OperatorRolesPrefix: sts.OperatorRolePrefix(),
+			PermissionsBoundary: sts.PermissionBoundary(),
+			OidcConfigID:        sts.OidcConfig().ID(),
+			AuditLogRoleARN:     cluster.AWS().AuditLog().RoleArn(),
+		}
+		if !s.HostedCP {
+			s.Roles.ControlPlaneRoleARN = sts.InstanceIAMRoles().MasterRoleARN()
+		}
+	}
+
+	if cluster.FIPS() || cluster.EtcdEncryption() || cluster.AWS().KMSKeyArn() != "" {
+		s.Encryption = &Encryption{
+			FIPS:                     cluster.FIPS(),
+			EtcdEncryption:           cluster.EtcdEncryption() && !cluster.FIPS(),
+			EtcdEncryptionKMSArn:     cluster.AWS().EtcdEncryption().KMSKeyARN(),
+			EnableCustomerManagedKey: cluster.AWS().KMSKeyArn() != "",
+			KMSKeyArn:                cluster.AWS().KMSKeyArn(),
+		}
+	}
+
+	network := cluster.Network()
+	s.Network = &Network{
+		Type:                        network.Type(),
+		MachineCIDR:                 network.MachineCIDR(),
+		ServiceCIDR:                 network.ServiceCIDR(),
+		PodCIDR:                     network.PodCIDR(),
+		HostPrefix:                  network.HostPrefix(),
+		Private:                     cluster.API().Listening() == cmv1.ListeningMethodInternal,
+		PrivateLink:                 cluster.AWS().PrivateLink(),
+		SubnetIDs:                   cluster.AWS().SubnetIDs(),
+		AdditionalAllowedPrincipals: cluster.AWS().AdditionalAllowedPrincipals(),
+	}
+	if s.Network.PrivateLink {
+		// Private link implies a private API, only one of the flags is accepted
+		s.Network.Private = false
+	}
+	if len(s.Network.SubnetIDs) == 0 && !s.HostedCP {
+		// Availability zones can only be selected for clusters without a BYO VPC
+		s.Network.AvailabilityZones = cluster.Nodes().AvailabilityZones()
+	}
+
+	if proxy := cluster.Proxy(); proxy.HTTPProxy() != "" || proxy.HTTPSProxy() != "" || proxy.NoProxy() != "" {
+		s.Proxy = &Proxy{
+			HTTPProxy:  proxy.HTTPProxy(),
+			HTTPSProxy: proxy.HTTPSProxy(),
+		}
+		if proxy.NoProxy() != "" {
+			s.Proxy.NoProxy = strings.Split(proxy.NoProxy(), ",")
+		}
+	}
+
+	nodes := cluster.Nodes()
+	s.Compute = &Compute{
+		MachineType: nodes.ComputeMachineType().ID(),
+		Labels:      nodes.ComputeLabels(),
+	}
+	if autoscaling, ok := nodes.GetAutoscaleCompute(); ok {
+		s.Compute.Autoscaling = &Autoscaling{
+			MinReplicas: autoscaling.MinReplicas(),
+			MaxReplicas: autoscaling.MaxReplicas(),
+		}
+	} else if replicas, ok := nodes.GetCompute(); ok {
+		s.Compute.Replicas = &replicas
+	}
+	if size := nodes.ComputeRootVolume().AWS().Size(); size != 0 {
+		s.Compute.DiskSize = fmt.Sprintf("%dGiB", size)
+	}
+
+	aws := cluster.AWS()
+	if len(aws.AdditionalComputeSecurityGroupIds()) > 0 || len(aws.AdditionalInfraSecurityGroupIds()) > 0 ||
+		len(aws.AdditionalControlPlaneSecurityGroupIds()) > 0 {
+		s.SecurityGroups = &SecurityGroups{
+			Compute:      aws.AdditionalComputeSecurityGroupIds(),
+			Infra:        aws.AdditionalInfraSecurityGroupIds(),
+			ControlPlane: aws.AdditionalControlPlaneSecurityGroupIds(),
+		}
+	}
+
+	if sources := cluster.RegistryConfig().RegistrySources(); sources != nil {
+		s.RegistryConfig = &RegistryConfig{
+			AllowedRegistries:  sources.AllowedRegistries(),
+			BlockedRegistries:  sources.BlockedRegistries(),
+			InsecureRegistries: sources.InsecureRegistries(),
+		}
+	}
+	if allowlist := cluster.RegistryConfig().PlatformAllowlist(); allowlist.ID() != "" {
+		if s.RegistryConfig == nil {
+			s.RegistryConfig = &RegistryConfig{}
+		}
+		s.RegistryConfig.PlatformAllowlist = allowlist.ID()
+	}
+
+	if aws.PrivateHostedZoneID() != "" {
+		s.SharedVPC = &SharedVPC{
+			PrivateHostedZoneID: aws.PrivateHostedZoneID(),
+			RoleARN:             aws.PrivateHostedZoneRoleARN(),
+			BaseDomain:          cluster.DNS().BaseDomain(),
+		}
+	}
+
+	if cluster.ExternalAuthConfig().Enabled() {
+		s.ExternalAuth = &ExternalAuth{Enabled: true}
+	}
+
+	return &ClusterSpec{
+		APIVersion: APIVersion,
+		Kind:       Kind,
+		Spec:       s,
+	}
+}
+
+// SetDefaultIngress adds the settings of the given default ingress of the cluster to the spec.
+func (c *ClusterSpec) SetDefaultIngress(ingress *cmv1.Ingress) {
+	c.Spec.DefaultIngress = &DefaultIngress{
+		RouteSelector:            ingress.RouteSelectors(),
+		ExcludedNamespaces:       ingress.ExcludedNamespaces(),
+		WildcardPolicy:           string(ingress.RouteWildcardPolicy()),
+		NamespaceOwnershipPolicy: string(ingress.RouteNamespaceOwnershipPolicy()),
+	}
+}
+
+// SetClusterAutoscaler adds the settings of the given cluster autoscaler to the spec. It returns the
+// settings that can't be written to the spec.
+func (c *ClusterSpec) SetClusterAutoscaler(autoscaler *cmv1.ClusterAutoscaler) []string {
+	var unsupported []string
+	settings := map[string]string{}
+	setBool := func(key string, value bool, ok bool) {
+		if ok {
+			settings[key] = strconv.FormatBool(value)
+		}
+	}
+	setInt := func(key string, value int, ok bool) {
+		if ok {
+			settings[key] = strconv.Itoa(value)
+		}
+	}
+	setString := func(key string, value string) {
+		if value != "" {
+			settings[key] = value
+		}
+	}
+
+	value, ok := autoscaler.GetBalanceSimilarNodeGroups()
+	setBool("balance-similar-node-groups", value, ok)
+	value, ok = autoscaler.GetSkipNodesWithLocalStorage()
+	setBool("skip-nodes-with-local-storage", value, ok)
+	value, ok = autoscaler.GetIgnoreDaemonsetsUtilization()
+	setBool("ignore-daemonsets-utilization", value, ok)
+	number, ok := autoscaler.GetLogVerbosity()
+	setInt("log-verbosity", number, ok)
+	number, ok = autoscaler.GetMaxPodGracePeriod()
+	setInt("max-pod-grace-period", number, ok)
+	number, ok = autoscaler.GetPodPriorityThreshold()
+	setInt("pod-priority-threshold", number, ok)
+	setString("max-node-provision-time", autoscaler.MaxNodeProvisionTime())
+	setString("balancing-ignored-labels", strings.Join(autoscaler.BalancingIgnoredLabels(), ","))
+
+	if limits, ok := autoscaler.GetResourceLimits(); ok {
+		number, ok = limits.GetMaxNodesTotal()
+		setInt("max-nodes-total", number, ok)
+		if cores, ok := limits.GetCores(); ok {
+			setInt("min-cores", cores.Min(), true)
+			setInt("max-cores", cores.Max(), true)
+		}
+		if memory, ok := limits.GetMemory(); ok {
+			setInt("min-memory", memory.Min(), true)
+			setInt("max-memory", memory.Max(), true)
+		}
+		// The spec holds a single value per flag, so only one GPU limit can be written:
+		gpus := limits.GPUS()
+		if len(gpus) == 1 {
+			settings["gpu-limit"] = fmt.Sprintf("%s,%d,%d", gpus[0].Type(), gpus[0].Range().Min(),
+				gpus[0].Range().Max())
+		} else if len(gpus) > 1 {
+			unsupported = append(unsupported, "gpu-limit")
+		}
+	}
+
+	if scaleDown, ok := autoscaler.GetScaleDown(); ok {
+		value, ok = scaleDown.GetEnabled()
+		setBool("scale-down-enabled", value, ok)
+		setString("scale-down-unneeded-time", scaleDown.UnneededTime())
+		setString("scale-down-utilization-threshold", scaleDown.UtilizationThreshold())
+		setString("scale-down-delay-after-add", scaleDown.DelayAfterAdd())
+		setString("scale-down-delay-after-delete", scaleDown.DelayAfterDelete())
+		setString("scale-down-delay-after-failure", scaleDown.DelayAfterFailure())
+	}
+
+	if len(settings) > 0 {
+		c.Spec.ClusterAutoscaler = settings
+	}
+	return unsupported
+}
+
+func userProperties(properties map[string]string) []string {
+	var result []string
+	for _, key := range sortedKeys(properties) {
+		if !strings.HasPrefix(key, managedPropertyPrefix) {
+			result = append(result, fmt.Sprintf("%s:%s", key, properties[key]))
+		}
+	}
+	return result
+}
+
+func userTags(tags map[string]string) map[string]string {
+	result := map[string]string{}
+	for k, v := range tags {
+		managed := false
+		for _, prefix := range managedTagPrefixes {
+			if strings.HasPrefix(k, prefix) {
+				managed = true
+				break
+			}
+		}
+		if !managed {
+			result[k] = v
+		}
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
@@ -0,0 +1,446 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clusterspec implements the declarative cluster specification file accepted by
+// 'rosa create cluster --from-file' and produced by 'rosa describe cluster --output spec'.
+//
+// The specification is not applied to OCM directly. Instead every field is translated into the
+// equivalent 'rosa create cluster' command line flag, so that a cluster created from a file goes
+// through exactly the same validations and defaults as a cluster created from flags.
+package clusterspec
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	APIVersion = "rosa.openshift.io/v1alpha1"
+	Kind       = "ClusterSpec"
+
+	// OutputFormat is the value of the '--output' flag used to render a cluster as a spec file
+	OutputFormat = "spec"
+
+	// FromFileFlag is the name of the 'rosa create cluster' flag that loads a spec file
+	FromFileFlag = "from-file"
+)
+
+// ClusterSpec is the versioned envelope of a cluster specification file.
+type ClusterSpec struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Spec       Spec   `json:"spec"`
+}
+
+// Spec describes the desired configuration of a cluster.
+type Spec struct {
+	Name                      string            `json:"name"`
+	DomainPrefix              string            `json:"domainPrefix,omitempty"`
+	Region                    string            `json:"region,omitempty"`
+	Version                   string            `json:"version,omitempty"`
+	ChannelGroup              string            `json:"channelGroup,omitempty"`
+	HostedCP                  bool              `json:"hostedCP,omitempty"`
+	MultiAZ                   bool              `json:"multiAZ,omitempty"`
+	STS                       *bool             `json:"sts,omitempty"`
+	Mode                      string            `json:"mode,omitempty"`
+	Expiration                string            `json:"expiration,omitempty"`
+	BillingAccount            string            `json:"billingAccount,omitempty"`
+	DisableSCPChecks          bool              `json:"disableSCPChecks,omitempty"`
+	DisableWorkloadMonitoring bool              `json:"disableWorkloadMonitoring,omitempty"`
+	Ec2MetadataHttpTokens     string            `json:"ec2MetadataHttpTokens,omitempty"`
+	Tags                      map[string]string `json:"tags,omitempty"`
+	Properties                []string          `json:"properties,omitempty"`
+
+	Roles             *Roles            `json:"roles,omitempty"`
+	Encryption        *Encryption       `json:"encryption,omitempty"`
+	Network           *Network          `json:"network,omitempty"`
+	Proxy             *Proxy            `json:"proxy,omitempty"`
+	Compute           *Compute          `json:"compute,omitempty"`
+	SecurityGroups    *SecurityGroups   `json:"securityGroups,omitempty"`
+	DefaultIngress    *DefaultIngress   `json:"defaultIngress,omitempty"`
+	RegistryConfig    *RegistryConfig   `json:"registryConfig,omitempty"`
+	ClusterAutoscaler map[string]string `json:"clusterAutoscaler,omitempty"`
+	ClusterAdmin      *ClusterAdmin     `json:"clusterAdmin,omitempty"`
+	SharedVPC         *SharedVPC        `json:"sharedVPC,omitempty"`
+	ExternalAuth      *ExternalAuth     `json:"externalAuth,omitempty"`
+}
+
+// Roles holds the STS account roles, operator roles and OIDC configuration of a cluster.
+type Roles struct {
+	RoleARN             string `json:"roleARN,omitempty"`
+	ExternalID          string `json:"externalID,omitempty"`
+	SupportRoleARN      string `json:"supportRoleARN,omitempty"`
+	ControlPlaneRoleARN string `json:"controlPlaneRoleARN,omitempty"`
+	WorkerRoleARN       string `json:"workerRoleARN,omitempty"`
+	OperatorRolesPrefix string `json:"operatorRolesPrefix,omitempty"`
+	PermissionsBoundary string `json:"permissionsBoundary,omitempty"`
+	OidcConfigID        string `json:"oidcConfigID,omitempty"`
+	ClassicOidcConfig   bool   `json:"classicOidcConfig,omitempty"`
+	AuditLogRoleARN     string `json:"auditLogRoleARN,omitempty"`
+}
+
+// Encryption holds the FIPS, etcd and KMS settings of a cluster.
+type Encryption struct {
+	FIPS                     bool   `json:"fips,omitempty"`
+	EtcdEncryption           bool   `json:"etcdEncryption,omitempty"`
+	EtcdEncryptionKMSArn     string `json:"etcdEncryptionKMSArn,omitempty"`
+	EnableCustomerManagedKey bool   `json:"enableCustomerManagedKey,omitempty"`
+	KMSKeyArn                string `json:"kmsKeyArn,omitempty"`
+}
+
+// Network holds the networking settings of a cluster.
+type Network struct {
+	Type                        string   `json:"type,omitempty"`
+	MachineCIDR                 string   `json:"machineCIDR,omitempty"`
+	ServiceCIDR                 string   `json:"serviceCIDR,omitempty"`
+	PodCIDR                     string   `json:"podCIDR,omitempty"`
+	HostPrefix                  int      `json:"hostPrefix,omitempty"`
+	Private                     bool     `json:"private,omitempty"`
+	PrivateLink                 bool     `json:"privateLink,omitempty"`
+	SubnetIDs                   []string `json:"subnetIDs,omitempty"`
+	AvailabilityZones           []string `json:"availabilityZones,omitempty"`
+	NoCNI                       bool     `json:"noCNI,omitempty"`
+	AdditionalAllowedPrincipals []string `json:"additionalAllowedPrincipals,omitempty"`
+}
+
+// Proxy holds the cluster-wide proxy settings.
+type Proxy struct {
+	HTTPProxy                 string   `json:"httpProxy,omitempty"`
+	HTTPSProxy                string   `json:"httpsProxy,omitempty"`
+	NoProxy                   []string `json:"noProxy,omitempty"`
+	AdditionalTrustBundleFile string   `json:"additionalTrustBundleFile,omitempty"`
+}
+
+// Compute holds the settings of the default worker machine pool.
+type Compute struct {
+	MachineType string            `json:"machineType,omitempty"`
+	Replicas    *int              `json:"replicas,omitempty"`
+	Autoscaling *Autoscaling      `json:"autoscaling,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	DiskSize    string            `json:"diskSize,omitempty"`
+}
+
+// Autoscaling holds the autoscaling limits of the default worker machine pool.
+type Autoscaling struct {
+	MinReplicas int `json:"minReplicas"`
+	MaxReplicas int `json:"maxReplicas"`
+}
+
+// SecurityGroups holds the additional security groups of each machine pool type.
+type SecurityGroups struct {
+	Compute      []string `json:"compute,omitempty"`
+	Infra        []string `json:"infra,omitempty"`
+	ControlPlane []string `json:"controlPlane,omitempty"`
+}
+
+// DefaultIngress holds the settings of the default ingress controller.
+type DefaultIngress struct {
+	RouteSelector            map[string]string `json:"routeSelector,omitempty"`
+	ExcludedNamespaces       []string          `json:"excludedNamespaces,omitempty"`
+	WildcardPolicy           string            `json:"wildcardPolicy,omitempty"`
+	NamespaceOwnershipPolicy string            `json:"namespaceOwnershipPolicy,omitempty"`
+}
+
+// RegistryConfig holds the image registry settings of a cluster.
+type RegistryConfig struct {
+	AllowedRegistries          []string `json:"allowedRegistries,omitempty"`
+	BlockedRegistries          []string `json:"blockedRegistries,omitempty"`
+	InsecureRegistries         []string `json:"insecureRegistries,omitempty"`
+	AllowedRegistriesForImport string   `json:"allowedRegistriesForImport,omitempty"`
+	PlatformAllowlist          string   `json:"platformAllowlist,omitempty"`
+	AdditionalTrustedCaFile    string   `json:"additionalTrustedCaFile,omitempty"`
+}
+
+// ClusterAdmin requests the creation of a cluster admin user. Passwords are intentionally not part of
+// the specification so that spec files can be kept in version control; use '--cluster-admin-password'.
+type ClusterAdmin struct {
+	Create   bool   `json:"create,omitempty"`
+	Username string `json:"username,omitempty"`
+}
+
+// SharedVPC holds the settings of a cluster installed into a VPC shared from another account.
+type SharedVPC struct {
+	PrivateHostedZoneID string `json:"privateHostedZoneID,omitempty"`
+	RoleARN             string `json:"roleARN,omitempty"`
+	BaseDomain          string `json:"baseDomain,omitempty"`
+}
+
+// ExternalAuth enables external authentication providers on hosted control plane clusters.
+type ExternalAuth struct {
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// Flag is a single 'rosa create cluster' command line flag derived from a spec.
+type Flag struct {
+	Name  string
+	Value string
+}
+
+// Load reads and validates a cluster spec file. Both YAML and JSON are accepted.
+func Load(path string) (*ClusterSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read cluster spec file '%s': %v", path, err)
+	}
+	spec, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid cluster spec file '%s': %v", path, err)
+	}
+	return spec, nil
+}
+
+// Parse decodes and validates a cluster spec document. Unknown fields are rejected so that typos
+// don't silently fall back to defaults.
+func Parse(data []byte) (*ClusterSpec, error) {
+	spec := &ClusterSpec{}
+	if err := yaml.UnmarshalStrict(data, spec); err != nil {
+		return nil, err
+	}
+	if spec.APIVersion != APIVersion {
+		return nil, fmt.Errorf("unsupported apiVersion '%s', expected '%s'", spec.APIVersion, APIVersion)
+	}
+	if spec.Kind != Kind {
+		return nil, fmt.Errorf("unsupported kind '%s', expected '%s'", spec.Kind, Kind)
+	}
+	if strings.TrimSpace(spec.Spec.Name) == "" {
+		return nil, fmt.Errorf("'spec.name' is required")
+	}
+	return spec, nil
+}
+
+// Marshal renders the spec as a YAML document.
+func Marshal(spec *ClusterSpec) ([]byte, error) {
+	return yaml.Marshal(spec)
+}
+
+// Flags translates the spec into the equivalent 'rosa create cluster' flags.
+func (c *ClusterSpec) Flags() []Flag {
+	s := c.Spec
+	b := &flagBuilder{}
+
+	b.str("cluster-name", s.Name)
+	b.str("domain-prefix", s.DomainPrefix)
+	b.str("region", s.Region)
+	b.str("version", s.Version)
+	b.str("channel-group", s.ChannelGroup)
+	b.boolean("hosted-cp", s.HostedCP)
+	b.boolean("multi-az", s.MultiAZ)
+	if s.STS != nil {
+		if *s.STS {
+			b.boolean("sts", true)
+		} else {
+			b.boolean("non-sts", true)
+		}
+	}
+	b.str("mode", s.Mode)
+	b.str("expiration", s.Expiration)
+	b.str("billing-account", s.BillingAccount)
+	b.boolean("disable-scp-checks", s.DisableSCPChecks)
+	b.boolean("disable-workload-monitoring", s.DisableWorkloadMonitoring)
+	b.str("ec2-metadata-http-tokens", s.Ec2MetadataHttpTokens)
+	b.str("tags", formatTags(s.Tags))
+	for _, property := range s.Properties {
+		b.str("properties", property)
+	}
+
+	if r := s.Roles; r != nil {
+		b.str("role-arn", r.RoleARN)
+		b.str("external-id", r.ExternalID)
+		b.str("support-role-arn", r.SupportRoleARN)
+		b.str("controlplane-iam-role-arn", r.ControlPlaneRoleARN)
+		b.str("worker-iam-role-arn", r.WorkerRoleARN)
+		b.str("operator-roles-prefix", r.OperatorRolesPrefix)
+		b.str("permissions-boundary", r.PermissionsBoundary)
+		b.str("oidc-config-id", r.OidcConfigID)
+		b.boolean("classic-oidc-config", r.ClassicOidcConfig)
+		b.str("audit-log-arn", r.AuditLogRoleARN)
+	}
+
+	if e := s.Encryption; e != nil {
+		b.boolean("fips", e.FIPS)
+		b.boolean("etcd-encryption", e.EtcdEncryption)
+		b.str("etcd-encryption-kms-arn", e.EtcdEncryptionKMSArn)
+		b.boolean("enable-customer-managed-key", e.EnableCustomerManagedKey)
+		b.str("kms-key-arn", e.KMSKeyArn)
+	}
+
+	if n := s.Network; n != nil {
+		b.str("network-type", n.Type)
+		b.str("machine-cidr", n.MachineCIDR)
+		b.str("service-cidr", n.ServiceCIDR)
+		b.str("pod-cidr", n.PodCIDR)
+		if n.HostPrefix != 0 {
+			b.str("host-prefix", strconv.Itoa(n.HostPrefix))
+		}
+		b.boolean("private", n.Private)
+		b.boolean("private-link", n.PrivateLink)
+		b.list("subnet-ids", n.SubnetIDs)
+		b.list("availability-zones", n.AvailabilityZones)
+		b.boolean("no-cni", n.NoCNI)
+		b.list("additional-allowed-principals", n.AdditionalAllowedPrincipals)
+	}
+
+	if p := s.Proxy; p != nil {
+		b.str("http-proxy", p.HTTPProxy)
+		b.str("https-proxy", p.HTTPSProxy)
+		b.list("no-proxy", p.NoProxy)
+		b.str("additional-trust-bundle-file", p.AdditionalTrustBundleFile)
+	}
+
+	if m := s.Compute; m != nil {
+		b.str("compute-machine-type", m.MachineType)
+		if m.Autoscaling != nil {
+			b.boolean("enable-autoscaling", true)
+			b.str("min-replicas", strconv.Itoa(m.Autoscaling.MinReplicas))
+			b.str("max-replicas", strconv.Itoa(m.Autoscaling.MaxReplicas))
+		} else if m.Replicas != nil {
+			b.str("replicas", strconv.Itoa(*m.Replicas))
+		}
+		b.str("worker-mp-labels", formatPairs(m.Labels))
+		b.str("worker-disk-size", m.DiskSize)
+	}
+
+	if g := s.SecurityGroups; g != nil {
+		b.list("additional-compute-security-group-ids", g.Compute)
+		b.list("additional-infra-security-group-ids", g.Infra)
+		b.list("additional-control-plane-security-group-ids", g.ControlPlane)
+	}
+
+	if i := s.DefaultIngress; i != nil {
+		b.str("default-ingress-route-selector", formatPairs(i.RouteSelector))
+		b.str("default-ingress-excluded-namespaces", strings.Join(i.ExcludedNamespaces, ","))
+		b.str("default-ingress-wildcard-policy", i.WildcardPolicy)
+		b.str("default-ingress-namespace-ownership-policy", i.NamespaceOwnershipPolicy)
+	}
+
+	if rc := s.RegistryConfig; rc != nil {
+		b.list("registry-config-allowed-registries", rc.AllowedRegistries)
+		b.list("registry-config-blocked-registries", rc.BlockedRegistries)
+		b.list("registry-config-insecure-registries", rc.InsecureRegistries)
+		b.str("registry-config-allowed-registries-for-import", rc.AllowedRegistriesForImport)
+		b.str("registry-config-platform-allowlist", rc.PlatformAllowlist)
+		b.str("registry-config-additional-trusted-ca", rc.AdditionalTrustedCaFile)
+	}
+
+	if len(s.ClusterAutoscaler) > 0 {
+		// Cluster autoscaler flags are only accepted together with '--enable-autoscaling'
+		if s.Compute == nil || s.Compute.Autoscaling == nil {
+			b.boolean("enable-autoscaling", true)
+		}
+		for _, key := range sortedKeys(s.ClusterAutoscaler) {
+			b.str("autoscaler-"+key, s.ClusterAutoscaler[key])
+		}
+	}
+
+	if a := s.ClusterAdmin; a != nil {
+		b.boolean("create-admin-user", a.Create && a.Username == "")
+		b.str("cluster-admin-user", a.Username)
+	}
+
+	if v := s.SharedVPC; v != nil {
+		b.str("private-hosted-zone-id", v.PrivateHostedZoneID)
+		b.str("shared-vpc-role-arn", v.RoleARN)
+		b.str("base-domain", v.BaseDomain)
+	}
+
+	if a := s.ExternalAuth; a != nil {
+		b.boolean("external-auth-providers-enabled", a.Enabled)
+	}
+
+	return b.flags
+}
+
+// Apply sets the flags derived from the spec on the given flag set. Flags that were explicitly given
+// on the command line take precedence over the values in the spec and are left untouched.
+func (c *ClusterSpec) Apply(flags *pflag.FlagSet) error {
+	overridden := map[string]bool{}
+	for _, flag := range c.Flags() {
+		f := flags.Lookup(flag.Name)
+		if f == nil {
+			return fmt.Errorf("Cluster spec field for flag '--%s' is not supported by this command", flag.Name)
+		}
+		if f.Changed && !overridden[flag.Name] {
+			continue
+		}
+		if err := flags.Set(flag.Name, flag.Value); err != nil {
+			return fmt.Errorf("Invalid value '%s' in cluster spec for flag '--%s': %v", flag.Value, flag.Name, err)
+		}
+		overridden[flag.Name] = true
+	}
+	return nil
+}
+
+type flagBuilder struct {
+	flags []Flag
+}
+
+func (b *flagBuilder) str(name string, value string) {
+	if value == "" {
+		return
+	}
+	b.flags = append(b.flags, Flag{Name: name, Value: value})
+}
+
+func (b *flagBuilder) boolean(name string, value bool) {
+	if !value {
+		return
+	}
+	b.flags = append(b.flags, Flag{Name: name, Value: "true"})
+}
+
+func (b *flagBuilder) list(name string, values []string) {
+	b.str(name, strings.Join(values, ","))
+}
+
+// formatTags renders tags the way the '--tags' flag expects them. The ':' delimiter is replaced by a
+// space when a key or value already contains a colon.
+func formatTags(tags map[string]string) string {
+	delim := ":"
+	for k, v := range tags {
+		if strings.Contains(k, ":") || strings.Contains(v, ":") {
+			delim = " "
+			break
+		}
+	}
+	formatted := []string{}
+	for _, k := range sortedKeys(tags) {
+		formatted = append(formatted, fmt.Sprintf("%s%s%s", k, delim, tags[k]))
+	}
+	return strings.Join(formatted, ",")
+}
+
+func formatPairs(pairs map[string]string) string {
+	formatted := []string{}
+	for _, k := range sortedKeys(pairs) {
+		formatted = append(formatted, fmt.Sprintf("%s=%s", k, pairs[k]))
+	}
+	return strings.Join(formatted, ",")
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,13 @@
+package clusterspec_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestClusterSpec(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Cluster Spec Suite")
+}
@@ -0,0 +1,226 @@
+package clusterspec
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/pflag"
+)
+
+const specYAML = `apiVersion: rosa.openshift.io/v1alpha1
+kind: ClusterSpec
+spec:
+  name: mycluster
+  region: us-east-1
+  hostedCP: true
+  sts: true
+  tags:
+    team: sre
+    env: prod
+  roles:
+    roleARN: arn:aws:iam::123456789012:role/ManagedOpenShift-HCP-ROSA-Installer-Role
+    oidcConfigID: 2a3b4c
+  network:
+    subnetIDs:
+    - subnet-1
+    - subnet-2
+  compute:
+    machineType: m5.xlarge
+    replicas: 3
+`
+
+var _ = Describe("Parse", func() {
+	It("parses a valid spec", func() {
+		spec, err := Parse([]byte(specYAML))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(spec.Spec.Name).To(Equal("mycluster"))
+		Expect(*spec.Spec.Compute.Replicas).To(Equal(3))
+	})
+	It("accepts JSON", func() {
+		spec, err := Parse([]byte(`{"apiVersion":"rosa.openshift.io/v1alpha1","kind":"ClusterSpec",` +
+			`"spec":{"name":"mycluster"}}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(spec.Spec.Name).To(Equal("mycluster"))
+	})
+	It("rejects an unknown apiVersion", func() {
+		_, err := Parse([]byte("apiVersion: v2\nkind: ClusterSpec\nspec:\n  name: mycluster\n"))
+		Expect(err).To(MatchError(ContainSubstring("unsupported apiVersion 'v2'")))
+	})
+	It("rejects unknown fields", func() {
+		_, err := Parse([]byte(specYAML + "  replica: 3\n"))
+		Expect(err).To(HaveOccurred())
+	})
+	It("requires a name", func() {
+		_, err := Parse([]byte("apiVersion: rosa.openshift.io/v1alpha1\nkind: ClusterSpec\nspec: {}\n"))
+		Expect(err).To(MatchError("'spec.name' is required"))
+	})
+})
+
+var _ = Describe("Flags", func() {
+	It("translates the spec into create cluster flags", func() {
+		spec, err := Parse([]byte(specYAML))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(spec.Flags()).To(Equal([]Flag{
+			{Name: "cluster-name", Value: "mycluster"},
+			{Name: "region", Value: "us-east-1"},
+			{Name: "hosted-cp", Value: "true"},
+			{Name: "sts", Value: "true"},
+			{Name: "tags", Value: "env:prod,team:sre"},
+			{Name: "role-arn", Value: "arn:aws:iam::123456789012:role/ManagedOpenShift-HCP-ROSA-Installer-Role"},
+			{Name: "oidc-config-id", Value: "2a3b4c"},
+			{Name: "subnet-ids", Value: "subnet-1,subnet-2"},
+			{Name: "compute-machine-type", Value: "m5.xlarge"},
+			{Name: "replicas", Value: "3"},
+		}))
+	})
+	It("uses a space delimiter for tags containing colons", func() {
+		spec := &ClusterSpec{Spec: Spec{Name: "mycluster", Tags: map[string]string{"a:b": "c"}}}
+		Expect(spec.Flags()).To(ContainElement(Flag{Name: "tags", Value: "a:b c"}))
+	})
+	It("selects --non-sts when sts is false", func() {
+		sts := false
+		spec := &ClusterSpec{Spec: Spec{Name: "mycluster", STS: &sts}}
+		Expect(spec.Flags()).To(ContainElement(Flag{Name: "non-sts", Value: "true"}))
+	})
+})
+
+var _ = Describe("Apply", func() {
+	var flags *pflag.FlagSet
+	var name, region string
+	var properties []string
+
+	BeforeEach(func() {
+		flags = pflag.NewFlagSet("test", pflag.ContinueOnError)
+		flags.StringVar(&name, "cluster-name", "", "")
+		flags.StringVar(&region, "region", "", "")
+		flags.StringArrayVar(&properties, "properties", nil, "")
+	})
+
+	It("keeps values given on the command line", func() {
+		Expect(flags.Parse([]string{"--cluster-name", "other"})).To(Succeed())
+		spec := &ClusterSpec{Spec: Spec{Name: "mycluster", Region: "us-east-1"}}
+		Expect(spec.Apply(flags)).To(Succeed())
+		Expect(name).To(Equal("other"))
+		Expect(region).To(Equal("us-east-1"))
+		Expect(flags.Changed("region")).To(BeTrue())
+	})
+	It("applies repeated flags", func() {
+		spec := &ClusterSpec{Spec: Spec{Name: "mycluster", Properties: []string{"a:b", "c:d"}}}
+		Expect(spec.Apply(flags)).To(Succeed())
+		Expect(properties).To(Equal([]string{"a:b", "c:d"}))
+	})
+	It("fails for flags the command doesn't support", func() {
+		spec := &ClusterSpec{Spec: Spec{Name: "mycluster", Version: "4.15.0"}}
+		Expect(spec.Apply(flags)).To(MatchError(ContainSubstring("'--version' is not supported")))
+	})
+})
+
+var _ = Describe("FromCluster", func() {
+	It("round trips through the spec file", func() {
+		cluster, err := cmv1.NewCluster().
+			Name("mycluster").
+			Region(cmv1.NewCloudRegion().ID("us-west-2")).
+			Version(cmv1.NewVersion().RawID("4.15.9").ChannelGroup("candidate")).
+			Hypershift(cmv1.NewHypershift().Enabled(true)).
+			AWS(cmv1.NewAWS().
+				SubnetIDs("subnet-1", "subnet-2").
+				Tags(map[string]string{"team": "sre", "red-hat-managed": "true"}).
+				STS(cmv1.NewSTS().
+					RoleARN("arn:aws:iam::123456789012:role/Installer").
+					SupportRoleARN("arn:aws:iam::123456789012:role/Support").
+					OperatorRolePrefix("mycluster-x1y2").
+					OidcConfig(cmv1.NewOidcConfig().ID("2a3b4c")).
+					InstanceIAMRoles(cmv1.NewInstanceIAMRoles().
+						MasterRoleARN("arn:aws:iam::123456789012:role/ControlPlane").
+						WorkerRoleARN("arn:aws:iam::123456789012:role/Worker")))).
+			Properties(map[string]string{"owner": "sre", "rosa_cli_version": "1.2.40"}).
+			Network(cmv1.NewNetwork().Type("OVNKubernetes").MachineCIDR("10.0.0.0/16").HostPrefix(23)).
+			Nodes(cmv1.NewClusterNodes().
+				ComputeMachineType(cmv1.NewMachineType().ID("m5.xlarge")).
+				AutoscaleCompute(cmv1.NewMachinePoolAutoscaling().MinReplicas(2).MaxReplicas(6))).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		data, err := Marshal(FromCluster(cluster))
+		Expect(err).ToNot(HaveOccurred())
+		spec, err := Parse(data)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(spec.Spec.ChannelGroup).To(Equal("candidate"))
+		Expect(spec.Spec.Tags).To(Equal(map[string]string{"team": "sre"}))
+		Expect(spec.Spec.Roles.ControlPlaneRoleARN).To(BeEmpty())
+		Expect(spec.Spec.Network.AvailabilityZones).To(BeEmpty())
+		Expect(spec.Flags()).To(ContainElements(
+			Flag{Name: "cluster-name", Value: "mycluster"},
+			Flag{Name: "version", Value: "4.15.9"},
+			Flag{Name: "hosted-cp", Value: "true"},
+			Flag{Name: "sts", Value: "true"},
+			Flag{Name: "worker-iam-role-arn", Value: "arn:aws:iam::123456789012:role/Worker"},
+			Flag{Name: "host-prefix", Value: "23"},
+			Flag{Name: "enable-autoscaling", Value: "true"},
+			Flag{Name: "min-replicas", Value: "2"},
+			Flag{Name: "max-replicas", Value: "6"},
+			Flag{Name: "properties", Value: "owner:sre"},
+		))
+		Expect(spec.Spec.Properties).To(Equal([]string{"owner:sre"}))
+	})
+
+	It("adds the default ingress and the cluster autoscaler", func() {
+		cluster, err := cmv1.NewCluster().Name("mycluster").Build()
+		Expect(err).ToNot(HaveOccurred())
+		ingress, err := cmv1.NewIngress().
+			RouteSelectors(map[string]string{"shard": "a"}).
+			ExcludedNamespaces("stage", "dev").
+			RouteWildcardPolicy(cmv1.WildcardPolicyWildcardsAllowed).
+			RouteNamespaceOwnershipPolicy(cmv1.NamespaceOwnershipPolicyInterNamespaceAllowed).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		autoscaler, err := cmv1.NewClusterAutoscaler().
+			BalanceSimilarNodeGroups(true).
+			MaxPodGracePeriod(300).
+			ResourceLimits(cmv1.NewAutoscalerResourceLimits().
+				MaxNodesTotal(10).
+				Cores(cmv1.NewResourceRange().Min(2).Max(20)).
+				GPUS(cmv1.NewAutoscalerResourceLimitsGPULimit().Type("nvidia.com/gpu").
+					Range(cmv1.NewResourceRange().Min(0).Max(4)))).
+			ScaleDown(cmv1.NewAutoscalerScaleDownConfig().Enabled(true).UnneededTime("10m")).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		spec := FromCluster(cluster)
+		spec.SetDefaultIngress(ingress)
+		Expect(spec.SetClusterAutoscaler(autoscaler)).To(BeEmpty())
+		Expect(spec.Flags()).To(ContainElements(
+			Flag{Name: "default-ingress-route-selector", Value: "shard=a"},
+			Flag{Name: "default-ingress-excluded-namespaces", Value: "stage,dev"},
+			Flag{Name: "default-ingress-wildcard-policy", Value: "WildcardsAllowed"},
+			Flag{Name: "default-ingress-namespace-ownership-policy", Value: "InterNamespaceAllowed"},
+			Flag{Name: "enable-autoscaling", Value: "true"},
+			Flag{Name: "autoscaler-balance-similar-node-groups", Value: "true"},
+			Flag{Name: "autoscaler-max-pod-grace-period", Value: "300"},
+			Flag{Name: "autoscaler-max-nodes-total", Value: "10"},
+			Flag{Name: "autoscaler-min-cores", Value: "2"},
+			Flag{Name: "autoscaler-max-cores", Value: "20"},
+			Flag{Name: "autoscaler-gpu-limit", Value: "nvidia.com/gpu,0,4"},
+			Flag{Name: "autoscaler-scale-down-enabled", Value: "true"},
+			Flag{Name: "autoscaler-scale-down-unneeded-time", Value: "10m"},
+		))
+	})
+
+	It("reports the cluster autoscaler settings that can't be added", func() {
+		cluster, err := cmv1.NewCluster().Name("mycluster").Build()
+		Expect(err).ToNot(HaveOccurred())
+		autoscaler, err := cmv1.NewClusterAutoscaler().
+			ResourceLimits(cmv1.NewAutoscalerResourceLimits().GPUS(
+				cmv1.NewAutoscalerResourceLimitsGPULimit().Type("nvidia.com/gpu").
+					Range(cmv1.NewResourceRange().Min(0).Max(4)),
+				cmv1.NewAutoscalerResourceLimitsGPULimit().Type("amd.com/gpu").
+					Range(cmv1.NewResourceRange().Min(1).Max(2)))).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		spec := FromCluster(cluster)
+		Expect(spec.SetClusterAutoscaler(autoscaler)).To(Equal([]string{"gpu-limit"}))
+		Expect(spec.Spec.ClusterAutoscaler).To(BeEmpty())
+	})
+})
@@ -57,20 +57,29 @@ type Config struct {
 	UserAgent    string   `json:"user_agent,omitempty" doc:"OCM client UserAgent. Default value is used if not set."`
 	Version      string   `json:"version,omitempty" doc:"OCM client version. Default value is used if not set."`
 	FedRAMP      bool     `json:"fedramp,omitempty" doc:"Indicates FedRAMP."`
+
+	CurrentContext string             `json:"current_context,omitempty" doc:"Name of the login context in use."`
+	Contexts       map[string]*Config `json:"contexts,omitempty" doc:"Named login contexts."`
+
+	// context is the name of the context this configuration was loaded from, when it isn't the current one
+	context string
 }
 
-var DisallowedSetConfigProperties = []string{"scopes"}
+var DisallowedSetConfigProperties = []string{"scopes", "current_context", "contexts"}
 
 func ConfigPropertiesNamesAndDocs() ([]string, []string) {
 	configType := reflect.ValueOf(Config{}).Type()
-	names := make([]string, configType.NumField())
-	docs := make([]string, configType.NumField())
+	names := make([]string, 0, configType.NumField())
+	docs := make([]string, 0, configType.NumField())
 	for i := 0; i < configType.NumField(); i++ {
+		if !configType.Field(i).IsExported() {
+			continue
+		}
 		tag := configType.Field(i).Tag
 		propName := strings.Split(tag.Get("json"), ",")[0]
-		names[i] = propName
+		names = append(names, propName)
 		propDoc := tag.Get("doc")
-		docs[i] = propDoc
+		docs = append(docs, propDoc)
 	}
 	return names, docs
 }
@@ -100,8 +109,14 @@ func GetAllowedConfigProperties() []string {
 	return allowedProperties
 }
 
-// Loads the configuration from the OS keyring if requested, load from the configuration file if not
+// Load loads the configuration of the context selected with the '--context' flag, or of the current
+// context if no context was selected. It returns nil if the configuration or the context doesn't exist.
 func Load() (cfg *Config, err error) {
+	return LoadContext(SelectedContext())
+}
+
+// Loads the configuration from the OS keyring if requested, load from the configuration file if not
+func loadDocument() (cfg *Config, err error) {
 	if keyring, ok := IsKeyringManaged(); ok {
 		return loadFromOS(keyring)
 	}
@@ -162,8 +177,35 @@ func loadFromFile() (cfg *Config, err error) {
 	return
 }
 
-// Save saves the given configuration to the configuration file.
+// Save saves the given configuration to the configuration file. Configurations loaded from a context other
+// than the current one are saved to that context only.
 func Save(cfg *Config) error {
+	name := SelectedContext()
+	if cfg != nil && cfg.context != "" {
+		name = cfg.context
+	}
+	if name == "" || cfg == nil {
+		return saveDocument(cfg)
+	}
+	doc, err := loadDocument()
+	if err != nil {
+		return err
+	}
+	if doc != nil && cfg.context == "" && name == doc.currentContext() {
+		return saveDocument(cfg)
+	}
+	return saveContext(doc, name, cfg)
+}
+
+// Saves the whole configuration, keeping the entry of the current context in sync with the credentials
+func saveDocument(cfg *Config) error {
+	if cfg != nil && cfg.CurrentContext != "" {
+		if cfg.Contexts == nil {
+			cfg.Contexts = map[string]*Config{}
+		}
+		cfg.Contexts[cfg.CurrentContext] = cfg.credentials()
+	}
+
 	file, err := Location()
 	if err != nil {
 		return err
@@ -194,8 +236,13 @@ func Save(cfg *Config) error {
 	return nil
 }
 
-// Remove removes the configuration file.
+// Remove removes the configuration file. If a context was selected with the '--context' flag, only that
+// context is removed.
 func Remove() error {
+	if name := SelectedContext(); name != "" {
+		return removeContext(name)
+	}
+
 	if keyring, ok := IsKeyringManaged(); ok {
 		err := RemoveConfigFromKeyring(keyring)
 		if err != nil {
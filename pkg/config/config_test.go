@@ -45,17 +45,19 @@ func TestConfig(t *testing.T) {
 
 var _ = Describe("Config", Ordered, func() {
 	propNamesAndDocs := map[string]string{
-		"access_token":  "Bearer access token.",
-		"client_id":     "OpenID client identifier.",
-		"client_secret": "OpenID client secret.",
-		"insecure":      "Enables insecure communication with the server.",
-		"refresh_token": "Offline or refresh token.",
-		"scopes":        "OpenID scope.",
-		"token_url":     "OpenID token URL.",
-		"url":           "URL of the API gateway.",
-		"user_agent":    "OCM client UserAgent. Default value is used if not set.",
-		"version":       "OCM client version. Default value is used if not set.",
-		"fedramp":       "Indicates FedRAMP.",
+		"access_token":    "Bearer access token.",
+		"client_id":       "OpenID client identifier.",
+		"client_secret":   "OpenID client secret.",
+		"insecure":        "Enables insecure communication with the server.",
+		"refresh_token":   "Offline or refresh token.",
+		"scopes":          "OpenID scope.",
+		"token_url":       "OpenID token URL.",
+		"url":             "URL of the API gateway.",
+		"user_agent":      "OCM client UserAgent. Default value is used if not set.",
+		"version":         "OCM client version. Default value is used if not set.",
+		"fedramp":         "Indicates FedRAMP.",
+		"current_context": "Name of the login context in use.",
+		"contexts":        "Named login contexts.",
 	}
 
 	It("Shows properties and docs for config", func() {
@@ -160,6 +162,78 @@ var _ = Describe("Config", Ordered, func() {
 		})
 	})
 
+	When("Using contexts", Ordered, func() {
+		var tmpdir string
+		var err error
+
+		BeforeAll(func() {
+			tmpdir, err = os.MkdirTemp("/tmp", ".ocm-config-*")
+			Expect(err).NotTo(HaveOccurred())
+			os.Setenv("OCM_CONFIG", tmpdir+"/ocm_config.json")
+			Expect(Save(&Config{URL: "https://api.openshift.com", AccessToken: "prod"})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			SetSelectedContext("")
+		})
+
+		AfterAll(func() {
+			os.Setenv("OCM_CONFIG", "")
+		})
+
+		It("Saves a new context without changing the current one", func() {
+			SetSelectedContext("staging")
+			cfg, err := Load()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg).To(BeNil())
+			Expect(Save(&Config{URL: "https://api.stage.openshift.com", AccessToken: "stage"})).To(Succeed())
+
+			SetSelectedContext("")
+			cfg, err = Load()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.AccessToken).To(Equal("prod"))
+			Expect(cfg.CurrentContext).To(Equal(DefaultContext))
+
+			current, contexts, err := GetContexts()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(current).To(Equal(DefaultContext))
+			Expect(ContextNames(contexts)).To(Equal([]string{DefaultContext, "staging"}))
+		})
+
+		It("Persists tokens to the selected context", func() {
+			SetSelectedContext("staging")
+			Expect(PersistTokens(nil, "stage2", "refresh")).To(Succeed())
+			cfg, err := LoadContext("staging")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.AccessToken).To(Equal("stage2"))
+
+			cfg, err = LoadContext("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.AccessToken).To(Equal("prod"))
+		})
+
+		It("Switches the current context", func() {
+			Expect(UseContext("staging")).To(Succeed())
+			cfg, err := Load()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.AccessToken).To(Equal("stage2"))
+			Expect(cfg.URL).To(Equal("https://api.stage.openshift.com"))
+			Expect(cfg.Contexts[DefaultContext].AccessToken).To(Equal("prod"))
+
+			Expect(UseContext("missing")).To(MatchError(ContainSubstring("Context 'missing' doesn't exist")))
+		})
+
+		It("Removes only the selected context", func() {
+			SetSelectedContext(DefaultContext)
+			Expect(Remove()).To(Succeed())
+			SetSelectedContext("")
+
+			current, contexts, err := GetContexts()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(current).To(Equal("staging"))
+			Expect(ContextNames(contexts)).To(Equal([]string{"staging"}))
+		})
+	})
 })
 var _ = Describe("Config Keyring", func() {
 	When("Load()", func() {
@@ -0,0 +1,206 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions used to manage named login contexts. Every context holds its own
+// credentials and URL. The top level of the configuration always contains a copy of the current
+// context, so that tools that don't know about contexts keep working.
+
+package config
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/pflag"
+)
+
+// DefaultContext is the name given to the credentials that were saved before any context was created.
+const DefaultContext = "default"
+
+const contextFlagName = "context"
+
+// selectedContext is the context selected with the '--context' flag for the current process
+var selectedContext string
+
+// AddContextFlag adds the '--context' flag to the given set of command line flags.
+func AddContextFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&selectedContext,
+		contextFlagName,
+		"",
+		"Name of the login context to use instead of the current one.",
+	)
+}
+
+// SelectedContext returns the name of the context selected with the '--context' flag, or an empty string
+// if the current context should be used.
+func SelectedContext() string {
+	return selectedContext
+}
+
+func SetSelectedContext(name string) {
+	selectedContext = name
+}
+
+// LoadContext loads the configuration of the given context. An empty name loads the current context. It
+// returns nil if the configuration or the context doesn't exist.
+func LoadContext(name string) (*Config, error) {
+	doc, err := loadDocument()
+	if err != nil || doc == nil {
+		return doc, err
+	}
+	if name == "" || name == doc.currentContext() {
+		return doc, nil
+	}
+	entry, ok := doc.Contexts[name]
+	if !ok || entry == nil {
+		return nil, nil
+	}
+	cfg := entry.credentials()
+	cfg.context = name
+	return cfg, nil
+}
+
+// GetContexts returns the name of the current context and the configuration of every context. Credentials
+// that were saved before any context was created are returned as the default context.
+func GetContexts() (current string, contexts map[string]*Config, err error) {
+	doc, err := loadDocument()
+	if err != nil || doc == nil {
+		return "", map[string]*Config{}, err
+	}
+	doc.normalize()
+	contexts = map[string]*Config{}
+	for name, entry := range doc.Contexts {
+		if entry != nil {
+			contexts[name] = entry.credentials()
+		}
+	}
+	return doc.CurrentContext, contexts, nil
+}
+
+// ContextNames returns the sorted names of the given contexts.
+func ContextNames(contexts map[string]*Config) []string {
+	names := make([]string, 0, len(contexts))
+	for name := range contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// UseContext makes the given context the current one.
+func UseContext(name string) error {
+	doc, err := loadDocument()
+	if err != nil {
+		return err
+	}
+	if doc == nil {
+		return fmt.Errorf("Context '%s' doesn't exist, run 'rosa login --context %s'", name, name)
+	}
+	doc.normalize()
+	entry, ok := doc.Contexts[name]
+	if !ok || entry == nil {
+		return fmt.Errorf("Context '%s' doesn't exist, run 'rosa login --context %s'", name, name)
+	}
+	if doc.CurrentContext != "" {
+		doc.Contexts[doc.CurrentContext] = doc.credentials()
+	}
+	doc.setCredentials(entry)
+	doc.CurrentContext = name
+	return saveDocument(doc)
+}
+
+// Saves the credentials of the given configuration to a context. The context becomes the current one if
+// there is no current context yet.
+func saveContext(doc *Config, name string, cfg *Config) error {
+	if doc == nil {
+		doc = &Config{}
+	}
+	doc.normalize()
+	if doc.Contexts == nil {
+		doc.Contexts = map[string]*Config{}
+	}
+	doc.Contexts[name] = cfg.credentials()
+	if doc.CurrentContext == "" || doc.CurrentContext == name {
+		doc.CurrentContext = name
+		doc.setCredentials(cfg)
+	}
+	return saveDocument(doc)
+}
+
+// Removes a context. Removing the current context also removes the top level credentials.
+func removeContext(name string) error {
+	doc, err := loadDocument()
+	if err != nil || doc == nil {
+		return err
+	}
+	doc.normalize()
+	if _, ok := doc.Contexts[name]; !ok {
+		return nil
+	}
+	delete(doc.Contexts, name)
+	if doc.CurrentContext == name {
+		doc.setCredentials(&Config{})
+		doc.CurrentContext = ""
+	}
+	if len(doc.Contexts) == 0 {
+		doc.Contexts = nil
+	}
+	return saveDocument(doc)
+}
+
+// Returns the name of the current context, including the implicit default context.
+func (c *Config) currentContext() string {
+	if c.CurrentContext == "" && len(c.Contexts) == 0 {
+		return DefaultContext
+	}
+	return c.CurrentContext
+}
+
+// Turns credentials saved before any context was created into the default context.
+func (c *Config) normalize() {
+	if c.CurrentContext != "" || !c.hasCredentials() {
+		return
+	}
+	if c.Contexts == nil {
+		c.Contexts = map[string]*Config{}
+	}
+	if _, ok := c.Contexts[DefaultContext]; ok {
+		return
+	}
+	c.Contexts[DefaultContext] = c.credentials()
+	c.CurrentContext = DefaultContext
+}
+
+func (c *Config) hasCredentials() bool {
+	return c.AccessToken != "" || c.RefreshToken != "" || c.ClientID != "" || c.URL != ""
+}
+
+// Returns a copy of the configuration without the contexts.
+func (c *Config) credentials() *Config {
+	result := *c
+	result.CurrentContext = ""
+	result.Contexts = nil
+	result.context = ""
+	return &result
+}
+
+// Replaces the top level credentials, keeping the contexts.
+func (c *Config) setCredentials(cfg *Config) {
+	current, contexts := c.CurrentContext, c.Contexts
+	*c = *cfg.credentials()
+	c.CurrentContext, c.Contexts = current, contexts
+}
@@ -0,0 +1,378 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the detection of the changes made to the IAM roles created by ROSA, for example
+// when somebody edits a policy in the AWS console. The live documents are compared with the documents
+// that ROSA would create with the current policy templates.
+
+package drift
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/roles"
+)
+
+// Role contains the expected documents of a role.
+type Role struct {
+	Name string
+	Type string
+
+	// The prefix of the account roles, used to find the permission policy of account roles:
+	Prefix string
+
+	// The expected permission policy, empty when the role uses policies managed by AWS:
+	PermissionPolicy string
+
+	// The expected trust policy:
+	TrustPolicy string
+}
+
+// Diff contains the elements that are in the live document but not in the expected one, and the
+// elements that are in the expected document but not in the live one.
+type Diff struct {
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+func (d Diff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0
+}
+
+// Result is the drift of a role. The actions, resources and principals summarize the differences, and the
+// permissions and the trust contain every difference, including the effects and the conditions.
+type Result struct {
+	RoleName    string `json:"role_name"`
+	RoleType    string `json:"role_type"`
+	PolicyARN   string `json:"policy_arn,omitempty"`
+	Actions     Diff   `json:"actions"`
+	Resources   Diff   `json:"resources"`
+	Principals  Diff   `json:"principals"`
+	Permissions Diff   `json:"permissions"`
+	Trust       Diff   `json:"trust"`
+	Error       string `json:"error,omitempty"`
+}
+
+// HasDrift returns true if the role doesn't match the expected documents, or if it couldn't be checked.
+func (r Result) HasDrift() bool {
+	return r.Error != "" || r.permissionPolicyDrifted() || r.trustPolicyDrifted()
+}
+
+func (r Result) permissionPolicyDrifted() bool {
+	return !r.Actions.IsEmpty() || !r.Resources.IsEmpty() || !r.Permissions.IsEmpty()
+}
+
+func (r Result) trustPolicyDrifted() bool {
+	return !r.Principals.IsEmpty() || !r.Trust.IsEmpty()
+}
+
+// AccountRoles returns the expected documents of the account roles with the given prefix. The permission
+// policies are only compared when they aren't managed by AWS.
+func AccountRoles(policies map[string]*cmv1.AWSSTSPolicy, prefix string, partition string, env string,
+	hostedCP bool, managedPolicies bool) []Role {
+	result := []Role{}
+	for _, accountRole := range roles.AccountRoles(prefix, hostedCP) {
+		role := Role{
+			Name:   accountRole.Name,
+			Type:   accountRole.Type,
+			Prefix: prefix,
+			TrustPolicy: aws.InterpolatePolicyDocument(partition,
+				aws.GetPolicyDetails(policies, fmt.Sprintf("sts_%s_trust_policy", accountRole.File)),
+				map[string]string{
+					"partition":      partition,
+					"aws_account_id": aws.GetJumpAccount(env),
+				}),
+		}
+		if !managedPolicies {
+			role.PermissionPolicy = aws.InterpolatePolicyDocument(partition,
+				aws.GetPolicyDetails(policies, fmt.Sprintf("sts_%s_permission_policy", accountRole.File)), nil)
+		}
+		result = append(result, role)
+	}
+	return result
+}
+
+// OperatorRoles returns the expected documents of the operator roles of the cluster.
+func OperatorRoles(cluster *cmv1.Cluster, credRequests map[string]*cmv1.STSOperator,
+	policies map[string]*cmv1.AWSSTSPolicy, partition string, accountID string) ([]Role, error) {
+	isSharedVpc := cluster.AWS().PrivateHostedZoneRoleARN() != ""
+	result := []Role{}
+	for _, operatorRole := range roles.OperatorRoles(cluster, credRequests) {
+		trustPolicy, err := aws.GenerateOperatorRolePolicyDoc(partition, cluster, accountID, operatorRole.Operator,
+			aws.GetPolicyDetails(policies, "operator_iam_role_policy"))
+		if err != nil {
+			return nil, fmt.Errorf("Failed to generate the trust policy of role '%s': %v", operatorRole.Name, err)
+		}
+		role := Role{
+			Name:        operatorRole.Name,
+			Type:        operatorRole.Type,
+			TrustPolicy: trustPolicy,
+		}
+		if !cluster.AWS().STS().ManagedPolicies() {
+			role.PermissionPolicy = aws.InterpolatePolicyDocument(partition,
+				aws.GetPolicyDetails(policies, aws.GetOperatorPolicyKey(operatorRole.Key,
+					cluster.Hypershift().Enabled(), isSharedVpc)),
+				map[string]string{
+					"shared_vpc_role_arn": cluster.AWS().PrivateHostedZoneRoleARN(),
+				})
+		}
+		result = append(result, role)
+	}
+	return result, nil
+}
+
+type Verifier struct {
+	awsClient aws.Client
+}
+
+func NewVerifier(awsClient aws.Client) *Verifier {
+	return &Verifier{
+		awsClient: awsClient,
+	}
+}
+
+// Verify compares the live documents of the role with the expected ones.
+func (v *Verifier) Verify(role Role) Result {
+	result := Result{
+		RoleName: role.Name,
+		RoleType: role.Type,
+	}
+	err := v.verify(role, &result)
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+func (v *Verifier) verify(role Role, result *Result) error {
+	liveRole, err := v.awsClient.GetRoleByName(role.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get role: %v", err)
+	}
+	trustPolicy, err := url.QueryUnescape(awssdk.ToString(liveRole.AssumeRolePolicyDocument))
+	if err != nil {
+		return fmt.Errorf("failed to decode trust policy: %v", err)
+	}
+	result.Principals, err = ComparePrincipals(role.TrustPolicy, trustPolicy)
+	if err != nil {
+		return fmt.Errorf("failed to compare trust policy: %v", err)
+	}
+	result.Trust, err = CompareStatements(role.TrustPolicy, trustPolicy)
+	if err != nil {
+		return fmt.Errorf("failed to compare trust policy: %v", err)
+	}
+
+	if role.PermissionPolicy == "" {
+		return nil
+	}
+	if role.Type == roles.AccountRole {
+		result.PolicyARN, err = v.awsClient.GetAccountRoleDefaultPolicy(role.Name, role.Prefix)
+	} else {
+		result.PolicyARN, err = v.awsClient.GetOperatorRoleDefaultPolicy(role.Name)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get permission policy: %v", err)
+	}
+	if result.PolicyARN == "" {
+		return fmt.Errorf("there is no permission policy managed by Red Hat attached to the role")
+	}
+	permissionPolicy, err := v.awsClient.GetDefaultPolicyDocument(result.PolicyARN)
+	if err != nil {
+		return fmt.Errorf("failed to get permission policy document: %v", err)
+	}
+	result.Actions, result.Resources, err = ComparePermissions(role.PermissionPolicy, permissionPolicy)
+	if err != nil {
+		return fmt.Errorf("failed to compare permission policy: %v", err)
+	}
+	result.Permissions, err = CompareStatements(role.PermissionPolicy, permissionPolicy)
+	if err != nil {
+		return fmt.Errorf("failed to compare permission policy: %v", err)
+	}
+	return nil
+}
+
+// Repair replaces the documents that drifted with the expected ones.
+func (v *Verifier) Repair(role Role, result Result) error {
+	if result.trustPolicyDrifted() {
+		err := v.awsClient.UpdateTrustPolicy(role.Name, role.TrustPolicy)
+		if err != nil {
+			return fmt.Errorf("Failed to update the trust policy of role '%s': %v", role.Name, err)
+		}
+	}
+	if result.PolicyARN != "" && result.permissionPolicyDrifted() {
+		err := v.awsClient.UpdatePolicyDocument(result.PolicyARN, role.PermissionPolicy)
+		if err != nil {
+			return fmt.Errorf("Failed to update the permission policy '%s': %v", result.PolicyARN, err)
+		}
+	}
+	return nil
+}
+
+// ComparePermissions returns the actions and the resources that differ between the expected and the live
+// permission policies. The actions are compared ignoring case, like AWS does.
+func ComparePermissions(expected string, live string) (actions Diff, resources Diff, err error) {
+	expectedActions, expectedResources, err := permissions(expected)
+	if err != nil {
+		return
+	}
+	liveActions, liveResources, err := permissions(live)
+	if err != nil {
+		return
+	}
+	actions = compare(expectedActions, liveActions, strings.ToLower)
+	resources = compare(expectedResources, liveResources, nil)
+	return
+}
+
+// ComparePrincipals returns the principals that differ between the expected and the live trust policies.
+func ComparePrincipals(expected string, live string) (Diff, error) {
+	expectedPrincipals, err := principals(expected)
+	if err != nil {
+		return Diff{}, err
+	}
+	livePrincipals, err := principals(live)
+	if err != nil {
+		return Diff{}, err
+	}
+	return compare(expectedPrincipals, livePrincipals, nil), nil
+}
+
+// CompareStatements returns the statements that differ between the expected and the live documents. The
+// statements are split in one entry per action, resource and principal, for example
+// 'Allow ec2:describeregions on * if StringEquals:aws:RequestedRegion=us-east-1', so that documents that
+// only group the same permissions differently don't drift, while a change of effect or of conditions does.
+func CompareStatements(expected string, live string) (Diff, error) {
+	expectedStatements, err := statements(expected)
+	if err != nil {
+		return Diff{}, err
+	}
+	liveStatements, err := statements(live)
+	if err != nil {
+		return Diff{}, err
+	}
+	return compare(expectedStatements, liveStatements, nil), nil
+}
+
+// Returns the actions and the resources of the document. The actions of statements that don't allow are
+// suffixed with their effect, so that changing the effect of a statement is detected.
+func permissions(document string) (actions []string, resources []string, err error) {
+	policy, err := aws.ParsePolicyDocument(document)
+	if err != nil {
+		return
+	}
+	for _, statement := range policy.Statement {
+		for _, action := range statement.GetActions() {
+			if statement.Effect != "Allow" {
+				action = fmt.Sprintf("%s (%s)", action, statement.Effect)
+			}
+			actions = append(actions, action)
+		}
+		resources = append(resources, statement.GetResources()...)
+	}
+	return
+}
+
+func principals(document string) ([]string, error) {
+	policy, err := aws.ParsePolicyDocument(document)
+	if err != nil {
+		return nil, err
+	}
+	result := []string{}
+	for _, statement := range policy.Statement {
+		result = append(result, statement.GetPrincipals()...)
+	}
+	return result, nil
+}
+
+// Returns the normalized statements of the document. The actions are lower case, as AWS ignores their case.
+func statements(document string) ([]string, error) {
+	policy, err := aws.ParsePolicyDocument(document)
+	if err != nil {
+		return nil, err
+	}
+	result := []string{}
+	for _, statement := range policy.Statement {
+		condition := ""
+		if conditions := statement.GetConditions(); len(conditions) > 0 {
+			condition = " if " + strings.Join(conditions, " and ")
+		}
+		resources := prefixed(" on ", statement.GetResources())
+		principals := prefixed(" by ", statement.GetPrincipals())
+		for _, action := range statement.GetActions() {
+			for _, resource := range resources {
+				for _, principal := range principals {
+					result = append(result, fmt.Sprintf("%s %s%s%s%s", statement.Effect,
+						strings.ToLower(action), resource, principal, condition))
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// Returns the values with the given prefix, or a single empty value if there are no values, so that the
+// statements without resources or principals still have entries.
+func prefixed(prefix string, values []string) []string {
+	if len(values) == 0 {
+		return []string{""}
+	}
+	result := make([]string, len(values))
+	for i, value := range values {
+		result[i] = prefix + value
+	}
+	return result
+}
+
+func compare(expected []string, live []string, normalize func(string) string) Diff {
+	if normalize == nil {
+		normalize = func(value string) string { return value }
+	}
+	expectedSet := map[string]bool{}
+	for _, value := range expected {
+		expectedSet[normalize(value)] = true
+	}
+	liveSet := map[string]bool{}
+	for _, value := range live {
+		liveSet[normalize(value)] = true
+	}
+	result := Diff{}
+	result.Added = missing(live, expectedSet, normalize)
+	result.Removed = missing(expected, liveSet, normalize)
+	return result
+}
+
+// Returns the values whose normalized form isn't in the set, sorted and without duplicates.
+func missing(values []string, set map[string]bool, normalize func(string) string) []string {
+	seen := map[string]bool{}
+	result := []string{}
+	for _, value := range values {
+		key := normalize(value)
+		if set[key] || seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, value)
+	}
+	sort.Strings(result)
+	return result
+}
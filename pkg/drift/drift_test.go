@@ -0,0 +1,214 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"fmt"
+	"net/url"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/roles"
+)
+
+const (
+	permissionPolicy = `{"Version": "2012-10-17", "Statement": [
+		{"Effect": "Allow", "Action": ["ec2:DescribeRegions", "s3:GetObject"], "Resource": "*"}
+	]}`
+	trustPolicy = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "sts:AssumeRole",
+		"Principal": {"AWS": "arn:aws:iam::710019948333:role/RH-Managed-OpenShift-Installer"}}]}`
+	policyARN = "arn:aws:iam::123456789012:policy/prefix-Installer-Role-Policy"
+)
+
+var _ = Describe("Compare", func() {
+	It("Reports the added and removed actions and resources", func() {
+		live := `{"Version": "2012-10-17", "Statement": [
+			{"Effect": "Allow", "Action": ["EC2:DescribeRegions", "iam:*"], "Resource": "*"},
+			{"Effect": "Deny", "Action": "s3:DeleteBucket", "Resource": "arn:aws:s3:::bucket"}
+		]}`
+		actions, resources, err := ComparePermissions(permissionPolicy, live)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actions).To(Equal(Diff{
+			Added:   []string{"iam:*", "s3:DeleteBucket (Deny)"},
+			Removed: []string{"s3:GetObject"},
+		}))
+		Expect(resources).To(Equal(Diff{Added: []string{"arn:aws:s3:::bucket"}, Removed: []string{}}))
+	})
+
+	It("Reports the added and removed principals", func() {
+		live := `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "sts:AssumeRole",
+			"Principal": {"AWS": ["arn:aws:iam::999999999999:root"], "Service": "ec2.amazonaws.com"}}]}`
+		principals, err := ComparePrincipals(trustPolicy, live)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(principals).To(Equal(Diff{
+			Added:   []string{"AWS:arn:aws:iam::999999999999:root", "Service:ec2.amazonaws.com"},
+			Removed: []string{"AWS:arn:aws:iam::710019948333:role/RH-Managed-OpenShift-Installer"},
+		}))
+	})
+
+	It("Finds no drift in equivalent documents", func() {
+		actions, resources, err := ComparePermissions(permissionPolicy, `{"Statement": [
+			{"Effect": "Allow", "Action": "s3:GetObject", "Resource": ["*"]},
+			{"Effect": "Allow", "Action": "ec2:DescribeRegions", "Resource": "*"}
+		]}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actions.IsEmpty()).To(BeTrue())
+		Expect(resources.IsEmpty()).To(BeTrue())
+	})
+})
+
+var _ = Describe("CompareStatements", func() {
+	It("Reports the statements whose conditions changed", func() {
+		live := `{"Version": "2012-10-17", "Statement": [
+			{"Effect": "Allow", "Action": "ec2:DescribeRegions", "Resource": "*"},
+			{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*",
+				"Condition": {"StringEquals": {"aws:RequestedRegion": "us-east-1"}}}
+		]}`
+		actions, resources, err := ComparePermissions(permissionPolicy, live)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actions.IsEmpty()).To(BeTrue())
+		Expect(resources.IsEmpty()).To(BeTrue())
+		statements, err := CompareStatements(permissionPolicy, live)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(statements).To(Equal(Diff{
+			Added:   []string{"Allow s3:getobject on * if StringEquals:aws:RequestedRegion=us-east-1"},
+			Removed: []string{"Allow s3:getobject on *"},
+		}))
+	})
+
+	It("Reports the trust statements whose conditions changed", func() {
+		live := `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "sts:AssumeRole",
+			"Principal": {"AWS": "arn:aws:iam::710019948333:role/RH-Managed-OpenShift-Installer"},
+			"Condition": {"StringEquals": {"sts:ExternalId": "abcd"}}}]}`
+		statements, err := CompareStatements(trustPolicy, live)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(statements.Added).To(Equal([]string{"Allow sts:assumerole by " +
+			"AWS:arn:aws:iam::710019948333:role/RH-Managed-OpenShift-Installer if StringEquals:sts:ExternalId=abcd"}))
+	})
+
+	It("Finds no drift in statements grouped differently", func() {
+		statements, err := CompareStatements(permissionPolicy, `{"Statement": [
+			{"Effect": "Allow", "Action": "S3:GetObject", "Resource": ["*"]},
+			{"Effect": "Allow", "Action": "ec2:DescribeRegions", "Resource": "*"}
+		]}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(statements.IsEmpty()).To(BeTrue())
+	})
+})
+
+var _ = Describe("AccountRoles", func() {
+	policies := map[string]*cmv1.AWSSTSPolicy{}
+	for _, file := range []string{"installer", "support", "instance_controlplane", "instance_worker"} {
+		policy, err := cmv1.NewAWSSTSPolicy().ID(fmt.Sprintf("sts_%s_trust_policy", file)).
+			Details(`{"Principal": {"AWS": "arn:aws:iam::%{aws_account_id}:role/Installer"}}`).Build()
+		Expect(err).NotTo(HaveOccurred())
+		policies[policy.ID()] = policy
+		policy, err = cmv1.NewAWSSTSPolicy().ID(fmt.Sprintf("sts_%s_permission_policy", file)).
+			Details(`{"Resource": "arn:aws:s3:::bucket"}`).Build()
+		Expect(err).NotTo(HaveOccurred())
+		policies[policy.ID()] = policy
+	}
+
+	It("Interpolates the expected documents", func() {
+		accountRoles := AccountRoles(policies, "prefix", "aws-us-gov", "production", false, false)
+		Expect(accountRoles).To(HaveLen(4))
+		Expect(accountRoles[0].Name).To(Equal("prefix-Installer-Role"))
+		Expect(accountRoles[0].TrustPolicy).To(Equal(`{"Principal": {"AWS": "arn:aws-us-gov:iam::` +
+			aws.GetJumpAccount("production") + `:role/Installer"}}`))
+		Expect(accountRoles[0].PermissionPolicy).To(Equal(`{"Resource": "arn:aws-us-gov:s3:::bucket"}`))
+	})
+
+	It("Doesn't compare the managed permission policies", func() {
+		accountRoles := AccountRoles(policies, "prefix", "aws", "production", false, true)
+		for _, role := range accountRoles {
+			Expect(role.PermissionPolicy).To(BeEmpty())
+		}
+	})
+})
+
+var _ = Describe("Verifier", func() {
+	var awsClient *aws.MockClient
+	role := Role{
+		Name:             "prefix-Installer-Role",
+		Type:             roles.AccountRole,
+		Prefix:           "prefix",
+		PermissionPolicy: permissionPolicy,
+		TrustPolicy:      trustPolicy,
+	}
+
+	BeforeEach(func() {
+		awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+	})
+
+	It("Verifies and repairs a role that drifted", func() {
+		awsClient.EXPECT().GetRoleByName(role.Name).Return(iamtypes.Role{
+			AssumeRolePolicyDocument: awssdk.String(url.QueryEscape(trustPolicy)),
+		}, nil)
+		awsClient.EXPECT().GetAccountRoleDefaultPolicy(role.Name, "prefix").Return(policyARN, nil)
+		awsClient.EXPECT().GetDefaultPolicyDocument(policyARN).Return(`{"Statement": [
+			{"Effect": "Allow", "Action": "ec2:DescribeRegions", "Resource": "*"}
+		]}`, nil)
+
+		verifier := NewVerifier(awsClient)
+		result := verifier.Verify(role)
+		Expect(result.HasDrift()).To(BeTrue())
+		Expect(result.PolicyARN).To(Equal(policyARN))
+		Expect(result.Actions.Removed).To(Equal([]string{"s3:GetObject"}))
+		Expect(result.Principals.IsEmpty()).To(BeTrue())
+		Expect(Differences(result)).To(Equal([]string{"-action s3:GetObject"}))
+
+		awsClient.EXPECT().UpdatePolicyDocument(policyARN, permissionPolicy).Return(nil)
+		Expect(verifier.Repair(role, result)).To(Succeed())
+	})
+
+	It("Repairs a role whose trust policy conditions drifted", func() {
+		awsClient.EXPECT().GetRoleByName(role.Name).Return(iamtypes.Role{
+			AssumeRolePolicyDocument: awssdk.String(url.QueryEscape(`{"Statement": [{"Effect": "Deny",
+				"Action": "sts:AssumeRole",
+				"Principal": {"AWS": "arn:aws:iam::710019948333:role/RH-Managed-OpenShift-Installer"}}]}`)),
+		}, nil)
+		awsClient.EXPECT().GetAccountRoleDefaultPolicy(role.Name, "prefix").Return(policyARN, nil)
+		awsClient.EXPECT().GetDefaultPolicyDocument(policyARN).Return(permissionPolicy, nil)
+
+		verifier := NewVerifier(awsClient)
+		result := verifier.Verify(role)
+		Expect(result.HasDrift()).To(BeTrue())
+		Expect(result.Principals.IsEmpty()).To(BeTrue())
+		Expect(Differences(result)).To(Equal([]string{
+			"+trust statement Deny sts:assumerole by " +
+				"AWS:arn:aws:iam::710019948333:role/RH-Managed-OpenShift-Installer",
+			"-trust statement Allow sts:assumerole by " +
+				"AWS:arn:aws:iam::710019948333:role/RH-Managed-OpenShift-Installer",
+		}))
+
+		awsClient.EXPECT().UpdateTrustPolicy(role.Name, trustPolicy).Return(nil)
+		Expect(verifier.Repair(role, result)).To(Succeed())
+	})
+
+	It("Reports the roles that can't be verified", func() {
+		awsClient.EXPECT().GetRoleByName(role.Name).Return(iamtypes.Role{}, fmt.Errorf("not found"))
+		result := NewVerifier(awsClient).Verify(role)
+		Expect(result.HasDrift()).To(BeTrue())
+		Expect(result.Error).To(Equal("failed to get role: not found"))
+	})
+})
@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/openshift/rosa/pkg/output"
+)
+
+const (
+	statusInSync  = "in sync"
+	statusDrifted = "drifted"
+	statusError   = "error"
+)
+
+func NewTable(results []Result) *output.Table {
+	table := output.NewTable("ROLE NAME", "TYPE", "STATUS", "DIFFERENCES")
+	for _, result := range results {
+		status := statusInSync
+		differences := Differences(result)
+		if result.Error != "" {
+			status = statusError
+			differences = []string{result.Error}
+		} else if result.HasDrift() {
+			status = statusDrifted
+		}
+		table.AddRow(result.RoleName, result.RoleType, status, strings.Join(differences, ", "))
+	}
+	return table
+}
+
+// Differences returns the differences of the result, for example '+action ec2:DeleteVpc' for an action
+// that was added to the policy. The statements are only listed when the actions, resources and principals
+// don't show the difference, for example when only a condition changed.
+func Differences(result Result) []string {
+	items := []namedDiff{
+		{"action", result.Actions},
+		{"resource", result.Resources},
+		{"principal", result.Principals},
+	}
+	if result.Actions.IsEmpty() && result.Resources.IsEmpty() {
+		items = append(items, namedDiff{"statement", result.Permissions})
+	}
+	if result.Principals.IsEmpty() {
+		items = append(items, namedDiff{"trust statement", result.Trust})
+	}
+	differences := []string{}
+	for _, item := range items {
+		for _, value := range item.diff.Added {
+			differences = append(differences, fmt.Sprintf("+%s %s", item.name, value))
+		}
+		for _, value := range item.diff.Removed {
+			differences = append(differences, fmt.Sprintf("-%s %s", item.name, value))
+		}
+	}
+	return differences
+}
+
+type namedDiff struct {
+	name string
+	diff Diff
+}
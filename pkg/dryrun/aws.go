@@ -0,0 +1,231 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the HTTP client used by the AWS SDK in dry run mode. The operation name is
+// taken from the context of the request, where the SDK puts it, so all the services are handled the
+// same way regardless of their protocol.
+
+package dryrun
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+)
+
+// HTTPClient is the interface of the HTTP client used by the AWS SDK.
+type HTTPClient interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// Prefixes of the names of the AWS operations that don't change anything.
+var readOnlyOperationPrefixes = []string{
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Simulate",
+	"Validate",
+}
+
+// NewAWSHTTPClient returns an HTTP client for the AWS SDK that sends the read operations to AWS and
+// records the rest, responding to them as if they succeeded.
+func NewAWSHTTPClient(next HTTPClient) HTTPClient {
+	return &awsHTTPClient{next: next}
+}
+
+type awsHTTPClient struct {
+	next HTTPClient
+}
+
+func (c *awsHTTPClient) Do(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+	name := awsmiddleware.GetOperationName(ctx)
+	if isAWSRead(name) {
+		return c.next.Do(request)
+	}
+
+	var body []byte
+	if request.Body != nil {
+		var err error
+		body, err = io.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	operation := Operation{
+		Kind:      KindAWS,
+		Service:   awsmiddleware.GetServiceID(ctx),
+		Region:    awsmiddleware.GetRegion(ctx),
+		Operation: name,
+	}
+	contentType := request.Header.Get("Content-Type")
+	switch {
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		// Query protocol, used by IAM, STS and CloudFormation:
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return nil, err
+		}
+		operation.Parameters = map[string]string{}
+		for key := range values {
+			if key != "Action" && key != "Version" {
+				operation.Parameters[key] = values.Get(key)
+			}
+		}
+	case strings.HasPrefix(contentType, "application/x-amz-json"):
+		operation.Body = indent(body)
+	default:
+		// REST protocols, used by S3:
+		operation.Method = request.Method
+		operation.Path = request.URL.Path
+		operation.Body = indent(body)
+	}
+	Record(operation)
+
+	return awsResponse(request, contentType, operation), nil
+}
+
+func isAWSRead(name string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the body indented if it is a JSON document, or nil otherwise.
+func indent(body []byte) json.RawMessage {
+	var buffer bytes.Buffer
+	if json.Indent(&buffer, body, "", "  ") != nil {
+		return nil
+	}
+	return buffer.Bytes()
+}
+
+// Returns a successful response for the operation. The SDK accepts an empty body for all the
+// operations, but rosa uses the ARNs and keys returned by some of them, so those are filled with the
+// values that AWS would return.
+func awsResponse(request *http.Request, contentType string, operation Operation) *http.Response {
+	var body string
+	parameters := operation.Parameters
+	arnPrefix := fmt.Sprintf("arn:%s:iam::%s:", partition(operation.Region), getAccountID())
+	switch operation.Operation {
+	case "CreateRole":
+		body = queryResponse(operation.Operation, "Role",
+			"Arn", arnPrefix+"role"+path(parameters)+parameters["RoleName"],
+			"RoleName", parameters["RoleName"],
+			"Path", path(parameters),
+		)
+	case "CreatePolicy":
+		body = queryResponse(operation.Operation, "Policy",
+			"Arn", arnPrefix+"policy"+path(parameters)+parameters["PolicyName"],
+			"PolicyName", parameters["PolicyName"],
+			"Path", path(parameters),
+		)
+	case "CreateOpenIDConnectProvider":
+		body = queryResponse(operation.Operation, "",
+			"OpenIDConnectProviderArn",
+			arnPrefix+"oidc-provider/"+strings.TrimPrefix(parameters["Url"], "https://"),
+		)
+	case "CreateAccessKey":
+		body = queryResponse(operation.Operation, "AccessKey",
+			"UserName", parameters["UserName"],
+			"AccessKeyId", ObjectID,
+			"SecretAccessKey", ObjectID,
+			"Status", "Active",
+		)
+	case "AssumeRole":
+		// The credentials aren't valid, so the requests sent with them fail instead of being sent
+		// with the credentials of the user:
+		body = fmt.Sprintf("<AssumeRoleResponse><AssumeRoleResult><Credentials>"+
+			"<AccessKeyId>%s</AccessKeyId><SecretAccessKey>%s</SecretAccessKey><SessionToken>%s</SessionToken>"+
+			"<Expiration>%s</Expiration></Credentials></AssumeRoleResult></AssumeRoleResponse>",
+			ObjectID, ObjectID, ObjectID, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+	case "CreateSecret":
+		var input struct {
+			Name string
+		}
+		_ = json.Unmarshal(operation.Body, &input)
+		response, _ := json.Marshal(map[string]string{
+			"ARN": fmt.Sprintf("arn:%s:secretsmanager:%s:%s:secret:%s",
+				partition(operation.Region), operation.Region, getAccountID(), input.Name),
+			"Name": input.Name,
+		})
+		body = string(response)
+	}
+
+	header := http.Header{}
+	header.Set("X-Amzn-Requestid", ObjectID)
+	switch {
+	case body == "":
+	case strings.HasPrefix(contentType, "application/x-amz-json"):
+		header.Set("Content-Type", contentType)
+	default:
+		header.Set("Content-Type", "text/xml")
+	}
+	return &http.Response{
+		Status:        http.StatusText(http.StatusOK),
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}
+}
+
+func path(parameters map[string]string) string {
+	if parameters["Path"] == "" {
+		return "/"
+	}
+	return parameters["Path"]
+}
+
+func partition(region string) string {
+	if strings.HasPrefix(region, "us-gov-") {
+		return "aws-us-gov"
+	}
+	return "aws"
+}
+
+// Returns the XML document of a query protocol response. The fields are pairs of names and values,
+// wrapped in the given element if it isn't empty.
+func queryResponse(name string, element string, fields ...string) string {
+	var buffer strings.Builder
+	for i := 0; i+1 < len(fields); i += 2 {
+		buffer.WriteString("<" + fields[i] + ">")
+		_ = xml.EscapeText(&buffer, []byte(fields[i+1]))
+		buffer.WriteString("</" + fields[i] + ">")
+	}
+	content := buffer.String()
+	if element != "" {
+		content = fmt.Sprintf("<%s>%s</%s>", element, content, element)
+	}
+	return fmt.Sprintf("<%sResponse><%sResult>%s</%sResult></%sResponse>", name, name, content, name, name)
+}
@@ -0,0 +1,119 @@
+package dryrun
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Client that fails all the requests, to check which ones aren't recorded.
+type failingClient struct {
+	requests int
+}
+
+func (c *failingClient) Do(*http.Request) (*http.Response, error) {
+	c.requests++
+	return nil, fmt.Errorf("not available")
+}
+
+var _ = Describe("AWS HTTP client", func() {
+	var next *failingClient
+	var cfg aws.Config
+
+	BeforeEach(func() {
+		Reset()
+		SetAccountID("123456789012")
+		next = &failingClient{}
+		cfg = aws.Config{
+			Region:      "us-east-1",
+			Credentials: credentials.NewStaticCredentialsProvider("key", "secret", ""),
+			HTTPClient:  NewAWSHTTPClient(next),
+			Retryer: func() aws.Retryer {
+				return aws.NopRetryer{}
+			},
+		}
+	})
+
+	It("Sends read operations to AWS", func() {
+		_, err := iam.NewFromConfig(cfg).GetRole(context.Background(), &iam.GetRoleInput{
+			RoleName: aws.String("my-role"),
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(next.requests).To(Equal(1))
+		Expect(Operations()).To(BeEmpty())
+	})
+
+	It("Records the operations that change something", func() {
+		client := iam.NewFromConfig(cfg)
+		role, err := client.CreateRole(context.Background(), &iam.CreateRoleInput{
+			RoleName:                 aws.String("my-role"),
+			Path:                     aws.String("/rosa/"),
+			AssumeRolePolicyDocument: aws.String(`{"Version": "2012-10-17"}`),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(aws.ToString(role.Role.Arn)).To(Equal("arn:aws:iam::123456789012:role/rosa/my-role"))
+
+		policy, err := client.CreatePolicy(context.Background(), &iam.CreatePolicyInput{
+			PolicyName:     aws.String("my-policy"),
+			PolicyDocument: aws.String(`{}`),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(aws.ToString(policy.Policy.Arn)).To(Equal("arn:aws:iam::123456789012:policy/my-policy"))
+
+		_, err = client.AttachRolePolicy(context.Background(), &iam.AttachRolePolicyInput{
+			RoleName:  aws.String("my-role"),
+			PolicyArn: policy.Policy.Arn,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(next.requests).To(BeZero())
+		operations := Operations()
+		Expect(operations).To(HaveLen(3))
+		Expect(operations[0].String()).To(Equal("AWS IAM CreateRole (us-east-1)"))
+		Expect(operations[0].Parameters).To(Equal(map[string]string{
+			"RoleName":                 "my-role",
+			"Path":                     "/rosa/",
+			"AssumeRolePolicyDocument": `{"Version": "2012-10-17"}`,
+		}))
+		Expect(operations[1].String()).To(Equal("AWS IAM CreatePolicy (us-east-1)"))
+		Expect(operations[2].String()).To(Equal("AWS IAM AttachRolePolicy (us-east-1)"))
+		Expect(operations[2].Parameters).To(HaveKeyWithValue("PolicyArn",
+			"arn:aws:iam::123456789012:policy/my-policy"))
+	})
+
+	It("Records REST operations", func() {
+		_, err := s3.NewFromConfig(cfg).PutObject(context.Background(), &s3.PutObjectInput{
+			Bucket: aws.String("my-bucket"),
+			Key:    aws.String("keys.json"),
+			Body:   bytes.NewReader([]byte(`{"keys": []}`)),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		operations := Operations()
+		Expect(operations).To(HaveLen(1))
+		Expect(operations[0].Operation).To(Equal("PutObject"))
+		Expect(operations[0].Method).To(Equal(http.MethodPut))
+		Expect(operations[0].Body).To(MatchJSON(`{"keys": []}`))
+	})
+
+	It("Records the assumed roles", func() {
+		output, err := sts.NewFromConfig(cfg).AssumeRole(context.Background(), &sts.AssumeRoleInput{
+			RoleArn:         aws.String("arn:aws:iam::123456789012:role/my-role"),
+			RoleSessionName: aws.String("rosa"),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(aws.ToString(output.Credentials.AccessKeyId)).To(Equal(ObjectID))
+		Expect(next.requests).To(BeZero())
+		operations := Operations()
+		Expect(operations).To(HaveLen(1))
+		Expect(operations[0].String()).To(Equal("AWS STS AssumeRole (us-east-1)"))
+	})
+})
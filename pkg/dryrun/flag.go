@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions used to implement the global '--dry-run' command line option.
+
+package dryrun
+
+import (
+	"github.com/spf13/pflag"
+)
+
+const FlagName = "dry-run"
+
+// AddFlag adds the dry run flag to the given set of command line flags.
+func AddFlag(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&enabled,
+		FlagName,
+		false,
+		"Don't change anything. Read requests are sent to AWS and OCM, but the requests that would "+
+			"make changes are recorded instead, and printed in order when the command finishes, so that "+
+			"they can be reviewed before running the command again without the flag.",
+	)
+}
+
+// Enabled returns a boolean flag that indicates if the dry run mode is enabled.
+func Enabled() bool {
+	return enabled
+}
+
+func SetEnabled(dryRunEnabled bool) {
+	enabled = dryRunEnabled
+}
+
+// enabled is a boolean flag that indicates that the dry run mode is enabled.
+var enabled bool
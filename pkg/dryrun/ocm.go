@@ -0,0 +1,224 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the OCM transport used in dry run mode.
+
+package dryrun
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// ID given to the objects that would have been created by OCM, so that the requests that use them
+// can be recorded too. The objects are returned by the follow-up GET requests.
+const ObjectID = "dry-run"
+
+// Some OCM endpoints use POST for requests that don't change anything, for example to query the
+// AWS account. These are sent to the server like the GET requests.
+var readOnlyPathSuffixes = []string{
+	"_review",
+	"/available_regions",
+	"/version_inquiry",
+}
+
+var readOnlyPathSegments = []string{
+	"/aws_inquiries/",
+	"/gcp_inquiries/",
+}
+
+// NewOCMTransport returns a transport wrapper for the OCM connection that sends read requests to the
+// server and records the rest, responding to them as if they succeeded.
+func NewOCMTransport(next http.RoundTripper) http.RoundTripper {
+	return &ocmTransport{
+		next:    next,
+		objects: map[string][]byte{},
+	}
+}
+
+type ocmTransport struct {
+	next http.RoundTripper
+
+	// Objects that would have been created or updated, indexed by path
+	objects map[string][]byte
+	lock    sync.Mutex
+}
+
+func (t *ocmTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if isOCMRead(request) {
+		if request.Method == http.MethodGet {
+			if object := t.object(request.URL.Path); object != nil {
+				return ocmResponse(request, http.StatusOK, object), nil
+			}
+		}
+		return t.next.RoundTrip(request)
+	}
+
+	var body []byte
+	if request.Body != nil {
+		var err error
+		body, err = io.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	operation := Operation{
+		Kind:   KindOCM,
+		Method: request.Method,
+		Path:   request.URL.Path,
+	}
+	if request.URL.RawQuery != "" {
+		operation.Path += "?" + request.URL.RawQuery
+	}
+	var indented bytes.Buffer
+	if json.Indent(&indented, body, "", "  ") == nil {
+		operation.Body = indented.Bytes()
+	}
+	Record(operation)
+
+	switch request.Method {
+	case http.MethodDelete:
+		t.setObject(request.URL.Path, nil)
+		return ocmResponse(request, http.StatusNoContent, nil), nil
+	case http.MethodPost:
+		object := withID(body)
+		if id := objectID(object); id != "" {
+			t.setObject(request.URL.Path+"/"+id, object)
+		}
+		return ocmResponse(request, http.StatusCreated, object), nil
+	default:
+		object := t.merge(request.URL.Path, withID(body))
+		t.setObject(request.URL.Path, object)
+		return ocmResponse(request, http.StatusOK, object), nil
+	}
+}
+
+func (t *ocmTransport) object(path string) []byte {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.objects[path]
+}
+
+func (t *ocmTransport) setObject(path string, object []byte) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if object == nil {
+		delete(t.objects, path)
+		return
+	}
+	t.objects[path] = object
+}
+
+// Returns the object that an update would result in: the fields of the update applied to the object
+// recorded before, if any. Objects that weren't recorded only contain the fields of the update, as
+// the ones of the server aren't known.
+func (t *ocmTransport) merge(path string, update []byte) []byte {
+	current := t.object(path)
+	if current == nil {
+		return update
+	}
+	object := map[string]interface{}{}
+	fields := map[string]interface{}{}
+	if json.Unmarshal(current, &object) != nil || json.Unmarshal(update, &fields) != nil {
+		return update
+	}
+	for key, value := range fields {
+		object[key] = value
+	}
+	result, err := json.Marshal(object)
+	if err != nil {
+		return update
+	}
+	return result
+}
+
+func isOCMRead(request *http.Request) bool {
+	if request.Method == http.MethodGet || request.Method == http.MethodHead {
+		return true
+	}
+	// Only the API is recorded, requests to other paths, like the ones used to refresh the tokens,
+	// are always sent:
+	path := request.URL.Path
+	if !strings.HasPrefix(path, "/api/") {
+		return true
+	}
+	// Requests that ask the server to only validate the object:
+	if request.URL.Query().Get("dryRun") == "true" {
+		return true
+	}
+	for _, suffix := range readOnlyPathSuffixes {
+		if strings.HasSuffix(path, suffix) {
+			return true
+		}
+	}
+	for _, segment := range readOnlyPathSegments {
+		if strings.Contains(path, segment) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the request body with an identifier added, so that it looks like the object returned by
+// the server.
+func withID(body []byte) []byte {
+	object := map[string]interface{}{}
+	if len(body) > 0 && json.Unmarshal(body, &object) != nil {
+		return body
+	}
+	if _, ok := object["id"]; !ok {
+		object["id"] = ObjectID
+	}
+	result, err := json.Marshal(object)
+	if err != nil {
+		return body
+	}
+	return result
+}
+
+// Returns the identifier of the given object, or an empty string if it doesn't have one.
+func objectID(object []byte) string {
+	var fields struct {
+		ID string `json:"id"`
+	}
+	if json.Unmarshal(object, &fields) != nil {
+		return ""
+	}
+	return fields.ID
+}
+
+func ocmResponse(request *http.Request, status int, body []byte) *http.Response {
+	header := http.Header{}
+	if body != nil {
+		header.Set("Content-Type", "application/json")
+	}
+	return &http.Response{
+		Status:        http.StatusText(status),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}
+}
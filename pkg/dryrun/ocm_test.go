@@ -0,0 +1,107 @@
+package dryrun
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("OCM transport", func() {
+	var server *ghttp.Server
+	var client *http.Client
+
+	BeforeEach(func() {
+		Reset()
+		server = ghttp.NewServer()
+		client = &http.Client{Transport: NewOCMTransport(http.DefaultTransport)}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("Sends read requests to the server", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				ghttp.RespondWith(http.StatusOK, `{"id": "123"}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/aws_inquiries/vpcs"),
+				ghttp.RespondWith(http.StatusOK, `{"items": []}`),
+			),
+		)
+		response, err := client.Get(server.URL() + "/api/clusters_mgmt/v1/clusters/123")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		response, err = client.Post(server.URL()+"/api/clusters_mgmt/v1/aws_inquiries/vpcs",
+			"application/json", strings.NewReader(`{}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		Expect(server.ReceivedRequests()).To(HaveLen(2))
+		Expect(Operations()).To(BeEmpty())
+	})
+
+	It("Records the requests that change something", func() {
+		response, err := client.Post(server.URL()+"/api/clusters_mgmt/v1/clusters/123/node_pools",
+			"application/json", strings.NewReader(`{"replicas": 2}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusCreated))
+		body, err := io.ReadAll(response.Body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(body).To(MatchJSON(`{"id": "dry-run", "replicas": 2}`))
+
+		request, err := http.NewRequest(http.MethodDelete,
+			server.URL()+"/api/clusters_mgmt/v1/clusters/123?deprovision=true", nil)
+		Expect(err).NotTo(HaveOccurred())
+		response, err = client.Do(request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+		Expect(server.ReceivedRequests()).To(BeEmpty())
+		operations := Operations()
+		Expect(operations).To(HaveLen(2))
+		Expect(operations[0].Index).To(Equal(1))
+		Expect(operations[0].String()).To(Equal("OCM POST /api/clusters_mgmt/v1/clusters/123/node_pools"))
+		Expect(operations[0].Body).To(MatchJSON(`{"replicas": 2}`))
+		Expect(operations[1].Index).To(Equal(2))
+		Expect(operations[1].String()).To(Equal("OCM DELETE /api/clusters_mgmt/v1/clusters/123?deprovision=true"))
+	})
+
+	It("Sends the requests that only validate the object", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters", "dryRun=true"),
+				ghttp.RespondWith(http.StatusNoContent, nil),
+			),
+		)
+		response, err := client.Post(server.URL()+"/api/clusters_mgmt/v1/clusters?dryRun=true",
+			"application/json", strings.NewReader(`{}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+		Expect(Operations()).To(BeEmpty())
+	})
+
+	It("Returns the recorded objects to the follow-up requests", func() {
+		path := server.URL() + "/api/clusters_mgmt/v1/clusters/123/machine_pools"
+		_, err := client.Post(path, "application/json", strings.NewReader(`{"replicas": 2}`))
+		Expect(err).NotTo(HaveOccurred())
+
+		request, err := http.NewRequest(http.MethodPatch, path+"/dry-run", strings.NewReader(`{"replicas": 3}`))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = client.Do(request)
+		Expect(err).NotTo(HaveOccurred())
+
+		response, err := client.Get(path + "/dry-run")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		body, err := io.ReadAll(response.Body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(body).To(MatchJSON(`{"id": "dry-run", "replicas": 3}`))
+		Expect(server.ReceivedRequests()).To(BeEmpty())
+	})
+})
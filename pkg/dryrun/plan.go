@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the plan of a dry run: the ordered list of the requests that would have changed
+// something in AWS or OCM. The plan is global because the AWS and OCM clients are created in many
+// places, and all of them need to record into the same list for the order to be preserved.
+
+package dryrun
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	KindAWS = "aws"
+	KindOCM = "ocm"
+)
+
+// Operation is a request recorded by a dry run.
+type Operation struct {
+	Index int    `json:"index"`
+	Kind  string `json:"kind"`
+
+	// Details of AWS API calls:
+	Service    string            `json:"service,omitempty"`
+	Region     string            `json:"region,omitempty"`
+	Operation  string            `json:"operation,omitempty"`
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// Details of OCM requests, and of AWS API calls that use REST, like S3:
+	Method string          `json:"method,omitempty"`
+	Path   string          `json:"path,omitempty"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+var (
+	mutex      sync.Mutex
+	operations []Operation
+	accountID  string
+
+	// Number of operations already printed, and if the plan was printed at all.
+	printed       int
+	printedHeader bool
+)
+
+// Record adds an operation to the end of the plan.
+func Record(operation Operation) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	operation.Index = len(operations) + 1
+	operations = append(operations, operation)
+}
+
+// Operations returns a copy of the operations recorded so far.
+func Operations() []Operation {
+	mutex.Lock()
+	defer mutex.Unlock()
+	return append([]Operation{}, operations...)
+}
+
+// Reset discards the recorded operations.
+func Reset() {
+	mutex.Lock()
+	defer mutex.Unlock()
+	operations = nil
+	printed = 0
+	printedHeader = false
+}
+
+// SetAccountID sets the identifier of the AWS account, used to build the ARNs of the resources that
+// the recorded operations would have created.
+func SetAccountID(value string) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	accountID = value
+}
+
+func getAccountID() string {
+	mutex.Lock()
+	defer mutex.Unlock()
+	return accountID
+}
+
+// PrintPlan writes the operations recorded since it was last called, in the order they would have
+// been executed. It is called when the command finishes and also before an error is reported, so that
+// the plan isn't lost when the command exits early.
+func PrintPlan(w io.Writer) {
+	mutex.Lock()
+	recorded := append([]Operation{}, operations[printed:]...)
+	first := !printedHeader
+	printed = len(operations)
+	printedHeader = true
+	mutex.Unlock()
+
+	switch {
+	case len(recorded) == 0 && first:
+		fmt.Fprintf(w, "\nDry run: the command wouldn't make any changes.\n")
+		return
+	case len(recorded) == 0:
+		return
+	case first:
+		fmt.Fprintf(w, "\nDry run: nothing was changed. The command would make the following %d requests:\n",
+			len(recorded))
+	default:
+		fmt.Fprintf(w, "\nDry run: the command would also make the following %d requests:\n", len(recorded))
+	}
+	for _, operation := range recorded {
+		fmt.Fprintf(w, "\n%3d. %s\n", operation.Index, operation.String())
+		keys := make([]string, 0, len(operation.Parameters))
+		for key := range operation.Parameters {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Fprintf(w, "     %s: %s\n", key, operation.Parameters[key])
+		}
+		if len(operation.Body) > 0 {
+			fmt.Fprintf(w, "     %s\n", strings.ReplaceAll(string(operation.Body), "\n", "\n     "))
+		}
+	}
+}
+
+// String returns the one line summary of the operation.
+func (o Operation) String() string {
+	if o.Kind == KindOCM {
+		return fmt.Sprintf("OCM %s %s", o.Method, o.Path)
+	}
+	result := fmt.Sprintf("AWS %s %s", o.Service, o.Operation)
+	if o.Path != "" {
+		result = fmt.Sprintf("%s %s %s", result, o.Method, o.Path)
+	}
+	return fmt.Sprintf("%s (%s)", result, o.Region)
+}
@@ -0,0 +1,64 @@
+package dryrun
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plan", func() {
+	BeforeEach(func() {
+		Reset()
+	})
+
+	It("Prints that nothing would change", func() {
+		var buffer bytes.Buffer
+		PrintPlan(&buffer)
+		Expect(buffer.String()).To(Equal("\nDry run: the command wouldn't make any changes.\n"))
+	})
+
+	It("Prints the operations in order", func() {
+		Record(Operation{
+			Kind:       KindAWS,
+			Service:    "IAM",
+			Region:     "us-east-1",
+			Operation:  "DeleteRole",
+			Parameters: map[string]string{"RoleName": "my-role"},
+		})
+		Record(Operation{
+			Kind:   KindOCM,
+			Method: "PATCH",
+			Path:   "/api/clusters_mgmt/v1/clusters/123",
+			Body:   []byte("{\n  \"name\": \"my-cluster\"\n}"),
+		})
+		var buffer bytes.Buffer
+		PrintPlan(&buffer)
+		Expect(buffer.String()).To(Equal("" +
+			"\nDry run: nothing was changed. The command would make the following 2 requests:\n" +
+			"\n" +
+			"  1. AWS IAM DeleteRole (us-east-1)\n" +
+			"     RoleName: my-role\n" +
+			"\n" +
+			"  2. OCM PATCH /api/clusters_mgmt/v1/clusters/123\n" +
+			"     {\n" +
+			"       \"name\": \"my-cluster\"\n" +
+			"     }\n"))
+	})
+
+	It("Prints only the operations recorded since the last time", func() {
+		Record(Operation{Kind: KindOCM, Method: "DELETE", Path: "/api/clusters_mgmt/v1/clusters/123"})
+		var buffer bytes.Buffer
+		PrintPlan(&buffer)
+		buffer.Reset()
+		PrintPlan(&buffer)
+		Expect(buffer.String()).To(BeEmpty())
+
+		Record(Operation{Kind: KindOCM, Method: "DELETE", Path: "/api/clusters_mgmt/v1/clusters/456"})
+		PrintPlan(&buffer)
+		Expect(buffer.String()).To(Equal("" +
+			"\nDry run: the command would also make the following 1 requests:\n" +
+			"\n" +
+			"  2. OCM DELETE /api/clusters_mgmt/v1/clusters/456\n"))
+	})
+})
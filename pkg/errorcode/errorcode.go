@@ -0,0 +1,257 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package errorcode contains the catalogue of the stable error codes reported by the tool, and the
+// exit codes that match them. The codes are part of the interface used by automation, so existing
+// codes and exit codes must never be changed or reused:
+//
+//	0   Success
+//	1   UNKNOWN              Any error that doesn't have a more specific code
+//	2   INVALID_INPUT        Invalid command line arguments or request
+//	3   WAIT_FAILED          The resource reached a failed or error state while waiting for it
+//	4   WAIT_TIMEOUT         The resource didn't reach the expected state before the timeout
+//	5   NOT_LOGGED_IN        Not logged in, or the token expired
+//	6   FORBIDDEN            The user isn't allowed to perform the operation
+//	7   NOT_FOUND            The resource doesn't exist
+//	8   CONFLICT             The resource already exists or was modified concurrently
+//	9   QUOTA_EXCEEDED       There isn't enough quota to perform the operation
+//	10  THROTTLED            Too many requests, the operation can be retried later
+//	11  SERVICE_UNAVAILABLE  The service failed or isn't available, the operation can be retried later
+package errorcode
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/aws/smithy-go"
+	weberr "github.com/zgalor/weberr"
+)
+
+// Code is a stable identifier of a kind of error.
+type Code string
+
+const (
+	Unknown            Code = "UNKNOWN"
+	InvalidInput       Code = "INVALID_INPUT"
+	WaitFailed         Code = "WAIT_FAILED"
+	WaitTimeout        Code = "WAIT_TIMEOUT"
+	NotLoggedIn        Code = "NOT_LOGGED_IN"
+	Forbidden          Code = "FORBIDDEN"
+	NotFound           Code = "NOT_FOUND"
+	Conflict           Code = "CONFLICT"
+	QuotaExceeded      Code = "QUOTA_EXCEEDED"
+	Throttled          Code = "THROTTLED"
+	ServiceUnavailable Code = "SERVICE_UNAVAILABLE"
+)
+
+var exitCodes = map[Code]int{
+	Unknown:            1,
+	InvalidInput:       2,
+	WaitFailed:         3,
+	WaitTimeout:        4,
+	NotLoggedIn:        5,
+	Forbidden:          6,
+	NotFound:           7,
+	Conflict:           8,
+	QuotaExceeded:      9,
+	Throttled:          10,
+	ServiceUnavailable: 11,
+}
+
+// ExitCode returns the exit code of the tool for the error code.
+func (c Code) ExitCode() int {
+	if exitCode, ok := exitCodes[c]; ok {
+		return exitCode
+	}
+	return exitCodes[Unknown]
+}
+
+// Error is an error with a stable code.
+type Error struct {
+	Code    Code
+	Message string
+	Err     error
+}
+
+// New creates an error with the given code and message.
+func New(code Code, format string, args ...interface{}) error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// Wrap sets the code of the given error, keeping its message.
+func Wrap(code Code, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Code: code, Message: err.Error(), Err: err}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func (e *Error) ErrorCode() Code {
+	return e.Code
+}
+
+// Coder is implemented by the errors of other packages that have a code, like the errors returned
+// while waiting for a resource.
+type Coder interface {
+	ErrorCode() Code
+}
+
+// OCMDetails is attached to the errors returned by the OCM API, so that they can be tracked in the
+// logs of the service.
+type OCMDetails struct {
+	OperationID string
+	Code        string
+}
+
+// Codes of the kinds of 'weberr' errors returned by the OCM client, which are the HTTP status codes.
+var webErrorCodes = map[weberr.ErrorType]Code{
+	weberr.BadRequest:          InvalidInput,
+	weberr.UnprocessableEntity: InvalidInput,
+	weberr.Unauthorized:        NotLoggedIn,
+	weberr.PaymentRequired:     QuotaExceeded,
+	weberr.Forbidden:           Forbidden,
+	weberr.NotFound:            NotFound,
+	weberr.Gone:                NotFound,
+	weberr.Conflict:            Conflict,
+	weberr.PreconditionFailed:  Conflict,
+	weberr.Locked:              Conflict,
+	weberr.TooManyRequests:     Throttled,
+	weberr.RequestTimeout:      ServiceUnavailable,
+}
+
+// Codes of the errors returned by the AWS API.
+var awsErrorCodes = map[string]Code{
+	"ValidationError":                InvalidInput,
+	"InvalidParameterValue":          InvalidInput,
+	"AccessDenied":                   Forbidden,
+	"AccessDeniedException":          Forbidden,
+	"UnauthorizedOperation":          Forbidden,
+	"NoSuchEntity":                   NotFound,
+	"EntityAlreadyExists":            Conflict,
+	"LimitExceeded":                  QuotaExceeded,
+	"ServiceQuotaExceededException":  QuotaExceeded,
+	"Throttling":                     Throttled,
+	"ThrottlingException":            Throttled,
+	"RequestLimitExceeded":           Throttled,
+	"TooManyRequestsException":       Throttled,
+	"ServiceUnavailable":             ServiceUnavailable,
+	"ServiceUnavailableException":    ServiceUnavailable,
+	"InternalFailure":                ServiceUnavailable,
+	"InternalError":                  ServiceUnavailable,
+	"ServiceFailure":                 ServiceUnavailable,
+	"RequestExpired":                 InvalidInput,
+	"OptInRequired":                  Forbidden,
+	"InvalidClientTokenId":           Forbidden,
+	"SignatureDoesNotMatch":          Forbidden,
+	"UnrecognizedClientException":    Forbidden,
+	"ExpiredToken":                   Forbidden,
+	"ExpiredTokenException":          Forbidden,
+	"ResourceNotFoundException":      NotFound,
+	"ResourceInUseException":         Conflict,
+	"ResourceAlreadyExistsException": Conflict,
+}
+
+// Returns the next error of the chain, supporting both the standard wrapping and the 'Cause' method
+// used by the 'weberr' and 'pkg/errors' packages.
+func next(err error) error {
+	if cause, ok := err.(interface{ Cause() error }); ok {
+		return cause.Cause()
+	}
+	return errors.Unwrap(err)
+}
+
+// Of returns the code of the error. The first error of the chain with a code, a 'weberr' kind or an
+// AWS error code determines it.
+func Of(err error) Code {
+	for current := err; current != nil; current = next(current) {
+		if coder, ok := current.(Coder); ok {
+			return coder.ErrorCode()
+		}
+		if typed, ok := current.(interface{ Type() weberr.ErrorType }); ok {
+			if code, ok := webErrorCodes[typed.Type()]; ok {
+				return code
+			}
+			if typed.Type() >= http.StatusInternalServerError {
+				return ServiceUnavailable
+			}
+		}
+		var apiErr smithy.APIError
+		if errors.As(current, &apiErr) {
+			if code, ok := awsErrorCodes[apiErr.ErrorCode()]; ok {
+				return code
+			}
+		}
+	}
+	if err == nil {
+		return ""
+	}
+	return Unknown
+}
+
+// ExitCode returns the exit code of the tool for the error, 0 when there is no error.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	return Of(err).ExitCode()
+}
+
+// OCMDetailsOf returns the details of the OCM API error of the chain, if any.
+func OCMDetailsOf(err error) *OCMDetails {
+	for current := err; current != nil; current = next(current) {
+		for _, detail := range weberr.GetDetails(current) {
+			if details, ok := detail.(OCMDetails); ok {
+				return &details
+			}
+		}
+	}
+	return nil
+}
+
+// Envelope is the JSON document written to the standard error when the 'json' output format is used.
+type Envelope struct {
+	Kind        string `json:"kind"`
+	Code        Code   `json:"code"`
+	ExitCode    int    `json:"exit_code"`
+	Message     string `json:"message"`
+	OperationID string `json:"operation_id,omitempty"`
+	OCMCode     string `json:"ocm_code,omitempty"`
+}
+
+// NewEnvelope returns the envelope of the given error.
+func NewEnvelope(err error) Envelope {
+	code := Of(err)
+	envelope := Envelope{
+		Kind:     "Error",
+		Code:     code,
+		ExitCode: code.ExitCode(),
+		Message:  err.Error(),
+	}
+	if details := OCMDetailsOf(err); details != nil {
+		envelope.OperationID = details.OperationID
+		envelope.OCMCode = details.Code
+	}
+	return envelope
+}
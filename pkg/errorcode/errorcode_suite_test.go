@@ -0,0 +1,13 @@
+package errorcode
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestErrorCode(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Error Code Suite")
+}
@@ -0,0 +1,82 @@
+package errorcode
+
+import (
+	"fmt"
+
+	"github.com/aws/smithy-go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	weberr "github.com/zgalor/weberr"
+)
+
+type waitError struct{}
+
+func (e *waitError) Error() string {
+	return "wait"
+}
+
+func (e *waitError) ErrorCode() Code {
+	return WaitTimeout
+}
+
+var _ = Describe("Error codes", func() {
+	DescribeTable("Returns the code of the error",
+		func(err error, code Code, exitCode int) {
+			Expect(Of(err)).To(Equal(code))
+			Expect(ExitCode(err)).To(Equal(exitCode))
+		},
+		Entry("no error", nil, Code(""), 0),
+		Entry("plain error", fmt.Errorf("boom"), Unknown, 1),
+		Entry("error with code", New(InvalidInput, "invalid %s", "flag"), InvalidInput, 2),
+		Entry("wrapped error with code", fmt.Errorf("failed: %w", New(NotLoggedIn, "login")), NotLoggedIn, 5),
+		Entry("error of another package", &waitError{}, WaitTimeout, 4),
+		Entry("weberr not found", weberr.NotFound.Errorf("no cluster"), NotFound, 7),
+		Entry("wrapped weberr", fmt.Errorf("failed: %w", weberr.Forbidden.Errorf("denied")), Forbidden, 6),
+		Entry("weberr caused by", errors.Wrap(weberr.Conflict.Errorf("exists"), "failed"), Conflict, 8),
+		Entry("weberr payment required", weberr.PaymentRequired.Errorf("quota"), QuotaExceeded, 9),
+		Entry("weberr too many requests", weberr.TooManyRequests.Errorf("slow"), Throttled, 10),
+		Entry("weberr server error", weberr.ErrorType(502).Errorf("bad gateway"), ServiceUnavailable, 11),
+		Entry("weberr without type", weberr.Errorf("untyped"), Unknown, 1),
+		Entry("AWS throttling", &smithy.GenericAPIError{Code: "Throttling"}, Throttled, 10),
+		Entry("wrapped AWS error", fmt.Errorf("role: %w", &smithy.GenericAPIError{Code: "NoSuchEntity"}),
+			NotFound, 7),
+		Entry("unknown AWS error", &smithy.GenericAPIError{Code: "Unexpected"}, Unknown, 1),
+	)
+
+	It("Keeps the message of wrapped errors", func() {
+		cause := fmt.Errorf("denied")
+		err := Wrap(Forbidden, cause)
+		Expect(err).To(MatchError("denied"))
+		Expect(err).To(MatchError(cause))
+		Expect(Of(err)).To(Equal(Forbidden))
+		Expect(Wrap(Forbidden, nil)).To(BeNil())
+	})
+
+	It("Returns the exit code of unknown codes", func() {
+		Expect(Code("OTHER").ExitCode()).To(Equal(1))
+	})
+
+	Context("Envelope", func() {
+		It("Contains the code and the message", func() {
+			envelope := NewEnvelope(fmt.Errorf("failed: %w", weberr.NotFound.Errorf("no cluster")))
+			Expect(envelope).To(Equal(Envelope{
+				Kind:     "Error",
+				Code:     NotFound,
+				ExitCode: 7,
+				Message:  "failed: no cluster",
+			}))
+		})
+
+		It("Contains the details of the OCM error", func() {
+			err := weberr.AddDetails(weberr.Unauthorized.Errorf("expired"), OCMDetails{
+				OperationID: "123",
+				Code:        "CLUSTERS-MGMT-401",
+			})
+			envelope := NewEnvelope(fmt.Errorf("failed: %w", err))
+			Expect(envelope.Code).To(Equal(NotLoggedIn))
+			Expect(envelope.OperationID).To(Equal("123"))
+			Expect(envelope.OCMCode).To(Equal("CLUSTERS-MGMT-401"))
+		})
+	})
+})
@@ -0,0 +1,332 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the collector of the fleet report. It gets the details of each cluster with a
+// bounded number of clusters in flight, so that large fleets don't flood the API, and keeps going when
+// a detail can't be retrieved, recording the error in the row of the cluster instead.
+
+package fleet
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	ver "github.com/hashicorp/go-version"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+// DefaultConcurrency is the default number of clusters whose details are retrieved at the same time.
+const DefaultConcurrency = 10
+
+// Version reported for the account roles that can't be read, because they are in another AWS account.
+const unknownVersion = "unknown"
+
+// Row contains the inventory and health details of a cluster.
+type Row struct {
+	ClusterID           string            `json:"cluster_id"`
+	ClusterName         string            `json:"cluster_name"`
+	State               string            `json:"state"`
+	HostedCP            bool              `json:"hosted_cp"`
+	Version             string            `json:"version"`
+	ChannelGroup        string            `json:"channel_group"`
+	EndOfLife           *time.Time        `json:"end_of_life,omitempty"`
+	DaysToEndOfLife     *int              `json:"days_to_end_of_life,omitempty"`
+	AvailableUpgrades   []string          `json:"available_upgrades"`
+	PendingUpgrade      string            `json:"pending_upgrade,omitempty"`
+	PendingUpgradeTime  *time.Time        `json:"pending_upgrade_time,omitempty"`
+	PendingUpgradeState string            `json:"pending_upgrade_state,omitempty"`
+	LimitedSupport      []string          `json:"limited_support_reasons"`
+	NodePoolVersions    map[string]string `json:"node_pool_versions,omitempty"`
+	NodePoolSkew        int               `json:"node_pool_minor_version_skew"`
+	AccountRoleVersions map[string]string `json:"account_role_versions,omitempty"`
+	Expiration          *time.Time        `json:"expiration,omitempty"`
+	Errors              []string          `json:"errors,omitempty"`
+}
+
+// Collector gets the rows of the clusters. The end of life and available upgrades of each version
+// are retrieved only once, as most clusters of a fleet share a handful of versions.
+type Collector struct {
+	ocmClient   *ocm.Client
+	awsClient   aws.Client
+	accountID   string
+	concurrency int
+	now         time.Time
+
+	lock              sync.Mutex
+	endOfLife         map[string]time.Time
+	availableUpgrades map[string][]string
+}
+
+// NewCollector creates a collector. The AWS client is used to read the tags of the account roles of the
+// clusters in the given AWS account, and can be nil to skip them.
+func NewCollector(ocmClient *ocm.Client, awsClient aws.Client, accountID string, concurrency int,
+	now time.Time) *Collector {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &Collector{
+		ocmClient:         ocmClient,
+		awsClient:         awsClient,
+		accountID:         accountID,
+		concurrency:       concurrency,
+		now:               now,
+		endOfLife:         map[string]time.Time{},
+		availableUpgrades: map[string][]string{},
+	}
+}
+
+// Collect returns the rows of the given clusters, in the same order.
+func (c *Collector) Collect(clusters []*cmv1.Cluster) []Row {
+	rows := make([]Row, len(clusters))
+	indexes := make(chan int)
+	var wait sync.WaitGroup
+	for i := 0; i < c.concurrency && i < len(clusters); i++ {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			for index := range indexes {
+				rows[index] = c.row(clusters[index])
+			}
+		}()
+	}
+	for i := range clusters {
+		indexes <- i
+	}
+	close(indexes)
+	wait.Wait()
+	return rows
+}
+
+func (c *Collector) row(cluster *cmv1.Cluster) Row {
+	row := Row{
+		ClusterID:         cluster.ID(),
+		ClusterName:       cluster.Name(),
+		State:             string(cluster.State()),
+		HostedCP:          ocm.IsHyperShiftCluster(cluster),
+		Version:           cluster.OpenshiftVersion(),
+		ChannelGroup:      cluster.Version().ChannelGroup(),
+		AvailableUpgrades: []string{},
+		LimitedSupport:    []string{},
+	}
+	if row.Version == "" {
+		row.Version = cluster.Version().RawID()
+	}
+	if expiration := cluster.ExpirationTimestamp(); !expiration.IsZero() {
+		row.Expiration = &expiration
+	}
+	addError := func(format string, args ...interface{}) {
+		row.Errors = append(row.Errors, fmt.Sprintf(format, args...))
+	}
+
+	if row.Version != "" {
+		endOfLife, err := c.getEndOfLife(row.Version, row.ChannelGroup)
+		if err != nil {
+			addError("Failed to get the end of life of version '%s': %v", row.Version, err)
+		} else if !endOfLife.IsZero() {
+			days := int(endOfLife.Sub(c.now).Hours() / 24)
+			row.EndOfLife = &endOfLife
+			row.DaysToEndOfLife = &days
+		}
+	}
+
+	if row.HostedCP {
+		row.AvailableUpgrades = ocm.GetAvailableUpgradesByCluster(cluster)
+		policy, err := c.ocmClient.GetControlPlaneScheduledUpgrade(cluster.ID())
+		if err != nil {
+			addError("Failed to get the scheduled upgrade: %v", err)
+		} else if policy != nil {
+			nextRun := policy.NextRun()
+			row.PendingUpgrade = policy.Version()
+			row.PendingUpgradeTime = &nextRun
+			row.PendingUpgradeState = string(policy.State().Value())
+		}
+	} else if row.Version != "" {
+		availableUpgrades, err := c.getAvailableUpgrades(ocm.GetVersionID(cluster))
+		if err != nil {
+			addError("Failed to get the available upgrades: %v", err)
+		} else {
+			row.AvailableUpgrades = availableUpgrades
+		}
+		policy, state, err := c.ocmClient.GetScheduledUpgrade(cluster.ID())
+		if err != nil {
+			addError("Failed to get the scheduled upgrade: %v", err)
+		} else if policy != nil {
+			nextRun := policy.NextRun()
+			row.PendingUpgrade = policy.Version()
+			row.PendingUpgradeTime = &nextRun
+			row.PendingUpgradeState = string(state.Value())
+		}
+	}
+
+	reasons, err := c.ocmClient.GetLimitedSupportReasons(cluster.ID())
+	if err != nil {
+		addError("Failed to get the limited support reasons: %v", err)
+	}
+	for _, reason := range reasons {
+		row.LimitedSupport = append(row.LimitedSupport, reason.Summary())
+	}
+
+	if row.HostedCP {
+		nodePools, err := c.ocmClient.GetNodePools(cluster.ID())
+		if err != nil {
+			addError("Failed to get the node pools: %v", err)
+		}
+		if len(nodePools) > 0 {
+			row.NodePoolVersions = map[string]string{}
+		}
+		for _, nodePool := range nodePools {
+			version := nodePool.Version().RawID()
+			row.NodePoolVersions[nodePool.ID()] = version
+			if skew := minorSkew(row.Version, version); skew > row.NodePoolSkew {
+				row.NodePoolSkew = skew
+			}
+		}
+	}
+
+	if c.awsClient != nil {
+		for _, role := range accountRoles(cluster) {
+			if row.AccountRoleVersions == nil {
+				row.AccountRoleVersions = map[string]string{}
+			}
+			// The roles of other accounts can't be read with the current credentials:
+			if role.AccountID != c.accountID {
+				row.AccountRoleVersions[role.Name] = unknownVersion
+				continue
+			}
+			version, err := c.awsClient.GetAccountRoleVersion(role.Name)
+			if err != nil {
+				addError("Failed to get the version of account role '%s': %v", role.Name, err)
+				row.AccountRoleVersions[role.Name] = unknownVersion
+				continue
+			}
+			row.AccountRoleVersions[role.Name] = version
+		}
+	}
+	return row
+}
+
+func (c *Collector) getEndOfLife(version string, channelGroup string) (time.Time, error) {
+	key := version + "/" + channelGroup
+	c.lock.Lock()
+	result, ok := c.endOfLife[key]
+	c.lock.Unlock()
+	if ok {
+		return result, nil
+	}
+	result, err := c.ocmClient.GetVersionEndOfLife(version, channelGroup)
+	if err != nil {
+		return result, err
+	}
+	c.lock.Lock()
+	c.endOfLife[key] = result
+	c.lock.Unlock()
+	return result, nil
+}
+
+func (c *Collector) getAvailableUpgrades(versionID string) ([]string, error) {
+	c.lock.Lock()
+	result, ok := c.availableUpgrades[versionID]
+	c.lock.Unlock()
+	if ok {
+		return result, nil
+	}
+	result, err := c.ocmClient.GetAvailableUpgrades(versionID)
+	if err != nil {
+		return result, err
+	}
+	c.lock.Lock()
+	c.availableUpgrades[versionID] = result
+	c.lock.Unlock()
+	return result, nil
+}
+
+type accountRole struct {
+	Name      string
+	AccountID string
+}
+
+// Returns the account roles of an STS cluster.
+func accountRoles(cluster *cmv1.Cluster) []accountRole {
+	sts := cluster.AWS().STS()
+	arns := []string{
+		sts.RoleARN(),
+		sts.SupportRoleARN(),
+		sts.InstanceIAMRoles().MasterRoleARN(),
+		sts.InstanceIAMRoles().WorkerRoleARN(),
+	}
+	result := []accountRole{}
+	for _, roleARN := range arns {
+		if roleARN == "" {
+			continue
+		}
+		parsed, err := arn.Parse(roleARN)
+		if err != nil {
+			continue
+		}
+		name, err := aws.GetResourceIdFromARN(roleARN)
+		if err == nil {
+			result = append(result, accountRole{Name: name, AccountID: parsed.AccountID})
+		}
+	}
+	return result
+}
+
+// Returns the number of minor versions that the node pool version is behind the control plane version,
+// or zero if either can't be parsed.
+func minorSkew(controlPlane string, nodePool string) int {
+	controlPlaneVersion, err := ver.NewVersion(controlPlane)
+	if err != nil {
+		return 0
+	}
+	nodePoolVersion, err := ver.NewVersion(nodePool)
+	if err != nil {
+		return 0
+	}
+	controlPlaneSegments := controlPlaneVersion.Segments()
+	nodePoolSegments := nodePoolVersion.Segments()
+	if controlPlaneSegments[0] != nodePoolSegments[0] {
+		return 0
+	}
+	skew := controlPlaneSegments[1] - nodePoolSegments[1]
+	if skew < 0 {
+		return 0
+	}
+	return skew
+}
+
+// Returns the distinct versions of the account roles, sorted.
+func roleVersions(versions map[string]string) string {
+	seen := map[string]bool{}
+	result := []string{}
+	for _, version := range versions {
+		if version == "" {
+			version = unknownVersion
+		}
+		if !seen[version] {
+			seen[version] = true
+			result = append(result, version)
+		}
+	}
+	sort.Strings(result)
+	return strings.Join(result, ", ")
+}
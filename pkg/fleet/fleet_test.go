@@ -0,0 +1,102 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleet
+
+import (
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+const emptyList = `{"kind": "List", "page": 1, "size": 0, "total": 0, "items": []}`
+
+var _ = Describe("Collector", func() {
+	now := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	It("Collects the details of classic clusters", func() {
+		t := NewTestRuntime()
+		awsClient := aws.NewMockClient(gomock.NewController(GinkgoT()))
+		awsClient.EXPECT().GetAccountRoleVersion("prefix-Installer-Role").Return("4.14", nil)
+
+		version := cmv1.NewVersion().ID("openshift-v4.14.5").RawID("4.14.5").ChannelGroup("stable")
+		first := MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.ID("1").Name("first").OpenshiftVersion("4.14.5").Version(version)
+			c.AWS(cmv1.NewAWS().STS(cmv1.NewSTS().RoleARN("arn:aws:iam::123456789012:role/prefix-Installer-Role")))
+		})
+		second := MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.ID("2").Name("second").OpenshiftVersion("4.14.5").Version(version)
+			c.AWS(cmv1.NewAWS().STS(cmv1.NewSTS().RoleARN("arn:aws:iam::210987654321:role/other-Installer-Role")))
+		})
+		t.ApiServer.AppendHandlers(
+			// End of life of the version:
+			RespondWithJSON(http.StatusOK, `{"kind": "VersionList", "page": 1, "size": 1, "total": 1, "items": [{
+				"kind": "Version", "id": "openshift-v4.14.5", "end_of_life_timestamp": "2024-06-01T00:00:00Z"
+			}]}`),
+			// Available upgrades:
+			RespondWithJSON(http.StatusOK, `{"kind": "Version", "id": "openshift-v4.14.5"}`),
+			// Upgrade policies and limited support reasons of the first cluster:
+			RespondWithJSON(http.StatusOK, emptyList),
+			RespondWithJSON(http.StatusOK, `{"kind": "LimitedSupportReasonList", "page": 1, "size": 1,
+				"total": 1, "items": [{"kind": "LimitedSupportReason", "summary": "Cluster is unhealthy"}]}`),
+			// The version details are reused for the second cluster:
+			RespondWithJSON(http.StatusOK, emptyList),
+			RespondWithJSON(http.StatusBadRequest, `{"kind": "Error", "reason": "Boom"}`),
+		)
+
+		rows := NewCollector(t.RosaRuntime.OCMClient, awsClient, "123456789012", 1, now).Collect([]*cmv1.Cluster{first, second})
+		Expect(rows).To(HaveLen(2))
+
+		Expect(rows[0].ClusterName).To(Equal("first"))
+		Expect(rows[0].Version).To(Equal("4.14.5"))
+		Expect(*rows[0].DaysToEndOfLife).To(Equal(31))
+		Expect(rows[0].AvailableUpgrades).To(BeEmpty())
+		Expect(rows[0].LimitedSupport).To(Equal([]string{"Cluster is unhealthy"}))
+		Expect(rows[0].AccountRoleVersions).To(Equal(map[string]string{"prefix-Installer-Role": "4.14"}))
+		Expect(rows[0].Errors).To(BeEmpty())
+
+		Expect(rows[1].ClusterName).To(Equal("second"))
+		Expect(*rows[1].DaysToEndOfLife).To(Equal(31))
+		Expect(rows[1].LimitedSupport).To(BeEmpty())
+		Expect(rows[1].AccountRoleVersions).To(Equal(map[string]string{"other-Installer-Role": "unknown"}))
+		Expect(rows[1].Errors).To(HaveLen(1))
+		Expect(rows[1].Errors[0]).To(ContainSubstring("Failed to get the limited support reasons"))
+	})
+
+	It("Returns no rows without clusters", func() {
+		Expect(NewCollector(nil, nil, "", 5, now).Collect(nil)).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Minor skew", func() {
+	It("Counts the minor versions that the node pool is behind", func() {
+		Expect(minorSkew("4.15.2", "4.13.9")).To(Equal(2))
+		Expect(minorSkew("4.15.2", "4.15.0")).To(Equal(0))
+	})
+
+	It("Ignores node pools ahead or with invalid versions", func() {
+		Expect(minorSkew("4.14.2", "4.15.0")).To(Equal(0))
+		Expect(minorSkew("4.14.2", "")).To(Equal(0))
+	})
+})
@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleet
+
+// The page of the HTML report. It has no external resources so that it can be attached to an email or a
+// document.
+const htmlSource = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>ROSA fleet report</title>
+<style>
+  body { font-family: sans-serif; margin: 2em; color: #151515; }
+  h1 { font-size: 1.5em; }
+  .summary { display: flex; gap: 1em; margin-bottom: 1.5em; }
+  .summary div { border: 1px solid #d2d2d2; border-radius: 4px; padding: 0.5em 1em; }
+  .summary strong { display: block; font-size: 1.5em; }
+  table { border-collapse: collapse; width: 100%; font-size: 0.9em; }
+  th, td { border: 1px solid #d2d2d2; padding: 0.4em; text-align: left; vertical-align: top; }
+  th { background: #f0f0f0; }
+  td.warning { background: #fdf7e7; }
+  td.danger { background: #faeae8; }
+  ul { margin: 0; padding-left: 1.2em; }
+</style>
+</head>
+<body>
+<h1>ROSA fleet report</h1>
+<p>Generated at {{ .GeneratedAt }}.</p>
+<div class="summary">
+  <div><strong>{{ .Summary.Clusters }}</strong>clusters</div>
+  <div><strong>{{ .Summary.LimitedSupport }}</strong>in limited support</div>
+  <div><strong>{{ .Summary.NearEndOfLife }}</strong>near or past end of life</div>
+  <div><strong>{{ .Summary.PendingUpgrade }}</strong>with a pending upgrade</div>
+</div>
+<table>
+  <thead>
+    <tr>
+      <th>Name</th>
+      <th>ID</th>
+      <th>State</th>
+      <th>Topology</th>
+      <th>Version</th>
+      <th>End of life</th>
+      <th>Available upgrades</th>
+      <th>Pending upgrade</th>
+      <th>Limited support</th>
+      <th>Node pools</th>
+      <th>Account roles</th>
+      <th>Expiration</th>
+      <th>Errors</th>
+    </tr>
+  </thead>
+  <tbody>
+  {{- range .Rows }}
+    <tr>
+      <td>{{ .ClusterName }}</td>
+      <td>{{ .ClusterID }}</td>
+      <td>{{ .State }}</td>
+      <td>{{ topology .HostedCP }}</td>
+      <td>{{ .Version }}</td>
+      <td{{ if nearEndOfLife . }} class="warning"{{ end }}>{{ endOfLife . }}</td>
+      <td>{{ upgrades .AvailableUpgrades }}</td>
+      <td>{{ pendingUpgrade . }}</td>
+      <td{{ if .LimitedSupport }} class="danger"{{ end }}>
+        {{- if .LimitedSupport }}<ul>{{ range .LimitedSupport }}<li>{{ . }}</li>{{ end }}</ul>{{ else }}no{{ end -}}
+      </td>
+      <td{{ if .NodePoolSkew }} class="warning"{{ end }}>{{ nodePoolVersion .NodePoolVersions }}</td>
+      <td>{{ roleVersions .AccountRoleVersions }}</td>
+      <td>{{ time .Expiration }}</td>
+      <td>{{ if .Errors }}<ul>{{ range .Errors }}<li>{{ . }}</li>{{ end }}</ul>{{ end }}</td>
+    </tr>
+  {{- end }}
+  </tbody>
+</table>
+</body>
+</html>
+`
@@ -0,0 +1,225 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleet
+
+import (
+	"encoding/csv"
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openshift/rosa/pkg/output"
+)
+
+// Formats of the fleet report that aren't supported by the '--output' flag of other commands.
+const (
+	CSV  = "csv"
+	HTML = "html"
+)
+
+// EndOfLifeWarningDays is the number of days before the end of life when a version is highlighted.
+const EndOfLifeWarningDays = 90
+
+func NewTable(rows []Row) *output.Table {
+	table := output.NewTable("ID", "NAME", "VERSION", "END OF LIFE", "UPGRADES", "PENDING UPGRADE",
+		"LIMITED SUPPORT", "NODE POOL SKEW", "ROLE VERSIONS", "EXPIRATION").
+		Wide("STATE", "TOPOLOGY", "ERRORS")
+	for _, row := range rows {
+		table.AddRow(
+			row.ClusterID,
+			row.ClusterName,
+			row.Version,
+			formatEndOfLife(row),
+			formatUpgrades(row.AvailableUpgrades),
+			formatPendingUpgrade(row),
+			formatLimitedSupport(row.LimitedSupport),
+			strconv.Itoa(row.NodePoolSkew),
+			roleVersions(row.AccountRoleVersions),
+			formatTime(row.Expiration),
+			row.State,
+			formatTopology(row.HostedCP),
+			strconv.Itoa(len(row.Errors)),
+		)
+	}
+	return table
+}
+
+var csvHeader = []string{
+	"cluster_id", "cluster_name", "state", "topology", "version", "channel_group", "end_of_life",
+	"days_to_end_of_life", "available_upgrades", "pending_upgrade", "pending_upgrade_time",
+	"pending_upgrade_state", "limited_support_reasons", "node_pool_versions", "node_pool_minor_version_skew",
+	"account_role_versions", "expiration", "errors",
+}
+
+// WriteCSV writes the rows as CSV, with the lists joined with semicolons.
+func WriteCSV(writer io.Writer, rows []Row) error {
+	csvWriter := csv.NewWriter(writer)
+	err := csvWriter.Write(csvHeader)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		days := ""
+		if row.DaysToEndOfLife != nil {
+			days = strconv.Itoa(*row.DaysToEndOfLife)
+		}
+		err = csvWriter.Write([]string{
+			row.ClusterID,
+			row.ClusterName,
+			row.State,
+			formatTopology(row.HostedCP),
+			row.Version,
+			row.ChannelGroup,
+			formatTime(row.EndOfLife),
+			days,
+			strings.Join(row.AvailableUpgrades, ";"),
+			row.PendingUpgrade,
+			formatTime(row.PendingUpgradeTime),
+			row.PendingUpgradeState,
+			strings.Join(row.LimitedSupport, ";"),
+			joinMap(row.NodePoolVersions),
+			strconv.Itoa(row.NodePoolSkew),
+			joinMap(row.AccountRoleVersions),
+			formatTime(row.Expiration),
+			strings.Join(row.Errors, ";"),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"endOfLife":       formatEndOfLife,
+	"limitedSupport":  formatLimitedSupport,
+	"pendingUpgrade":  formatPendingUpgrade,
+	"roleVersions":    roleVersions,
+	"time":            formatTime,
+	"topology":        formatTopology,
+	"upgrades":        formatUpgrades,
+	"nearEndOfLife":   nearEndOfLife,
+	"nodePoolVersion": joinMap,
+}).Parse(htmlSource))
+
+// WriteHTML writes the rows as a self-contained HTML page, with a summary of the clusters that need
+// attention.
+func WriteHTML(writer io.Writer, rows []Row, generatedAt time.Time) error {
+	summary := struct {
+		Clusters       int
+		LimitedSupport int
+		NearEndOfLife  int
+		PendingUpgrade int
+	}{Clusters: len(rows)}
+	for _, row := range rows {
+		if len(row.LimitedSupport) > 0 {
+			summary.LimitedSupport++
+		}
+		if nearEndOfLife(row) {
+			summary.NearEndOfLife++
+		}
+		if row.PendingUpgrade != "" {
+			summary.PendingUpgrade++
+		}
+	}
+	return htmlTemplate.Execute(writer, map[string]interface{}{
+		"GeneratedAt": generatedAt.UTC().Format(time.RFC3339),
+		"Summary":     summary,
+		"Rows":        rows,
+	})
+}
+
+func nearEndOfLife(row Row) bool {
+	return row.DaysToEndOfLife != nil && *row.DaysToEndOfLife <= EndOfLifeWarningDays
+}
+
+func formatEndOfLife(row Row) string {
+	if row.EndOfLife == nil {
+		return ""
+	}
+	days := *row.DaysToEndOfLife
+	if days < 0 {
+		return fmt.Sprintf("%s (passed)", row.EndOfLife.Format(time.DateOnly))
+	}
+	return fmt.Sprintf("%s (%d days)", row.EndOfLife.Format(time.DateOnly), days)
+}
+
+// Returns the latest available upgrade and how many there are.
+func formatUpgrades(upgrades []string) string {
+	switch len(upgrades) {
+	case 0:
+		return "none"
+	case 1:
+		return upgrades[0]
+	default:
+		return fmt.Sprintf("%s (+%d)", upgrades[0], len(upgrades)-1)
+	}
+}
+
+func formatPendingUpgrade(row Row) string {
+	if row.PendingUpgrade == "" {
+		return ""
+	}
+	result := row.PendingUpgrade
+	if row.PendingUpgradeTime != nil {
+		result = fmt.Sprintf("%s at %s", result, row.PendingUpgradeTime.UTC().Format("2006-01-02 15:04 MST"))
+	}
+	if row.PendingUpgradeState != "" {
+		result = fmt.Sprintf("%s (%s)", result, row.PendingUpgradeState)
+	}
+	return result
+}
+
+func formatLimitedSupport(reasons []string) string {
+	if len(reasons) == 0 {
+		return "no"
+	}
+	return fmt.Sprintf("yes (%d)", len(reasons))
+}
+
+func formatTopology(hostedCP bool) string {
+	if hostedCP {
+		return "Hosted CP"
+	}
+	return "Classic"
+}
+
+func formatTime(value *time.Time) string {
+	if value == nil || value.IsZero() {
+		return ""
+	}
+	return value.UTC().Format(time.RFC3339)
+}
+
+// Returns the entries of a map as 'key=value' pairs separated by semicolons, sorted by key.
+func joinMap(values map[string]string) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", key, values[key]))
+	}
+	return strings.Join(pairs, ";")
+}
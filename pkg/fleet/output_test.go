@@ -0,0 +1,70 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleet
+
+import (
+	"bytes"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Output", func() {
+	endOfLife := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	days := 31
+	rows := []Row{{
+		ClusterID:           "1",
+		ClusterName:         "<first>",
+		State:               "ready",
+		Version:             "4.14.5",
+		EndOfLife:           &endOfLife,
+		DaysToEndOfLife:     &days,
+		AvailableUpgrades:   []string{"4.15.1", "4.14.9"},
+		LimitedSupport:      []string{"Cluster is unhealthy"},
+		AccountRoleVersions: map[string]string{"Installer": "4.14", "Support": "4.13"},
+	}}
+
+	It("Writes CSV", func() {
+		var buffer bytes.Buffer
+		Expect(WriteCSV(&buffer, rows)).To(Succeed())
+		lines := bytes.Split(bytes.TrimSpace(buffer.Bytes()), []byte("\n"))
+		Expect(lines).To(HaveLen(2))
+		Expect(string(lines[1])).To(Equal("1,<first>,ready,Classic,4.14.5,,2024-06-01T00:00:00Z,31," +
+			"4.15.1;4.14.9,,,,Cluster is unhealthy,,0,Installer=4.14;Support=4.13,,"))
+	})
+
+	It("Writes HTML", func() {
+		var buffer bytes.Buffer
+		Expect(WriteHTML(&buffer, rows, endOfLife)).To(Succeed())
+		page := buffer.String()
+		Expect(page).To(ContainSubstring("&lt;first&gt;"))
+		Expect(page).To(ContainSubstring(`<td class="warning">2024-06-01 (31 days)</td>`))
+		Expect(page).To(ContainSubstring("<li>Cluster is unhealthy</li>"))
+		Expect(page).To(ContainSubstring("<strong>1</strong>in limited support"))
+	})
+
+	It("Formats the available upgrades", func() {
+		Expect(formatUpgrades(nil)).To(Equal("none"))
+		Expect(formatUpgrades([]string{"4.15.1"})).To(Equal("4.15.1"))
+		Expect(formatUpgrades([]string{"4.15.1", "4.14.9"})).To(Equal("4.15.1 (+1)"))
+	})
+
+	It("Formats the versions of the account roles", func() {
+		Expect(roleVersions(map[string]string{"a": "4.14", "b": "4.14", "c": ""})).To(Equal("4.14, unknown"))
+	})
+})
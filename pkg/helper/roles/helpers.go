@@ -2,7 +2,6 @@ package roles
 
 import (
 	"fmt"
-	"os"
 	"time"
 
 	awsCommonUtils "github.com/openshift-online/ocm-common/pkg/aws/utils"
@@ -17,6 +16,7 @@ import (
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -187,7 +187,7 @@ func CreateMissingRoles(
 			err = createOperatorRole(mode, r, cluster, prefix, missingRolesInCS, policies, unifiedPath, managedPolicies)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 			createdMissingRoles++
 		}
@@ -225,7 +225,7 @@ func createOperatorRole(
 		fmt.Println(commands)
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	return nil
 }
@@ -0,0 +1,184 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the executor of the hibernation schedules. Every run compares the last action of
+// the schedule of each cluster with the state of the cluster, and hibernates or resumes it when they
+// don't match. That way it can run from cron or from a pod at any interval. The only state is when the
+// last applied action was due, stored in the properties of the cluster, so that an action is applied only
+// once and doesn't undo a manual hibernation or resume that happened after it.
+
+package hibernation
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+// Result is the outcome of running the schedule of a cluster.
+type Result struct {
+	ClusterID   string `json:"cluster_id"`
+	ClusterName string `json:"cluster_name"`
+	Action      Action `json:"action"`
+	Done        bool   `json:"done"`
+	Message     string `json:"message,omitempty"`
+	Err         error  `json:"-"`
+}
+
+// MarshalJSON adds the message of the error, which isn't marshalled by default.
+func (r Result) MarshalJSON() ([]byte, error) {
+	type result Result
+	value := struct {
+		result
+		Error string `json:"error,omitempty"`
+	}{result: result(r)}
+	if r.Err != nil {
+		value.Error = r.Err.Error()
+	}
+	return json.Marshal(value)
+}
+
+// Run runs the schedules of the given clusters.
+func Run(client *ocm.Client, clusters []*cmv1.Cluster, now time.Time) []Result {
+	results := make([]Result, 0, len(clusters))
+	for _, cluster := range clusters {
+		results = append(results, runCluster(client, cluster, now))
+	}
+	return results
+}
+
+func runCluster(client *ocm.Client, cluster *cmv1.Cluster, now time.Time) Result {
+	result := Result{ClusterID: cluster.ID(), ClusterName: cluster.Name()}
+	schedule, found, err := GetSchedule(cluster)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	if !found {
+		result.Message = "The cluster doesn't have a hibernation schedule"
+		return result
+	}
+
+	var due time.Time
+	result.Action, due = schedule.Current(now)
+	if result.Action != ActionNone && !Applied(cluster).Before(due) {
+		result.Message = fmt.Sprintf("The %s scheduled for %s was already applied", result.Action,
+			due.UTC().Format(time.RFC3339))
+		return result
+	}
+	state := cluster.State()
+	switch result.Action {
+	case ActionHibernate:
+		switch state {
+		case cmv1.ClusterStateHibernating, cmv1.ClusterStatePoweringDown:
+			result.Message = "The cluster is already hibernating"
+			return result
+		case cmv1.ClusterStateReady:
+		default:
+			result.Message = fmt.Sprintf("Can't hibernate the cluster while it is '%s'", state)
+			return result
+		}
+		reason, err := pendingUpgrade(client, cluster, schedule.NextResume(now))
+		if err != nil {
+			result.Err = fmt.Errorf("Failed to check the upgrade policies: %v", err)
+			return result
+		}
+		if reason != "" {
+			result.Message = fmt.Sprintf("Refusing to hibernate the cluster, %s", reason)
+			return result
+		}
+		result.Err = client.HibernateCluster(cluster.ID())
+		if result.Err == nil {
+			result.Done = true
+			result.Message = "Hibernating the cluster"
+			result.Err = recordApplied(client, cluster, due)
+		}
+	case ActionResume:
+		switch state {
+		case cmv1.ClusterStateHibernating:
+		case cmv1.ClusterStatePoweringDown:
+			result.Message = "The cluster is still powering down, it will be resumed by the next run"
+			return result
+		default:
+			result.Message = "The cluster isn't hibernating"
+			return result
+		}
+		result.Err = client.ResumeCluster(cluster.ID())
+		if result.Err == nil {
+			result.Done = true
+			result.Message = "Resuming the cluster"
+			result.Err = recordApplied(client, cluster, due)
+		}
+	default:
+		result.Message = "The schedule wasn't due in the last week"
+	}
+	return result
+}
+
+// Records that the action due at the given time was applied to the cluster, so that the next runs don't
+// apply it again.
+func recordApplied(client *ocm.Client, cluster *cmv1.Cluster, due time.Time) error {
+	err := client.UpdateClusterProperties(cluster.ID(), SetApplied(cluster, due))
+	if err != nil {
+		return fmt.Errorf("Failed to record the applied action: %v", err)
+	}
+	return nil
+}
+
+// Returns the reason why the cluster can't be hibernated because of its upgrade policy, or an empty
+// string if there is no upgrade in progress or planned before the cluster is resumed again. A recurring
+// policy always has a next run, so it only prevents hibernation when that run is before the resume.
+func pendingUpgrade(client *ocm.Client, cluster *cmv1.Cluster, resume time.Time) (string, error) {
+	var state cmv1.UpgradePolicyStateValue
+	var scheduleType cmv1.ScheduleType
+	var nextRun time.Time
+	var version string
+	if ocm.IsHyperShiftCluster(cluster) {
+		policy, err := client.GetControlPlaneScheduledUpgrade(cluster.ID())
+		if err != nil || policy == nil {
+			return "", err
+		}
+		state = policy.State().Value()
+		scheduleType = policy.ScheduleType()
+		nextRun = policy.NextRun()
+		version = policy.Version()
+	} else {
+		policy, policyState, err := client.GetScheduledUpgrade(cluster.ID())
+		if err != nil || policy == nil {
+			return "", err
+		}
+		state = policyState.Value()
+		scheduleType = policy.ScheduleType()
+		nextRun = policy.NextRun()
+		version = policy.Version()
+	}
+
+	switch state {
+	case cmv1.UpgradePolicyStateValueStarted:
+		return fmt.Sprintf("the upgrade to version '%s' is in progress", version), nil
+	case cmv1.UpgradePolicyStateValuePending, cmv1.UpgradePolicyStateValueScheduled,
+		cmv1.UpgradePolicyStateValueDelayed:
+		if scheduleType != cmv1.ScheduleTypeAutomatic || nextRun.Before(resume) {
+			return fmt.Sprintf("there is an upgrade to version '%s' scheduled for %s",
+				version, nextRun.UTC().Format(time.RFC3339)), nil
+		}
+	}
+	return "", nil
+}
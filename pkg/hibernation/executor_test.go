@@ -0,0 +1,212 @@
+package hibernation
+
+import (
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/properties"
+	"github.com/openshift/rosa/pkg/test"
+)
+
+const (
+	currentAccount = `{
+		"kind": "Account",
+		"id": "account",
+		"organization": {"kind": "Organization", "id": "org"}
+	}`
+	organization = `{
+		"kind": "Organization",
+		"id": "org",
+		"capabilities": [{"name": "capability.organization.hibernate_cluster", "value": "true"}]
+	}`
+	emptyList = `{"kind": "UpgradePolicyList", "page": 1, "size": 0, "total": 0, "items": []}`
+)
+
+var _ = Describe("Executor", func() {
+	var t *test.TestingRuntime
+
+	// 2024-03-05 20:00 UTC is a Tuesday, after the hibernation time and before the resume time
+	now := time.Date(2024, 3, 5, 20, 0, 0, 0, time.UTC)
+
+	mockClusterApplied := func(state cmv1.ClusterState, applied string) *cmv1.Cluster {
+		clusterProperties := map[string]string{
+			properties.HibernateSchedule: "0 19 * * 1-5",
+			properties.ResumeSchedule:    "0 7 * * 1-5",
+			properties.ScheduleTimezone:  "UTC",
+		}
+		if applied != "" {
+			clusterProperties[properties.ScheduleApplied] = applied
+		}
+		return test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.ID("123").Name("foo").State(state).Properties(clusterProperties)
+		})
+	}
+
+	mockCluster := func(state cmv1.ClusterState) *cmv1.Cluster {
+		return mockClusterApplied(state, "")
+	}
+
+	expectApplied := func(applied string) {
+		t.ApiServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPatch, "/api/clusters_mgmt/v1/clusters/123"),
+				ghttp.VerifyJSON(`{
+					"kind": "Cluster",
+					"properties": {
+						"`+properties.HibernateSchedule+`": "0 19 * * 1-5",
+						"`+properties.ResumeSchedule+`": "0 7 * * 1-5",
+						"`+properties.ScheduleTimezone+`": "UTC",
+						"`+properties.ScheduleApplied+`": "`+applied+`"
+					}
+				}`),
+				RespondWithJSON(http.StatusOK, "{}"),
+			),
+		)
+	}
+
+	expectCapability := func() {
+		t.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, currentAccount),
+			RespondWithJSON(http.StatusOK, organization),
+		)
+	}
+
+	BeforeEach(func() {
+		t = test.NewTestRuntime()
+	})
+
+	It("Hibernates a ready cluster", func() {
+		t.ApiServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies"),
+				RespondWithJSON(http.StatusOK, emptyList),
+			),
+		)
+		expectCapability()
+		t.ApiServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters/123/hibernate"),
+				RespondWithJSON(http.StatusAccepted, "{}"),
+			),
+		)
+		expectApplied("2024-03-05T19:00:00Z")
+
+		results := Run(t.RosaRuntime.OCMClient, []*cmv1.Cluster{mockCluster(cmv1.ClusterStateReady)}, now)
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].Err).ToNot(HaveOccurred())
+		Expect(results[0].Action).To(Equal(ActionHibernate))
+		Expect(results[0].Done).To(BeTrue())
+		Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(5))
+	})
+
+	It("Refuses to hibernate a cluster with a pending upgrade", func() {
+		t.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"kind": "UpgradePolicyList", "page": 1, "size": 1, "total": 1,
+				"items": [{
+					"kind": "UpgradePolicy",
+					"id": "456",
+					"upgrade_type": "OSD",
+					"schedule_type": "manual",
+					"version": "4.14.2",
+					"next_run": "2024-03-05T22:00:00Z"
+				}]
+			}`),
+			RespondWithJSON(http.StatusOK, `{"kind": "UpgradePolicyState", "value": "scheduled"}`),
+		)
+
+		results := Run(t.RosaRuntime.OCMClient, []*cmv1.Cluster{mockCluster(cmv1.ClusterStateReady)}, now)
+		Expect(results[0].Err).ToNot(HaveOccurred())
+		Expect(results[0].Done).To(BeFalse())
+		Expect(results[0].Message).To(Equal("Refusing to hibernate the cluster, there is an upgrade to " +
+			"version '4.14.2' scheduled for 2024-03-05T22:00:00Z"))
+		Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("Hibernates a cluster with a recurring upgrade after the resume", func() {
+		t.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"kind": "UpgradePolicyList", "page": 1, "size": 1, "total": 1,
+				"items": [{
+					"kind": "UpgradePolicy",
+					"id": "456",
+					"upgrade_type": "OSD",
+					"schedule_type": "automatic",
+					"next_run": "2024-03-10T02:00:00Z"
+				}]
+			}`),
+			RespondWithJSON(http.StatusOK, `{"kind": "UpgradePolicyState", "value": "scheduled"}`),
+		)
+		expectCapability()
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusAccepted, "{}"))
+		expectApplied("2024-03-05T19:00:00Z")
+
+		results := Run(t.RosaRuntime.OCMClient, []*cmv1.Cluster{mockCluster(cmv1.ClusterStateReady)}, now)
+		Expect(results[0].Err).ToNot(HaveOccurred())
+		Expect(results[0].Done).To(BeTrue())
+	})
+
+	It("Resumes a hibernating cluster", func() {
+		expectCapability()
+		t.ApiServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters/123/resume"),
+				RespondWithJSON(http.StatusAccepted, "{}"),
+			),
+		)
+		expectApplied("2024-03-06T07:00:00Z")
+
+		morning := time.Date(2024, 3, 6, 8, 0, 0, 0, time.UTC)
+		results := Run(t.RosaRuntime.OCMClient, []*cmv1.Cluster{mockCluster(cmv1.ClusterStateHibernating)}, morning)
+		Expect(results[0].Err).ToNot(HaveOccurred())
+		Expect(results[0].Action).To(Equal(ActionResume))
+		Expect(results[0].Done).To(BeTrue())
+	})
+
+	It("Doesn't change a cluster that is already in the scheduled state", func() {
+		results := Run(t.RosaRuntime.OCMClient, []*cmv1.Cluster{mockCluster(cmv1.ClusterStateHibernating)}, now)
+		Expect(results[0].Err).ToNot(HaveOccurred())
+		Expect(results[0].Done).To(BeFalse())
+		Expect(results[0].Message).To(Equal("The cluster is already hibernating"))
+		Expect(t.ApiServer.ReceivedRequests()).To(BeEmpty())
+	})
+
+	It("Doesn't hibernate again a cluster that was resumed manually", func() {
+		cluster := mockClusterApplied(cmv1.ClusterStateReady, "2024-03-05T19:00:00Z")
+		results := Run(t.RosaRuntime.OCMClient, []*cmv1.Cluster{cluster}, now)
+		Expect(results[0].Err).ToNot(HaveOccurred())
+		Expect(results[0].Action).To(Equal(ActionHibernate))
+		Expect(results[0].Done).To(BeFalse())
+		Expect(results[0].Message).To(Equal("The hibernate scheduled for 2024-03-05T19:00:00Z was already applied"))
+		Expect(t.ApiServer.ReceivedRequests()).To(BeEmpty())
+	})
+
+	It("Applies the next action after the one that was already applied", func() {
+		expectCapability()
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusAccepted, "{}"))
+		expectApplied("2024-03-06T07:00:00Z")
+
+		cluster := mockClusterApplied(cmv1.ClusterStateHibernating, "2024-03-05T19:00:00Z")
+		morning := time.Date(2024, 3, 6, 8, 0, 0, 0, time.UTC)
+		results := Run(t.RosaRuntime.OCMClient, []*cmv1.Cluster{cluster}, morning)
+		Expect(results[0].Err).ToNot(HaveOccurred())
+		Expect(results[0].Action).To(Equal(ActionResume))
+		Expect(results[0].Done).To(BeTrue())
+	})
+
+	It("Reports the errors", func() {
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, emptyList))
+		expectCapability()
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusBadRequest, `{"kind": "Error", "reason": "boom"}`))
+
+		results := Run(t.RosaRuntime.OCMClient, []*cmv1.Cluster{mockCluster(cmv1.ClusterStateReady)}, now)
+		Expect(results[0].Err).To(MatchError(ContainSubstring("Failed to hibernate the cluster")))
+		Expect(NewResultTable(results).String()).To(ContainSubstring("foo\thibernate\tFailed: "))
+	})
+})
@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hibernation
+
+import (
+	"fmt"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/output"
+)
+
+// Description is the hibernation schedule of a cluster, as printed by the list and describe commands.
+type Description struct {
+	ClusterID     string    `json:"cluster_id"`
+	ClusterName   string    `json:"cluster_name"`
+	State         string    `json:"state"`
+	Hibernate     string    `json:"hibernate"`
+	Resume        string    `json:"resume"`
+	Timezone      string    `json:"timezone"`
+	NextHibernate time.Time `json:"next_hibernate"`
+	NextResume    time.Time `json:"next_resume"`
+}
+
+// NewDescription returns the description of the schedule of the cluster at the given time.
+func NewDescription(cluster *cmv1.Cluster, schedule *Schedule, now time.Time) *Description {
+	return &Description{
+		ClusterID:     cluster.ID(),
+		ClusterName:   cluster.Name(),
+		State:         string(cluster.State()),
+		Hibernate:     schedule.Hibernate,
+		Resume:        schedule.Resume,
+		Timezone:      schedule.Timezone,
+		NextHibernate: schedule.NextHibernate(now),
+		NextResume:    schedule.NextResume(now),
+	}
+}
+
+// PrintDescription returns the text printed by the describe command.
+func PrintDescription(description *Description) string {
+	return fmt.Sprintf(""+
+		"Cluster ID:                 %s\n"+
+		"Cluster name:               %s\n"+
+		"State:                      %s\n"+
+		"Hibernate:                  %s\n"+
+		"Resume:                     %s\n"+
+		"Time zone:                  %s\n"+
+		"Next hibernation:           %s\n"+
+		"Next resume:                %s\n",
+		description.ClusterID,
+		description.ClusterName,
+		description.State,
+		description.Hibernate,
+		description.Resume,
+		description.Timezone,
+		formatTime(description.NextHibernate, description.Timezone),
+		formatTime(description.NextResume, description.Timezone),
+	)
+}
+
+// NewDescriptionTable returns the table printed by the list command.
+func NewDescriptionTable(descriptions []*Description) *output.Table {
+	table := output.NewTable("ID", "NAME", "STATE", "HIBERNATE", "RESUME", "TIME ZONE").
+		Wide("NEXT HIBERNATION", "NEXT RESUME")
+	for _, description := range descriptions {
+		table.AddRow(
+			description.ClusterID,
+			description.ClusterName,
+			description.State,
+			description.Hibernate,
+			description.Resume,
+			description.Timezone,
+			formatTime(description.NextHibernate, description.Timezone),
+			formatTime(description.NextResume, description.Timezone),
+		)
+	}
+	return table
+}
+
+// NewResultTable returns the table printed by the 'run-schedules' command.
+func NewResultTable(results []Result) *output.Table {
+	table := output.NewTable("ID", "NAME", "ACTION", "RESULT")
+	for _, result := range results {
+		action := string(result.Action)
+		if action == "" {
+			action = "none"
+		}
+		message := result.Message
+		if result.Err != nil {
+			message = fmt.Sprintf("Failed: %v", result.Err)
+		}
+		table.AddRow(result.ClusterID, result.ClusterName, action, message)
+	}
+	return table
+}
+
+func formatTime(value time.Time, timezone string) string {
+	if value.IsZero() {
+		return "never"
+	}
+	location, err := time.LoadLocation(timezone)
+	if err == nil {
+		value = value.In(location)
+	}
+	return value.Format("2006-01-02 15:04 MST")
+}
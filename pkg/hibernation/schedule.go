@@ -0,0 +1,191 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the hibernation schedule of a cluster. The schedule is a pair of cron expressions,
+// one for hibernating the cluster and one for resuming it, evaluated in a time zone. It is stored in the
+// properties of the cluster, so it doesn't need any other storage, and it is executed by the
+// 'rosa run-schedules' command.
+
+package hibernation
+
+import (
+	"fmt"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/robfig/cron/v3"
+
+	"github.com/openshift/rosa/pkg/properties"
+)
+
+const DefaultTimezone = "UTC"
+
+// Action is the operation that a schedule requests for a cluster.
+type Action string
+
+const (
+	ActionNone      Action = ""
+	ActionHibernate Action = "hibernate"
+	ActionResume    Action = "resume"
+)
+
+// Maximum time to look back for the last execution of a cron expression. Schedules are expected to run
+// at least once a week, for example during the weekend.
+const lookBack = 8 * 24 * time.Hour
+
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+// Schedule is the hibernation schedule of a cluster.
+type Schedule struct {
+	Hibernate string `json:"hibernate"`
+	Resume    string `json:"resume"`
+	Timezone  string `json:"timezone"`
+
+	hibernate cron.Schedule
+	resume    cron.Schedule
+}
+
+// NewSchedule parses the cron expressions and the time zone of a schedule.
+func NewSchedule(hibernate string, resume string, timezone string) (*Schedule, error) {
+	if timezone == "" {
+		timezone = DefaultTimezone
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid time zone '%s': %v", timezone, err)
+	}
+	s := &Schedule{
+		Hibernate: hibernate,
+		Resume:    resume,
+		Timezone:  location.String(),
+	}
+	s.hibernate, err = parse(hibernate, s.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid hibernate schedule: %v", err)
+	}
+	s.resume, err = parse(resume, s.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid resume schedule: %v", err)
+	}
+	return s, nil
+}
+
+func parse(expression string, timezone string) (cron.Schedule, error) {
+	if expression == "" {
+		return nil, fmt.Errorf("The cron expression is empty")
+	}
+	schedule, err := cronParser.Parse(fmt.Sprintf("CRON_TZ=%s %s", timezone, expression))
+	if err != nil {
+		return nil, fmt.Errorf("'%s' is not a valid cron expression", expression)
+	}
+	return schedule, nil
+}
+
+// GetSchedule returns the hibernation schedule stored in the properties of the cluster. The second
+// result is false if the cluster doesn't have a schedule.
+func GetSchedule(cluster *cmv1.Cluster) (*Schedule, bool, error) {
+	clusterProperties := cluster.Properties()
+	hibernate, hasHibernate := clusterProperties[properties.HibernateSchedule]
+	resume, hasResume := clusterProperties[properties.ResumeSchedule]
+	if !hasHibernate && !hasResume {
+		return nil, false, nil
+	}
+	schedule, err := NewSchedule(hibernate, resume, clusterProperties[properties.ScheduleTimezone])
+	if err != nil {
+		return nil, true, fmt.Errorf("Cluster '%s' has an invalid hibernation schedule: %v", cluster.Name(), err)
+	}
+	return schedule, true, nil
+}
+
+// SetSchedule returns a copy of the properties of the cluster that contains the schedule.
+func SetSchedule(cluster *cmv1.Cluster, schedule *Schedule) map[string]string {
+	result := RemoveSchedule(cluster)
+	result[properties.HibernateSchedule] = schedule.Hibernate
+	result[properties.ResumeSchedule] = schedule.Resume
+	result[properties.ScheduleTimezone] = schedule.Timezone
+	return result
+}
+
+// Applied returns when the last action applied to the cluster by the executor was due, or zero if it
+// didn't apply any action of the current schedule yet.
+func Applied(cluster *cmv1.Cluster) time.Time {
+	value, err := time.Parse(time.RFC3339, cluster.Properties()[properties.ScheduleApplied])
+	if err != nil {
+		return time.Time{}
+	}
+	return value
+}
+
+// SetApplied returns a copy of the properties of the cluster that records when the last action applied
+// to the cluster was due.
+func SetApplied(cluster *cmv1.Cluster, due time.Time) map[string]string {
+	result := map[string]string{}
+	for key, value := range cluster.Properties() {
+		result[key] = value
+	}
+	result[properties.ScheduleApplied] = due.UTC().Format(time.RFC3339)
+	return result
+}
+
+// RemoveSchedule returns a copy of the properties of the cluster without the schedule, nor the last
+// action applied from it.
+func RemoveSchedule(cluster *cmv1.Cluster) map[string]string {
+	result := map[string]string{}
+	for key, value := range cluster.Properties() {
+		switch key {
+		case properties.HibernateSchedule, properties.ResumeSchedule, properties.ScheduleTimezone,
+			properties.ScheduleApplied:
+		default:
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// NextHibernate returns the next time the cluster will be hibernated after the given time.
+func (s *Schedule) NextHibernate(now time.Time) time.Time {
+	return s.hibernate.Next(now)
+}
+
+// NextResume returns the next time the cluster will be resumed after the given time.
+func (s *Schedule) NextResume(now time.Time) time.Time {
+	return s.resume.Next(now)
+}
+
+// Current returns the last action of the schedule before the given time, and when it was due. The
+// executor makes the cluster match that action, so a run that is missed is applied by the next one.
+func (s *Schedule) Current(now time.Time) (Action, time.Time) {
+	lastHibernate := last(s.hibernate, now)
+	lastResume := last(s.resume, now)
+	switch {
+	case lastHibernate.IsZero() && lastResume.IsZero():
+		return ActionNone, time.Time{}
+	case lastHibernate.After(lastResume):
+		return ActionHibernate, lastHibernate
+	default:
+		return ActionResume, lastResume
+	}
+}
+
+// Returns the last time the schedule was due before the given time, or zero if it wasn't due since the
+// look back period.
+func last(schedule cron.Schedule, now time.Time) time.Time {
+	result := time.Time{}
+	for next := schedule.Next(now.Add(-lookBack)); !next.IsZero() && !next.After(now); next = schedule.Next(next) {
+		result = next
+	}
+	return result
+}
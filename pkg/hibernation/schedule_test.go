@@ -0,0 +1,114 @@
+package hibernation
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/properties"
+	"github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Schedule", func() {
+	var berlin *time.Location
+
+	BeforeEach(func() {
+		var err error
+		berlin, err = time.LoadLocation("Europe/Berlin")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	DescribeTable("Rejects invalid schedules",
+		func(hibernate string, resume string, timezone string, message string) {
+			_, err := NewSchedule(hibernate, resume, timezone)
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("invalid hibernate", "0 19 * *", "0 7 * * 1-5", "UTC",
+			"Invalid hibernate schedule: '0 19 * *' is not a valid cron expression"),
+		Entry("missing resume", "0 19 * * 1-5", "", "UTC",
+			"Invalid resume schedule: The cron expression is empty"),
+		Entry("invalid time zone", "0 19 * * 1-5", "0 7 * * 1-5", "Mars/Olympus",
+			"Invalid time zone 'Mars/Olympus'"),
+	)
+
+	DescribeTable("Returns the last action",
+		func(now time.Time, action Action, due time.Time) {
+			schedule, err := NewSchedule("0 19 * * 1-5", "0 7 * * 1-5", "Europe/Berlin")
+			Expect(err).ToNot(HaveOccurred())
+			currentAction, currentDue := schedule.Current(now.In(time.UTC))
+			Expect(currentAction).To(Equal(action))
+			Expect(currentDue.Equal(due)).To(BeTrue(), "due at %s", currentDue)
+		},
+		// 2024-03-05 is a Tuesday
+		Entry("weekday evening",
+			time.Date(2024, 3, 5, 20, 0, 0, 0, time.FixedZone("CET", 3600)), ActionHibernate,
+			time.Date(2024, 3, 5, 19, 0, 0, 0, time.FixedZone("CET", 3600))),
+		Entry("weekday morning",
+			time.Date(2024, 3, 5, 8, 0, 0, 0, time.FixedZone("CET", 3600)), ActionResume,
+			time.Date(2024, 3, 5, 7, 0, 0, 0, time.FixedZone("CET", 3600))),
+		Entry("weekend",
+			time.Date(2024, 3, 9, 12, 0, 0, 0, time.FixedZone("CET", 3600)), ActionHibernate,
+			time.Date(2024, 3, 8, 19, 0, 0, 0, time.FixedZone("CET", 3600))),
+		Entry("exactly at the resume time",
+			time.Date(2024, 3, 11, 7, 0, 0, 0, time.FixedZone("CET", 3600)), ActionResume,
+			time.Date(2024, 3, 11, 7, 0, 0, 0, time.FixedZone("CET", 3600))),
+	)
+
+	It("Returns no action if the schedule wasn't due in the look back period", func() {
+		schedule, err := NewSchedule("0 19 1 1 *", "0 7 2 1 *", "UTC")
+		Expect(err).ToNot(HaveOccurred())
+		action, due := schedule.Current(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+		Expect(action).To(Equal(ActionNone))
+		Expect(due.IsZero()).To(BeTrue())
+	})
+
+	It("Returns the next times in the time zone of the schedule", func() {
+		schedule, err := NewSchedule("0 19 * * 1-5", "0 7 * * 1-5", "Europe/Berlin")
+		Expect(err).ToNot(HaveOccurred())
+		now := time.Date(2024, 3, 8, 20, 0, 0, 0, berlin)
+		Expect(schedule.NextResume(now)).To(Equal(time.Date(2024, 3, 11, 7, 0, 0, 0, berlin)))
+		Expect(schedule.NextHibernate(now)).To(Equal(time.Date(2024, 3, 11, 19, 0, 0, 0, berlin)))
+	})
+
+	Context("Cluster properties", func() {
+		It("Stores the schedule and keeps the other properties", func() {
+			cluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.Properties(map[string]string{"rosa_creator_arn": "arn"})
+			})
+			_, found, err := GetSchedule(cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+
+			schedule, err := NewSchedule("0 19 * * 1-5", "0 7 * * 1-5", "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(schedule.Timezone).To(Equal(DefaultTimezone))
+			values := SetSchedule(cluster, schedule)
+			Expect(values).To(Equal(map[string]string{
+				"rosa_creator_arn":           "arn",
+				properties.HibernateSchedule: "0 19 * * 1-5",
+				properties.ResumeSchedule:    "0 7 * * 1-5",
+				properties.ScheduleTimezone:  "UTC",
+			}))
+
+			cluster = test.MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.Properties(values)
+			})
+			stored, found, err := GetSchedule(cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(stored.Hibernate).To(Equal("0 19 * * 1-5"))
+			Expect(RemoveSchedule(cluster)).To(Equal(map[string]string{"rosa_creator_arn": "arn"}))
+		})
+
+		It("Fails with an invalid stored schedule", func() {
+			cluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.Name("foo").Properties(map[string]string{properties.HibernateSchedule: "bad"})
+			})
+			_, found, err := GetSchedule(cluster)
+			Expect(found).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("Cluster 'foo' has an invalid hibernation schedule")))
+		})
+	})
+})
@@ -22,14 +22,18 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/arguments"
+	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
 )
 
 var mode string
+var manualFormat string
 
 const (
 	Mode       = "mode"
 	ModeAuto   = "auto"
 	ModeManual = "manual"
+
+	ManualFormat = "manual-format"
 )
 
 var Modes = []string{ModeAuto, ModeManual}
@@ -47,6 +51,43 @@ func AddModeFlag(cmd *cobra.Command) {
 	cmd.RegisterFlagCompletionFunc("mode", modeCompletion)
 }
 
+// AddManualFormatFlag adds the flag that selects how the resources of the manual mode are written.
+func AddManualFormatFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&manualFormat,
+		ManualFormat,
+		string(awscb.FormatShell),
+		"Format of the output of the manual mode. Valid options are:\n"+
+			"shell: AWS CLI commands\n"+
+			"terraform: Terraform resources for the AWS provider\n"+
+			"cloudformation: CloudFormation template\n"+
+			"json: Structured JSON plan of the AWS CLI commands",
+	)
+	cmd.RegisterFlagCompletionFunc(ManualFormat, manualFormatCompletion)
+}
+
+// GetManualFormat returns the format selected for the manual mode, failing if it was selected for
+// another mode.
+func GetManualFormat(mode string) (awscb.Format, error) {
+	format, err := awscb.ParseFormat(manualFormat)
+	if err != nil {
+		return "", err
+	}
+	if format != awscb.FormatShell && mode != ModeManual {
+		return "", fmt.Errorf("The '--%s' flag can only be used with '--%s %s'", ManualFormat, Mode, ModeManual)
+	}
+	return format, nil
+}
+
+func SetManualFormat(format string) {
+	manualFormat = format
+}
+
+func manualFormatCompletion(cmd *cobra.Command, args []string, toComplete string) ([]string,
+	cobra.ShellCompDirective) {
+	return awscb.Formats, cobra.ShellCompDirectiveDefault
+}
+
 func SetModeKey(key string) {
 	mode = key
 }
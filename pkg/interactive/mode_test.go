@@ -6,6 +6,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/cobra"
+
+	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
 )
 
 var _ = Describe("Mode Test", func() {
@@ -43,4 +45,29 @@ var _ = Describe("Mode Test", func() {
 			Expect(err.Error()).To(ContainSubstring("invalid mode"))
 		})
 	})
+
+	Context("GetManualFormat", func() {
+		AfterEach(func() {
+			SetManualFormat(string(awscb.FormatShell))
+		})
+
+		It("should return the format for the manual mode", func() {
+			SetManualFormat("terraform")
+			format, err := GetManualFormat(ModeManual)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(format).To(Equal(awscb.FormatTerraform))
+		})
+
+		It("should return an error for the auto mode", func() {
+			SetManualFormat("cloudformation")
+			_, err := GetManualFormat(ModeAuto)
+			Expect(err).To(MatchError(ContainSubstring("can only be used with '--mode manual'")))
+		})
+
+		It("should default to the shell format", func() {
+			format, err := GetManualFormat(ModeAuto)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(format).To(Equal(awscb.FormatShell))
+		})
+	})
 })
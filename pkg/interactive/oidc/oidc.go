@@ -2,12 +2,12 @@ package oidc
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	. "github.com/openshift/rosa/pkg/interactive"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -34,7 +34,7 @@ func GetOidcConfigID(r *rosa.Runtime, cmd *cobra.Command) string {
 	})
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid OIDC Config ID: %s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	return strings.TrimSpace(strings.Split(oidcConfigId, "|")[0])
 }
@@ -2,7 +2,6 @@ package roles
 
 import (
 	"fmt"
-	"os"
 	"time"
 
 	"github.com/briandowns/spinner"
@@ -12,6 +11,7 @@ import (
 	. "github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -27,7 +27,7 @@ func GetInstallerRoleArn(r *rosa.Runtime, cmd *cobra.Command,
 	roleARNs, err := findRoleARNs(aws.InstallerAccountRole, minMinorVersion)
 	if err != nil {
 		r.Reporter.Errorf("Failed to find %s role: %s", role.Name, err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	spin.Stop()
 
@@ -59,7 +59,7 @@ func GetInstallerRoleArn(r *rosa.Runtime, cmd *cobra.Command,
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid role ARN: %s", err)
-				os.Exit(1)
+				reporter.Exit(1)
 			}
 		}
 	} else if len(roleARNs) == 1 {
@@ -71,7 +71,7 @@ func GetInstallerRoleArn(r *rosa.Runtime, cmd *cobra.Command,
 		createAccountRolesCommand := "rosa create account-roles"
 		r.Reporter.Warnf(fmt.Sprintf("No account roles found. You will need to manually set them in the "+
 			"next steps or run '%s' to create them first.", createAccountRolesCommand))
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	return roleARN
 }
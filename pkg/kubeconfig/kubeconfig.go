@@ -0,0 +1,266 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// EnvVar is the environment variable that kubectl reads the list of kubeconfig files from.
+	EnvVar = "KUBECONFIG"
+
+	// DefaultPath is the value of the flags that write a kubeconfig when they are given without a path. It
+	// selects the file that kubectl would use.
+	DefaultPath = "default"
+)
+
+// Config is the part of a kubeconfig file that the ROSA CLI needs to merge contexts. The details of the
+// clusters and users are kept as they are.
+type Config struct {
+	APIVersion     string           `json:"apiVersion,omitempty"`
+	Kind           string           `json:"kind,omitempty"`
+	Preferences    json.RawMessage  `json:"preferences,omitempty"`
+	Clusters       []NamedCluster   `json:"clusters"`
+	Users          []NamedUser      `json:"users"`
+	Contexts       []NamedContext   `json:"contexts"`
+	CurrentContext string           `json:"current-context"`
+	Extensions     []NamedExtension `json:"extensions,omitempty"`
+}
+
+type NamedCluster struct {
+	Name    string          `json:"name"`
+	Cluster json.RawMessage `json:"cluster"`
+}
+
+type NamedUser struct {
+	Name string          `json:"name"`
+	User json.RawMessage `json:"user"`
+}
+
+type NamedContext struct {
+	Name    string  `json:"name"`
+	Context Context `json:"context"`
+}
+
+type Context struct {
+	Cluster    string           `json:"cluster"`
+	User       string           `json:"user"`
+	Namespace  string           `json:"namespace,omitempty"`
+	Extensions []NamedExtension `json:"extensions,omitempty"`
+}
+
+type NamedExtension struct {
+	Name      string          `json:"name"`
+	Extension json.RawMessage `json:"extension"`
+}
+
+// Path returns the kubeconfig file to write. An empty path or DefaultPath select the file that kubectl
+// uses: the first file of the KUBECONFIG environment variable, or ~/.kube/config.
+func Path(path string) (string, error) {
+	if path != "" && path != DefaultPath {
+		return path, nil
+	}
+	for _, file := range filepath.SplitList(os.Getenv(EnvVar)) {
+		if file != "" {
+			return file, nil
+		}
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find the home directory: %v", err)
+	}
+	return filepath.Join(home, ".kube", "config"), nil
+}
+
+// New returns an empty kubeconfig.
+func New() *Config {
+	return &Config{
+		APIVersion: "v1",
+		Kind:       "Config",
+	}
+}
+
+// Parse parses the content of a kubeconfig file.
+func Parse(data []byte) (*Config, error) {
+	config := New()
+	err := yaml.Unmarshal(data, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig: %v", err)
+	}
+	return config, nil
+}
+
+// Load reads a kubeconfig file. A file that doesn't exist is an empty kubeconfig.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return New(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read kubeconfig '%s': %v", path, err)
+	}
+	config, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig '%s': %v", path, err)
+	}
+	return config, nil
+}
+
+// Save writes the kubeconfig file, readable only by the user as it contains credentials.
+func (c *Config) Save(path string) error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return fmt.Errorf("failed to create the directory of kubeconfig '%s': %v", path, err)
+	}
+	err = os.WriteFile(path, data, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to write kubeconfig '%s': %v", path, err)
+	}
+	return nil
+}
+
+// Merge adds the current context of the source kubeconfig, with its cluster and user, under the given name.
+// Entries that already have that name are replaced, so merging the same credential again updates it.
+func (c *Config) Merge(source *Config, name string, extensions ...NamedExtension) error {
+	if len(source.Contexts) == 0 {
+		return fmt.Errorf("the kubeconfig has no context")
+	}
+	context := source.Contexts[0]
+	for _, candidate := range source.Contexts {
+		if candidate.Name == source.CurrentContext {
+			context = candidate
+		}
+	}
+	cluster, ok := source.cluster(context.Context.Cluster)
+	if !ok {
+		return fmt.Errorf("the kubeconfig has no cluster '%s'", context.Context.Cluster)
+	}
+	user, ok := source.user(context.Context.User)
+	if !ok {
+		return fmt.Errorf("the kubeconfig has no user '%s'", context.Context.User)
+	}
+
+	c.RemoveContext(name)
+	c.Clusters = append(c.Clusters, NamedCluster{Name: name, Cluster: cluster.Cluster})
+	c.Users = append(c.Users, NamedUser{Name: name, User: user.User})
+	c.Contexts = append(c.Contexts, NamedContext{
+		Name: name,
+		Context: Context{
+			Cluster:    name,
+			User:       name,
+			Namespace:  context.Context.Namespace,
+			Extensions: extensions,
+		},
+	})
+	return nil
+}
+
+// RemoveContext removes a context, and its cluster and user when no other context uses them. It returns
+// false if there is no such context.
+func (c *Config) RemoveContext(name string) bool {
+	var cluster, user string
+	found := false
+	contexts := c.Contexts[:0]
+	for _, context := range c.Contexts {
+		if context.Name == name {
+			cluster, user, found = context.Context.Cluster, context.Context.User, true
+			continue
+		}
+		contexts = append(contexts, context)
+	}
+	if !found {
+		return false
+	}
+	c.Contexts = contexts
+	if c.CurrentContext == name {
+		c.CurrentContext = ""
+	}
+
+	used := map[string]bool{}
+	for _, context := range c.Contexts {
+		used["cluster/"+context.Context.Cluster] = true
+		used["user/"+context.Context.User] = true
+	}
+	clusters := c.Clusters[:0]
+	for _, candidate := range c.Clusters {
+		if candidate.Name != cluster || used["cluster/"+cluster] {
+			clusters = append(clusters, candidate)
+		}
+	}
+	c.Clusters = clusters
+	users := c.Users[:0]
+	for _, candidate := range c.Users {
+		if candidate.Name != user || used["user/"+user] {
+			users = append(users, candidate)
+		}
+	}
+	c.Users = users
+	return true
+}
+
+// Extension decodes the extension of a context with the given name. It returns false if the context doesn't
+// have that extension.
+func (c *NamedContext) Extension(name string, value interface{}) (bool, error) {
+	for _, extension := range c.Context.Extensions {
+		if extension.Name != name {
+			continue
+		}
+		err := json.Unmarshal(extension.Extension, value)
+		if err != nil {
+			return false, fmt.Errorf("failed to parse extension '%s' of context '%s': %v", name, c.Name, err)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// NewExtension encodes the value of a context extension.
+func NewExtension(name string, value interface{}) (NamedExtension, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return NamedExtension{}, err
+	}
+	return NamedExtension{Name: name, Extension: data}, nil
+}
+
+func (c *Config) cluster(name string) (NamedCluster, bool) {
+	for _, cluster := range c.Clusters {
+		if cluster.Name == name {
+			return cluster, true
+		}
+	}
+	return NamedCluster{}, false
+}
+
+func (c *Config) user(name string) (NamedUser, bool) {
+	for _, user := range c.Users {
+		if user.Name == name {
+			return user, true
+		}
+	}
+	return NamedUser{}, false
+}
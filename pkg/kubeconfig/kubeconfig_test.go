@@ -0,0 +1,185 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeconfig_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift/rosa/pkg/kubeconfig"
+)
+
+const breakGlassKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: cluster
+  cluster:
+    server: https://api.mycluster.example.com:443
+users:
+- name: user
+  user:
+    client-certificate-data: Y2VydA==
+    client-key-data: a2V5
+contexts:
+- name: other
+  context:
+    cluster: missing
+    user: missing
+- name: admin
+  context:
+    cluster: cluster
+    user: user
+current-context: admin
+`
+
+const existingKubeconfig = `apiVersion: v1
+kind: Config
+preferences:
+  colors: true
+clusters:
+- name: dev
+  cluster:
+    server: https://dev.example.com:6443
+    insecure-skip-tls-verify: true
+users:
+- name: dev-user
+  user:
+    token: secret
+contexts:
+- name: dev
+  context:
+    cluster: dev
+    user: dev-user
+    namespace: default
+- name: dev-admin
+  context:
+    cluster: dev
+    user: dev-user
+current-context: dev
+`
+
+var _ = Describe("Kubeconfig", func() {
+	Context("Path", func() {
+		It("Uses the given path", func() {
+			GinkgoT().Setenv(kubeconfig.EnvVar, "/tmp/first")
+			Expect(kubeconfig.Path("/tmp/kubeconfig")).To(Equal("/tmp/kubeconfig"))
+		})
+
+		It("Uses the first file of KUBECONFIG", func() {
+			separator := string(os.PathListSeparator)
+			GinkgoT().Setenv(kubeconfig.EnvVar, separator+"/tmp/first"+separator+"/tmp/second")
+			Expect(kubeconfig.Path(kubeconfig.DefaultPath)).To(Equal("/tmp/first"))
+		})
+
+		It("Defaults to the kubeconfig of the home directory", func() {
+			GinkgoT().Setenv(kubeconfig.EnvVar, "")
+			GinkgoT().Setenv("HOME", "/home/user")
+			Expect(kubeconfig.Path("")).To(Equal(filepath.Join("/home/user", ".kube", "config")))
+		})
+	})
+
+	Context("Merge", func() {
+		var config *kubeconfig.Config
+		var source *kubeconfig.Config
+
+		BeforeEach(func() {
+			var err error
+			config, err = kubeconfig.Parse([]byte(existingKubeconfig))
+			Expect(err).NotTo(HaveOccurred())
+			source, err = kubeconfig.Parse([]byte(breakGlassKubeconfig))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Adds the current context of the source under the given name", func() {
+			extension, err := kubeconfig.NewExtension("example.com/extension", map[string]string{"key": "value"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Merge(source, "break-glass", extension)).To(Succeed())
+
+			Expect(config.CurrentContext).To(Equal("dev"))
+			Expect(config.Contexts).To(HaveLen(3))
+			Expect(config.Contexts[2].Name).To(Equal("break-glass"))
+			Expect(config.Contexts[2].Context.Cluster).To(Equal("break-glass"))
+			Expect(config.Contexts[2].Context.User).To(Equal("break-glass"))
+			Expect(config.Clusters[1].Cluster).To(MatchJSON(`{"server":"https://api.mycluster.example.com:443"}`))
+			Expect(config.Users[1].User).To(MatchJSON(`{"client-certificate-data":"Y2VydA==","client-key-data":"a2V5"}`))
+
+			var value map[string]string
+			Expect(config.Contexts[2].Extension("example.com/extension", &value)).To(BeTrue())
+			Expect(value).To(Equal(map[string]string{"key": "value"}))
+			Expect(config.Contexts[0].Extension("example.com/extension", &value)).To(BeFalse())
+		})
+
+		It("Replaces the entries with the same name", func() {
+			Expect(config.Merge(source, "break-glass")).To(Succeed())
+			Expect(config.Merge(source, "break-glass")).To(Succeed())
+			Expect(config.Contexts).To(HaveLen(3))
+			Expect(config.Clusters).To(HaveLen(2))
+			Expect(config.Users).To(HaveLen(2))
+		})
+
+		It("Fails if the cluster of the context is missing", func() {
+			source.CurrentContext = "other"
+			Expect(config.Merge(source, "break-glass")).To(MatchError("the kubeconfig has no cluster 'missing'"))
+		})
+	})
+
+	Context("RemoveContext", func() {
+		It("Keeps the clusters and users used by other contexts", func() {
+			config, err := kubeconfig.Parse([]byte(existingKubeconfig))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(config.RemoveContext("dev")).To(BeTrue())
+			Expect(config.CurrentContext).To(BeEmpty())
+			Expect(config.Contexts).To(HaveLen(1))
+			Expect(config.Clusters).To(HaveLen(1))
+			Expect(config.Users).To(HaveLen(1))
+
+			Expect(config.RemoveContext("dev-admin")).To(BeTrue())
+			Expect(config.Contexts).To(BeEmpty())
+			Expect(config.Clusters).To(BeEmpty())
+			Expect(config.Users).To(BeEmpty())
+
+			Expect(config.RemoveContext("dev")).To(BeFalse())
+		})
+	})
+
+	Context("Load and save", func() {
+		It("Loads a missing file as an empty kubeconfig", func() {
+			config, err := kubeconfig.Load(filepath.Join(GinkgoT().TempDir(), "config"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config).To(Equal(kubeconfig.New()))
+		})
+
+		It("Keeps the content of the file and restricts its permissions", func() {
+			path := filepath.Join(GinkgoT().TempDir(), ".kube", "config")
+			config, err := kubeconfig.Parse([]byte(existingKubeconfig))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Save(path)).To(Succeed())
+
+			info, err := os.Stat(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+			loaded, err := kubeconfig.Load(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loaded).To(Equal(config))
+			Expect(loaded.Preferences).To(MatchJSON(`{"colors":true}`))
+		})
+	})
+})
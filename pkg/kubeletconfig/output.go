@@ -4,17 +4,18 @@ import (
 	"fmt"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/output"
 )
 
 const emptyName = "-"
 
-func PrintKubeletConfigsForTabularOutput(configs []*cmv1.KubeletConfig) string {
-	output := "ID\tNAME\tPOD PIDS LIMIT\n"
+func PrintKubeletConfigsForTabularOutput(configs []*cmv1.KubeletConfig) *output.Table {
+	table := output.NewTable("ID", "NAME", "POD PIDS LIMIT")
 	for _, config := range configs {
-		output += fmt.Sprintf("%s\t%s\t%d\n", config.ID(), getName(config), config.PodPidsLimit())
+		table.AddRow(config.ID(), getName(config), config.PodPidsLimit())
 	}
-
-	return output
+	return table
 }
 
 func getName(config *cmv1.KubeletConfig) string {
@@ -47,7 +47,7 @@ var _ = Describe("KubeletConfig Output", func() {
 			k.Name("").PodPidsLimit(20000).ID("bar")
 		})
 
-		output := PrintKubeletConfigsForTabularOutput([]*cmv1.KubeletConfig{kubeletConfig, kubeletConfig2})
+		output := PrintKubeletConfigsForTabularOutput([]*cmv1.KubeletConfig{kubeletConfig, kubeletConfig2}).String()
 		Expect(output).To(Equal("ID\tNAME\tPOD PIDS LIMIT\nfoo\ttest\t10000\nbar\t-\t20000\n"))
 	})
 
@@ -0,0 +1,263 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the cassettes where the round tripper saves the requests sent and the
+// responses received, so that they can be played back later without sending the requests.
+
+package logging
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	// RecordEnvVar is the environment variable that contains the directory where the requests
+	// and responses are saved.
+	RecordEnvVar = "ROSA_RECORD"
+
+	// ReplayEnvVar is the environment variable that contains the directory where the requests and
+	// responses are played back from.
+	ReplayEnvVar = "ROSA_REPLAY"
+)
+
+// OCMSecretFields are the fields of the OCM API whose values are redacted from the cassettes: the tokens,
+// the AWS and GCP credentials sent to list the regions and to create clusters, the secrets of the identity
+// providers and of the external authentication clients, the registry credentials and the kubeconfigs of
+// the break glass credentials.
+var OCMSecretFields = []string{
+	"access_token",
+	"refresh_token",
+	"id_token",
+	"authorization_token",
+	"token",
+	"auths",
+	"access_key_id",
+	"secret_access_key",
+	"private_key",
+	"private_key_id",
+	"client_secret",
+	"secret",
+	"password",
+	"hashed_password",
+	"bind_password",
+	"kubeconfig",
+}
+
+// RecordDir returns the directory where the requests and responses are saved, or an empty string
+// if they aren't being recorded.
+func RecordDir() string {
+	return os.Getenv(RecordEnvVar)
+}
+
+// ReplayDir returns the directory where the requests and responses are played back from, or an
+// empty string if they aren't being played back.
+func ReplayDir() string {
+	return os.Getenv(ReplayEnvVar)
+}
+
+// Interaction is a request and the response received for it, as saved in a cassette. Security
+// sensitive headers and fields are redacted.
+type Interaction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// CassetteRequest is the request of an interaction.
+type CassetteRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// CassetteResponse is the response of an interaction.
+type CassetteResponse struct {
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// Cassette is a file that contains the interactions of a client, in the order that they happened.
+// Don't create instances of this type directly; use the OpenCassette function instead.
+type Cassette struct {
+	path         string
+	replay       bool
+	interactions []*Interaction
+	played       []bool
+	lock         sync.Mutex
+}
+
+// The cassettes opened by the process, indexed by path, so that all the clients that use the same
+// cassette share it.
+var (
+	cassettes     = map[string]*Cassette{}
+	cassettesLock sync.Mutex
+)
+
+// OpenCassette returns the cassette with the given name in the directory of the ROSA_RECORD or
+// ROSA_REPLAY environment variable, or nil if none of them is set. When recording, the interactions
+// that the cassette contained are discarded the first time that it is opened by the process.
+func OpenCassette(name string) (result *Cassette, err error) {
+	recordDir := RecordDir()
+	replayDir := ReplayDir()
+	if recordDir != "" && replayDir != "" {
+		err = fmt.Errorf("Environment variables %s and %s can't be used together", RecordEnvVar, ReplayEnvVar)
+		return
+	}
+	if recordDir == "" && replayDir == "" {
+		return
+	}
+
+	cassettesLock.Lock()
+	defer cassettesLock.Unlock()
+
+	if replayDir != "" {
+		path := filepath.Join(replayDir, name+".json")
+		result = cassettes[path]
+		if result == nil {
+			result, err = LoadCassette(path)
+			if err != nil {
+				return
+			}
+			cassettes[path] = result
+		}
+		return
+	}
+
+	path := filepath.Join(recordDir, name+".json")
+	result = cassettes[path]
+	if result == nil {
+		err = os.MkdirAll(recordDir, 0700)
+		if err != nil {
+			err = fmt.Errorf("Failed to create cassette directory '%s': %v", recordDir, err)
+			return
+		}
+		result = NewCassette(path)
+		err = result.save()
+		if err != nil {
+			return
+		}
+		cassettes[path] = result
+	}
+	return
+}
+
+// NewCassette creates an empty cassette that saves the interactions recorded to the given file.
+func NewCassette(path string) *Cassette {
+	return &Cassette{
+		path:         path,
+		interactions: []*Interaction{},
+	}
+}
+
+// LoadCassette loads the cassette saved in the given file, so that its interactions can be played
+// back.
+func LoadCassette(path string) (result *Cassette, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		err = fmt.Errorf("Failed to read cassette '%s': %v", path, err)
+		return
+	}
+	var interactions []*Interaction
+	err = json.Unmarshal(data, &interactions)
+	if err != nil {
+		err = fmt.Errorf("Failed to parse cassette '%s': %v", path, err)
+		return
+	}
+	result = &Cassette{
+		path:         path,
+		replay:       true,
+		interactions: interactions,
+		played:       make([]bool, len(interactions)),
+	}
+	return
+}
+
+// Path returns the path of the file of the cassette.
+func (c *Cassette) Path() string {
+	return c.path
+}
+
+// Replaying returns true if the interactions of the cassette are played back instead of recorded.
+func (c *Cassette) Replaying() bool {
+	return c.replay
+}
+
+// Interactions returns the interactions of the cassette.
+func (c *Cassette) Interactions() []*Interaction {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return append([]*Interaction{}, c.interactions...)
+}
+
+// Record adds the given interaction to the cassette and saves it.
+func (c *Cassette) Record(interaction *Interaction) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.replay {
+		return errors.New("Interactions can't be recorded while playing back a cassette")
+	}
+	c.interactions = append(c.interactions, interaction)
+	return c.save()
+}
+
+// Play returns the first interaction that hasn't been played yet for the given method and URL.
+// Interactions with the same body are preferred, as the requests sent repeatedly to the same URL
+// may only differ in the body.
+func (c *Cassette) Play(method string, url string, body string) (result *Interaction, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	found := -1
+	for i, interaction := range c.interactions {
+		if c.played[i] || interaction.Request.Method != method || interaction.Request.URL != url {
+			continue
+		}
+		if interaction.Request.Body == body {
+			found = i
+			break
+		}
+		if found == -1 {
+			found = i
+		}
+	}
+	if found == -1 {
+		err = fmt.Errorf("No recorded response for %s %s in cassette '%s'", method, url, c.path)
+		return
+	}
+	c.played[found] = true
+	result = c.interactions[found]
+	return
+}
+
+// save writes the interactions to the file of the cassette. It is written completely every time,
+// so that it is complete even if the process exits without closing the clients.
+func (c *Cassette) save() error {
+	data, err := json.MarshalIndent(c.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(c.path, append(data, '\n'), 0600)
+	if err != nil {
+		return fmt.Errorf("Failed to write cassette '%s': %v", c.path, err)
+	}
+	return nil
+}
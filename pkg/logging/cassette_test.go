@@ -0,0 +1,206 @@
+package logging
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Cassette", func() {
+	var server *ghttp.Server
+	var path string
+
+	newClient := func(cassette *Cassette) *http.Client {
+		roundTripper, err := NewRoundTripper().
+			Logger(NewLogger()).
+			Redact("refresh_token").
+			Redact("access_token").
+			Redact("SecretAccessKey").
+			Cassette(cassette).
+			Next(http.DefaultTransport).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		return &http.Client{Transport: roundTripper}
+	}
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		path = filepath.Join(GinkgoT().TempDir(), "ocm.json")
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("Records the requests and responses with the sensitive fields redacted", func() {
+		server.AppendHandlers(
+			ghttp.RespondWith(http.StatusOK, `{"access_token": "secret", "expires_in": 900}`,
+				http.Header{"Content-Type": []string{"application/json"}}),
+			ghttp.RespondWith(http.StatusOK,
+				`<CreateAccessKeyResult><SecretAccessKey>secret</SecretAccessKey></CreateAccessKeyResult>`,
+				http.Header{"Content-Type": []string{"text/xml"}}),
+		)
+		client := newClient(NewCassette(path))
+		response, err := client.PostForm(server.URL()+"/token", url.Values{
+			"grant_type":    []string{"refresh_token"},
+			"refresh_token": []string{"secret"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		body, err := io.ReadAll(response.Body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(body).To(MatchJSON(`{"access_token": "secret", "expires_in": 900}`))
+		request, err := http.NewRequest(http.MethodGet, server.URL()+"/keys", nil)
+		Expect(err).NotTo(HaveOccurred())
+		request.Header.Set("Authorization", "Bearer secret")
+		_, err = client.Do(request)
+		Expect(err).NotTo(HaveOccurred())
+
+		data, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).NotTo(ContainSubstring("secret"))
+		cassette, err := LoadCassette(path)
+		Expect(err).NotTo(HaveOccurred())
+		interactions := cassette.Interactions()
+		Expect(interactions).To(HaveLen(2))
+		Expect(interactions[0].Request.Method).To(Equal(http.MethodPost))
+		Expect(interactions[0].Request.Body).To(Equal("grant_type=refresh_token&refresh_token=%2A%2A%2A"))
+		Expect(interactions[0].Response.Status).To(Equal(http.StatusOK))
+		Expect(interactions[0].Response.Body).To(MatchJSON(`{"access_token": "***", "expires_in": 900}`))
+		Expect(interactions[1].Request.Header.Get("Authorization")).To(Equal("***"))
+		Expect(interactions[1].Response.Body).To(Equal(
+			`<CreateAccessKeyResult><SecretAccessKey>***</SecretAccessKey></CreateAccessKeyResult>`))
+	})
+
+	It("Redacts the AWS credentials sent to OCM", func() {
+		server.AppendHandlers(
+			ghttp.RespondWith(http.StatusOK, `{"kind": "CloudRegionList", "items": []}`,
+				http.Header{"Content-Type": []string{"application/json"}}),
+		)
+		builder := NewRoundTripper().
+			Logger(NewLogger()).
+			Cassette(NewCassette(path)).
+			Next(http.DefaultTransport)
+		for _, field := range OCMSecretFields {
+			builder.Redact(field)
+		}
+		roundTripper, err := builder.Build()
+		Expect(err).NotTo(HaveOccurred())
+		client := &http.Client{Transport: roundTripper}
+		_, err = client.Post(
+			server.URL()+"/api/clusters_mgmt/v1/cloud_providers/aws/available_regions",
+			"application/json",
+			strings.NewReader(`{"access_key_id": "AKIAEXAMPLEKEYID", "secret_access_key": "secretaccesskey"}`),
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		data, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).NotTo(ContainSubstring("AKIAEXAMPLEKEYID"))
+		Expect(string(data)).NotTo(ContainSubstring("secretaccesskey"))
+		cassette, err := LoadCassette(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cassette.Interactions()[0].Request.Body).To(MatchJSON(
+			`{"access_key_id": "***", "secret_access_key": "***"}`))
+	})
+
+	It("Plays back the responses in order without sending the requests", func() {
+		server.AppendHandlers(
+			ghttp.RespondWith(http.StatusOK, `{"state": "installing"}`),
+			ghttp.RespondWith(http.StatusOK, `{"state": "ready"}`),
+			ghttp.RespondWith(http.StatusNotFound, `{"kind": "Error"}`),
+		)
+		address := server.URL()
+		recorder := newClient(NewCassette(path))
+		for _, cluster := range []string{"123", "123", "456"} {
+			_, err := recorder.Get(address + "/clusters/" + cluster)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		server.Close()
+
+		cassette, err := LoadCassette(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cassette.Replaying()).To(BeTrue())
+		player := newClient(cassette)
+		for _, expected := range []struct {
+			path   string
+			status int
+			body   string
+		}{
+			{"/clusters/456", http.StatusNotFound, `{"kind": "Error"}`},
+			{"/clusters/123", http.StatusOK, `{"state": "installing"}`},
+			{"/clusters/123", http.StatusOK, `{"state": "ready"}`},
+		} {
+			response, err := player.Get(address + expected.path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.StatusCode).To(Equal(expected.status))
+			body, err := io.ReadAll(response.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(body).To(MatchJSON(expected.body))
+		}
+		_, err = player.Get(address + "/clusters/123")
+		Expect(err).To(MatchError(ContainSubstring("No recorded response for GET")))
+	})
+
+	It("Plays back redacted tokens as unsigned tokens that don't expire soon", func() {
+		server.AppendHandlers(
+			ghttp.RespondWith(http.StatusOK, `{"access_token": "secret", "refresh_token": "secret"}`,
+				http.Header{"Content-Type": []string{"application/json"}}),
+		)
+		_, err := newClient(NewCassette(path)).PostForm(server.URL()+"/token", url.Values{
+			"refresh_token": []string{"first"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		cassette, err := LoadCassette(path)
+		Expect(err).NotTo(HaveOccurred())
+		response, err := newClient(cassette).PostForm(server.URL()+"/token", url.Values{
+			"refresh_token": []string{"second"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		var tokens map[string]string
+		Expect(json.NewDecoder(response.Body).Decode(&tokens)).To(Succeed())
+		for name, typ := range map[string]string{"access_token": "Bearer", "refresh_token": "Refresh"} {
+			token, _, err := new(jwt.Parser).ParseUnverified(tokens[name], jwt.MapClaims{})
+			Expect(err).NotTo(HaveOccurred())
+			claims := token.Claims.(jwt.MapClaims)
+			Expect(claims["typ"]).To(Equal(typ))
+			Expect(claims.VerifyExpiresAt(time.Now().Add(30*time.Minute).Unix(), true)).To(BeTrue())
+		}
+	})
+
+	It("Is shared by the clients of the process", func() {
+		dir := GinkgoT().TempDir()
+		GinkgoT().Setenv(RecordEnvVar, dir)
+		first, err := OpenCassette("shared")
+		Expect(err).NotTo(HaveOccurred())
+		second, err := OpenCassette("shared")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(second).To(BeIdenticalTo(first))
+		Expect(filepath.Join(dir, "shared.json")).To(BeAnExistingFile())
+	})
+
+	It("Can't be recorded and played back at the same time", func() {
+		GinkgoT().Setenv(RecordEnvVar, GinkgoT().TempDir())
+		GinkgoT().Setenv(ReplayEnvVar, GinkgoT().TempDir())
+		_, err := OpenCassette("ocm")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Isn't opened if the requests aren't recorded or played back", func() {
+		GinkgoT().Setenv(RecordEnvVar, "")
+		GinkgoT().Setenv(ReplayEnvVar, "")
+		cassette, err := OpenCassette("ocm")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cassette).To(BeNil())
+	})
+})
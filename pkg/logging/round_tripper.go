@@ -27,9 +27,12 @@ import (
 	"mime"
 	"net/http"
 	"net/url"
+	"regexp"
 	"sort"
 	"strings"
+	"time"
 
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/sirupsen/logrus"
 
 	"gitlab.com/c0b/go-ordered-json"
@@ -39,17 +42,19 @@ import (
 // sends to the log the details of the requests sent and the responses received. Don't create
 // instances of this type directly; use the NewRoundTripper function instead.
 type RoundTripperBuilder struct {
-	logger *logrus.Logger
-	redact map[string]bool
-	next   http.RoundTripper
+	logger   *logrus.Logger
+	redact   map[string]bool
+	cassette *Cassette
+	next     http.RoundTripper
 }
 
 // RoundTripper is a round tripper that dumps the details of the requests and the responses to
 // the log. Don't create instances of this type directly; use the NewRoundTripper function instead.
 type RoundTripper struct {
-	logger *logrus.Logger
-	redact map[string]bool
-	next   http.RoundTripper
+	logger   *logrus.Logger
+	redact   map[string]bool
+	cassette *Cassette
+	next     http.RoundTripper
 }
 
 // Make sure that we implement the http.RoundTripper interface:
@@ -77,6 +82,14 @@ func (b *RoundTripperBuilder) Redact(value string) *RoundTripperBuilder {
 	return b
 }
 
+// Cassette sets the cassette where the requests and responses are saved, with the security
+// sensitive fields redacted. If the cassette is being played back the requests aren't sent,
+// instead the responses are taken from the cassette.
+func (b *RoundTripperBuilder) Cassette(value *Cassette) *RoundTripperBuilder {
+	b.cassette = value
+	return b
+}
+
 // Next sets the next round tripper. The details of the request will be sent to the log before
 // calling it, and the details of the response will be sent to the log after calling it.
 func (b *RoundTripperBuilder) Next(value http.RoundTripper) *RoundTripperBuilder {
@@ -92,7 +105,7 @@ func (b *RoundTripperBuilder) Build() (result *RoundTripper, err error) {
 		err = fmt.Errorf("Logger is mandatory")
 		return
 	}
-	if b.next == nil {
+	if b.next == nil && (b.cassette == nil || !b.cassette.Replaying()) {
 		err = fmt.Errorf("Next handler is mandatory")
 		return
 	}
@@ -105,9 +118,10 @@ func (b *RoundTripperBuilder) Build() (result *RoundTripper, err error) {
 
 	// Create and populate the object:
 	result = &RoundTripper{
-		logger: b.logger,
-		redact: redact,
-		next:   b.next,
+		logger:   b.logger,
+		redact:   redact,
+		cassette: b.cassette,
+		next:     b.next,
 	}
 
 	return
@@ -117,9 +131,9 @@ func (b *RoundTripperBuilder) Build() (result *RoundTripper, err error) {
 func (d *RoundTripper) RoundTrip(request *http.Request) (response *http.Response, err error) {
 	// Read the complete body in memory, in order to send it to the log, and replace it with a
 	// reader that reads it from memory:
+	var requestBody []byte
 	if request.Body != nil {
-		var body []byte
-		body, err = io.ReadAll(request.Body)
+		requestBody, err = io.ReadAll(request.Body)
 		if err != nil {
 			return
 		}
@@ -127,23 +141,28 @@ func (d *RoundTripper) RoundTrip(request *http.Request) (response *http.Response
 		if err != nil {
 			return
 		}
-		d.dumpRequest(request, body)
-		request.Body = io.NopCloser(bytes.NewBuffer(body))
+		d.dumpRequest(request, requestBody)
+		request.Body = io.NopCloser(bytes.NewBuffer(requestBody))
 	} else {
 		d.dumpRequest(request, nil)
 	}
 
-	// Call the next round tripper:
-	response, err = d.next.RoundTrip(request)
+	// Take the response from the cassette if it is being played back, otherwise call the next
+	// round tripper:
+	if d.cassette != nil && d.cassette.Replaying() {
+		response, err = d.play(request, requestBody)
+	} else {
+		response, err = d.next.RoundTrip(request)
+	}
 	if err != nil {
 		return
 	}
 
 	// Read the complete response body in memory, in order to send it the log, and replace it
 	// with a reader that reads it from memory:
+	var responseBody []byte
 	if response.Body != nil {
-		var body []byte
-		body, err = io.ReadAll(response.Body)
+		responseBody, err = io.ReadAll(response.Body)
 		if err != nil {
 			return
 		}
@@ -151,12 +170,79 @@ func (d *RoundTripper) RoundTrip(request *http.Request) (response *http.Response
 		if err != nil {
 			return
 		}
-		d.dumpResponse(response, body)
-		response.Body = io.NopCloser(bytes.NewBuffer(body))
+		d.dumpResponse(response, responseBody)
+		response.Body = io.NopCloser(bytes.NewBuffer(responseBody))
 	} else {
 		d.dumpResponse(response, nil)
 	}
 
+	// Save the request and the response to the cassette. The request was already sent, so failing
+	// to save them doesn't fail it:
+	if d.cassette != nil && !d.cassette.Replaying() {
+		recordErr := d.record(request, requestBody, response, responseBody)
+		if recordErr != nil {
+			d.logger.Errorf("Failed to record %s %s: %v", request.Method, request.URL, recordErr)
+		}
+	}
+
+	return
+}
+
+// Do implements the interface of the HTTP client used by the AWS SDK.
+func (d *RoundTripper) Do(request *http.Request) (*http.Response, error) {
+	return d.RoundTrip(request)
+}
+
+// record saves to the cassette the given request and response, redacting the security sensitive
+// headers and fields.
+func (d *RoundTripper) record(request *http.Request, requestBody []byte, response *http.Response,
+	responseBody []byte) error {
+	return d.cassette.Record(&Interaction{
+		Request: CassetteRequest{
+			Method: request.Method,
+			URL:    request.URL.String(),
+			Header: d.redactHeader(request.Header),
+			Body:   string(d.redactBody(request.Header, requestBody)),
+		},
+		Response: CassetteResponse{
+			Status: response.StatusCode,
+			Header: d.redactHeader(response.Header),
+			Body:   string(d.redactBody(response.Header, responseBody)),
+		},
+	})
+}
+
+// play returns the response saved in the cassette for the given request. The body of the request
+// is redacted like it was when it was recorded, so that they can be compared.
+func (d *RoundTripper) play(request *http.Request, body []byte) (response *http.Response, err error) {
+	interaction, err := d.cassette.Play(
+		request.Method,
+		request.URL.String(),
+		string(d.redactBody(request.Header, body)),
+	)
+	if err != nil {
+		return
+	}
+	d.logger.Debugf("Response played back from cassette '%s'", d.cassette.Path())
+	header := interaction.Response.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	data := d.replaceTokens(header, []byte(interaction.Response.Body))
+	response = &http.Response{
+		Status: fmt.Sprintf(
+			"%d %s",
+			interaction.Response.Status, http.StatusText(interaction.Response.Status),
+		),
+		StatusCode:    interaction.Response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(data)),
+		ContentLength: int64(len(data)),
+		Request:       request,
+	}
 	return
 }
 
@@ -312,6 +398,9 @@ func (d *RoundTripper) dumpJSON(what string, data []byte) {
 
 // dumpBytes dump the given data as an array of bytes.
 func (d *RoundTripper) dumpBytes(what string, data []byte) {
+	if !d.logger.IsLevelEnabled(logrus.DebugLevel) {
+		return
+	}
 	size := len(data)
 	if size > 0 {
 		d.logger.Debugf("%s body follows", what)
@@ -323,8 +412,9 @@ func (d *RoundTripper) dumpBytes(what string, data []byte) {
 	}
 }
 
-// redactSensitive replaces sensitive fields within a response with redactionStr.
-func (d *RoundTripper) redactSensitive(body *ordered.OrderedMap) {
+// redactSensitive replaces sensitive fields within a response with redactionStr, including the
+// fields of nested objects. It returns true if any field was replaced.
+func (d *RoundTripper) redactSensitive(body *ordered.OrderedMap) (redacted bool) {
 	iterator := body.EntriesIter()
 	for {
 		pair, ok := iterator()
@@ -333,8 +423,151 @@ func (d *RoundTripper) redactSensitive(body *ordered.OrderedMap) {
 		}
 		if d.redact[pair.Key] {
 			body.Set(pair.Key, redactedReplacement)
+			redacted = true
+		} else if d.redactValue(pair.Value) {
+			redacted = true
+		}
+	}
+	return
+}
+
+// redactValue replaces the sensitive fields of the objects contained in the given JSON value.
+func (d *RoundTripper) redactValue(value interface{}) (redacted bool) {
+	switch typed := value.(type) {
+	case *ordered.OrderedMap:
+		redacted = d.redactSensitive(typed)
+	case []interface{}:
+		for _, item := range typed {
+			if d.redactValue(item) {
+				redacted = true
+			}
+		}
+	}
+	return
+}
+
+// redactHeader returns a copy of the given header with the values of the security sensitive
+// headers replaced.
+func (d *RoundTripper) redactHeader(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+	result := header.Clone()
+	for name, values := range result {
+		if redactedHeaders[strings.ToLower(name)] {
+			for i := range values {
+				values[i] = redactedReplacement
+			}
+		}
+	}
+	return result
+}
+
+// redactBody returns the given body with the values of the security sensitive fields replaced,
+// according to the content type used in the given header. The body is returned unchanged if it
+// doesn't contain any of those fields.
+func (d *RoundTripper) redactBody(header http.Header, body []byte) []byte {
+	if len(body) == 0 || len(d.redact) == 0 {
+		return body
+	}
+	switch mediaType(header) {
+	case "application/x-www-form-urlencoded":
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return body
+		}
+		redacted := false
+		for name, values := range form {
+			if d.redact[name] {
+				for i := range values {
+					values[i] = redactedReplacement
+				}
+				redacted = true
+			}
+		}
+		if redacted {
+			return []byte(form.Encode())
+		}
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		parsed := ordered.NewOrderedMap()
+		if json.Unmarshal(body, parsed) != nil || !d.redactSensitive(parsed) {
+			return body
+		}
+		data, err := json.Marshal(parsed)
+		if err == nil {
+			return data
+		}
+	case "application/xml", "text/xml":
+		for name := range d.redact {
+			element := regexp.QuoteMeta(name)
+			pattern := regexp.MustCompile("<" + element + ">[^<]*</" + element + ">")
+			body = pattern.ReplaceAll(body, []byte("<"+name+">"+redactedReplacement+"</"+name+">"))
+		}
+	}
+	return body
+}
+
+// replaceTokens replaces the redacted tokens of a JSON body played back from a cassette with
+// unsigned tokens that don't expire soon, so that the clients that parse them can use them.
+func (d *RoundTripper) replaceTokens(header http.Header, body []byte) []byte {
+	if mediaType(header) != "application/json" {
+		return body
+	}
+	parsed := ordered.NewOrderedMap()
+	if json.Unmarshal(body, parsed) != nil {
+		return body
+	}
+	replaced := false
+	iterator := parsed.EntriesIter()
+	for {
+		pair, ok := iterator()
+		if !ok {
+			break
+		}
+		if !strings.HasSuffix(pair.Key, "_token") || pair.Value != redactedReplacement {
+			continue
+		}
+		tokenType := "Bearer"
+		if pair.Key == "refresh_token" {
+			tokenType = "Refresh"
+		}
+		token, err := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.MapClaims{
+			"typ": tokenType,
+			"exp": time.Now().Add(time.Hour).Unix(),
+		}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+		if err != nil {
+			continue
 		}
+		parsed.Set(pair.Key, token)
+		replaced = true
 	}
+	if !replaced {
+		return body
+	}
+	data, err := json.Marshal(parsed)
+	if err != nil {
+		return body
+	}
+	return data
+}
+
+// mediaType returns the media type of the content type used in the given header, or an empty
+// string if it can't be parsed.
+func mediaType(header http.Header) string {
+	result, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		return ""
+	}
+	return result
+}
+
+// Headers whose values are replaced in the cassettes, in lower case.
+var redactedHeaders = map[string]bool{
+	"authorization":        true,
+	"cookie":               true,
+	"proxy-authorization":  true,
+	"set-cookie":           true,
+	"x-amz-security-token": true,
 }
 
 // String that replaces redactedReplacement fields in messages sent to the log:
@@ -0,0 +1,183 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the follower of the install and uninstall logs of a cluster. It counts the lines
+// it has received and asks OCM only for the lines after them, so each line is printed exactly once
+// however fast the log grows between requests.
+
+package logs
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// PollInterval is the time between requests when following a log.
+const PollInterval = 15 * time.Second
+
+// FetchFunc returns the log without the given number of lines from the start.
+type FetchFunc func(offset int) (*cmv1.Log, error)
+
+// Follower prints the lines of a log that it didn't print before.
+type Follower struct {
+	fetch  FetchFunc
+	out    io.Writer
+	file   io.Writer
+	tail   int
+	since  time.Time
+	offset int
+
+	// Time of the last line that had one, used for the lines that don't:
+	lastTime time.Time
+}
+
+// NewFollower creates a follower that gets the log with the given function and prints it to the given
+// writer.
+func NewFollower(fetch FetchFunc, out io.Writer) *Follower {
+	return &Follower{
+		fetch: fetch,
+		out:   out,
+	}
+}
+
+// Tail sets the number of lines printed from the end of the log the first time. Zero prints all the lines.
+func (f *Follower) Tail(value int) *Follower {
+	f.tail = value
+	return f
+}
+
+// Since sets the time of the oldest line printed. Lines without a time take the time of the last line
+// before them that has one, and are printed if there is no such line.
+func (f *Follower) Since(value time.Time) *Follower {
+	f.since = value
+	return f
+}
+
+// File sets a writer that receives all the lines of the log, regardless of the tail and since options,
+// so that the complete log can be saved.
+func (f *Follower) File(value io.Writer) *Follower {
+	f.file = value
+	return f
+}
+
+// Next gets the new lines of the log and prints them. It returns the number of lines printed.
+func (f *Follower) Next() (int, error) {
+	return f.next(false)
+}
+
+// Last gets the new lines of the log and prints them, including the last one when it doesn't end with a
+// line break. It is used when the log will not grow anymore, to print it completely. It returns the number
+// of lines printed.
+func (f *Follower) Last() (int, error) {
+	return f.next(true)
+}
+
+func (f *Follower) next(last bool) (int, error) {
+	log, err := f.fetch(f.offset)
+	if err != nil {
+		return 0, err
+	}
+	lines := completeLines(log.Content())
+	if last {
+		lines = allLines(log.Content())
+	}
+	if len(lines) == 0 {
+		return 0, nil
+	}
+	if f.file != nil {
+		_, err = io.WriteString(f.file, strings.Join(lines, "\n")+"\n")
+		if err != nil {
+			return 0, fmt.Errorf("Failed to write the log to the output file: %v", err)
+		}
+	}
+	first := f.offset == 0
+	f.offset += len(lines)
+
+	selected := make([]string, 0, len(lines))
+	for _, line := range lines {
+		lineTime, ok := parseTime(line)
+		if ok {
+			f.lastTime = lineTime
+		}
+		if f.since.IsZero() || f.lastTime.IsZero() || !f.lastTime.Before(f.since) {
+			selected = append(selected, line)
+		}
+	}
+	if first && f.tail > 0 && len(selected) > f.tail {
+		selected = selected[len(selected)-f.tail:]
+	}
+	if len(selected) == 0 {
+		return 0, nil
+	}
+	_, err = io.WriteString(f.out, strings.Join(selected, "\n")+"\n")
+	return len(selected), err
+}
+
+// Returns the lines of the content that end with a line break. The last line may still be written, so
+// it is left for the next request, which will return it again because it isn't counted in the offset.
+func completeLines(content string) []string {
+	end := strings.LastIndex(content, "\n")
+	if end < 0 {
+		return nil
+	}
+	return strings.Split(content[:end], "\n")
+}
+
+// Returns all the lines of the content, including the last one when it doesn't end with a line break.
+func allLines(content string) []string {
+	if content == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+}
+
+// The installer and Hive write lines like 'time="2024-05-01T12:00:00Z" level=info msg="..."'.
+var timePattern = regexp.MustCompile(`^time="([^"]+)"`)
+
+func parseTime(line string) (time.Time, bool) {
+	match := timePattern.FindStringSubmatch(line)
+	if match == nil {
+		return time.Time{}, false
+	}
+	value, err := time.Parse(time.RFC3339, match[1])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return value, true
+}
+
+// ParseSince parses the value of a '--since' flag, which can be a duration before now or a time in
+// RFC3339 format.
+func ParseSince(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err == nil {
+		return now.Add(-duration), nil
+	}
+	result, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Invalid value '%s' for '--since', it must be a duration, "+
+			"like '1h', or a time in RFC3339 format, like '2024-05-01T12:00:00Z'", value)
+	}
+	return result, nil
+}
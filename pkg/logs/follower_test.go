@@ -0,0 +1,142 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Follower", func() {
+	var content string
+	var offsets []int
+	var out bytes.Buffer
+
+	// Returns the lines of the content after the offset, like the OCM API does:
+	fetch := func(offset int) (*cmv1.Log, error) {
+		offsets = append(offsets, offset)
+		lines := strings.SplitAfter(content, "\n")
+		if offset > len(lines) {
+			offset = len(lines)
+		}
+		return cmv1.NewLog().Content(strings.Join(lines[offset:], "")).Build()
+	}
+
+	BeforeEach(func() {
+		content = ""
+		offsets = nil
+		out.Reset()
+	})
+
+	It("Prints only the new lines", func() {
+		follower := NewFollower(fetch, &out)
+		content = "one\ntwo\n"
+		Expect(follower.Next()).To(Equal(2))
+		content += "three\n"
+		Expect(follower.Next()).To(Equal(1))
+		Expect(follower.Next()).To(Equal(0))
+		Expect(out.String()).To(Equal("one\ntwo\nthree\n"))
+		Expect(offsets).To(Equal([]int{0, 2, 3}))
+	})
+
+	It("Waits for the end of a partial line", func() {
+		follower := NewFollower(fetch, &out)
+		content = "one\ntw"
+		Expect(follower.Next()).To(Equal(1))
+		content += "o\n"
+		Expect(follower.Next()).To(Equal(1))
+		Expect(out.String()).To(Equal("one\ntwo\n"))
+	})
+
+	It("Prints the last line without a line break when the log is complete", func() {
+		var file bytes.Buffer
+		follower := NewFollower(fetch, &out).File(&file)
+		content = "one\ntw"
+		Expect(follower.Next()).To(Equal(1))
+		content += "o"
+		Expect(follower.Last()).To(Equal(1))
+		Expect(out.String()).To(Equal("one\ntwo\n"))
+		Expect(file.String()).To(Equal("one\ntwo\n"))
+	})
+
+	It("Prints the whole log without a line break when it is complete", func() {
+		follower := NewFollower(fetch, &out).Tail(1)
+		content = "one\ntwo"
+		Expect(follower.Last()).To(Equal(1))
+		Expect(out.String()).To(Equal("two\n"))
+	})
+
+	It("Applies the tail only the first time", func() {
+		follower := NewFollower(fetch, &out).Tail(1)
+		content = "one\ntwo\n"
+		Expect(follower.Next()).To(Equal(1))
+		content += "three\nfour\n"
+		Expect(follower.Next()).To(Equal(2))
+		Expect(out.String()).To(Equal("two\nthree\nfour\n"))
+	})
+
+	It("Skips the lines before the since time", func() {
+		since := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		follower := NewFollower(fetch, &out).Since(since)
+		content = "header\n" +
+			`time="2024-05-01T11:00:00Z" level=info msg="old"` + "\n" +
+			"old detail\n" +
+			`time="2024-05-01T12:30:00Z" level=info msg="new"` + "\n" +
+			"new detail\n"
+		Expect(follower.Next()).To(Equal(3))
+		Expect(out.String()).To(Equal("header\n" +
+			`time="2024-05-01T12:30:00Z" level=info msg="new"` + "\n" +
+			"new detail\n"))
+	})
+
+	It("Writes all the lines to the file", func() {
+		var file bytes.Buffer
+		follower := NewFollower(fetch, &out).Tail(1).File(&file)
+		content = "one\ntwo\n"
+		Expect(follower.Next()).To(Equal(1))
+		content += "three\n"
+		Expect(follower.Next()).To(Equal(1))
+		Expect(out.String()).To(Equal("two\nthree\n"))
+		Expect(file.String()).To(Equal("one\ntwo\nthree\n"))
+	})
+})
+
+var _ = Describe("Since", func() {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	It("Is zero when empty", func() {
+		Expect(ParseSince("", now)).To(BeZero())
+	})
+
+	It("Accepts a duration", func() {
+		Expect(ParseSince("1h", now)).To(Equal(now.Add(-time.Hour)))
+	})
+
+	It("Accepts a time", func() {
+		Expect(ParseSince("2024-04-30T10:00:00Z", now)).To(Equal(time.Date(2024, 4, 30, 10, 0, 0, 0, time.UTC)))
+	})
+
+	It("Rejects other values", func() {
+		_, err := ParseSince("yesterday", now)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -4,12 +4,10 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"os"
 	"regexp"
 	"slices"
 	"strconv"
 	"strings"
-	"text/tabwriter"
 	"time"
 
 	"github.com/briandowns/spinner"
@@ -33,6 +31,7 @@ import (
 	"github.com/openshift/rosa/pkg/output"
 	rprtr "github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/wait"
 )
 
 var fetchMessage string = "Fetching %s '%s' for cluster '%s'"
@@ -483,6 +482,10 @@ func (m *machinePool) CreateMachinePool(r *rosa.Runtime, cmd *cobra.Command, clu
 		r.Reporter.Infof("To view all machine pools, run 'rosa list machinepools --cluster %s'", clusterKey)
 	}
 
+	if wait.Enabled() {
+		r.Reporter.Infof("Waiting for machine pool '%s' to be scaled", createdMachinePool.ID())
+		return r.OCMClient.WaitForMachinePool(cluster.ID(), createdMachinePool.ID(), wait.Timeout())
+	}
 	return nil
 }
 
@@ -965,6 +968,10 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 		r.Reporter.Infof("To view all machine pools, run 'rosa list machinepools --cluster %s'", clusterKey)
 	}
 
+	if wait.Enabled() {
+		r.Reporter.Infof("Waiting for machine pool '%s' to be scaled", createdNodePool.ID())
+		return r.OCMClient.WaitForNodePool(cluster.ID(), createdNodePool.ID(), wait.Timeout())
+	}
 	return nil
 }
 
@@ -995,16 +1002,10 @@ func (m *machinePool) ListMachinePools(r *rosa.Runtime, clusterKey string, clust
 		return output.Print(machinePools)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-
-	finalStringToOutput := getMachinePoolsString(machinePools)
 	if isHypershift {
-		finalStringToOutput = getNodePoolsString(nodePools)
+		return getNodePoolsTable(nodePools).Print()
 	}
-	fmt.Fprint(writer, finalStringToOutput)
-	writer.Flush()
-	return nil
+	return getMachinePoolsTable(machinePools).Print()
 }
 
 // DescribeMachinePool describes either a machinepool, or, a nodepool (if hypershift)
@@ -1172,11 +1173,11 @@ func appendUpgradesIfExist(scheduledUpgrade *cmv1.NodePoolUpgradePolicy, output
 	return output
 }
 
-func getMachinePoolsString(machinePools []*cmv1.MachinePool) string {
-	outputString := "ID\tAUTOSCALING\tREPLICAS\tINSTANCE TYPE\tLABELS\t\tTAINTS\t" +
-		"\tAVAILABILITY ZONES\t\tSUBNETS\t\tSPOT INSTANCES\tDISK SIZE\tSG IDs\n"
+func getMachinePoolsTable(machinePools []*cmv1.MachinePool) *output.Table {
+	table := output.NewTable("ID", "AUTOSCALING", "REPLICAS", "INSTANCE TYPE", "LABELS", "", "TAINTS", "",
+		"AVAILABILITY ZONES", "", "SUBNETS", "", "SPOT INSTANCES", "DISK SIZE", "SG IDs")
 	for _, machinePool := range machinePools {
-		outputString += fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t\t%s\t\t%s\t\t%s\t\t%s\t%s\t%s\n",
+		table.AddRow(
 			machinePool.ID(),
 			ocmOutput.PrintMachinePoolAutoscaling(machinePool.Autoscaling()),
 			ocmOutput.PrintMachinePoolReplicas(machinePool.Autoscaling(), machinePool.Replicas()),
@@ -1190,14 +1191,14 @@ func getMachinePoolsString(machinePools []*cmv1.MachinePool) string {
 			output.PrintStringSlice(machinePool.AWS().AdditionalSecurityGroupIds()),
 		)
 	}
-	return outputString
+	return table
 }
 
-func getNodePoolsString(nodePools []*cmv1.NodePool) string {
-	outputString := "ID\tAUTOSCALING\tREPLICAS\t" +
-		"INSTANCE TYPE\tLABELS\t\tTAINTS\t\tAVAILABILITY ZONE\tSUBNET\tDISK SIZE\tVERSION\tAUTOREPAIR\t\n"
+func getNodePoolsTable(nodePools []*cmv1.NodePool) *output.Table {
+	table := output.NewTable("ID", "AUTOSCALING", "REPLICAS", "INSTANCE TYPE", "LABELS", "", "TAINTS", "",
+		"AVAILABILITY ZONE", "SUBNET", "DISK SIZE", "VERSION", "AUTOREPAIR", "")
 	for _, nodePool := range nodePools {
-		outputString += fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t\t%s\t\t%s\t%s\t%s\t%s\t%s\t\n",
+		table.AddRow(
 			nodePool.ID(),
 			ocmOutput.PrintNodePoolAutoscaling(nodePool.Autoscaling()),
 			ocmOutput.PrintNodePoolReplicasShort(
@@ -1214,7 +1215,7 @@ func getNodePoolsString(nodePools []*cmv1.NodePool) string {
 			ocmOutput.PrintNodePoolAutorepair(nodePool.AutoRepair()),
 		)
 	}
-	return outputString
+	return table
 }
 
 func (m *machinePool) EditMachinePool(cmd *cobra.Command, machinePoolId string, clusterKey string,
@@ -1487,6 +1488,10 @@ func editMachinePool(cmd *cobra.Command, machinePoolId string,
 			machinePool.ID(), clusterKey, err)
 	}
 	r.Reporter.Infof("Updated machine pool '%s' on cluster '%s'", machinePool.ID(), clusterKey)
+	if wait.Enabled() {
+		r.Reporter.Infof("Waiting for machine pool '%s' to be scaled", machinePool.ID())
+		return r.OCMClient.WaitForMachinePool(cluster.ID(), machinePool.ID(), wait.Timeout())
+	}
 	return nil
 }
 
@@ -1666,7 +1671,7 @@ func editNodePool(cmd *cobra.Command, nodePoolID string,
 		err = ValidateKubeletConfig(inputKubeletConfig)
 		if err != nil {
 			r.Reporter.Errorf(err.Error())
-			os.Exit(1)
+			rprtr.Exit(1)
 		}
 		npBuilder.KubeletConfigs(inputKubeletConfig...)
 		isKubeletConfigSet = true
@@ -1725,7 +1730,7 @@ func editNodePool(cmd *cobra.Command, nodePoolID string,
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid value for max surge: %s", err)
-				os.Exit(1)
+				rprtr.Exit(1)
 			}
 
 			maxUnavailable, err = interactive.GetString(interactive.Input{
@@ -1739,7 +1744,7 @@ func editNodePool(cmd *cobra.Command, nodePoolID string,
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid value for max unavailable: %s", err)
-				os.Exit(1)
+				rprtr.Exit(1)
 			}
 		}
 
@@ -1772,6 +1777,10 @@ func editNodePool(cmd *cobra.Command, nodePoolID string,
 			nodePool.ID(), clusterKey, err)
 	}
 	r.Reporter.Infof("Updated machine pool '%s' on hosted cluster '%s'", nodePool.ID(), clusterKey)
+	if wait.Enabled() {
+		r.Reporter.Infof("Waiting for machine pool '%s' to be scaled", nodePool.ID())
+		return r.OCMClient.WaitForNodePool(cluster.ID(), nodePool.ID(), wait.Timeout())
+	}
 	return nil
 }
 
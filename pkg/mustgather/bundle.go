@@ -0,0 +1,163 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the writer of the support bundles created by 'rosa must-gather'. A bundle is a
+// compressed tarball with a directory that contains the collected files and a manifest that lists them,
+// along with the errors hit while collecting the ones that are missing.
+
+package mustgather
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"time"
+)
+
+// ManifestFile is the name of the manifest inside the directory of the bundle.
+const ManifestFile = "manifest.json"
+
+type Manifest struct {
+	ClusterID   string    `json:"cluster_id"`
+	ClusterName string    `json:"cluster_name"`
+	RosaVersion string    `json:"rosa_version"`
+	CollectedAt time.Time `json:"collected_at"`
+	Files       []File    `json:"files"`
+	Errors      []Error   `json:"errors"`
+}
+
+type File struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Size        int    `json:"size"`
+}
+
+type Error struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Error       string `json:"error"`
+}
+
+// CollectFunc returns the content of a file of the bundle.
+type CollectFunc func() ([]byte, error)
+
+type Bundle struct {
+	dir      string
+	gzip     *gzip.Writer
+	tar      *tar.Writer
+	manifest Manifest
+}
+
+// NewBundle creates a bundle that writes the tarball to the given writer, with the files inside the
+// given directory. The files and errors of the manifest are filled as the files are added.
+func NewBundle(writer io.Writer, dir string, manifest Manifest) *Bundle {
+	manifest.Files = []File{}
+	manifest.Errors = []Error{}
+	compressed := gzip.NewWriter(writer)
+	return &Bundle{
+		dir:      dir,
+		gzip:     compressed,
+		tar:      tar.NewWriter(compressed),
+		manifest: manifest,
+	}
+}
+
+// Add collects a file and adds it to the bundle. If the collection fails the error is added to the
+// manifest instead, so that one missing file doesn't prevent collecting the rest. The returned error
+// is only for failures to write the tarball.
+func (b *Bundle) Add(name string, description string, collect CollectFunc) error {
+	content, err := collect()
+	if err != nil {
+		b.manifest.Errors = append(b.manifest.Errors, Error{
+			Name:        name,
+			Description: description,
+			Error:       err.Error(),
+		})
+		return nil
+	}
+	err = b.write(name, content)
+	if err != nil {
+		return err
+	}
+	b.manifest.Files = append(b.manifest.Files, File{
+		Name:        name,
+		Description: description,
+		Size:        len(content),
+	})
+	return nil
+}
+
+// Close writes the manifest and finishes the tarball. It doesn't close the underlying writer.
+func (b *Bundle) Close() error {
+	content, err := json.MarshalIndent(b.manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = b.write(ManifestFile, append(content, '\n'))
+	if err != nil {
+		return err
+	}
+	err = b.tar.Close()
+	if err != nil {
+		return err
+	}
+	return b.gzip.Close()
+}
+
+// Manifest returns the manifest with the files and errors added so far.
+func (b *Bundle) Manifest() Manifest {
+	return b.manifest
+}
+
+func (b *Bundle) write(name string, content []byte) error {
+	err := b.tar.WriteHeader(&tar.Header{
+		Name:    path.Join(b.dir, name),
+		Mode:    0600,
+		Size:    int64(len(content)),
+		ModTime: b.manifest.CollectedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to write '%s' to the bundle: %v", name, err)
+	}
+	_, err = b.tar.Write(content)
+	if err != nil {
+		return fmt.Errorf("Failed to write '%s' to the bundle: %v", name, err)
+	}
+	return nil
+}
+
+// MarshalFunc writes an object as JSON, like the marshal functions of the OCM SDK.
+type MarshalFunc func(writer io.Writer) error
+
+// JSON returns the indented JSON document written by the given function.
+func JSON(marshal MarshalFunc) ([]byte, error) {
+	var buffer bytes.Buffer
+	err := marshal(&buffer)
+	if err != nil {
+		return nil, err
+	}
+	var result bytes.Buffer
+	err = json.Indent(&result, buffer.Bytes(), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	result.WriteByte('\n')
+	return result.Bytes(), nil
+}
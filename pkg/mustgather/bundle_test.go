@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mustgather
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Returns the content of the files of a tarball by name.
+func readBundle(data []byte) map[string]string {
+	compressed, err := gzip.NewReader(bytes.NewReader(data))
+	Expect(err).NotTo(HaveOccurred())
+	reader := tar.NewReader(compressed)
+	result := map[string]string{}
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return result
+		}
+		Expect(err).NotTo(HaveOccurred())
+		content, err := io.ReadAll(reader)
+		Expect(err).NotTo(HaveOccurred())
+		result[header.Name] = string(content)
+	}
+}
+
+var _ = Describe("Bundle", func() {
+	collectedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	It("Writes the files and the manifest", func() {
+		var buffer bytes.Buffer
+		bundle := NewBundle(&buffer, "must-gather", Manifest{
+			ClusterID:   "123",
+			ClusterName: "mycluster",
+			CollectedAt: collectedAt,
+		})
+		Expect(bundle.Add("cluster.json", "the cluster", func() ([]byte, error) {
+			return []byte("{}\n"), nil
+		})).To(Succeed())
+		Expect(bundle.Add("logs/install.log", "the install logs", func() ([]byte, error) {
+			return nil, fmt.Errorf("not found")
+		})).To(Succeed())
+		Expect(bundle.Close()).To(Succeed())
+
+		files := readBundle(buffer.Bytes())
+		Expect(files).To(HaveLen(2))
+		Expect(files).To(HaveKeyWithValue("must-gather/cluster.json", "{}\n"))
+		Expect(files).To(HaveKey("must-gather/manifest.json"))
+
+		var manifest Manifest
+		Expect(json.Unmarshal([]byte(files["must-gather/manifest.json"]), &manifest)).To(Succeed())
+		Expect(manifest.ClusterID).To(Equal("123"))
+		Expect(manifest.CollectedAt).To(Equal(collectedAt))
+		Expect(manifest.Files).To(Equal([]File{{Name: "cluster.json", Description: "the cluster", Size: 3}}))
+		Expect(manifest.Errors).To(Equal([]Error{{
+			Name:        "logs/install.log",
+			Description: "the install logs",
+			Error:       "not found",
+		}}))
+	})
+
+	It("Lists no files or errors in an empty manifest", func() {
+		var buffer bytes.Buffer
+		bundle := NewBundle(&buffer, "must-gather", Manifest{})
+		Expect(bundle.Close()).To(Succeed())
+		Expect(readBundle(buffer.Bytes())["must-gather/manifest.json"]).To(And(
+			ContainSubstring(`"files": []`),
+			ContainSubstring(`"errors": []`),
+		))
+	})
+})
+
+var _ = Describe("JSON", func() {
+	It("Indents the document", func() {
+		content, err := JSON(func(writer io.Writer) error {
+			_, err := writer.Write([]byte(`{"id":"123"}`))
+			return err
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("{\n  \"id\": \"123\"\n}\n"))
+	})
+})
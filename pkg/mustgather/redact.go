@@ -0,0 +1,65 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mustgather
+
+import (
+	"encoding/json"
+)
+
+// Redacted replaces the values of the secret fields.
+const Redacted = "REDACTED"
+
+// SecretFields are the fields of identity providers that contain secrets: the client secrets of the
+// OAuth providers, the bind password of LDAP and the passwords of the HTPasswd users.
+var SecretFields = []string{"client_secret", "bind_password", "password", "hashed_password"}
+
+// Redact returns a copy of a JSON document where the values of the given fields are replaced, at any
+// depth.
+func Redact(content []byte, fields []string) ([]byte, error) {
+	var document interface{}
+	err := json.Unmarshal(content, &document)
+	if err != nil {
+		return nil, err
+	}
+	secrets := map[string]bool{}
+	for _, field := range fields {
+		secrets[field] = true
+	}
+	result, err := json.MarshalIndent(redact(document, secrets), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(result, '\n'), nil
+}
+
+func redact(value interface{}, secrets map[string]bool) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, item := range typed {
+			if secrets[key] {
+				typed[key] = Redacted
+			} else {
+				typed[key] = redact(item, secrets)
+			}
+		}
+	case []interface{}:
+		for i, item := range typed {
+			typed[i] = redact(item, secrets)
+		}
+	}
+	return value
+}
@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mustgather
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Redact", func() {
+	It("Replaces the secret fields at any depth", func() {
+		content, err := Redact([]byte(`{
+			"items": [
+				{"name": "github", "github": {"client_id": "abc", "client_secret": "def"}},
+				{"name": "htpasswd", "htpasswd": {"users": {"items": [{"username": "me", "password": "ghi"}]}}},
+				{"name": "ldap", "ldap": {"bind_dn": "cn=me", "bind_password": "jkl"}}
+			]
+		}`), SecretFields)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring(`"client_id": "abc"`))
+		Expect(string(content)).To(ContainSubstring(`"bind_dn": "cn=me"`))
+		Expect(string(content)).NotTo(Or(
+			ContainSubstring("def"),
+			ContainSubstring("ghi"),
+			ContainSubstring("jkl"),
+		))
+		Expect(string(content)).To(ContainSubstring(`"client_secret": "REDACTED"`))
+	})
+
+	It("Fails with invalid JSON", func() {
+		_, err := Redact([]byte("{"), SecretFields)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"strconv"
+
+	"github.com/openshift/rosa/pkg/output"
+)
+
+// NewTable returns a table with the proposed networks.
+func NewTable(plan *Plan) *output.Table {
+	table := output.NewTable("SETTING", "VALUE")
+	table.AddRow("Machine CIDR", plan.MachineCIDR)
+	table.AddRow("Service CIDR", plan.ServiceCIDR)
+	table.AddRow("Pod CIDR", plan.PodCIDR)
+	table.AddRow("Host prefix", "/"+strconv.Itoa(plan.HostPrefix))
+	table.AddRow("Max nodes", strconv.Itoa(plan.MaxNodes))
+	return table
+}
+
+// NewConflictsTable returns a table with the conflicts of the plan.
+func NewConflictsTable(plan *Plan) *output.Table {
+	table := output.NewTable("RANGE", "OVERLAPS WITH", "EXPLANATION")
+	for _, conflict := range plan.Conflicts {
+		table.AddRow(conflict.Network.String(), conflict.OverlapsWith.String(), conflict.Explanation)
+	}
+	return table
+}
@@ -0,0 +1,396 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package network proposes the machine, service and pod CIDRs and the host prefix of a new cluster,
+// so that they don't overlap the networks that the VPC already reaches or the ranges reserved by the
+// user.
+package network
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+	"net"
+	"sort"
+)
+
+const (
+	DefaultServiceCIDR = "172.30.0.0/16"
+	DefaultPodCIDR     = "10.128.0.0/14"
+	DefaultMaxPods     = 250
+
+	HostPrefixMin = 23
+	HostPrefixMax = 26
+
+	// Addresses of each node subnet that can't be used by pods: the network address, the gateway and
+	// the management port.
+	nodeReservedAddresses = 3
+
+	// Nodes that get a subnet of the pod network besides the compute nodes: the control plane and the
+	// infrastructure nodes.
+	additionalNodes = 6
+
+	// Addresses that AWS reserves in each subnet.
+	awsReservedAddresses = 5
+
+	serviceCIDRPrefixMin = 16
+	serviceCIDRPrefixMax = 24
+)
+
+const (
+	SourceVPC      = "vpc"
+	SourceSubnet   = "subnet"
+	SourceRoute    = "route"
+	SourceReserved = "reserved"
+	SourceInternal = "internal"
+	SourceService  = "service network"
+	SourcePod      = "pod network"
+)
+
+// Ranges used internally by OVN-Kubernetes, which the cluster networks must not overlap.
+var internalNetworks = []Network{
+	{Source: SourceInternal, Name: "OVN-Kubernetes join switch", CIDR: "100.64.0.0/16"},
+	{Source: SourceInternal, Name: "OVN-Kubernetes transit switch", CIDR: "100.88.0.0/16"},
+}
+
+// Private ranges where the service and pod networks are looked for.
+var privateRanges = []string{
+	"10.0.0.0/8",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+}
+
+// Network is a named IPv4 range.
+type Network struct {
+	Source string `json:"source"`
+	Name   string `json:"name"`
+	CIDR   string `json:"cidr"`
+}
+
+func (n Network) String() string {
+	if n.Name == "" {
+		return fmt.Sprintf("%s %s", n.Source, n.CIDR)
+	}
+	return fmt.Sprintf("%s '%s' %s", n.Source, n.Name, n.CIDR)
+}
+
+// Input describes the VPC where the cluster is going to be created.
+type Input struct {
+	// CIDR blocks of the VPC
+	VPCCIDRs []string
+
+	// Subnets of the cluster, the name of each network is the identifier of the subnet
+	Subnets []Network
+
+	// Routes of the VPC to other networks, the name of each network is the target of the route
+	Routes []Network
+
+	// Ranges that the cluster must not use
+	Reserved []Network
+
+	// Number of compute nodes
+	Nodes int
+
+	// Maximum number of pods per node
+	MaxPods int
+}
+
+// Conflict is an overlap between two ranges and how the plan deals with it.
+type Conflict struct {
+	Network      Network `json:"network"`
+	OverlapsWith Network `json:"overlaps_with"`
+	Explanation  string  `json:"explanation"`
+}
+
+// Plan is the proposed network configuration of the cluster.
+type Plan struct {
+	MachineCIDR string     `json:"machine_cidr"`
+	ServiceCIDR string     `json:"service_cidr"`
+	PodCIDR     string     `json:"pod_cidr"`
+	HostPrefix  int        `json:"host_prefix"`
+	MaxNodes    int        `json:"max_nodes"`
+	Conflicts   []Conflict `json:"conflicts,omitempty"`
+	Warnings    []string   `json:"warnings,omitempty"`
+}
+
+// Flags returns the 'rosa create cluster' flags of the plan.
+func (p *Plan) Flags() string {
+	return fmt.Sprintf("--machine-cidr %s --service-cidr %s --pod-cidr %s --host-prefix %d",
+		p.MachineCIDR, p.ServiceCIDR, p.PodCIDR, p.HostPrefix)
+}
+
+type parsedNetwork struct {
+	Network
+	ipNet *net.IPNet
+}
+
+func parse(networks []Network) ([]parsedNetwork, error) {
+	result := make([]parsedNetwork, 0, len(networks))
+	for _, network := range networks {
+		ip, ipNet, err := net.ParseCIDR(network.CIDR)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid CIDR '%s' of %s: %v", network.CIDR, network.Source, err)
+		}
+		if ip.To4() == nil {
+			return nil, fmt.Errorf("CIDR '%s' of %s isn't an IPv4 range", network.CIDR, network.Source)
+		}
+		network.CIDR = ipNet.String()
+		result = append(result, parsedNetwork{Network: network, ipNet: ipNet})
+	}
+	return result, nil
+}
+
+// HostPrefix returns the longest host prefix that gives every node room for the pods.
+func HostPrefix(maxPods int) (int, error) {
+	hostPrefix := 32 - bitsFor(maxPods+nodeReservedAddresses)
+	if hostPrefix < HostPrefixMin {
+		return 0, fmt.Errorf("%d pods per node need more addresses than the host prefix /%d gives",
+			maxPods, HostPrefixMin)
+	}
+	if hostPrefix > HostPrefixMax {
+		hostPrefix = HostPrefixMax
+	}
+	return hostPrefix, nil
+}
+
+// Propose calculates the network configuration of a cluster in the VPC.
+func Propose(input Input) (*Plan, error) {
+	if input.Nodes < 1 {
+		return nil, fmt.Errorf("The number of nodes must be at least 1")
+	}
+	maxPods := input.MaxPods
+	if maxPods == 0 {
+		maxPods = DefaultMaxPods
+	}
+	if maxPods < 1 {
+		return nil, fmt.Errorf("The maximum number of pods per node must be at least 1")
+	}
+
+	vpcNetworks := make([]Network, 0, len(input.VPCCIDRs))
+	for _, cidr := range input.VPCCIDRs {
+		vpcNetworks = append(vpcNetworks, Network{Source: SourceVPC, CIDR: cidr})
+	}
+	vpc, err := parse(vpcNetworks)
+	if err != nil {
+		return nil, err
+	}
+	subnets, err := parse(input.Subnets)
+	if err != nil {
+		return nil, err
+	}
+	routes, err := parse(input.Routes)
+	if err != nil {
+		return nil, err
+	}
+	reserved, err := parse(input.Reserved)
+	if err != nil {
+		return nil, err
+	}
+	internal, _ := parse(internalNetworks)
+
+	plan := &Plan{}
+	machine, err := machineNetwork(vpc, subnets)
+	if err != nil {
+		return nil, err
+	}
+	plan.MachineCIDR = machine.CIDR
+	plan.Warnings = subnetWarnings(subnets, input.Nodes)
+
+	plan.HostPrefix, err = HostPrefix(maxPods)
+	if err != nil {
+		return nil, err
+	}
+
+	// The ranges reserved by the user can't be used, so their overlaps with the networks that already
+	// exist can only be reported
+	existing := append(append(append([]parsedNetwork{}, vpc...), subnets...), routes...)
+	for _, r := range reserved {
+		for _, e := range existing {
+			if overlaps(r.ipNet, e.ipNet) {
+				plan.Conflicts = append(plan.Conflicts, Conflict{
+					Network:      e.Network,
+					OverlapsWith: r.Network,
+					Explanation: fmt.Sprintf("The %s already uses part of the reserved range, "+
+						"this can't be fixed by choosing other cluster networks", e.Source),
+				})
+			}
+		}
+	}
+
+	used := append(append(append([]parsedNetwork{}, existing...), reserved...), internal...)
+
+	nodes := input.Nodes + additionalNodes
+	podPrefix := plan.HostPrefix - bitsFor(nodes)
+	if podPrefix < 1 {
+		return nil, fmt.Errorf("%d nodes don't fit in a pod network with host prefix /%d", input.Nodes,
+			plan.HostPrefix)
+	}
+	pod, conflicts, err := choose(SourcePod, DefaultPodCIDR, []int{podPrefix}, used)
+	if err != nil {
+		return nil, err
+	}
+	if pod.CIDR != DefaultPodCIDR && len(conflicts) == 0 {
+		plan.Warnings = append(plan.Warnings, fmt.Sprintf("The default %s %s is too small for %d nodes "+
+			"with host prefix /%d", SourcePod, DefaultPodCIDR, input.Nodes, plan.HostPrefix))
+	}
+	plan.PodCIDR = pod.CIDR
+	plan.Conflicts = append(plan.Conflicts, conflicts...)
+	podPrefix, _ = pod.ipNet.Mask.Size()
+	plan.MaxNodes = 1<<(plan.HostPrefix-podPrefix) - additionalNodes
+
+	servicePrefixes := []int{}
+	for prefix := serviceCIDRPrefixMin; prefix <= serviceCIDRPrefixMax; prefix++ {
+		servicePrefixes = append(servicePrefixes, prefix)
+	}
+	service, conflicts, err := choose(SourceService, DefaultServiceCIDR, servicePrefixes, append(used, pod))
+	if err != nil {
+		return nil, err
+	}
+	plan.ServiceCIDR = service.CIDR
+	plan.Conflicts = append(plan.Conflicts, conflicts...)
+	return plan, nil
+}
+
+// The machine network is the block of the VPC that contains all the subnets.
+func machineNetwork(vpc []parsedNetwork, subnets []parsedNetwork) (parsedNetwork, error) {
+	if len(subnets) == 0 {
+		return parsedNetwork{}, fmt.Errorf("At least one subnet is needed")
+	}
+	for _, block := range vpc {
+		containsAll := true
+		for _, subnet := range subnets {
+			if !contains(block.ipNet, subnet.ipNet) {
+				containsAll = false
+				break
+			}
+		}
+		if containsAll {
+			return block, nil
+		}
+	}
+	return parsedNetwork{}, fmt.Errorf("The subnets aren't contained in a single CIDR block of the VPC")
+}
+
+func subnetWarnings(subnets []parsedNetwork, nodes int) []string {
+	available := big.NewInt(0)
+	for _, subnet := range subnets {
+		ones, size := subnet.ipNet.Mask.Size()
+		addresses := new(big.Int).Lsh(big.NewInt(1), uint(size-ones))
+		addresses.Sub(addresses, big.NewInt(awsReservedAddresses))
+		if addresses.Sign() > 0 {
+			available.Add(available, addresses)
+		}
+	}
+	if available.Cmp(big.NewInt(int64(nodes+additionalNodes))) < 0 {
+		return []string{fmt.Sprintf("The subnets only have %s addresses for %d nodes", available,
+			nodes+additionalNodes)}
+	}
+	return nil
+}
+
+// Returns the default range if it doesn't overlap any used network and it is large enough, otherwise
+// the first free range of the private ranges, trying the prefixes in order. The overlaps of the default
+// range are returned as conflicts. A default range that is too small has no conflicts, the caller
+// reports it.
+func choose(source string, defaultCIDR string, prefixes []int,
+	used []parsedNetwork) (parsedNetwork, []Conflict, error) {
+	_, defaultNet, _ := net.ParseCIDR(defaultCIDR)
+	defaultPrefix, _ := defaultNet.Mask.Size()
+	overlapping := overlapsWith(defaultNet, used)
+	if len(overlapping) == 0 && defaultPrefix <= prefixes[0] {
+		return parsedNetwork{Network: Network{Source: source, CIDR: defaultCIDR}, ipNet: defaultNet}, nil, nil
+	}
+
+	for _, prefix := range prefixes {
+		for _, r := range privateRanges {
+			_, privateNet, _ := net.ParseCIDR(r)
+			candidate := findFree(privateNet, prefix, used)
+			if candidate == nil {
+				continue
+			}
+			chosen := parsedNetwork{Network: Network{Source: source, CIDR: candidate.String()}, ipNet: candidate}
+			conflicts := []Conflict{}
+			for _, o := range overlapping {
+				conflicts = append(conflicts, Conflict{
+					Network:      Network{Source: source, Name: "default", CIDR: defaultCIDR},
+					OverlapsWith: o.Network,
+					Explanation:  fmt.Sprintf("The default %s isn't used, %s is proposed instead", source, chosen.CIDR),
+				})
+			}
+			return chosen, conflicts, nil
+		}
+	}
+	return parsedNetwork{}, nil, fmt.Errorf("There is no free private range for the %s", source)
+}
+
+// Returns the first range of the given prefix inside the parent that doesn't overlap any used network.
+func findFree(parent *net.IPNet, prefix int, used []parsedNetwork) *net.IPNet {
+	parentPrefix, _ := parent.Mask.Size()
+	if prefix < parentPrefix {
+		return nil
+	}
+	start := ipToInt(parent.IP)
+	step := uint32(1) << (32 - prefix)
+	count := uint32(1) << (prefix - parentPrefix)
+	mask := net.CIDRMask(prefix, 32)
+	for i := uint32(0); i < count; i++ {
+		candidate := &net.IPNet{IP: intToIP(start + i*step), Mask: mask}
+		if len(overlapsWith(candidate, used)) == 0 {
+			return candidate
+		}
+	}
+	return nil
+}
+
+func overlapsWith(ipNet *net.IPNet, used []parsedNetwork) []parsedNetwork {
+	result := []parsedNetwork{}
+	for _, u := range used {
+		if overlaps(ipNet, u.ipNet) {
+			result = append(result, u)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return ipToInt(result[i].ipNet.IP) < ipToInt(result[j].ipNet.IP)
+	})
+	return result
+}
+
+func overlaps(a *net.IPNet, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+func contains(outer *net.IPNet, inner *net.IPNet) bool {
+	outerPrefix, _ := outer.Mask.Size()
+	innerPrefix, _ := inner.Mask.Size()
+	return outerPrefix <= innerPrefix && outer.Contains(inner.IP)
+}
+
+// Returns the number of bits needed to count up to n.
+func bitsFor(n int) int {
+	if n <= 1 {
+		return 0
+	}
+	return bits.Len(uint(n - 1))
+}
+
+func ipToInt(ip net.IP) uint32 {
+	ip = ip.To4()
+	return uint32(ip[0])<<24 | uint32(ip[1])<<16 | uint32(ip[2])<<8 | uint32(ip[3])
+}
+
+func intToIP(n uint32) net.IP {
+	return net.IPv4(byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+}
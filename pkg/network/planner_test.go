@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Propose", func() {
+	var input Input
+
+	BeforeEach(func() {
+		input = Input{
+			VPCCIDRs: []string{"10.0.0.0/16"},
+			Subnets: []Network{
+				{Source: SourceSubnet, Name: "subnet-a", CIDR: "10.0.0.0/24"},
+				{Source: SourceSubnet, Name: "subnet-b", CIDR: "10.0.1.0/24"},
+			},
+			Nodes: 10,
+		}
+	})
+
+	It("Uses the defaults when they are free", func() {
+		plan, err := Propose(input)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.MachineCIDR).To(Equal("10.0.0.0/16"))
+		Expect(plan.ServiceCIDR).To(Equal(DefaultServiceCIDR))
+		Expect(plan.PodCIDR).To(Equal(DefaultPodCIDR))
+		Expect(plan.HostPrefix).To(Equal(24))
+		Expect(plan.MaxNodes).To(Equal(1018))
+		Expect(plan.Conflicts).To(BeEmpty())
+		Expect(plan.Warnings).To(BeEmpty())
+		Expect(plan.Flags()).To(Equal(
+			"--machine-cidr 10.0.0.0/16 --service-cidr 172.30.0.0/16 --pod-cidr 10.128.0.0/14 --host-prefix 24"))
+	})
+
+	It("Avoids the peered networks and the reserved ranges", func() {
+		input.Routes = []Network{{Source: SourceRoute, Name: "pcx-1", CIDR: "10.130.0.0/16"}}
+		input.Reserved = []Network{{Source: SourceReserved, CIDR: "172.16.0.0/12"}}
+
+		plan, err := Propose(input)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.PodCIDR).To(Equal("10.1.0.0/20"))
+		Expect(plan.MaxNodes).To(Equal(10))
+		Expect(plan.ServiceCIDR).To(Equal("10.2.0.0/16"))
+		Expect(plan.Conflicts).To(Equal([]Conflict{
+			{
+				Network:      Network{Source: SourcePod, Name: "default", CIDR: DefaultPodCIDR},
+				OverlapsWith: Network{Source: SourceRoute, Name: "pcx-1", CIDR: "10.130.0.0/16"},
+				Explanation:  "The default pod network isn't used, 10.1.0.0/20 is proposed instead",
+			},
+			{
+				Network:      Network{Source: SourceService, Name: "default", CIDR: DefaultServiceCIDR},
+				OverlapsWith: Network{Source: SourceReserved, CIDR: "172.16.0.0/12"},
+				Explanation:  "The default service network isn't used, 10.2.0.0/16 is proposed instead",
+			},
+		}))
+	})
+
+	It("Explains the reserved ranges that the VPC already uses", func() {
+		input.Reserved = []Network{{Source: SourceReserved, CIDR: "10.0.0.0/8"}}
+
+		plan, err := Propose(input)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.PodCIDR).To(Equal("172.16.0.0/20"))
+		Expect(plan.ServiceCIDR).To(Equal(DefaultServiceCIDR))
+		Expect(plan.Conflicts).To(HaveLen(4))
+		Expect(plan.Conflicts[0].Network).To(Equal(Network{Source: SourceVPC, CIDR: "10.0.0.0/16"}))
+		Expect(plan.Conflicts[0].Explanation).To(ContainSubstring("can't be fixed"))
+		Expect(plan.Conflicts[1].Network.Name).To(Equal("subnet-a"))
+		Expect(plan.Conflicts[2].Network.Name).To(Equal("subnet-b"))
+		Expect(plan.Conflicts[3].Network.Source).To(Equal(SourcePod))
+	})
+
+	It("Warns when the subnets are too small for the nodes", func() {
+		input.Nodes = 1000
+		plan, err := Propose(input)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.Warnings).To(ConsistOf("The subnets only have 502 addresses for 1006 nodes"))
+	})
+
+	It("Fails if the subnets aren't in a block of the VPC", func() {
+		input.Subnets = append(input.Subnets, Network{Source: SourceSubnet, Name: "subnet-c", CIDR: "10.1.0.0/24"})
+		_, err := Propose(input)
+		Expect(err).To(MatchError("The subnets aren't contained in a single CIDR block of the VPC"))
+	})
+
+	It("Fails if the reserved ranges are invalid", func() {
+		input.Reserved = []Network{{Source: SourceReserved, CIDR: "10.0.0.0"}}
+		_, err := Propose(input)
+		Expect(err).To(MatchError(ContainSubstring("Invalid CIDR '10.0.0.0' of reserved")))
+	})
+})
+
+var _ = Describe("HostPrefix", func() {
+	DescribeTable("Gives room for the pods of each node",
+		func(maxPods int, hostPrefix int) {
+			Expect(HostPrefix(maxPods)).To(Equal(hostPrefix))
+		},
+		Entry("default", 250, 24),
+		Entry("many pods", 500, 23),
+		Entry("few pods", 20, HostPrefixMax),
+	)
+
+	It("Fails if the pods don't fit in the largest host prefix", func() {
+		_, err := HostPrefix(600)
+		Expect(err).To(MatchError("600 pods per node need more addresses than the host prefix /23 gives"))
+	})
+})
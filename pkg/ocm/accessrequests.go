@@ -1,6 +1,10 @@
 package ocm
 
-import v1 "github.com/openshift-online/ocm-sdk-go/accesstransparency/v1"
+import (
+	"fmt"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/accesstransparency/v1"
+)
 
 func (c *Client) CreateDecision(accessRequest string, decision string, justification string) error {
 	decisionSpec, err := v1.NewDecision().
@@ -20,3 +24,28 @@ func (c *Client) CreateDecision(accessRequest string, decision string, justifica
 	}
 	return nil
 }
+
+// ListAccessRequests returns the access requests of the given cluster, or of all the clusters that the
+// user can see if the identifier is empty, most recent first.
+func (c *Client) ListAccessRequests(clusterID string) ([]*v1.AccessRequest, error) {
+	request := c.ocm.AccessTransparency().V1().AccessRequests().List().
+		Order("created_at desc").
+		Page(1).
+		Size(-1)
+	if clusterID != "" {
+		request.Search(fmt.Sprintf("cluster_id = '%s'", clusterID))
+	}
+	response, err := request.Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Items().Slice(), nil
+}
+
+func (c *Client) GetAccessRequest(id string) (*v1.AccessRequest, error) {
+	response, err := c.ocm.AccessTransparency().V1().AccessRequests().AccessRequest(id).Get().Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Body(), nil
+}
@@ -18,14 +18,17 @@ package ocm
 
 import (
 	"fmt"
-	"os"
+	"net/http"
 	"strings"
 	"time"
 
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	"github.com/sirupsen/logrus"
 
+	"github.com/openshift/rosa/pkg/cache"
 	"github.com/openshift/rosa/pkg/config"
+	"github.com/openshift/rosa/pkg/dryrun"
+	"github.com/openshift/rosa/pkg/errorcode"
 	"github.com/openshift/rosa/pkg/fedramp"
 	"github.com/openshift/rosa/pkg/info"
 	"github.com/openshift/rosa/pkg/logging"
@@ -60,8 +63,7 @@ func CreateNewClientOrExit(logger *logrus.Logger, reporter *reporter.Object) *Cl
 		Logger(logger).
 		Build()
 	if err != nil {
-		reporter.Errorf("Failed to create OCM connection: %v", err)
-		os.Exit(1)
+		reporter.ExitWithError(fmt.Errorf("Failed to create OCM connection: %w", err))
 	}
 
 	return client
@@ -89,8 +91,14 @@ func (b *ClientBuilder) Build() (result *Client, err error) {
 			err = fmt.Errorf("Failed to load config file: %v", err)
 			return nil, err
 		}
+		if b.cfg == nil && config.SelectedContext() != "" {
+			err = errorcode.New(errorcode.NotLoggedIn,
+				"Not logged in to context '%s', run the 'rosa login --context %s' command",
+				config.SelectedContext(), config.SelectedContext())
+			return nil, err
+		}
 		if b.cfg == nil {
-			err = fmt.Errorf("Not logged in, run the 'rosa login' command")
+			err = errorcode.New(errorcode.NotLoggedIn, "Not logged in, run the 'rosa login' command")
 			return nil, err
 		}
 	}
@@ -151,6 +159,44 @@ func (b *ClientBuilder) Build() (result *Client, err error) {
 		builder.Tokens(tokens...)
 	}
 	builder.Insecure(b.cfg.Insecure)
+	if dryrun.Enabled() {
+		builder.TransportWrapper(dryrun.NewOCMTransport)
+	}
+	// The requests and responses are saved to a cassette, or played back from it, when the
+	// ROSA_RECORD or ROSA_REPLAY environment variable is set:
+	cassette, err := logging.OpenCassette("ocm")
+	if err != nil {
+		return
+	}
+	var cacheTransport *cache.OCMTransport
+	if cache.Enabled() && cassette != nil {
+		b.logger.Debugf("The OCM catalog cache isn't used while recording or playing back requests")
+	} else if cache.Enabled() {
+		path, err := cache.DefaultCatalogPath()
+		if err != nil {
+			b.logger.Debugf("Can't find the OCM catalog cache, it won't be used: %v", err)
+		} else {
+			cacheTransport = cache.NewOCMTransport(path, cache.Refresh())
+			builder.TransportWrapper(cacheTransport.Wrap)
+		}
+	}
+	if cassette != nil {
+		builder.TransportWrapper(func(next http.RoundTripper) http.RoundTripper {
+			recorderBuilder := logging.NewRoundTripper().
+				Logger(b.logger).
+				Cassette(cassette).
+				Next(next)
+			for _, field := range logging.OCMSecretFields {
+				recorderBuilder.Redact(field)
+			}
+			recorder, err := recorderBuilder.Build()
+			if err != nil {
+				b.logger.Errorf("Failed to create the cassette round tripper: %v", err)
+				return next
+			}
+			return recorder
+		})
+	}
 
 	// Create the connection:
 	conn, err := builder.Build()
@@ -160,16 +206,22 @@ func (b *ClientBuilder) Build() (result *Client, err error) {
 	accessToken, refreshToken, err := conn.Tokens(10 * time.Minute)
 	if err != nil {
 		if strings.Contains(err.Error(), "invalid_grant") {
-			return nil, fmt.Errorf("your authorization token needs to be updated. " +
+			return nil, errorcode.New(errorcode.NotLoggedIn, "your authorization token needs to be updated. "+
 				"Please login again using rosa login")
 		}
 		return nil, fmt.Errorf("error creating connection. Not able to get authentication token: %s", err)
 	}
 
-	// Persist tokens in the configuration file, the SDK may have refreshed them
-	err = config.PersistTokens(b.cfg, accessToken, refreshToken)
-	if err != nil {
-		return nil, fmt.Errorf("error creating connection. Can't persist tokens to config: %s", err)
+	// Persist tokens in the configuration file, the SDK may have refreshed them. The tokens of
+	// responses played back from a cassette aren't real, so they aren't persisted:
+	if cassette == nil || !cassette.Replaying() {
+		err = config.PersistTokens(b.cfg, accessToken, refreshToken)
+		if err != nil {
+			return nil, fmt.Errorf("error creating connection. Can't persist tokens to config: %s", err)
+		}
+	}
+	if cacheTransport != nil {
+		cacheTransport.SetOrganization(cacheOrganization(b.cfg))
 	}
 
 	return &Client{
@@ -177,6 +229,18 @@ func (b *ClientBuilder) Build() (result *Client, err error) {
 	}, nil
 }
 
+// cacheOrganization returns the organization that the cached OCM catalog data is kept separately for. Tokens
+// without an organization, like the ones of service accounts, use their subject.
+func cacheOrganization(cfg *config.Config) string {
+	for _, claim := range []string{"org_id", "sub"} {
+		value, err := cfg.GetData(claim)
+		if err == nil && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
 func (c *Client) Close() error {
 	return c.ocm.Close()
 }
@@ -198,6 +262,10 @@ func (c *Client) KeepTokensAlive() error {
 	if err != nil {
 		return fmt.Errorf("Can't get new tokens: %v", err)
 	}
+	// The tokens of responses played back from a cassette aren't real:
+	if logging.ReplayDir() != "" {
+		return nil
+	}
 
 	err = config.PersistTokens(nil, accessToken, refreshToken)
 	if err != nil {
@@ -127,6 +127,12 @@ func (c *Client) CreateClusterAutoscaler(clusterId string, config *AutoscalerCon
 		return nil, err
 	}
 
+	return c.PostClusterAutoscaler(clusterId, object)
+}
+
+// PostClusterAutoscaler creates the cluster autoscaler from an already built object
+func (c *Client) PostClusterAutoscaler(clusterId string,
+	object *cmv1.ClusterAutoscaler) (*cmv1.ClusterAutoscaler, error) {
 	response, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterId).Autoscaler().Post().Request(object).Send()
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
@@ -141,6 +147,12 @@ func (c *Client) UpdateClusterAutoscaler(clusterId string, config *AutoscalerCon
 		return nil, err
 	}
 
+	return c.PatchClusterAutoscaler(clusterId, object)
+}
+
+// PatchClusterAutoscaler updates the cluster autoscaler with the fields set in an already built object
+func (c *Client) PatchClusterAutoscaler(clusterId string,
+	object *cmv1.ClusterAutoscaler) (*cmv1.ClusterAutoscaler, error) {
 	response, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterId).Autoscaler().Update().Body(object).Send()
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
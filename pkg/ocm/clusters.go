@@ -19,9 +19,10 @@ package ocm
 import (
 	"fmt"
 	"net"
-	"os"
 	"reflect"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	idputils "github.com/openshift-online/ocm-common/pkg/idp/utils"
@@ -296,54 +297,173 @@ func (c *Client) GetClustersUsingAccountRole(aws *aws.Creator, role aws.Role, co
 		return nil, err
 	}
 
-	return c.queryClusters(query, count)
+	if count < 0 {
+		return nil, errors.Errorf("Invalid Cluster count")
+	}
+	return c.queryClusters(query, count, ClusterListOptions{})
 }
 
-func (c *Client) queryClusters(query string, count int) (clusters []*cmv1.Cluster, err error) {
+// ClusterStates are the states that can be used to filter the list of clusters.
+var ClusterStates = []string{
+	string(cmv1.ClusterStateError),
+	string(cmv1.ClusterStateHibernating),
+	string(cmv1.ClusterStateInstalling),
+	string(cmv1.ClusterStatePending),
+	string(cmv1.ClusterStatePoweringDown),
+	string(cmv1.ClusterStateReady),
+	string(cmv1.ClusterStateResuming),
+	string(cmv1.ClusterStateUninstalling),
+	string(cmv1.ClusterStateUnknown),
+	string(cmv1.ClusterStateValidating),
+	string(cmv1.ClusterStateWaiting),
+}
 
-	if count < 0 {
-		err = errors.Errorf("Invalid Cluster count")
-		return
+const clusterListPageSize = 100
+
+var clusterLabelKeyRE = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// ClusterListOptions contains the filters, order and limit of a list of clusters. They are all sent
+// to the server, so the clusters are never filtered on the client side.
+type ClusterListOptions struct {
+	// Search is a query in the OCM search language, for example "name like 'my-%'"
+	Search string
+
+	States []string
+
+	// Version is either a full version like '4.14.1' or a prefix like '4.14'
+	Version string
+
+	Region string
+
+	// HostedCP selects hosted control plane or classic clusters, when it is set
+	HostedCP *bool
+
+	// Labels are 'key=value' pairs, or just keys, matched against the cluster properties
+	Labels []string
+
+	// OrderBy is an OCM order clause, for example 'creation_timestamp desc'
+	OrderBy string
+
+	// Limit is the maximum number of clusters returned, 0 means no limit
+	Limit int
+}
+
+// Quotes a value of the OCM search language, where single quotes are escaped by doubling them.
+func quoteSearchValue(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// Filter appends the search clauses of the options to the given query.
+func (o ClusterListOptions) Filter(query string) (string, error) {
+	clauses := []string{query}
+	if o.Search != "" {
+		clauses = append(clauses, fmt.Sprintf("(%s)", o.Search))
+	}
+	if len(o.States) > 0 {
+		states := make([]string, len(o.States))
+		for i, state := range o.States {
+			if !helper.Contains(ClusterStates, state) {
+				return "", fmt.Errorf("Invalid cluster state '%s', valid states are: %s",
+					state, strings.Join(ClusterStates, ", "))
+			}
+			states[i] = quoteSearchValue(state)
+		}
+		clauses = append(clauses, fmt.Sprintf("state IN (%s)", strings.Join(states, ", ")))
+	}
+	if o.Version != "" {
+		// A full version like '4.14.1' is matched exactly, a shorter one is a prefix
+		if strings.Count(o.Version, ".") >= 2 {
+			clauses = append(clauses, fmt.Sprintf("version.raw_id = %s", quoteSearchValue(o.Version)))
+		} else {
+			clauses = append(clauses, fmt.Sprintf("version.raw_id LIKE %s",
+				quoteSearchValue(strings.TrimSuffix(o.Version, ".")+".%")))
+		}
+	}
+	if o.Region != "" {
+		clauses = append(clauses, fmt.Sprintf("region.id = %s", quoteSearchValue(o.Region)))
+	}
+	if o.HostedCP != nil {
+		clauses = append(clauses, fmt.Sprintf("hypershift.enabled = '%t'", *o.HostedCP))
+	}
+	for _, label := range o.Labels {
+		key, value, hasValue := strings.Cut(label, "=")
+		if !clusterLabelKeyRE.MatchString(key) {
+			return "", fmt.Errorf("Invalid label '%s', expected 'key=value' or 'key'", label)
+		}
+		if hasValue {
+			clauses = append(clauses, fmt.Sprintf("properties.%s = %s", key, quoteSearchValue(value)))
+		} else {
+			clauses = append(clauses, fmt.Sprintf("properties.%s != ''", key))
+		}
+	}
+	return strings.Join(clauses, " AND "), nil
+}
+
+// ListClusters returns the clusters of the creator that match the given options. Pass a nil creator
+// to list the clusters of all the accounts of the organization.
+func (c *Client) ListClusters(creator *aws.Creator, options ClusterListOptions) ([]*cmv1.Cluster, error) {
+	return c.queryClusters(getClusterFilter(creator), 0, options)
+}
+
+// ListClustersUsingAccountRole returns the clusters created with the given account role that match the
+// given options.
+func (c *Client) ListClustersUsingAccountRole(creator *aws.Creator, role aws.Role,
+	options ClusterListOptions) ([]*cmv1.Cluster, error) {
+	query, err := getAccountRoleClusterFilter(creator, role)
+	if err != nil {
+		return nil, err
+	}
+	return c.queryClusters(query, 0, options)
+}
+
+// queryClusters sends the list request page by page and returns the clusters that match the query
+// and the options. A page size of 0 means the default page size.
+func (c *Client) queryClusters(query string, size int,
+	options ClusterListOptions) (clusters []*cmv1.Cluster, err error) {
+	if options.Limit < 0 {
+		return nil, errors.Errorf("Invalid limit %d, it must be a positive number", options.Limit)
+	}
+	query, err = options.Filter(query)
+	if err != nil {
+		return nil, err
 	}
 
 	request := c.ocm.ClustersMgmt().V1().Clusters().List().Search(query)
-	page := 1
-	for {
-		clusterRequestList := request.Page(page)
-		if count > 0 {
-			clusterRequestList = clusterRequestList.Size(count)
-		}
-		response, err := clusterRequestList.Send()
+	if options.OrderBy != "" {
+		request = request.Order(options.OrderBy)
+	}
+	if size == 0 {
+		size = clusterListPageSize
+	}
+	if options.Limit > 0 && options.Limit < size {
+		size = options.Limit
+	}
+	for page := 1; ; page++ {
+		response, err := request.Page(page).Size(size).Send()
 		if err != nil {
-			return clusters, err
+			return clusters, handleErr(response.Error(), err)
 		}
-
-		response.Items().Each(func(cluster *cmv1.Cluster) bool {
-			clusters = append(clusters, cluster)
-			return true
-		})
-		if response.Size() != count {
-			break
+		clusters = append(clusters, response.Items().Slice()...)
+		if options.Limit > 0 && len(clusters) >= options.Limit {
+			return clusters[:options.Limit], nil
+		}
+		if response.Size() < size || len(clusters) >= response.Total() {
+			return clusters, nil
 		}
-		page++
 	}
-	return clusters, nil
 }
 
 // Pass 0 to get all clusters
 func (c *Client) GetClusters(creator *aws.Creator, count int) (clusters []*cmv1.Cluster, err error) {
-	return c.queryClusters(getClusterFilter(creator), count)
+	if count < 0 {
+		err = errors.Errorf("Invalid Cluster count")
+		return
+	}
+	return c.queryClusters(getClusterFilter(creator), count, ClusterListOptions{})
 }
 
 func (c *Client) GetAllClusters(creator *aws.Creator) (clusters []*cmv1.Cluster, err error) {
-	query := getClusterFilter(creator)
-	request := c.ocm.ClustersMgmt().V1().Clusters().List().Search(query)
-	response, err := request.Send()
-
-	if err != nil {
-		return clusters, err
-	}
-	return response.Items().Slice(), nil
+	return c.queryClusters(getClusterFilter(creator), 0, ClusterListOptions{})
 }
 
 // GetCluster gets a cluster key that can be either 'id', 'name' or 'external_id'
@@ -708,6 +828,27 @@ func (c *Client) UpdateClusterDeletionProtection(clusterId string, deleteProtect
 	return nil
 }
 
+// UpdateClusterProperties replaces the properties of the cluster with the given ones, so callers need to
+// include the existing properties that should be preserved.
+func (c *Client) UpdateClusterProperties(clusterID string, properties map[string]string) error {
+	if properties == nil {
+		properties = map[string]string{}
+	}
+	cluster, err := cmv1.NewCluster().Properties(properties).Build()
+	if err != nil {
+		return err
+	}
+	response, err := c.ocm.ClustersMgmt().V1().Clusters().
+		Cluster(clusterID).
+		Update().
+		Body(cluster).
+		Send()
+	if err != nil {
+		return handleErr(response.Error(), err)
+	}
+	return nil
+}
+
 // EnsureNoPendingClusters ensures that no clusters are pending in the account. For non-STS clusters,
 // the osdCcsAdmin user credentials are used to create the cluster, and it is required that these credentials
 // are rotated between cluster creation. If a user is creating a non-STS cluster, we need to therefore make sure
@@ -724,12 +865,12 @@ func (c *Client) EnsureNoPendingClusters(awsCreator *aws.Creator) error {
 		pendingCluster, err := c.GetPendingClusterForARN(awsCreator)
 		if err != nil {
 			reporter.Errorf("Error getting cluster using ARN '%s'", awsCreator.ARN)
-			os.Exit(1)
+			rprtr.Exit(1)
 		}
 		if time.Now().After(deadline) {
 			reporter.Errorf("Timeout waiting for the cluster '%s' installation. Try again in a few minutes",
 				pendingCluster.ID())
-			os.Exit(1)
+			rprtr.Exit(1)
 		}
 		if pendingCluster == nil {
 			break
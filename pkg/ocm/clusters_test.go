@@ -1,9 +1,18 @@
 package ocm
 
 import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
 
 	"github.com/openshift/rosa/pkg/aws"
 )
@@ -111,3 +120,134 @@ var _ = Context("List Clusters", func() {
 
 	})
 })
+
+var _ = Describe("Cluster list options", func() {
+	const baseQuery = "product.id = 'rosa'"
+
+	It("Keeps the query without options", func() {
+		query, err := ClusterListOptions{}.Filter(baseQuery)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(query).To(Equal(baseQuery))
+	})
+
+	It("Appends every filter to the query", func() {
+		hostedCP := true
+		query, err := ClusterListOptions{
+			Search:   "name like 'prod-%'",
+			States:   []string{"ready", "error"},
+			Version:  "4.14",
+			Region:   "us-east-1",
+			HostedCP: &hostedCP,
+			Labels:   []string{"team=it's", "owner"},
+		}.Filter(baseQuery)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(query).To(Equal("product.id = 'rosa' AND (name like 'prod-%') AND state IN ('ready', 'error') AND " +
+			"version.raw_id LIKE '4.14.%' AND region.id = 'us-east-1' AND hypershift.enabled = 'true' AND " +
+			"properties.team = 'it''s' AND properties.owner != ''"))
+	})
+
+	It("Matches a full version exactly", func() {
+		query, err := ClusterListOptions{Version: "4.14.1"}.Filter(baseQuery)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(query).To(Equal("product.id = 'rosa' AND version.raw_id = '4.14.1'"))
+	})
+
+	It("Rejects an unknown state", func() {
+		_, err := ClusterListOptions{States: []string{"running"}}.Filter(baseQuery)
+		Expect(err).To(MatchError(ContainSubstring("Invalid cluster state 'running'")))
+	})
+
+	It("Rejects a label key that isn't a property name", func() {
+		_, err := ClusterListOptions{Labels: []string{"a' OR 'b=c"}}.Filter(baseQuery)
+		Expect(err).To(MatchError(ContainSubstring("Invalid label")))
+	})
+
+	Context("Listing", func() {
+		var ssoServer, apiServer *ghttp.Server
+		var ocmClient *Client
+
+		BeforeEach(func() {
+			ssoServer = MakeTCPServer()
+			apiServer = MakeTCPServer()
+			accessToken := MakeTokenString("Bearer", 15*time.Minute)
+			ssoServer.AppendHandlers(RespondWithAccessToken(accessToken))
+			connection, err := sdk.NewConnectionBuilder().
+				Tokens(accessToken).
+				URL(apiServer.URL()).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			ocmClient = &Client{ocm: connection}
+		})
+
+		AfterEach(func() {
+			ssoServer.Close()
+			apiServer.Close()
+			Expect(ocmClient.Close()).To(Succeed())
+		})
+
+		clusterPage := func(page int, size int, total int, ids ...string) string {
+			items := make([]string, len(ids))
+			for i, id := range ids {
+				items[i] = fmt.Sprintf(`{"kind": "Cluster", "id": "%s"}`, id)
+			}
+			return fmt.Sprintf(`{"kind": "ClusterList", "page": %d, "size": %d, "total": %d, "items": [%s]}`,
+				page, size, total, strings.Join(items, ","))
+		}
+
+		It("Sends the search, order and limit to the server", func() {
+			apiServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters"),
+					ghttp.VerifyFormKV("search", "product.id = 'rosa' AND region.id = 'us-east-1'"),
+					ghttp.VerifyFormKV("order", "name asc"),
+					ghttp.VerifyFormKV("page", "1"),
+					ghttp.VerifyFormKV("size", "2"),
+					RespondWithJSON(http.StatusOK, clusterPage(1, 2, 5, "a", "b")),
+				),
+			)
+			clusters, err := ocmClient.ListClusters(nil, ClusterListOptions{
+				Region:  "us-east-1",
+				OrderBy: "name asc",
+				Limit:   2,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusters).To(HaveLen(2))
+			Expect(apiServer.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("Requests pages until the limit is reached", func() {
+			apiServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyFormKV("page", "1"),
+					ghttp.VerifyFormKV("size", "100"),
+					RespondWithJSON(http.StatusOK, clusterPage(1, 100, 300, pageIDs(0, 100)...)),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyFormKV("page", "2"),
+					RespondWithJSON(http.StatusOK, clusterPage(2, 100, 300, pageIDs(100, 100)...)),
+				),
+			)
+			clusters, err := ocmClient.ListClusters(nil, ClusterListOptions{Limit: 150})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusters).To(HaveLen(150))
+			Expect(clusters[149].ID()).To(Equal("149"))
+		})
+
+		It("Stops at the last page without a limit", func() {
+			apiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, clusterPage(1, 3, 3, "a", "b", "c")),
+			)
+			clusters, err := ocmClient.ListClusters(nil, ClusterListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusters).To(HaveLen(3))
+		})
+	})
+})
+
+func pageIDs(start int, count int) []string {
+	ids := make([]string, count)
+	for i := range ids {
+		ids[i] = strconv.Itoa(start + i)
+	}
+	return ids
+}
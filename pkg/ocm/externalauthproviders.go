@@ -40,6 +40,19 @@ func (c *Client) GetExternalAuths(clusterID string) ([]*cmv1.ExternalAuth, error
 	return response.Items().Slice(), nil
 }
 
+func (c *Client) UpdateExternalAuth(clusterID string, externalAuth *cmv1.ExternalAuth) (*cmv1.ExternalAuth, error) {
+	response, err := c.ocm.ClustersMgmt().V1().
+		Clusters().Cluster(clusterID).
+		ExternalAuthConfig().ExternalAuths().
+		ExternalAuth(externalAuth.ID()).
+		Update().Body(externalAuth).
+		Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Body(), nil
+}
+
 func (c *Client) DeleteExternalAuth(clusterID string, externalAuthId string) error {
 	response, err := c.ocm.ClustersMgmt().V1().
 		Clusters().Cluster(clusterID).
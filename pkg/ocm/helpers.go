@@ -42,6 +42,7 @@ import (
 	errors "github.com/zgalor/weberr"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/errorcode"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/reporter"
@@ -249,7 +250,15 @@ func handleErr(res *ocmerrors.Error, err error) error {
 	}
 	// The error type set will be No Type though
 	errType := errors.ErrorType(res.Status())
-	return errType.Set(errors.Errorf("%s", msg))
+	result := errType.Set(errors.Errorf("%s", msg))
+	// Keep the operation identifier so that the failed request can be found in the logs of the service
+	if res.OperationID() != "" || res.Code() != "" {
+		result = errType.AddDetails(result, errorcode.OCMDetails{
+			OperationID: res.OperationID(),
+			Code:        res.Code(),
+		})
+	}
+	return result
 }
 
 func (c *Client) GetDefaultClusterFlavors(flavour string) (dMachinecidr *net.IPNet, dPodcidr *net.IPNet,
@@ -18,6 +18,7 @@ package ocm
 
 import (
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 	"time"
@@ -34,6 +35,7 @@ import (
 	ocmerrors "github.com/openshift-online/ocm-sdk-go/errors"
 
 	mock "github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/errorcode"
 )
 
 var _ = Describe("Error Handler", func() {
@@ -64,6 +66,21 @@ var _ = Describe("Error Handler", func() {
 		Expect(err).To(HaveOccurred())
 		Expect(err.Error()).To(Equal(sendError.Error()))
 	})
+	It("Keeps the status and the operation identifier", func() {
+		ocmError, err := ocmerrors.NewError().
+			Status(http.StatusConflict).
+			Code("CLUSTERS-MGMT-409").
+			OperationID("123").
+			Reason("test").
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		err = handleErr(ocmError, sendError)
+		Expect(errorcode.Of(err)).To(Equal(errorcode.Conflict))
+		Expect(errorcode.OCMDetailsOf(fmt.Errorf("Failed: %w", err))).To(Equal(&errorcode.OCMDetails{
+			OperationID: "123",
+			Code:        "CLUSTERS-MGMT-409",
+		}))
+	})
 })
 
 var _ = Describe("Http tokens", func() {
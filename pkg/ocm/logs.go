@@ -17,104 +17,108 @@ limitations under the License.
 package ocm
 
 import (
-	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
 	errors "github.com/zgalor/weberr"
 )
 
 const interval = 15 * time.Second
 
-func (c *Client) GetInstallLogs(clusterID string, tail int) (logs *cmv1.Log, err error) {
-	logsClient := c.ocm.ClustersMgmt().V1().Clusters().
-		Cluster(clusterID).
-		Logs().
-		Install()
-	response, err := logsClient.Get().
-		Parameter("tail", tail).
-		Send()
-	if err != nil {
-		err = handleErr(response.Error(), err)
-		if response.Status() == http.StatusNotFound {
-			err = errors.NotFound.UserErrorf("Failed to get logs for cluster '%s'", clusterID)
-		}
-		return
-	}
+// GetInstallLogsFrom returns the install log of the cluster without the given number of lines from the
+// start, so that a log that is being followed only returns the new lines.
+func (c *Client) GetInstallLogsFrom(clusterID string, offset int) (*cmv1.Log, error) {
+	return getLogsFrom(c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).Logs().Install(), clusterID, offset)
+}
 
-	return response.Body(), nil
+// GetUninstallLogsFrom returns the uninstall log of the cluster without the given number of lines from
+// the start, so that a log that is being followed only returns the new lines.
+func (c *Client) GetUninstallLogsFrom(clusterID string, offset int) (*cmv1.Log, error) {
+	return getLogsFrom(c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).Logs().Uninstall(), clusterID, offset)
 }
 
-func (c *Client) GetUninstallLogs(clusterID string, tail int) (logs *cmv1.Log, err error) {
-	logsClient := c.ocm.ClustersMgmt().V1().Clusters().
-		Cluster(clusterID).
-		Logs().
-		Uninstall()
-	response, err := logsClient.Get().
-		Parameter("tail", tail).
-		Send()
+func getLogsFrom(logsClient *cmv1.LogClient, clusterID string, offset int) (*cmv1.Log, error) {
+	request := logsClient.Get()
+	if offset > 0 {
+		request.Offset(offset)
+	}
+	response, err := request.Send()
 	if err != nil {
 		err = handleErr(response.Error(), err)
 		if response.Status() == http.StatusNotFound {
 			err = errors.NotFound.UserErrorf("Failed to get logs for cluster '%s'", clusterID)
 		}
-		return
+		return nil, err
 	}
-
 	return response.Body(), nil
 }
 
-func (c *Client) PollInstallLogs(clusterID string, cb func(*cmv1.LogGetResponse) bool) (logs *cmv1.Log, err error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
-	defer func() {
-		cancel()
-	}()
-
-	logsClient := c.ocm.ClustersMgmt().V1().Clusters().
-		Cluster(clusterID).
-		Logs().
-		Install()
-	response, err := logsClient.Poll().
-		Parameter("tail", 100).
-		Interval(interval).
-		Predicate(cb).
-		StartContext(ctx)
-	if err != nil {
-		err = fmt.Errorf("Failed to poll logs for cluster '%s': %v", clusterID, err)
-		if response.Status() == http.StatusNotFound {
-			err = errors.NotFound.UserErrorf("Failed to poll logs for cluster '%s'", clusterID)
-		}
-		return
-	}
+// ServiceLogListOptions are the filters applied to the service log entries of a cluster.
+type ServiceLogListOptions struct {
+	// Only entries logged at or after this time, if not zero:
+	Since time.Time
 
-	return response.Body(), nil
+	// Only entries with one of these severities, if not empty:
+	Severities []slv1.Severity
+
+	// Only entries logged by this service, if not empty:
+	ServiceName string
 }
 
-func (c *Client) PollUninstallLogs(clusterID string,
-	cb func(*cmv1.LogGetResponse) bool) (logs *cmv1.Log, err error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
-	defer func() {
-		cancel()
-	}()
-
-	logsClient := c.ocm.ClustersMgmt().V1().Clusters().
-		Cluster(clusterID).
-		Logs().
-		Uninstall()
-	response, err := logsClient.Poll().
-		Parameter("tail", 100).
-		Interval(interval).
-		Predicate(cb).
-		StartContext(ctx)
-	if err != nil {
-		err = fmt.Errorf("Failed to poll logs for cluster '%s': %v", clusterID, err)
-		if response.Status() == http.StatusNotFound {
-			err = errors.NotFound.UserErrorf("Failed to poll logs for cluster '%s'", clusterID)
+// ServiceLogSeverities are the severities that can be used to filter the service log entries.
+var ServiceLogSeverities = []slv1.Severity{
+	slv1.SeverityDebug,
+	slv1.SeverityInfo,
+	slv1.SeverityWarning,
+	slv1.SeverityError,
+	slv1.SeverityFatal,
+}
+
+// GetServiceLogs returns the entries of the service log of the cluster that match the options, oldest
+// first.
+func (c *Client) GetServiceLogs(clusterID string, options ServiceLogListOptions) ([]*slv1.LogEntry, error) {
+	var entries []*slv1.LogEntry
+	request := c.ocm.ServiceLogs().V1().Clusters().ClusterLogs().List().
+		ClusterID(clusterID).
+		Order("timestamp asc")
+	query := serviceLogQuery(options)
+	if query != "" {
+		request.Search(query)
+	}
+	size := 100
+	for page := 1; ; page++ {
+		response, err := request.Page(page).Size(size).Send()
+		if err != nil {
+			return nil, handleErr(response.Error(), err)
+		}
+		entries = append(entries, response.Items().Slice()...)
+		if response.Size() < size {
+			break
 		}
-		return
 	}
+	return entries, nil
+}
 
-	return response.Body(), nil
+func serviceLogQuery(options ServiceLogListOptions) string {
+	var conditions []string
+	if !options.Since.IsZero() {
+		conditions = append(conditions,
+			fmt.Sprintf("timestamp >= '%s'", options.Since.UTC().Format(time.RFC3339)))
+	}
+	if len(options.Severities) > 0 {
+		values := make([]string, len(options.Severities))
+		for i, severity := range options.Severities {
+			values[i] = fmt.Sprintf("'%s'", severity)
+		}
+		conditions = append(conditions, fmt.Sprintf("severity in (%s)", strings.Join(values, ", ")))
+	}
+	if options.ServiceName != "" {
+		conditions = append(conditions,
+			fmt.Sprintf("service_name = '%s'", strings.ReplaceAll(options.ServiceName, "'", "''")))
+	}
+	return strings.Join(conditions, " and ")
 }
@@ -0,0 +1,30 @@
+package ocm
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"
+	. "github.com/onsi/gomega"
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+)
+
+var _ = Describe("Service log query", func() {
+	It("Is empty without filters", func() {
+		Expect(serviceLogQuery(ServiceLogListOptions{})).To(BeEmpty())
+	})
+
+	It("Combines all the filters", func() {
+		query := serviceLogQuery(ServiceLogListOptions{
+			Since:       time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+			Severities:  []slv1.Severity{slv1.SeverityWarning, slv1.SeverityError},
+			ServiceName: "SREManualAction",
+		})
+		Expect(query).To(Equal("timestamp >= '2024-05-01T12:00:00Z' and " +
+			"severity in ('Warning', 'Error') and service_name = 'SREManualAction'"))
+	})
+
+	It("Escapes the service name", func() {
+		query := serviceLogQuery(ServiceLogListOptions{ServiceName: "it's"})
+		Expect(query).To(Equal("service_name = 'it''s'"))
+	})
+})
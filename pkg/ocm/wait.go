@@ -0,0 +1,385 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions that wait for asynchronous operations, like hibernating a cluster
+// or scaling a machine pool, to finish. They poll the resource like the functions that watch the
+// installation logs, and stop when it reaches the expected state, a failed state or the timeout.
+
+package ocm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/errorcode"
+)
+
+// Time between two checks of a resource that is being waited for. It is a variable so that the tests
+// don't have to wait.
+var waitInterval = interval
+
+// WaitFailedError is returned when the resource reaches a failed or error state while waiting for it.
+type WaitFailedError struct {
+	Message string
+}
+
+func (e *WaitFailedError) Error() string {
+	return e.Message
+}
+
+func (e *WaitFailedError) ErrorCode() errorcode.Code {
+	return errorcode.WaitFailed
+}
+
+// WaitTimeoutError is returned when the resource doesn't reach the expected state before the timeout.
+type WaitTimeoutError struct {
+	Description string
+	Timeout     time.Duration
+}
+
+func (e *WaitTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out after %s waiting for %s", e.Timeout, e.Description)
+}
+
+func (e *WaitTimeoutError) ErrorCode() errorcode.Code {
+	return errorcode.WaitTimeout
+}
+
+// waitCheck is called by the predicates with the state of the resource. It returns true, which stops
+// the polling, when the resource is done or failed.
+type waitCheck func(done bool, failure string) bool
+
+// waitFor runs the poll function with a context that expires after the timeout, and translates the
+// last check of the resource into the result of the wait.
+func waitFor(description string, timeout time.Duration,
+	poll func(ctx context.Context, check waitCheck) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	done := false
+	failure := ""
+	err := poll(ctx, func(isDone bool, isFailure string) bool {
+		done = isDone
+		failure = isFailure
+		return done || failure != ""
+	})
+	if failure != "" {
+		return &WaitFailedError{Message: failure}
+	}
+	if done {
+		return nil
+	}
+	if err != nil && ctx.Err() == nil {
+		return fmt.Errorf("Failed to wait for %s: %v", description, err)
+	}
+	return &WaitTimeoutError{Description: description, Timeout: timeout}
+}
+
+func clusterFailure(cluster *cmv1.Cluster) string {
+	if cluster.State() != cmv1.ClusterStateError {
+		return ""
+	}
+	message := fmt.Sprintf("Cluster '%s' is in error state", cluster.Name())
+	if cluster.Status().ProvisionErrorMessage() != "" {
+		message = fmt.Sprintf("%s: %s", message, cluster.Status().ProvisionErrorMessage())
+	}
+	return message
+}
+
+// WaitForClusterState waits till the cluster reaches the given state. It fails if the cluster reaches
+// the error state instead.
+func (c *Client) WaitForClusterState(clusterID string, state cmv1.ClusterState, timeout time.Duration) error {
+	description := fmt.Sprintf("cluster '%s' to be %s", clusterID, state)
+	return waitFor(description, timeout, func(ctx context.Context, check waitCheck) error {
+		_, err := c.ocm.ClustersMgmt().V1().Clusters().
+			Cluster(clusterID).
+			Poll().
+			Interval(waitInterval).
+			Predicate(func(response *cmv1.ClusterGetResponse) bool {
+				cluster := response.Body()
+				return check(cluster.State() == state, clusterFailure(cluster))
+			}).
+			StartContext(ctx)
+		return err
+	})
+}
+
+// WaitForClusterDeletion waits till the cluster doesn't exist anymore.
+func (c *Client) WaitForClusterDeletion(clusterID string, timeout time.Duration) error {
+	description := fmt.Sprintf("cluster '%s' to be deleted", clusterID)
+	return waitFor(description, timeout, func(ctx context.Context, check waitCheck) error {
+		_, err := c.ocm.ClustersMgmt().V1().Clusters().
+			Cluster(clusterID).
+			Poll().
+			Interval(waitInterval).
+			Status(http.StatusOK).
+			Status(http.StatusNotFound).
+			Predicate(func(response *cmv1.ClusterGetResponse) bool {
+				if response.Status() == http.StatusNotFound {
+					return check(true, "")
+				}
+				return check(false, clusterFailure(response.Body()))
+			}).
+			StartContext(ctx)
+		return err
+	})
+}
+
+// hiveMachinePool is the part of a Hive machine pool, as returned in the live resources of a classic
+// cluster, that contains the nodes of the machine pool.
+type hiveMachinePool struct {
+	Kind string `json:"kind"`
+	Spec struct {
+		Name string `json:"name"`
+	} `json:"spec"`
+	Status struct {
+		Replicas    int `json:"replicas"`
+		MachineSets []struct {
+			Replicas      int  `json:"replicas"`
+			ReadyReplicas *int `json:"readyReplicas"`
+		} `json:"machineSets"`
+	} `json:"status"`
+}
+
+// Returns the ready nodes of the machine pool from the live resources of the cluster, and false if
+// the machine pool isn't there yet. Machine sets that don't report ready replicas are counted with
+// their replicas.
+func machinePoolNodes(resources map[string]string, machinePoolID string) (int, bool) {
+	for _, resource := range resources {
+		var machinePool hiveMachinePool
+		if json.Unmarshal([]byte(resource), &machinePool) != nil ||
+			machinePool.Kind != "MachinePool" || machinePool.Spec.Name != machinePoolID {
+			continue
+		}
+		if len(machinePool.Status.MachineSets) == 0 {
+			return machinePool.Status.Replicas, true
+		}
+		nodes := 0
+		for _, machineSet := range machinePool.Status.MachineSets {
+			if machineSet.ReadyReplicas != nil {
+				nodes += *machineSet.ReadyReplicas
+			} else {
+				nodes += machineSet.Replicas
+			}
+		}
+		return nodes, true
+	}
+	return 0, false
+}
+
+// WaitForMachinePool waits till the nodes of a classic machine pool match its replicas, or the range
+// of its autoscaling. The nodes are read from the machine pool in the live resources of the cluster,
+// so other machine pools being scaled at the same time don't affect the result.
+func (c *Client) WaitForMachinePool(clusterID string, machinePoolID string, timeout time.Duration) error {
+	machinePool, exists, err := c.GetMachinePool(clusterID, machinePoolID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("Machine pool '%s' doesn't exist", machinePoolID)
+	}
+	minReplicas := machinePool.Replicas()
+	maxReplicas := machinePool.Replicas()
+	if machinePool.Autoscaling() != nil {
+		minReplicas = machinePool.Autoscaling().MinReplicas()
+		maxReplicas = machinePool.Autoscaling().MaxReplicas()
+	}
+
+	description := fmt.Sprintf("machine pool '%s' to be scaled", machinePoolID)
+	return waitFor(description, timeout, func(ctx context.Context, check waitCheck) error {
+		_, err := c.ocm.ClustersMgmt().V1().Clusters().
+			Cluster(clusterID).
+			Resources().
+			Live().
+			Poll().
+			Interval(waitInterval).
+			Predicate(func(response *cmv1.ClusterResourcesGetResponse) bool {
+				nodes, ok := machinePoolNodes(response.Body().Resources(), machinePoolID)
+				return check(ok && nodes >= minReplicas && nodes <= maxReplicas, "")
+			}).
+			StartContext(ctx)
+		return err
+	})
+}
+
+// WaitForNodePool waits till the current replicas of a hosted control plane node pool match the
+// requested ones.
+func (c *Client) WaitForNodePool(clusterID string, nodePoolID string, timeout time.Duration) error {
+	description := fmt.Sprintf("node pool '%s' to be scaled", nodePoolID)
+	return waitFor(description, timeout, func(ctx context.Context, check waitCheck) error {
+		_, err := c.ocm.ClustersMgmt().V1().Clusters().
+			Cluster(clusterID).
+			NodePools().
+			NodePool(nodePoolID).
+			Poll().
+			Interval(waitInterval).
+			Predicate(func(response *cmv1.NodePoolGetResponse) bool {
+				nodePool := response.Body()
+				current, ok := nodePool.Status().GetCurrentReplicas()
+				if !ok {
+					return check(false, "")
+				}
+				if nodePool.Autoscaling() != nil {
+					return check(current >= nodePool.Autoscaling().MinReplica() &&
+						current <= nodePool.Autoscaling().MaxReplica(), "")
+				}
+				return check(current == nodePool.Replicas(), "")
+			}).
+			StartContext(ctx)
+		return err
+	})
+}
+
+// WaitForAddOnInstallation waits till the add-on is ready. It fails if the installation fails.
+func (c *Client) WaitForAddOnInstallation(clusterID string, addOnID string, timeout time.Duration) error {
+	description := fmt.Sprintf("add-on '%s' to be installed", addOnID)
+	return waitFor(description, timeout, func(ctx context.Context, check waitCheck) error {
+		_, err := c.ocm.ClustersMgmt().V1().Clusters().
+			Cluster(clusterID).
+			Addons().
+			Addoninstallation(addOnID).
+			Poll().
+			Interval(waitInterval).
+			Predicate(func(response *cmv1.AddOnInstallationGetResponse) bool {
+				installation := response.Body()
+				if installation.State() == cmv1.AddOnInstallationStateFailed {
+					return check(false, fmt.Sprintf("Add-on '%s' failed to install: %s",
+						addOnID, installation.StateDescription()))
+				}
+				return check(installation.State() == cmv1.AddOnInstallationStateReady, "")
+			}).
+			StartContext(ctx)
+		return err
+	})
+}
+
+// Checks the state of an upgrade policy. The policy is removed once the upgrade is completed, so when
+// it doesn't exist anymore the version is used to tell if the upgrade was completed or cancelled.
+func upgradeCheck(check waitCheck, status int, state *cmv1.UpgradePolicyState,
+	hasVersion func() (bool, error)) bool {
+	if status == http.StatusNotFound {
+		upgraded, err := hasVersion()
+		if err != nil {
+			return check(false, "")
+		}
+		if !upgraded {
+			return check(false, "The upgrade policy was removed before the upgrade was completed")
+		}
+		return check(true, "")
+	}
+	switch state.Value() {
+	case cmv1.UpgradePolicyStateValueCompleted:
+		return check(true, "")
+	case cmv1.UpgradePolicyStateValueFailed, cmv1.UpgradePolicyStateValueCancelled:
+		message := fmt.Sprintf("Upgrade is %s", state.Value())
+		if state.Description() != "" {
+			message = fmt.Sprintf("%s: %s", message, state.Description())
+		}
+		return check(false, message)
+	}
+	return check(false, "")
+}
+
+func (c *Client) clusterHasVersion(clusterID string, version string) func() (bool, error) {
+	return func() (bool, error) {
+		response, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).Get().Send()
+		if err != nil {
+			return false, handleErr(response.Error(), err)
+		}
+		return response.Body().Version().RawID() == version, nil
+	}
+}
+
+// WaitForUpgrade waits till the upgrade policy of a classic cluster is completed.
+func (c *Client) WaitForUpgrade(clusterID string, policyID string, version string, timeout time.Duration) error {
+	description := fmt.Sprintf("cluster '%s' to be upgraded to version '%s'", clusterID, version)
+	return waitFor(description, timeout, func(ctx context.Context, check waitCheck) error {
+		_, err := c.ocm.ClustersMgmt().V1().Clusters().
+			Cluster(clusterID).
+			UpgradePolicies().
+			UpgradePolicy(policyID).
+			State().
+			Poll().
+			Interval(waitInterval).
+			Status(http.StatusOK).
+			Status(http.StatusNotFound).
+			Predicate(func(response *cmv1.UpgradePolicyStateGetResponse) bool {
+				return upgradeCheck(check, response.Status(), response.Body(), c.clusterHasVersion(clusterID, version))
+			}).
+			StartContext(ctx)
+		return err
+	})
+}
+
+// WaitForControlPlaneUpgrade waits till the control plane upgrade policy of a hosted control plane
+// cluster is completed.
+func (c *Client) WaitForControlPlaneUpgrade(clusterID string, policyID string, version string,
+	timeout time.Duration) error {
+	description := fmt.Sprintf("the control plane of cluster '%s' to be upgraded to version '%s'",
+		clusterID, version)
+	return waitFor(description, timeout, func(ctx context.Context, check waitCheck) error {
+		_, err := c.ocm.ClustersMgmt().V1().Clusters().
+			Cluster(clusterID).
+			ControlPlane().
+			UpgradePolicies().
+			ControlPlaneUpgradePolicy(policyID).
+			Poll().
+			Interval(waitInterval).
+			Status(http.StatusOK).
+			Status(http.StatusNotFound).
+			Predicate(func(response *cmv1.ControlPlaneUpgradePolicyGetResponse) bool {
+				return upgradeCheck(check, response.Status(), response.Body().State(),
+					c.clusterHasVersion(clusterID, version))
+			}).
+			StartContext(ctx)
+		return err
+	})
+}
+
+// WaitForNodePoolUpgrade waits till the upgrade policy of a hosted control plane node pool is completed.
+func (c *Client) WaitForNodePoolUpgrade(clusterID string, nodePoolID string, policyID string, version string,
+	timeout time.Duration) error {
+	description := fmt.Sprintf("node pool '%s' to be upgraded to version '%s'", nodePoolID, version)
+	hasVersion := func() (bool, error) {
+		nodePool, exists, err := c.GetNodePool(clusterID, nodePoolID)
+		if err != nil || !exists {
+			return false, err
+		}
+		return nodePool.Version().RawID() == version, nil
+	}
+	return waitFor(description, timeout, func(ctx context.Context, check waitCheck) error {
+		_, err := c.ocm.ClustersMgmt().V1().Clusters().
+			Cluster(clusterID).
+			NodePools().
+			NodePool(nodePoolID).
+			UpgradePolicies().
+			NodePoolUpgradePolicy(policyID).
+			Poll().
+			Interval(waitInterval).
+			Status(http.StatusOK).
+			Status(http.StatusNotFound).
+			Predicate(func(response *cmv1.NodePoolUpgradePolicyGetResponse) bool {
+				return upgradeCheck(check, response.Status(), response.Body().State(), hasVersion)
+			}).
+			StartContext(ctx)
+		return err
+	})
+}
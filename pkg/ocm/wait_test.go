@@ -0,0 +1,162 @@
+package ocm
+
+import (
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+)
+
+var _ = Describe("Wait", func() {
+	const (
+		clusterPath = "/api/clusters_mgmt/v1/clusters/123"
+		policyPath  = clusterPath + "/upgrade_policies/456/state"
+	)
+
+	var ssoServer, apiServer *ghttp.Server
+	var ocmClient *Client
+	var savedInterval time.Duration
+
+	BeforeEach(func() {
+		savedInterval = waitInterval
+		waitInterval = 10 * time.Millisecond
+
+		ssoServer = MakeTCPServer()
+		apiServer = MakeTCPServer()
+		accessToken := MakeTokenString("Bearer", 15*time.Minute)
+		ssoServer.AppendHandlers(RespondWithAccessToken(accessToken))
+		connection, err := sdk.NewConnectionBuilder().
+			Tokens(accessToken).
+			URL(apiServer.URL()).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		ocmClient = &Client{ocm: connection}
+	})
+
+	AfterEach(func() {
+		waitInterval = savedInterval
+		ssoServer.Close()
+		apiServer.Close()
+		Expect(ocmClient.Close()).To(Succeed())
+	})
+
+	respondWithCluster := func(state string) http.HandlerFunc {
+		return ghttp.CombineHandlers(
+			ghttp.VerifyRequest(http.MethodGet, clusterPath),
+			RespondWithJSON(http.StatusOK, `{
+			  "kind": "Cluster",
+			  "id": "123",
+			  "name": "mycluster",
+			  "state": "`+state+`",
+			  "version": {"raw_id": "4.14.2"},
+			  "status": {"provision_error_message": "Quota exceeded"}
+			}`),
+		)
+	}
+
+	It("Waits till the cluster reaches the state", func() {
+		apiServer.AppendHandlers(
+			respondWithCluster("powering_down"),
+			respondWithCluster("powering_down"),
+			respondWithCluster("hibernating"),
+		)
+		err := ocmClient.WaitForClusterState("123", cmv1.ClusterStateHibernating, time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(apiServer.ReceivedRequests()).To(HaveLen(3))
+	})
+
+	It("Fails when the cluster reaches the error state", func() {
+		apiServer.AppendHandlers(
+			respondWithCluster("resuming"),
+			respondWithCluster("error"),
+		)
+		err := ocmClient.WaitForClusterState("123", cmv1.ClusterStateReady, time.Minute)
+		var failed *WaitFailedError
+		Expect(err).To(BeAssignableToTypeOf(failed))
+		Expect(err).To(MatchError("Cluster 'mycluster' is in error state: Quota exceeded"))
+	})
+
+	It("Times out when the cluster doesn't reach the state", func() {
+		apiServer.RouteToHandler(http.MethodGet, clusterPath, respondWithCluster("resuming"))
+		err := ocmClient.WaitForClusterState("123", cmv1.ClusterStateReady, 50*time.Millisecond)
+		var timedOut *WaitTimeoutError
+		Expect(err).To(BeAssignableToTypeOf(timedOut))
+		Expect(err).To(MatchError(ContainSubstring("waiting for cluster '123' to be ready")))
+	})
+
+	It("Waits till the cluster is deleted", func() {
+		apiServer.AppendHandlers(
+			respondWithCluster("uninstalling"),
+			RespondWithJSON(http.StatusNotFound, `{"kind": "Error", "id": "404"}`),
+		)
+		err := ocmClient.WaitForClusterDeletion("123", time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Waits till the upgrade is completed", func() {
+		apiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{"kind": "UpgradePolicyState", "value": "started"}`),
+			RespondWithJSON(http.StatusOK, `{"kind": "UpgradePolicyState", "value": "completed"}`),
+		)
+		err := ocmClient.WaitForUpgrade("123", "456", "4.14.2", time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Checks the version when the upgrade policy is removed", func() {
+		apiServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, policyPath),
+				RespondWithJSON(http.StatusNotFound, `{"kind": "Error", "id": "404"}`),
+			),
+			respondWithCluster("ready"),
+		)
+		err := ocmClient.WaitForUpgrade("123", "456", "4.14.3", time.Minute)
+		Expect(err).To(MatchError("The upgrade policy was removed before the upgrade was completed"))
+	})
+
+	It("Fails when the upgrade fails", func() {
+		apiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+			  "kind": "UpgradePolicyState",
+			  "value": "failed",
+			  "description": "Upgrade failed during cordon"
+			}`),
+		)
+		err := ocmClient.WaitForUpgrade("123", "456", "4.14.2", time.Minute)
+		Expect(err).To(MatchError("Upgrade is failed: Upgrade failed during cordon"))
+	})
+
+	It("Waits till the nodes of the machine pool match its replicas", func() {
+		respondWithResources := func(machineSets string) http.HandlerFunc {
+			return ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, clusterPath+"/resources/live"),
+				RespondWithJSON(http.StatusOK, `{
+				  "kind": "ClusterResources",
+				  "resources": {
+				    "machine_pool_infra": "{\"kind\": \"MachinePool\", \"spec\": {\"name\": \"infra\"}, `+
+					`\"status\": {\"replicas\": 2}}",
+				    "machine_pool_workers": "{\"kind\": \"MachinePool\", \"spec\": {\"name\": \"workers\"}, `+
+					`\"status\": {\"replicas\": 3, \"machineSets\": [`+machineSets+`]}}"
+				  }
+				}`),
+			)
+		}
+		apiServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, clusterPath+"/machine_pools/workers"),
+				RespondWithJSON(http.StatusOK, `{"kind": "MachinePool", "id": "workers", "replicas": 3}`),
+			),
+			respondWithResources(`{\"replicas\": 3, \"readyReplicas\": 1}`),
+			respondWithResources(`{\"replicas\": 2, \"readyReplicas\": 2}, `+
+				`{\"replicas\": 1, \"readyReplicas\": 1}`),
+		)
+		err := ocmClient.WaitForMachinePool("123", "workers", time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(apiServer.ReceivedRequests()).To(HaveLen(3))
+	})
+})
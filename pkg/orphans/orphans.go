@@ -0,0 +1,291 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the finder of the AWS resources that ROSA created for clusters that no longer
+// exist: operator roles, OIDC providers and OIDC configurations. A resource is an orphan when no cluster
+// references it and the cluster that it is tagged with no longer exists. Resources that aren't tagged with
+// a cluster, like the operator roles and OIDC configurations created before the cluster, are only orphans
+// when the unused resources are requested. The clusters are searched in the current OCM environment, so
+// clusters of other environments that share the AWS account make their resources look like orphans.
+
+package orphans
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+type Kind string
+
+const (
+	KindOperatorRoles Kind = "operator-roles"
+	KindOidcProvider  Kind = "oidc-provider"
+	KindOidcConfig    Kind = "oidc-config"
+)
+
+const (
+	// ReasonClusterDeleted is the reason of the resources tagged with a cluster that no longer exists.
+	ReasonClusterDeleted = "cluster deleted"
+
+	// ReasonUnused is the reason of the resources that no cluster uses, but that may be used by a
+	// cluster created later.
+	ReasonUnused = "unused"
+)
+
+// Orphan is a group of resources that are deleted together: the operator roles with the same prefix,
+// an OIDC provider, or an OIDC configuration with its bucket and private key secret.
+type Orphan struct {
+	Kind Kind `json:"kind"`
+
+	// The prefix of the operator roles, the ARN of the OIDC provider or the identifier of the OIDC
+	// configuration:
+	ID string `json:"id"`
+
+	// The cluster that the resources were created for, if they are tagged with it:
+	ClusterID string `json:"cluster_id,omitempty"`
+
+	// Why the resources are orphans, the deletion of their cluster or that no cluster uses them:
+	Reason string `json:"reason"`
+
+	// The names of the operator roles, the ARN of the OIDC provider, or the private key secret and bucket
+	// of the OIDC configuration:
+	Resources []string `json:"resources"`
+
+	// Only for operator roles, true if their policies are managed by AWS and shouldn't be deleted:
+	ManagedPolicies bool `json:"managed_policies,omitempty"`
+
+	// Only for OIDC configurations:
+	IssuerURL  string `json:"issuer_url,omitempty"`
+	Managed    bool   `json:"managed,omitempty"`
+	BucketName string `json:"bucket_name,omitempty"`
+	SecretARN  string `json:"secret_arn,omitempty"`
+}
+
+type Finder struct {
+	ocmClient     *ocm.Client
+	awsClient     aws.Client
+	accountID     string
+	includeUnused bool
+}
+
+// NewFinder creates a finder for the resources of the given AWS account. The resources that no cluster
+// uses, but whose cluster isn't known to be deleted, are only returned if includeUnused is true.
+func NewFinder(ocmClient *ocm.Client, awsClient aws.Client, accountID string, includeUnused bool) *Finder {
+	return &Finder{
+		ocmClient:     ocmClient,
+		awsClient:     awsClient,
+		accountID:     accountID,
+		includeUnused: includeUnused,
+	}
+}
+
+// Find returns the orphaned operator roles, OIDC providers and OIDC configurations, in that order.
+func (f *Finder) Find() ([]Orphan, error) {
+	result := []Orphan{}
+	operatorRoles, err := f.findOperatorRoles()
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, operatorRoles...)
+	oidcProviders, err := f.findOidcProviders()
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, oidcProviders...)
+	oidcConfigs, err := f.findOidcConfigs()
+	if err != nil {
+		return nil, err
+	}
+	return append(result, oidcConfigs...), nil
+}
+
+func (f *Finder) findOperatorRoles() ([]Orphan, error) {
+	roles, err := f.awsClient.ListOperatorRoles("", "", "")
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list operator roles: %v", err)
+	}
+	keys := make([]string, 0, len(roles))
+	for key := range roles {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := []Orphan{}
+	for _, key := range keys {
+		details := roles[key]
+		if len(details) == 0 {
+			continue
+		}
+		// The keys are in lower case, but the search of clusters is case sensitive:
+		prefix := details[0].RoleName[:len(key)]
+		used, err := f.ocmClient.HasAClusterUsingOperatorRolesPrefix(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to check if a cluster uses operator roles prefix '%s': %v",
+				prefix, err)
+		}
+		if used {
+			continue
+		}
+		orphan := Orphan{
+			Kind:            KindOperatorRoles,
+			ID:              prefix,
+			ManagedPolicies: true,
+			Resources:       []string{},
+		}
+		for _, detail := range details {
+			orphan.Resources = append(orphan.Resources, detail.RoleName)
+			orphan.ManagedPolicies = orphan.ManagedPolicies && detail.ManagedPolicy
+			if orphan.ClusterID == "" {
+				orphan.ClusterID = detail.ClusterID
+			}
+		}
+		orphan.Reason, err = f.reason(orphan.ClusterID)
+		if err != nil {
+			return nil, err
+		}
+		if orphan.Reason == "" {
+			continue
+		}
+		sort.Strings(orphan.Resources)
+		result = append(result, orphan)
+	}
+	return result, nil
+}
+
+func (f *Finder) findOidcProviders() ([]Orphan, error) {
+	// Only the providers tagged as managed by Red Hat are returned:
+	providers, err := f.awsClient.ListOidcProviders("", nil)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list OIDC providers: %v", err)
+	}
+	result := []Orphan{}
+	for _, provider := range providers {
+		issuerURL, err := IssuerURL(provider.Arn)
+		if err != nil {
+			return nil, err
+		}
+		used, err := f.ocmClient.HasAClusterUsingOidcEndpointUrl(issuerURL)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to check if a cluster uses OIDC endpoint '%s': %v", issuerURL, err)
+		}
+		if used {
+			continue
+		}
+		reason, err := f.reason(provider.ClusterId)
+		if err != nil {
+			return nil, err
+		}
+		if reason == "" {
+			continue
+		}
+		result = append(result, Orphan{
+			Kind:      KindOidcProvider,
+			ID:        provider.Arn,
+			ClusterID: provider.ClusterId,
+			Reason:    reason,
+			Resources: []string{provider.Arn},
+			IssuerURL: issuerURL,
+		})
+	}
+	return result, nil
+}
+
+// The OIDC configurations aren't tagged with a cluster, as they are usually created before it and can be
+// reused by several clusters, so they are only orphans when the unused resources are requested.
+func (f *Finder) findOidcConfigs() ([]Orphan, error) {
+	if !f.includeUnused {
+		return []Orphan{}, nil
+	}
+	oidcConfigs, err := f.ocmClient.ListOidcConfigs(f.accountID)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list OIDC configurations: %v", err)
+	}
+	result := []Orphan{}
+	for _, oidcConfig := range oidcConfigs {
+		used, err := f.ocmClient.HasAClusterUsingOidcEndpointUrl(oidcConfig.IssuerUrl())
+		if err != nil {
+			return nil, fmt.Errorf("Failed to check if a cluster uses OIDC configuration '%s': %v",
+				oidcConfig.ID(), err)
+		}
+		if used {
+			continue
+		}
+		orphan, err := oidcConfigOrphan(oidcConfig)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, orphan)
+	}
+	return result, nil
+}
+
+// Returns why the resources that no cluster uses and that are tagged with the given cluster are orphans,
+// or an empty string if they aren't.
+func (f *Finder) reason(clusterID string) (string, error) {
+	if clusterID != "" {
+		_, err := f.ocmClient.GetClusterByID(clusterID, nil)
+		if errors.GetType(err) == errors.NotFound {
+			return ReasonClusterDeleted, nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("Failed to check if cluster '%s' exists: %v", clusterID, err)
+		}
+	}
+	if f.includeUnused {
+		return ReasonUnused, nil
+	}
+	return "", nil
+}
+
+func oidcConfigOrphan(oidcConfig *cmv1.OidcConfig) (Orphan, error) {
+	orphan := Orphan{
+		Kind:      KindOidcConfig,
+		ID:        oidcConfig.ID(),
+		Reason:    ReasonUnused,
+		IssuerURL: oidcConfig.IssuerUrl(),
+		Managed:   oidcConfig.Managed(),
+		Resources: []string{},
+	}
+	if orphan.Managed {
+		// The bucket and the secret are in the account of Red Hat, and are deleted by OCM:
+		return orphan, nil
+	}
+	bucketName, err := aws.GetBucketNameFromSecretArn(oidcConfig.SecretArn())
+	if err != nil {
+		return orphan, fmt.Errorf("Failed to get the bucket of OIDC configuration '%s': %v",
+			oidcConfig.ID(), err)
+	}
+	orphan.BucketName = bucketName
+	orphan.SecretARN = oidcConfig.SecretArn()
+	orphan.Resources = []string{oidcConfig.SecretArn(), fmt.Sprintf("s3://%s", bucketName)}
+	return orphan, nil
+}
+
+// IssuerURL returns the URL of the issuer of an OIDC provider from its ARN.
+func IssuerURL(providerARN string) (string, error) {
+	resourceID, err := aws.GetResourceIdFromOidcProviderARN(providerARN)
+	if err != nil {
+		return "", err
+	}
+	return "https://" + strings.TrimSuffix(resourceID, "/"), nil
+}
@@ -0,0 +1,182 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphans
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+const (
+	noClusters  = `{"kind": "ClusterList", "page": 1, "size": 0, "total": 0, "items": []}`
+	oneCluster  = `{"kind": "ClusterList", "page": 1, "size": 1, "total": 1, "items": [{"kind": "Cluster", "id": "1"}]}`
+	providerARN = "arn:aws:iam::123456789012:oidc-provider/oidc.example.com/abcd"
+	secretARN   = "arn:aws:secretsmanager:us-east-1:123456789012:secret:rosa-private-key-oidc-abcd-ZyXwV1"
+)
+
+var _ = Describe("Finder", func() {
+	var t *TestingRuntime
+	var awsClient *aws.MockClient
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+		awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+	})
+
+	It("Returns the resources of the clusters that no longer exist", func() {
+		awsClient.EXPECT().ListOperatorRoles("", "", "").Return(map[string][]aws.OperatorRoleDetail{
+			"used":       {{RoleName: "used-openshift-ingress-operator-cloud-credentials", ClusterID: "1"}},
+			"live-c3d4":  {{RoleName: "live-c3d4-openshift-ingress-operator-cloud-credentials", ClusterID: "456"}},
+			"precreated": {{RoleName: "precreated-openshift-ingress-operator-cloud-credentials"}},
+			"mycluster-a1b2": {
+				{RoleName: "MyCluster-a1b2-openshift-ingress-operator-cloud-credentials", ClusterID: "123",
+					ManagedPolicy: true},
+				{RoleName: "MyCluster-a1b2-kube-system-kube-controller-manager", ClusterID: "123",
+					ManagedPolicy: true},
+			},
+		}, nil)
+		awsClient.EXPECT().ListOidcProviders("", nil).Return([]aws.OidcProviderOutput{
+			{Arn: providerARN, ClusterId: "123"},
+		}, nil)
+		t.ApiServer.AppendHandlers(
+			// Operator roles, sorted by prefix. The ones tagged with a cluster are only orphans if the
+			// cluster doesn't exist, and the pre-created ones aren't orphans:
+			RespondWithJSON(http.StatusOK, noClusters),
+			RespondWithJSON(http.StatusOK, oneCluster),
+			RespondWithJSON(http.StatusOK, noClusters),
+			RespondWithJSON(http.StatusOK, noClusters),
+			RespondWithJSON(http.StatusOK, noClusters),
+			RespondWithJSON(http.StatusOK, oneCluster),
+			// OIDC provider:
+			RespondWithJSON(http.StatusOK, noClusters),
+			RespondWithJSON(http.StatusOK, noClusters),
+		)
+
+		orphans, err := NewFinder(t.RosaRuntime.OCMClient, awsClient, "123456789012", false).Find()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(orphans).To(Equal([]Orphan{
+			{
+				Kind:      KindOperatorRoles,
+				ID:        "MyCluster-a1b2",
+				ClusterID: "123",
+				Reason:    ReasonClusterDeleted,
+				Resources: []string{
+					"MyCluster-a1b2-kube-system-kube-controller-manager",
+					"MyCluster-a1b2-openshift-ingress-operator-cloud-credentials",
+				},
+				ManagedPolicies: true,
+			},
+			{
+				Kind:      KindOidcProvider,
+				ID:        providerARN,
+				ClusterID: "123",
+				Reason:    ReasonClusterDeleted,
+				Resources: []string{providerARN},
+				IssuerURL: "https://oidc.example.com/abcd",
+			},
+		}))
+		Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(8))
+	})
+
+	It("Returns the pre-created roles and the OIDC configurations only when unused ones are requested", func() {
+		awsClient.EXPECT().ListOperatorRoles("", "", "").Return(map[string][]aws.OperatorRoleDetail{
+			"precreated": {{RoleName: "precreated-openshift-ingress-operator-cloud-credentials"}},
+		}, nil)
+		awsClient.EXPECT().ListOidcProviders("", nil).Return([]aws.OidcProviderOutput{}, nil)
+		t.ApiServer.AppendHandlers(
+			// Operator roles:
+			RespondWithJSON(http.StatusOK, noClusters),
+			// OIDC configurations:
+			RespondWithJSON(http.StatusOK, `{"kind": "OidcConfigList", "page": 1, "size": 3, "total": 3,
+				"items": [
+					{"id": "abcd", "issuer_url": "https://oidc.example.com/abcd", "secret_arn": "`+secretARN+`"},
+					{"id": "efgh", "issuer_url": "https://oidc.example.com/efgh", "managed": true},
+					{"id": "ijkl", "issuer_url": "https://oidc.example.com/ijkl", "managed": true}
+				]}`),
+			RespondWithJSON(http.StatusOK, noClusters),
+			RespondWithJSON(http.StatusOK, noClusters),
+			RespondWithJSON(http.StatusOK, oneCluster),
+		)
+
+		orphans, err := NewFinder(t.RosaRuntime.OCMClient, awsClient, "123456789012", true).Find()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(orphans).To(Equal([]Orphan{
+			{
+				Kind:      KindOperatorRoles,
+				ID:        "precreated",
+				Reason:    ReasonUnused,
+				Resources: []string{"precreated-openshift-ingress-operator-cloud-credentials"},
+			},
+			{
+				Kind:       KindOidcConfig,
+				ID:         "abcd",
+				Reason:     ReasonUnused,
+				Resources:  []string{secretARN, "s3://oidc-abcd"},
+				IssuerURL:  "https://oidc.example.com/abcd",
+				BucketName: "oidc-abcd",
+				SecretARN:  secretARN,
+			},
+			{
+				Kind:      KindOidcConfig,
+				ID:        "efgh",
+				Reason:    ReasonUnused,
+				Resources: []string{},
+				IssuerURL: "https://oidc.example.com/efgh",
+				Managed:   true,
+			},
+		}))
+	})
+
+	It("Doesn't return the pre-created roles and the OIDC configurations by default", func() {
+		awsClient.EXPECT().ListOperatorRoles("", "", "").Return(map[string][]aws.OperatorRoleDetail{
+			"precreated": {{RoleName: "precreated-openshift-ingress-operator-cloud-credentials"}},
+		}, nil)
+		awsClient.EXPECT().ListOidcProviders("", nil).Return([]aws.OidcProviderOutput{}, nil)
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, noClusters))
+
+		orphans, err := NewFinder(t.RosaRuntime.OCMClient, awsClient, "123456789012", false).Find()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(orphans).To(BeEmpty())
+		Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("Fails when the clusters can't be checked", func() {
+		awsClient.EXPECT().ListOperatorRoles("", "", "").Return(map[string][]aws.OperatorRoleDetail{
+			"prefix": {{RoleName: "prefix-openshift-ingress-operator-cloud-credentials"}},
+		}, nil)
+		t.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusBadRequest, `{"kind": "Error", "reason": "Boom"}`),
+		)
+
+		_, err := NewFinder(t.RosaRuntime.OCMClient, awsClient, "123456789012", false).Find()
+		Expect(err).To(MatchError(ContainSubstring(
+			"Failed to check if a cluster uses operator roles prefix 'prefix'")))
+	})
+})
+
+var _ = Describe("IssuerURL", func() {
+	It("Returns the issuer URL of the provider", func() {
+		Expect(IssuerURL(providerARN)).To(Equal("https://oidc.example.com/abcd"))
+	})
+})
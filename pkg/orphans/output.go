@@ -0,0 +1,32 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphans
+
+import (
+	"strings"
+
+	"github.com/openshift/rosa/pkg/output"
+)
+
+func NewTable(orphans []Orphan) *output.Table {
+	table := output.NewTable("TYPE", "ID", "CLUSTER ID", "REASON", "RESOURCES")
+	for _, orphan := range orphans {
+		table.AddRow(string(orphan.Kind), orphan.ID, orphan.ClusterID, orphan.Reason,
+			strings.Join(orphan.Resources, ", "))
+	}
+	return table
+}
@@ -20,6 +20,7 @@ package output
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 )
@@ -27,13 +28,21 @@ import (
 const (
 	JSON           = "json"
 	YAML           = "yaml"
+	WIDE           = "wide"
+	CUSTOM_COLUMNS = "custom-columns"
+	JSONPATH       = "jsonpath"
+	GO_TEMPLATE    = "go-template"
 	FLAG_NAME      = "output"
 	FLAG_SHORTHAND = "o"
+
+	NO_HEADERS_FLAG_NAME = "no-headers"
 )
 
 var o string
 
-var formats = []string{JSON, YAML}
+var noHeaders bool
+
+var formats = []string{JSON, YAML, WIDE, CUSTOM_COLUMNS + "=", JSONPATH + "=", GO_TEMPLATE + "="}
 
 // AddFlag adds the interactive flag to the given set of command line flags.
 func AddFlag(cmd *cobra.Command) {
@@ -48,15 +57,70 @@ func AddFlag(cmd *cobra.Command) {
 	cmd.RegisterFlagCompletionFunc(FLAG_NAME, completion)
 }
 
+// AddFlagWithFormats adds the output flag accepting also the given formats. The command must handle
+// them itself, as they aren't supported by Print.
+func AddFlagWithFormats(cmd *cobra.Command, extra ...string) {
+	allowed := append(Formats(), extra...)
+	cmd.Flags().StringVarP(
+		&o,
+		FLAG_NAME,
+		FLAG_SHORTHAND,
+		"",
+		fmt.Sprintf("Output format. Allowed formats are %s", allowed),
+	)
+
+	cmd.RegisterFlagCompletionFunc(FLAG_NAME,
+		func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+			return allowed, cobra.ShellCompDirectiveDefault
+		})
+}
+
+// AddNoHeadersFlag adds the flag used to omit the headers of tables and custom columns.
+func AddNoHeadersFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(
+		&noHeaders,
+		NO_HEADERS_FLAG_NAME,
+		false,
+		"Don't print headers when printing a table or custom columns.",
+	)
+}
+
 func completion(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 	return formats, cobra.ShellCompDirectiveDefault
 }
 
+// Formats returns the output formats supported by the '--output' flag.
+func Formats() []string {
+	return append([]string{}, formats...)
+}
+
+// HasFlag returns true if the output format prints the representation of the resource instead of the
+// table or text printed by default. The wide format is a table, so it returns false for it.
 func HasFlag() bool {
-	return o != ""
+	return o != "" && o != WIDE
+}
+
+// IsWide returns true if tables should include their wide columns.
+func IsWide() bool {
+	return o == WIDE
+}
+
+// NoHeaders returns true if the headers of tables and custom columns should be omitted.
+func NoHeaders() bool {
+	return noHeaders
+}
+
+func SetNoHeaders(value bool) {
+	noHeaders = value
+}
+
+// Splits an output format like 'jsonpath={.id}' into its name and its argument.
+func splitFormat(value string) (string, string) {
+	name, arg, _ := strings.Cut(value, "=")
+	return name, arg
 }
 
-// Enabled retursn a boolean flag that indicates if the interactive mode is enabled.
+// Output returns the output format given with the '--output' flag.
 func Output() string {
 	return o
 }
@@ -28,13 +28,28 @@ var _ = Describe("Output flag", func() {
 		Expect(flag.Name).To(Equal(FLAG_NAME))
 		Expect(flag.Shorthand).To(Equal(FLAG_SHORTHAND))
 		Expect(flag.Value.String()).To(Equal(""))
-		Expect(flag.Usage).To(Equal("Output format. Allowed formats are [json yaml]"))
+		Expect(flag.Usage).To(Equal("Output format. Allowed formats are " +
+			"[json yaml wide custom-columns= jsonpath= go-template=]"))
+	})
+
+	It("Adds flag with extra formats to command", func() {
+		cmd := &cobra.Command{}
+		AddFlagWithFormats(cmd, "csv", "html")
+
+		flag := cmd.Flag(FLAG_NAME)
+		Expect(flag).NotTo(BeNil())
+		Expect(flag.Usage).To(Equal("Output format. Allowed formats are " +
+			"[json yaml wide custom-columns= jsonpath= go-template= csv html]"))
+		completionFunc, ok := cmd.GetFlagCompletionFunc(FLAG_NAME)
+		Expect(ok).To(BeTrue())
+		args, _ := completionFunc(cmd, nil, "")
+		Expect(args).To(ContainElements("csv", "html"))
 	})
 
 	It("Has a completion function", func() {
 		args, directive := completion(nil, nil, "")
-		Expect(len(args)).To(Equal(2))
-		Expect(args).To(ContainElements(JSON, YAML))
+		Expect(len(args)).To(Equal(6))
+		Expect(args).To(ContainElements(JSON, YAML, WIDE, "custom-columns=", "jsonpath=", "go-template="))
 
 		Expect(directive).To(Equal(cobra.ShellCompDirectiveDefault))
 	})
@@ -50,4 +65,19 @@ var _ = Describe("Output flag", func() {
 		Expect(HasFlag()).To(BeFalse())
 	})
 
+	It("Prints tables for the wide format", func() {
+		SetOutput(WIDE)
+		Expect(HasFlag()).To(BeFalse())
+		Expect(IsWide()).To(BeTrue())
+	})
+
+	It("Adds the no headers flag to command", func() {
+		cmd := &cobra.Command{}
+		AddNoHeadersFlag(cmd)
+
+		flag := cmd.Flag(NO_HEADERS_FLAG_NAME)
+		Expect(flag).NotTo(BeNil())
+		Expect(flag.Value.String()).To(Equal("false"))
+	})
+
 })
@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the JSONPath templates used by the 'jsonpath' and 'custom-columns' output
+// formats. They use the same implementation and syntax as kubectl, for example:
+//
+//	{range [*]}{.id}{"\t"}{.version.raw_id}{"\n"}{end}
+
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// Checks that the given JSONPath template can be parsed, so that errors are reported before
+// anything is printed.
+func validateJSONPath(template string) error {
+	_, err := newJSONPath(template)
+	return err
+}
+
+// Writes the given JSONPath template for the given decoded JSON document. The template is parsed
+// every time because the parsed template keeps the state of the 'range' blocks, so it can't be
+// executed more than once.
+func executeJSONPath(w io.Writer, template string, data interface{}) error {
+	parsed, err := newJSONPath(template)
+	if err != nil {
+		return err
+	}
+	err = parsed.Execute(w, data)
+	if err != nil {
+		return fmt.Errorf("Failed to execute JSONPath template '%s': %v", template, err)
+	}
+	return nil
+}
+
+func newJSONPath(template string) (*jsonpath.JSONPath, error) {
+	parsed := jsonpath.New(JSONPATH).AllowMissingKeys(true)
+	err := parsed.Parse(template)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid JSONPath template '%s': %v", template, err)
+	}
+	return parsed, nil
+}
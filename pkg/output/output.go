@@ -27,7 +27,9 @@ import (
 
 	"sigs.k8s.io/yaml"
 
+	atv1 "github.com/openshift-online/ocm-sdk-go/accesstransparency/v1"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
 	msv1 "github.com/openshift-online/ocm-sdk-go/servicemgmt/v1"
 
 	"gitlab.com/c0b/go-ordered-json"
@@ -45,10 +47,46 @@ func Print(resource interface{}) error {
 	var b bytes.Buffer
 
 	switch reflect.TypeOf(resource).String() {
+	case "[]*v1.LogEntry":
+		if logEntries, ok := resource.([]*slv1.LogEntry); ok {
+			slv1.MarshalLogEntryList(logEntries, &b)
+		}
+	case "[]*v1.AccessRequest":
+		if accessRequests, ok := resource.([]*atv1.AccessRequest); ok {
+			atv1.MarshalAccessRequestList(accessRequests, &b)
+		}
+	case "*v1.AccessRequest":
+		if accessRequest, ok := resource.(*atv1.AccessRequest); ok {
+			atv1.MarshalAccessRequest(accessRequest, &b)
+		}
 	case "[]*v1.ManagedService":
 		if managedServices, ok := resource.([]*msv1.ManagedService); ok {
 			msv1.MarshalManagedServiceList(managedServices, &b)
 		}
+	case "*v1.ManagedService":
+		if managedService, ok := resource.(*msv1.ManagedService); ok {
+			msv1.MarshalManagedService(managedService, &b)
+		}
+	case "*v1.AddOn":
+		if addOn, ok := resource.(*cmv1.AddOn); ok {
+			cmv1.MarshalAddOn(addOn, &b)
+		}
+	case "*v1.AddOnInstallation":
+		if addOnInstallation, ok := resource.(*cmv1.AddOnInstallation); ok {
+			cmv1.MarshalAddOnInstallation(addOnInstallation, &b)
+		}
+	case "[]*v1.UpgradePolicy":
+		if upgradePolicies, ok := resource.([]*cmv1.UpgradePolicy); ok {
+			cmv1.MarshalUpgradePolicyList(upgradePolicies, &b)
+		}
+	case "[]*v1.ControlPlaneUpgradePolicy":
+		if upgradePolicies, ok := resource.([]*cmv1.ControlPlaneUpgradePolicy); ok {
+			cmv1.MarshalControlPlaneUpgradePolicyList(upgradePolicies, &b)
+		}
+	case "[]*v1.NodePoolUpgradePolicy":
+		if upgradePolicies, ok := resource.([]*cmv1.NodePoolUpgradePolicy); ok {
+			cmv1.MarshalNodePoolUpgradePolicyList(upgradePolicies, &b)
+		}
 	case "[]*v1.CloudRegion":
 		if cloudRegions, ok := resource.([]*cmv1.CloudRegion); ok {
 			cmv1.MarshalCloudRegionList(cloudRegions, &b)
@@ -212,7 +250,20 @@ func defaultEncode(resource interface{}, b *bytes.Buffer) error {
 }
 
 func parseResource(body bytes.Buffer) (string, error) {
-	switch o {
+	format, arg := splitFormat(o)
+	if arg != "" && (format == JSON || format == YAML || format == WIDE) {
+		format = o
+	}
+	if body.Len() == 0 && (format == CUSTOM_COLUMNS || format == JSONPATH || format == GO_TEMPLATE) {
+		return "", nil
+	}
+	switch format {
+	case CUSTOM_COLUMNS:
+		return printCustomColumns(arg, body.Bytes())
+	case JSONPATH:
+		return printJSONPath(arg, body.Bytes())
+	case GO_TEMPLATE:
+		return printGoTemplate(arg, body.Bytes())
 	case "json":
 		var out bytes.Buffer
 		prettifyJSON(&out, body.Bytes())
@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the table printed by the list commands when no output format, or the 'wide'
+// output format, is given.
+
+package output
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+type column struct {
+	header string
+	wide   bool
+}
+
+// Table collects the rows of a list command and prints them as aligned columns. Wide columns are only
+// printed with '--output wide', and the headers are omitted with '--no-headers'.
+type Table struct {
+	columns []column
+	rows    [][]string
+}
+
+// NewTable creates a table with the given column headers. An empty header adds an empty spacer column,
+// which doesn't take a value in the rows.
+func NewTable(headers ...string) *Table {
+	t := &Table{}
+	for _, header := range headers {
+		t.columns = append(t.columns, column{header: header})
+	}
+	return t
+}
+
+// Wide adds columns that are only printed with '--output wide'.
+func (t *Table) Wide(headers ...string) *Table {
+	for _, header := range headers {
+		t.columns = append(t.columns, column{header: header, wide: true})
+	}
+	return t
+}
+
+// AddRow adds a row with a value for each column that isn't a spacer, including the wide columns.
+func (t *Table) AddRow(values ...interface{}) {
+	row := make([]string, len(values))
+	for i, value := range values {
+		row[i] = fmt.Sprint(value)
+	}
+	t.rows = append(t.rows, row)
+}
+
+// Len returns the number of rows of the table.
+func (t *Table) Len() int {
+	return len(t.rows)
+}
+
+// String returns the rows of the table with the cells separated by tabs, before they are aligned.
+func (t *Table) String() string {
+	var b strings.Builder
+	if !noHeaders {
+		headers := []string{}
+		for _, c := range t.columns {
+			if c.header != "" {
+				headers = append(headers, c.header)
+			}
+		}
+		t.writeRow(&b, headers)
+	}
+	for _, row := range t.rows {
+		t.writeRow(&b, row)
+	}
+	return b.String()
+}
+
+func (t *Table) writeRow(b *strings.Builder, values []string) {
+	cells := []string{}
+	next := 0
+	for _, c := range t.columns {
+		if c.header == "" {
+			cells = append(cells, "")
+			continue
+		}
+		value := ""
+		if next < len(values) {
+			value = values[next]
+		}
+		next++
+		if c.wide && !IsWide() {
+			continue
+		}
+		cells = append(cells, value)
+	}
+	b.WriteString(strings.Join(cells, "\t"))
+	b.WriteString("\n")
+}
+
+// Format returns the aligned rows of the table.
+func (t *Table) Format() string {
+	var b strings.Builder
+	// Writing to a strings.Builder doesn't fail
+	_ = t.Write(&b)
+	return b.String()
+}
+
+// Write writes the aligned rows of the table to the given writer.
+func (t *Table) Write(w io.Writer) error {
+	writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, err := fmt.Fprint(writer, t.String())
+	if err != nil {
+		return err
+	}
+	return writer.Flush()
+}
+
+// Print writes the aligned rows of the table to the standard output.
+func (t *Table) Print() error {
+	return t.Write(os.Stdout)
+}
@@ -0,0 +1,49 @@
+package output
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Table", func() {
+	var table *Table
+
+	BeforeEach(func() {
+		table = NewTable("ID", "", "NAME").Wide("VERSION")
+		table.AddRow("a1", "first", "4.14.1")
+		table.AddRow("b22", "second", 15)
+	})
+
+	AfterEach(func() {
+		SetOutput("")
+		SetNoHeaders(false)
+	})
+
+	It("Keeps spacer columns and hides wide columns", func() {
+		Expect(table.Len()).To(Equal(2))
+		Expect(table.String()).To(Equal("ID\t\tNAME\na1\t\tfirst\nb22\t\tsecond\n"))
+	})
+
+	It("Prints wide columns with the wide format", func() {
+		SetOutput(WIDE)
+		Expect(table.String()).To(Equal("ID\t\tNAME\tVERSION\na1\t\tfirst\t4.14.1\nb22\t\tsecond\t15\n"))
+	})
+
+	It("Omits the headers", func() {
+		SetNoHeaders(true)
+		Expect(table.String()).To(Equal("a1\t\tfirst\nb22\t\tsecond\n"))
+	})
+
+	It("Aligns the columns", func() {
+		Expect(table.Format()).To(Equal("" +
+			"ID     NAME\n" +
+			"a1     first\n" +
+			"b22    second\n"))
+	})
+
+	It("Keeps trailing spacer columns", func() {
+		table = NewTable("ID", "GROUPS", "")
+		table.AddRow("user", "cluster-admins")
+		Expect(table.String()).To(Equal("ID\tGROUPS\t\nuser\tcluster-admins\t\n"))
+	})
+})
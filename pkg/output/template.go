@@ -0,0 +1,150 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions used to implement the 'custom-columns', 'jsonpath' and
+// 'go-template' output formats. They are applied to the same JSON document that is printed by the
+// 'json' format, so a list is an array and a single resource is an object.
+
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+const noneValue = "<none>"
+
+func decodeJSON(body []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var data interface{}
+	err := decoder.Decode(&data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func printJSONPath(expression string, body []byte) (string, error) {
+	if expression == "" {
+		return "", fmt.Errorf("Missing template of the '%s' output format, for example '%s={.id}'",
+			JSONPATH, JSONPATH)
+	}
+	err := validateJSONPath(expression)
+	if err != nil {
+		return "", err
+	}
+	data, err := decodeJSON(body)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	err = executeJSONPath(&b, expression, data)
+	if err != nil {
+		return "", err
+	}
+	return b.String() + "\n", nil
+}
+
+func printGoTemplate(text string, body []byte) (string, error) {
+	if text == "" {
+		return "", fmt.Errorf("Missing template of the '%s' output format, for example '%s={{.id}}'",
+			GO_TEMPLATE, GO_TEMPLATE)
+	}
+	tmpl, err := template.New(GO_TEMPLATE).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("Invalid template: %v", err)
+	}
+	data, err := decodeJSON(body)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	err = tmpl.Execute(&b, data)
+	if err != nil {
+		return "", fmt.Errorf("Failed to execute template: %v", err)
+	}
+	return b.String(), nil
+}
+
+type customColumn struct {
+	header   string
+	template string
+}
+
+// Parses custom columns like 'NAME:.name,VERSION:.version.raw_id'.
+func parseCustomColumns(spec string) ([]customColumn, error) {
+	if spec == "" {
+		return nil, fmt.Errorf("Missing columns of the '%s' output format, for example '%s=ID:.id,NAME:.name'",
+			CUSTOM_COLUMNS, CUSTOM_COLUMNS)
+	}
+	columns := []customColumn{}
+	for _, part := range strings.Split(spec, ",") {
+		header, expression, found := strings.Cut(part, ":")
+		if !found || header == "" || expression == "" {
+			return nil, fmt.Errorf("Invalid custom column '%s', expected 'HEADER:.path'", part)
+		}
+		if !strings.HasPrefix(expression, "{") {
+			expression = "{" + expression + "}"
+		}
+		err := validateJSONPath(expression)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, customColumn{header: header, template: expression})
+	}
+	return columns, nil
+}
+
+func printCustomColumns(spec string, body []byte) (string, error) {
+	columns, err := parseCustomColumns(spec)
+	if err != nil {
+		return "", err
+	}
+	data, err := decodeJSON(body)
+	if err != nil {
+		return "", err
+	}
+	items, ok := data.([]interface{})
+	if !ok {
+		items = []interface{}{data}
+	}
+
+	headers := make([]string, len(columns))
+	for i, column := range columns {
+		headers[i] = column.header
+	}
+	table := NewTable(headers...)
+	for _, item := range items {
+		row := make([]interface{}, len(columns))
+		for i, column := range columns {
+			var b strings.Builder
+			err = executeJSONPath(&b, column.template, item)
+			if err != nil {
+				return "", err
+			}
+			row[i] = b.String()
+			if row[i] == "" {
+				row[i] = noneValue
+			}
+		}
+		table.AddRow(row...)
+	}
+	return table.Format(), nil
+}
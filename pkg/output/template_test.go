@@ -0,0 +1,127 @@
+package output
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var clustersJSON = []byte(`[
+  {
+    "id": "123",
+    "name": "foo",
+    "version": {"raw_id": "4.14.1"},
+    "nodes": {"compute": 3},
+    "properties": {"a": "1", "b": "2"}
+  },
+  {
+    "id": "456",
+    "name": "bar",
+    "nodes": {"compute": 6}
+  }
+]`)
+
+var _ = Describe("Templates", func() {
+	AfterEach(func() {
+		SetOutput("")
+		SetNoHeaders(false)
+	})
+
+	Context("JSONPath", func() {
+		DescribeTable("Prints the selected values",
+			func(template string, expected string) {
+				out, err := printJSONPath(template, clustersJSON)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(out).To(Equal(expected))
+			},
+			Entry("wildcard", "{[*].id}", "123 456\n"),
+			Entry("root", "{$[0].name}", "foo\n"),
+			Entry("negative index", "{[-1].name}", "bar\n"),
+			Entry("nested field", "{[0].version.raw_id}", "4.14.1\n"),
+			Entry("number", "{[1].nodes.compute}", "6\n"),
+			Entry("missing field", "{[1].version.raw_id}", "\n"),
+			Entry("map key", "{[0].properties.b}", "2\n"),
+			Entry("object", "{[0].version}", "{\"raw_id\":\"4.14.1\"}\n"),
+			Entry("range", `{range [*]}{.id}{"\t"}{.name}{"\n"}{end}`, "123\tfoo\n456\tbar\n\n"),
+			Entry("text", "ID={[0].id}", "ID=123\n"),
+			Entry("filter", `{[?(@.id=="456")].name}`, "bar\n"),
+			Entry("recursive descent", "{..raw_id}", "4.14.1\n"),
+			Entry("brace in string literal", `{"}"}{[0].id}`, "}123\n"),
+		)
+
+		DescribeTable("Fails with invalid templates",
+			func(template string) {
+				_, err := printJSONPath(template, clustersJSON)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("empty", ""),
+			Entry("unclosed expression", "{.id"),
+			Entry("unexpected end", "{.id}{end}"),
+			Entry("unclosed filter", "{[?(@.id==\"123\")}"),
+		)
+	})
+
+	Context("Custom columns", func() {
+		It("Prints a row per item", func() {
+			out, err := printCustomColumns("ID:.id,VERSION:.version.raw_id", clustersJSON)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(Equal("" +
+				"ID   VERSION\n" +
+				"123  4.14.1\n" +
+				"456  <none>\n"))
+		})
+
+		It("Prints a single resource", func() {
+			out, err := printCustomColumns("NAME:{.name}", []byte(`{"name": "foo"}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(Equal("NAME\nfoo\n"))
+		})
+
+		It("Executes the same range for every item", func() {
+			out, err := printCustomColumns(`KEYS:{range .properties.*}{@}{end}`, clustersJSON)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(Or(Equal("KEYS\n12\n<none>\n"), Equal("KEYS\n21\n<none>\n")))
+		})
+
+		It("Omits the headers", func() {
+			SetNoHeaders(true)
+			out, err := printCustomColumns("ID:.id", clustersJSON)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(Equal("123\n456\n"))
+		})
+
+		It("Fails with invalid columns", func() {
+			_, err := printCustomColumns("ID", clustersJSON)
+			Expect(err).To(MatchError("Invalid custom column 'ID', expected 'HEADER:.path'"))
+		})
+	})
+
+	Context("Go template", func() {
+		It("Executes the template", func() {
+			out, err := printGoTemplate(`{{range .}}{{.id}}:{{.nodes.compute}} {{end}}`, clustersJSON)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(Equal("123:3 456:6 "))
+		})
+
+		It("Fails with an invalid template", func() {
+			_, err := printGoTemplate(`{{range .}}`, clustersJSON)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("Output format", func() {
+		It("Selects the printer from the output flag", func() {
+			SetOutput("jsonpath={[*].name}")
+			out, err := parseResource(*bytes.NewBuffer(clustersJSON))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(Equal("foo bar\n"))
+		})
+
+		It("Rejects unknown formats", func() {
+			SetOutput("json=foo")
+			_, err := parseResource(*bytes.NewBuffer(clustersJSON))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,126 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/config"
+	"github.com/openshift/rosa/pkg/debug"
+	"github.com/openshift/rosa/pkg/logging"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
+)
+
+// Dispatch runs the plugin named by the arguments when they don't name a builtin command, and returns
+// its exit code. The global flags of rosa can be given before the name of the plugin. It returns false
+// when there is no such plugin, so that the root command reports the unknown command.
+func Dispatch(root *cobra.Command, args []string) (bool, int) {
+	flags := pflag.NewFlagSet(root.Name(), pflag.ContinueOnError)
+	flags.SetInterspersed(false)
+	flags.SetOutput(io.Discard)
+	arguments.AddDebugFlag(flags)
+	arguments.AddContextFlag(flags)
+	arguments.AddProfileFlag(flags)
+	arguments.AddRegionFlag(flags)
+	var outputFormat string
+	flags.StringVarP(&outputFormat, output.FLAG_NAME, output.FLAG_SHORTHAND, "", "")
+	if err := flags.Parse(args); err != nil {
+		return false, 0
+	}
+	rest := flags.Args()
+	if len(rest) == 0 || IsBuiltin(root, rest[0]) {
+		return false, 0
+	}
+	path, pluginArgs, found := Find(rest, exec.LookPath)
+	if !found {
+		return false, 0
+	}
+
+	r := reporter.CreateReporter()
+	r.Debugf("Running plugin '%s'", path)
+	settings := loadSettings(r, outputFormat)
+	code, err := Run(path, pluginArgs, settings.Environ(os.Environ()))
+	if err != nil {
+		r.Errorf("%v", err)
+	}
+	return true, code
+}
+
+// IsBuiltin returns true if the name is a command or an alias of a command of the root command.
+func IsBuiltin(root *cobra.Command, name string) bool {
+	// The help command is added when the root command runs, and the completion commands are hidden
+	if name == "help" || strings.HasPrefix(name, "__") {
+		return true
+	}
+	for _, cmd := range root.Commands() {
+		if cmd.Name() == name || cmd.HasAlias(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Settings that can't be loaded are left empty, as not every plugin needs them.
+func loadSettings(r *reporter.Object, outputFormat string) Settings {
+	settings := Settings{
+		Context: config.SelectedContext(),
+		Debug:   debug.Enabled(),
+		Output:  outputFormat,
+		Profile: arguments.GetProfile(),
+		Region:  arguments.GetRegion(),
+	}
+	if binary, err := os.Executable(); err == nil {
+		settings.Binary = binary
+	}
+	if settings.Region == "" && settings.Profile == "" {
+		if region, err := aws.GetRegion(""); err == nil {
+			settings.Region = region
+		}
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		r.Debugf("Not passing OCM credentials to the plugin, the configuration can't be loaded: %v", err)
+		return settings
+	}
+	if cfg == nil {
+		r.Debugf("Not passing OCM credentials to the plugin, not logged in")
+		return settings
+	}
+	settings.OCMURL = cfg.URL
+	client, err := ocm.NewClient().Logger(logging.NewLogger()).Config(cfg).Build()
+	if err != nil {
+		r.Debugf("Not passing an OCM token to the plugin: %v", err)
+		return settings
+	}
+	defer client.Close()
+	settings.Token, _, err = client.GetConnectionTokens()
+	if err != nil {
+		r.Debugf("Not passing an OCM token to the plugin: %v", err)
+	}
+	return settings
+}
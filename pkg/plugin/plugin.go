@@ -0,0 +1,202 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package plugin implements the plugins of rosa: executables named 'rosa-<name>' found in the PATH
+// that are run when 'rosa <name>' isn't a builtin command. The settings of rosa, including a fresh OCM
+// access token, are passed to them in environment variables so that they don't need to log in.
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const Prefix = "rosa-"
+
+// Environment variables passed to the plugins.
+const (
+	EnvBinary  = "ROSA_BINARY"
+	EnvOCMURL  = "ROSA_OCM_URL"
+	EnvToken   = "ROSA_TOKEN"
+	EnvContext = "ROSA_CONTEXT"
+	EnvDebug   = "ROSA_DEBUG"
+	EnvOutput  = "ROSA_OUTPUT"
+	EnvProfile = "AWS_PROFILE"
+	EnvRegion  = "AWS_REGION"
+)
+
+// Plugin is an executable found in the PATH.
+type Plugin struct {
+	Name     string   `json:"name"`
+	Path     string   `json:"path"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Settings are the settings of rosa passed to the plugins.
+type Settings struct {
+	Binary  string
+	OCMURL  string
+	Token   string
+	Context string
+	Debug   bool
+	Output  string
+	Profile string
+	Region  string
+}
+
+// Environ returns the given environment with the variables of the settings. Empty settings are
+// omitted, so that the plugin sees the value inherited from the environment, if any.
+func (s Settings) Environ(environ []string) []string {
+	result := append([]string{}, environ...)
+	for _, variable := range []struct {
+		name  string
+		value string
+	}{
+		{EnvBinary, s.Binary},
+		{EnvOCMURL, s.OCMURL},
+		{EnvToken, s.Token},
+		{EnvContext, s.Context},
+		{EnvDebug, strconv.FormatBool(s.Debug)},
+		{EnvOutput, s.Output},
+		{EnvProfile, s.Profile},
+		{EnvRegion, s.Region},
+	} {
+		if variable.value != "" {
+			result = append(result, variable.name+"="+variable.value)
+		}
+	}
+	return result
+}
+
+// Find returns the path of the plugin that handles the arguments and the arguments that are passed to
+// it. The longest name wins, so 'rosa foo bar' runs 'rosa-foo-bar' if it exists and 'rosa-foo bar'
+// otherwise. Flags end the name of the plugin.
+func Find(args []string, lookPath func(string) (string, error)) (string, []string, bool) {
+	names := []string{}
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "-") || !isValidName(arg) {
+			break
+		}
+		names = append(names, arg)
+	}
+	for i := len(names); i > 0; i-- {
+		path, err := lookPath(Prefix + strings.Join(names[:i], "-"))
+		if err == nil {
+			return path, args[i:], true
+		}
+	}
+	return "", nil, false
+}
+
+func isValidName(name string) bool {
+	return name != "" && !strings.ContainsAny(name, "/\\=") && name != "." && name != ".."
+}
+
+// List returns the plugins found in the directories of the given PATH, in the order in which they are
+// found. The isBuiltin function tells if the first word of the name of a plugin is a builtin command,
+// which takes precedence over the plugin.
+func List(path string, isBuiltin func(name string) bool) []Plugin {
+	plugins := []Plugin{}
+	found := map[string]string{}
+	seenDirs := map[string]bool{}
+	for _, dir := range filepath.SplitList(path) {
+		if dir == "" || seenDirs[dir] {
+			continue
+		}
+		seenDirs[dir] = true
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		names := []string{}
+		for _, entry := range entries {
+			if !entry.IsDir() && strings.HasPrefix(entry.Name(), Prefix) {
+				names = append(names, entry.Name())
+			}
+		}
+		sort.Strings(names)
+		for _, fileName := range names {
+			plugin := Plugin{
+				Name: commandName(fileName),
+				Path: filepath.Join(dir, fileName),
+			}
+			if plugin.Name == "" {
+				continue
+			}
+			if first, ok := found[plugin.Name]; ok {
+				plugin.Warnings = append(plugin.Warnings, fmt.Sprintf("shadowed by '%s'", first))
+			} else {
+				found[plugin.Name] = plugin.Path
+			}
+			if !isExecutable(plugin.Path) {
+				plugin.Warnings = append(plugin.Warnings, "not executable")
+			}
+			if first := strings.SplitN(plugin.Name, " ", 2)[0]; isBuiltin(first) {
+				plugin.Warnings = append(plugin.Warnings,
+					fmt.Sprintf("overridden by the builtin command 'rosa %s'", first))
+			}
+			plugins = append(plugins, plugin)
+		}
+	}
+	return plugins
+}
+
+// Returns the command that runs the plugin, for example 'foo bar' for 'rosa-foo-bar'.
+func commandName(fileName string) string {
+	name := strings.TrimPrefix(fileName, Prefix)
+	if runtime.GOOS == "windows" {
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+	return strings.Join(strings.FieldsFunc(name, func(r rune) bool {
+		return r == '-'
+	}), " ")
+}
+
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return true
+	}
+	return info.Mode()&0111 != 0
+}
+
+// Run runs the plugin with the standard input and outputs of rosa and returns its exit code.
+func Run(path string, args []string, environ []string) (int, error) {
+	cmd := exec.Command(path, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = environ
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode(), nil
+	}
+	if err != nil {
+		return 1, fmt.Errorf("Failed to run plugin '%s': %v", path, err)
+	}
+	return 0, nil
+}
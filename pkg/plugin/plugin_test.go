@@ -0,0 +1,172 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+)
+
+func writeExecutable(dir string, name string, content string, mode os.FileMode) string {
+	path := filepath.Join(dir, name)
+	Expect(os.WriteFile(path, []byte(content), mode)).To(Succeed())
+	return path
+}
+
+var _ = Describe("Find", func() {
+	lookPath := func(names ...string) func(string) (string, error) {
+		return func(name string) (string, error) {
+			for _, n := range names {
+				if n == name {
+					return "/bin/" + name, nil
+				}
+			}
+			return "", fmt.Errorf("not found")
+		}
+	}
+
+	It("Prefers the longest name", func() {
+		path, args, found := Find([]string{"foo", "bar", "baz"}, lookPath("rosa-foo", "rosa-foo-bar"))
+		Expect(found).To(BeTrue())
+		Expect(path).To(Equal("/bin/rosa-foo-bar"))
+		Expect(args).To(Equal([]string{"baz"}))
+	})
+
+	It("Stops the name at the first flag", func() {
+		path, args, found := Find([]string{"foo", "--bar", "baz"}, lookPath("rosa-foo", "rosa-foo-baz"))
+		Expect(found).To(BeTrue())
+		Expect(path).To(Equal("/bin/rosa-foo"))
+		Expect(args).To(Equal([]string{"--bar", "baz"}))
+	})
+
+	It("Ignores names that are paths", func() {
+		_, _, found := Find([]string{"../foo"}, lookPath("rosa-../foo"))
+		Expect(found).To(BeFalse())
+	})
+})
+
+var _ = Describe("Settings", func() {
+	It("Adds the settings that aren't empty to the environment", func() {
+		settings := Settings{
+			Binary: "/usr/bin/rosa",
+			OCMURL: "https://api.openshift.com",
+			Token:  "token",
+			Region: "us-east-1",
+		}
+		Expect(settings.Environ([]string{"HOME=/home/user"})).To(Equal([]string{
+			"HOME=/home/user",
+			"ROSA_BINARY=/usr/bin/rosa",
+			"ROSA_OCM_URL=https://api.openshift.com",
+			"ROSA_TOKEN=token",
+			"ROSA_DEBUG=false",
+			"AWS_REGION=us-east-1",
+		}))
+	})
+})
+
+var _ = Describe("List", func() {
+	BeforeEach(func() {
+		if runtime.GOOS == "windows" {
+			Skip("The plugins of the test are shell scripts")
+		}
+	})
+
+	It("Reports the plugins that can't be run", func() {
+		first := GinkgoT().TempDir()
+		second := GinkgoT().TempDir()
+		writeExecutable(first, "rosa-cost-tags", "#!/bin/sh\n", 0755)
+		writeExecutable(first, "rosa-list", "#!/bin/sh\n", 0755)
+		writeExecutable(first, "rosa-notes", "", 0644)
+		writeExecutable(first, "other", "#!/bin/sh\n", 0755)
+		writeExecutable(second, "rosa-cost-tags", "#!/bin/sh\n", 0755)
+
+		root := &cobra.Command{Use: "rosa"}
+		root.AddCommand(&cobra.Command{Use: "list"})
+		plugins := List(first+string(os.PathListSeparator)+second, func(name string) bool {
+			return IsBuiltin(root, name)
+		})
+		Expect(plugins).To(Equal([]Plugin{
+			{Name: "cost tags", Path: filepath.Join(first, "rosa-cost-tags")},
+			{
+				Name:     "list",
+				Path:     filepath.Join(first, "rosa-list"),
+				Warnings: []string{"overridden by the builtin command 'rosa list'"},
+			},
+			{Name: "notes", Path: filepath.Join(first, "rosa-notes"), Warnings: []string{"not executable"}},
+			{
+				Name:     "cost tags",
+				Path:     filepath.Join(second, "rosa-cost-tags"),
+				Warnings: []string{fmt.Sprintf("shadowed by '%s'", filepath.Join(first, "rosa-cost-tags"))},
+			},
+		}))
+	})
+
+	It("Runs the plugin and returns its exit code", func() {
+		dir := GinkgoT().TempDir()
+		out := filepath.Join(dir, "out")
+		path := writeExecutable(dir, "rosa-hello",
+			fmt.Sprintf("#!/bin/sh\necho \"$*|$ROSA_TOKEN\" > %s\nexit 4\n", out), 0755)
+
+		code, err := Run(path, []string{"a", "b"}, Settings{Token: "token"}.Environ(nil))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(code).To(Equal(4))
+		Expect(os.ReadFile(out)).To(Equal([]byte("a b|token\n")))
+	})
+})
+
+var _ = Describe("Dispatch", func() {
+	var root *cobra.Command
+
+	BeforeEach(func() {
+		if runtime.GOOS == "windows" {
+			Skip("The plugins of the test are shell scripts")
+		}
+		root = &cobra.Command{Use: "rosa"}
+		root.AddCommand(&cobra.Command{Use: "list", Aliases: []string{"ls"}})
+		dir := GinkgoT().TempDir()
+		writeExecutable(dir, "rosa-list", "#!/bin/sh\nexit 5\n", 0755)
+		writeExecutable(dir, "rosa-hello", "#!/bin/sh\ntest \"$ROSA_OUTPUT\" = json && exit 6\n", 0755)
+		GinkgoT().Setenv("PATH", dir)
+		GinkgoT().Setenv("OCM_CONFIG", filepath.Join(dir, "ocm.json"))
+	})
+
+	It("Leaves the builtin commands to the root command", func() {
+		handled, _ := Dispatch(root, []string{"list"})
+		Expect(handled).To(BeFalse())
+		handled, _ = Dispatch(root, []string{"ls"})
+		Expect(handled).To(BeFalse())
+		handled, _ = Dispatch(root, []string{"unknown"})
+		Expect(handled).To(BeFalse())
+	})
+
+	It("Passes the global flags given before the plugin name", func() {
+		handled, code := Dispatch(root, []string{"--output", "json", "hello"})
+		Expect(handled).To(BeTrue())
+		Expect(code).To(Equal(6))
+	})
+
+	It("Leaves unknown flags to the root command", func() {
+		handled, _ := Dispatch(root, []string{"--unknown", "hello"})
+		Expect(handled).To(BeFalse())
+	})
+})
@@ -32,3 +32,12 @@ const UseLocalCredentials = "use_local_credentials"
 const ProvisionShardId = "provision_shard_id"
 
 const KeyringEnvKey = "OCM_KEYRING"
+
+// Properties that store the hibernation schedule of a cluster, see 'rosa create hibernation-schedule',
+// and when the last action applied by 'rosa run-schedules' was due:
+const (
+	HibernateSchedule = prefix + "hibernate_schedule"
+	ResumeSchedule    = prefix + "resume_schedule"
+	ScheduleTimezone  = prefix + "schedule_timezone"
+	ScheduleApplied   = prefix + "schedule_applied"
+)
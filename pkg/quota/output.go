@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/openshift/rosa/pkg/output"
+)
+
+const (
+	statusOK           = "ok"
+	statusInsufficient = "insufficient"
+)
+
+// NewTable returns a table with the headroom of each quota.
+func NewTable(checks []Check) *output.Table {
+	table := output.NewTable("SERVICE", "QUOTA CODE", "QUOTA NAME", "UNIT", "QUOTA", "USAGE", "REQUIRED",
+		"HEADROOM", "STATUS")
+	for _, check := range checks {
+		status := statusOK
+		if !check.IsSufficient() {
+			status = statusInsufficient
+		}
+		table.AddRow(check.ServiceCode, check.QuotaCode, check.QuotaName, check.Unit, formatValue(check.Quota),
+			formatValue(check.Usage), formatValue(check.Required), formatValue(check.Headroom), status)
+	}
+	return table
+}
+
+// Storage is reported in TiB, so the values are rounded to two decimals.
+func formatValue(value float64) string {
+	return strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64)
+}
@@ -0,0 +1,407 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package quota calculates the AWS service quotas needed by the clusters that are planned, and
+// compares them with the quotas and the current usage of the account.
+package quota
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clusterspec"
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+const (
+	DefaultMachineType = "m5.xlarge"
+
+	// Size, in GiB, of the root volumes of the machines created by the installer
+	defaultWorkerDiskSize       = 300
+	controlPlaneDiskSize        = 350
+	infraDiskSize               = 300
+	bootstrapDiskSize           = 120
+	bootstrapMachineType        = "m5.xlarge"
+	volumeType                  = "gp3"
+	gibPerTib                   = 1024
+	singleAZCount               = 1
+	multiAZCount                = 3
+	controlPlaneReplicas        = 3
+	singleAZInfraReplicas       = 2
+	multiAZInfraReplicas        = 3
+	singleAZDefaultReplicas     = 2
+	multiAZDefaultReplicas      = 3
+	hostedCPDefaultReplicas     = 2
+	mediumClusterWorkerReplicas = 25
+	largeClusterWorkerReplicas  = 100
+)
+
+const (
+	EIPsQuotaCode        = "L-0263D0A3"
+	NatGatewaysQuotaCode = "L-FE5A380F"
+	StorageQuotaCode     = "L-7A658B76"
+)
+
+// Quota identifies an AWS service quota.
+type Quota struct {
+	ServiceCode string
+	QuotaCode   string
+	QuotaName   string
+}
+
+var (
+	eipsQuota = Quota{
+		ServiceCode: "ec2",
+		QuotaCode:   EIPsQuotaCode,
+		QuotaName:   "EC2-VPC Elastic IPs",
+	}
+	natGatewaysQuota = Quota{
+		ServiceCode: "vpc",
+		QuotaCode:   NatGatewaysQuotaCode,
+		QuotaName:   "NAT gateways per Availability Zone",
+	}
+	storageQuota = Quota{
+		ServiceCode: "ebs",
+		QuotaCode:   StorageQuotaCode,
+		QuotaName:   "Storage for General Purpose SSD (gp3) volumes, in TiB",
+	}
+)
+
+// The vCPU quotas of the on demand instances. The families are the letters at the beginning of the
+// instance type, the standard quota covers all the families that aren't listed.
+var (
+	standardVCPUQuota = Quota{
+		ServiceCode: "ec2",
+		QuotaCode:   "L-1216C47A",
+		QuotaName:   "Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances",
+	}
+	vcpuQuotas = map[string]Quota{
+		"dl":  {ServiceCode: "ec2", QuotaCode: "L-6E869C2A", QuotaName: "Running On-Demand DL instances"},
+		"f":   {ServiceCode: "ec2", QuotaCode: "L-74FC7D96", QuotaName: "Running On-Demand F instances"},
+		"g":   {ServiceCode: "ec2", QuotaCode: "L-DB2E81BA", QuotaName: "Running On-Demand G and VT instances"},
+		"vt":  {ServiceCode: "ec2", QuotaCode: "L-DB2E81BA", QuotaName: "Running On-Demand G and VT instances"},
+		"hpc": {ServiceCode: "ec2", QuotaCode: "L-F7808C92", QuotaName: "Running On-Demand HPC instances"},
+		"inf": {ServiceCode: "ec2", QuotaCode: "L-1945791B", QuotaName: "Running On-Demand Inf instances"},
+		"p":   {ServiceCode: "ec2", QuotaCode: "L-417A185B", QuotaName: "Running On-Demand P instances"},
+		"trn": {ServiceCode: "ec2", QuotaCode: "L-2C3B7624", QuotaName: "Running On-Demand Trn instances"},
+		"u":   {ServiceCode: "ec2", QuotaCode: "L-43DA4232", QuotaName: "Running On-Demand High Memory instances"},
+		"x":   {ServiceCode: "ec2", QuotaCode: "L-7295265B", QuotaName: "Running On-Demand X instances"},
+	}
+)
+
+// VCPUQuota returns the vCPU quota that applies to the instance type.
+func VCPUQuota(instanceType string) Quota {
+	family := instanceType
+	end := strings.IndexFunc(instanceType, func(r rune) bool {
+		return r < 'a' || r > 'z'
+	})
+	if end != -1 {
+		family = instanceType[:end]
+	}
+	if quota, ok := vcpuQuotas[family]; ok {
+		return quota
+	}
+	return standardVCPUQuota
+}
+
+// Plan describes the clusters that are going to be created.
+type Plan struct {
+	Region      string
+	MachineType string
+	Replicas    int
+	MultiAZ     bool
+	HostedCP    bool
+	Zones       int
+	BYOVPC      bool
+	DiskSize    int
+	Count       int
+}
+
+// NewPlan returns the plan of a single cluster with the default settings.
+func NewPlan() *Plan {
+	return &Plan{
+		MachineType: DefaultMachineType,
+		DiskSize:    defaultWorkerDiskSize,
+		Count:       1,
+	}
+}
+
+// PlanFromSpec returns the plan of a single cluster created from the spec. When autoscaling is
+// enabled the maximum number of replicas is used.
+func PlanFromSpec(spec *clusterspec.ClusterSpec) (*Plan, error) {
+	s := spec.Spec
+	plan := NewPlan()
+	plan.Region = s.Region
+	plan.MultiAZ = s.MultiAZ
+	plan.HostedCP = s.HostedCP
+	if n := s.Network; n != nil {
+		plan.BYOVPC = len(n.SubnetIDs) > 0
+		plan.Zones = len(n.AvailabilityZones)
+	}
+	if c := s.Compute; c != nil {
+		if c.MachineType != "" {
+			plan.MachineType = c.MachineType
+		}
+		if c.Autoscaling != nil {
+			plan.Replicas = c.Autoscaling.MaxReplicas
+		} else if c.Replicas != nil {
+			plan.Replicas = *c.Replicas
+		}
+		if c.DiskSize != "" {
+			diskSize, err := ocm.ParseDiskSizeToGigibyte(c.DiskSize)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid disk size '%s': %v", c.DiskSize, err)
+			}
+			plan.DiskSize = diskSize
+		}
+	}
+	return plan, nil
+}
+
+// Validate checks the values of the plan.
+func (p *Plan) Validate() error {
+	if p.Count < 1 {
+		return fmt.Errorf("The number of clusters must be at least 1")
+	}
+	if p.Replicas < 0 {
+		return fmt.Errorf("The number of replicas can't be negative")
+	}
+	if p.DiskSize < 0 {
+		return fmt.Errorf("The disk size can't be negative")
+	}
+	return nil
+}
+
+func (p *Plan) zones() int {
+	switch {
+	case p.Zones > 0:
+		return p.Zones
+	case p.MultiAZ:
+		return multiAZCount
+	}
+	return singleAZCount
+}
+
+func (p *Plan) workerReplicas() int {
+	switch {
+	case p.Replicas > 0:
+		return p.Replicas
+	case p.HostedCP:
+		return hostedCPDefaultReplicas
+	case p.MultiAZ:
+		return multiAZDefaultReplicas
+	}
+	return singleAZDefaultReplicas
+}
+
+// Machines returns the number of machines of each instance type that a cluster of the plan runs while
+// it is being installed. The control plane of hosted clusters doesn't run in the account.
+func (p *Plan) Machines() map[string]int {
+	workers := p.workerReplicas()
+	machines := map[string]int{p.MachineType: workers}
+	if p.HostedCP {
+		return machines
+	}
+	controlPlane, infra := "m5.2xlarge", "r5.xlarge"
+	switch {
+	case workers > largeClusterWorkerReplicas:
+		controlPlane, infra = "m5.8xlarge", "r5.4xlarge"
+	case workers > mediumClusterWorkerReplicas:
+		controlPlane, infra = "m5.4xlarge", "r5.2xlarge"
+	}
+	infraReplicas := singleAZInfraReplicas
+	if p.MultiAZ {
+		infraReplicas = multiAZInfraReplicas
+	}
+	machines[controlPlane] += controlPlaneReplicas
+	machines[infra] += infraReplicas
+	machines[bootstrapMachineType]++
+	return machines
+}
+
+// Storage returns the size, in GiB, of the root volumes of a cluster of the plan.
+func (p *Plan) Storage() int {
+	storage := p.workerReplicas() * p.DiskSize
+	if p.HostedCP {
+		return storage
+	}
+	infraReplicas := singleAZInfraReplicas
+	if p.MultiAZ {
+		infraReplicas = multiAZInfraReplicas
+	}
+	return storage + controlPlaneReplicas*controlPlaneDiskSize + infraReplicas*infraDiskSize + bootstrapDiskSize
+}
+
+// NatGateways returns the number of NAT gateways, each with its own elastic IP, that the installer
+// creates for a cluster of the plan: one per availability zone, unless the VPC is provided.
+func (p *Plan) NatGateways() int {
+	if p.BYOVPC || p.HostedCP {
+		return 0
+	}
+	return p.zones()
+}
+
+// Check is the comparison of a quota with its usage and what the plan requires.
+type Check struct {
+	ServiceCode string  `json:"service_code"`
+	QuotaCode   string  `json:"quota_code"`
+	QuotaName   string  `json:"quota_name"`
+	Unit        string  `json:"unit"`
+	Quota       float64 `json:"quota"`
+	Usage       float64 `json:"usage"`
+	Required    float64 `json:"required"`
+	Headroom    float64 `json:"headroom"`
+}
+
+// IsSufficient returns true if the quota leaves room for what the plan requires.
+func (c Check) IsSufficient() bool {
+	return c.Headroom >= 0
+}
+
+type Checker struct {
+	awsClient aws.Client
+}
+
+func NewChecker(awsClient aws.Client) *Checker {
+	return &Checker{
+		awsClient: awsClient,
+	}
+}
+
+// Check compares what the plan requires with the quotas and the current usage of the account.
+func (c *Checker) Check(plan *Plan) ([]Check, error) {
+	err := plan.Validate()
+	if err != nil {
+		return nil, err
+	}
+	checks, err := c.checkVCPUs(plan)
+	if err != nil {
+		return nil, err
+	}
+	if natGateways := plan.NatGateways(); natGateways > 0 {
+		eips, err := c.checkElasticIPs(plan.Count * natGateways)
+		if err != nil {
+			return nil, err
+		}
+		natGateways, err := c.checkNatGateways(plan.Count)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, eips, natGateways)
+	}
+	storage, err := c.checkStorage(plan.Count * plan.Storage())
+	if err != nil {
+		return nil, err
+	}
+	return append(checks, storage), nil
+}
+
+func (c *Checker) checkVCPUs(plan *Plan) ([]Check, error) {
+	machines := plan.Machines()
+	instanceTypes := make([]string, 0, len(machines))
+	for instanceType := range machines {
+		instanceTypes = append(instanceTypes, instanceType)
+	}
+	sort.Strings(instanceTypes)
+	vcpus, err := c.awsClient.GetInstanceTypeVCPUs(instanceTypes)
+	if err != nil {
+		return nil, err
+	}
+	required := map[Quota]int{}
+	for _, instanceType := range instanceTypes {
+		count, ok := vcpus[instanceType]
+		if !ok {
+			return nil, fmt.Errorf("Instance type '%s' isn't available in the region", instanceType)
+		}
+		required[VCPUQuota(instanceType)] += plan.Count * machines[instanceType] * count
+	}
+
+	running, err := c.awsClient.GetRunningInstanceVCPUs()
+	if err != nil {
+		return nil, err
+	}
+	usage := map[Quota]int{}
+	for instanceType, count := range running {
+		usage[VCPUQuota(instanceType)] += count
+	}
+
+	quotas := make([]Quota, 0, len(required))
+	for quota := range required {
+		quotas = append(quotas, quota)
+	}
+	sort.Slice(quotas, func(i, j int) bool {
+		return quotas[i].QuotaCode < quotas[j].QuotaCode
+	})
+	checks := []Check{}
+	for _, quota := range quotas {
+		check, err := c.check(quota, "vCPUs", float64(usage[quota]), float64(required[quota]))
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, check)
+	}
+	return checks, nil
+}
+
+func (c *Checker) checkElasticIPs(required int) (Check, error) {
+	usage, err := c.awsClient.CountElasticIPs()
+	if err != nil {
+		return Check{}, err
+	}
+	return c.check(eipsQuota, "addresses", float64(usage), float64(required))
+}
+
+// The NAT gateway quota applies to each availability zone, so the usage is the one of the busiest zone.
+func (c *Checker) checkNatGateways(required int) (Check, error) {
+	zones, err := c.awsClient.CountNatGatewaysByZone()
+	if err != nil {
+		return Check{}, err
+	}
+	usage := 0
+	for _, count := range zones {
+		if count > usage {
+			usage = count
+		}
+	}
+	return c.check(natGatewaysQuota, "per zone", float64(usage), float64(required))
+}
+
+func (c *Checker) checkStorage(required int) (Check, error) {
+	usage, err := c.awsClient.GetVolumeStorage(volumeType)
+	if err != nil {
+		return Check{}, err
+	}
+	return c.check(storageQuota, "TiB", float64(usage)/gibPerTib, float64(required)/gibPerTib)
+}
+
+func (c *Checker) check(quota Quota, unit string, usage float64, required float64) (Check, error) {
+	value, err := c.awsClient.GetServiceQuotaValue(quota.ServiceCode, quota.QuotaCode)
+	if err != nil {
+		return Check{}, err
+	}
+	return Check{
+		ServiceCode: quota.ServiceCode,
+		QuotaCode:   quota.QuotaCode,
+		QuotaName:   quota.QuotaName,
+		Unit:        unit,
+		Quota:       value,
+		Usage:       usage,
+		Required:    required,
+		Headroom:    value - usage - required,
+	}, nil
+}
@@ -0,0 +1,157 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clusterspec"
+)
+
+var _ = Describe("VCPUQuota", func() {
+	DescribeTable("Finds the quota of the instance family",
+		func(instanceType string, quotaCode string) {
+			Expect(VCPUQuota(instanceType).QuotaCode).To(Equal(quotaCode))
+		},
+		Entry("standard", "m5.xlarge", "L-1216C47A"),
+		Entry("standard with several letters", "im4gn.large", "L-1216C47A"),
+		Entry("G", "g4dn.xlarge", "L-DB2E81BA"),
+		Entry("VT", "vt1.3xlarge", "L-DB2E81BA"),
+		Entry("P", "p4d.24xlarge", "L-417A185B"),
+		Entry("Inf", "inf2.xlarge", "L-1945791B"),
+		Entry("DL", "dl1.24xlarge", "L-6E869C2A"),
+		Entry("Trn", "trn1.2xlarge", "L-2C3B7624"),
+		Entry("High memory", "u-6tb1.metal", "L-43DA4232"),
+	)
+})
+
+var _ = Describe("Plan", func() {
+	It("Uses the defaults of a single zone classic cluster", func() {
+		plan := NewPlan()
+		Expect(plan.Machines()).To(Equal(map[string]int{"m5.xlarge": 3, "m5.2xlarge": 3, "r5.xlarge": 2}))
+		Expect(plan.Storage()).To(Equal(2*300 + 3*350 + 2*300 + 120))
+		Expect(plan.NatGateways()).To(Equal(1))
+	})
+
+	It("Sizes the control plane after the number of workers", func() {
+		plan := NewPlan()
+		plan.MachineType = "c5.4xlarge"
+		plan.Replicas = 30
+		plan.MultiAZ = true
+		Expect(plan.Machines()).To(Equal(map[string]int{
+			"c5.4xlarge": 30, "m5.4xlarge": 3, "r5.2xlarge": 3, "m5.xlarge": 1,
+		}))
+		Expect(plan.NatGateways()).To(Equal(3))
+	})
+
+	It("Only counts the workers of hosted control plane clusters", func() {
+		plan := NewPlan()
+		plan.HostedCP = true
+		Expect(plan.Machines()).To(Equal(map[string]int{"m5.xlarge": 2}))
+		Expect(plan.Storage()).To(Equal(600))
+		Expect(plan.NatGateways()).To(BeZero())
+	})
+
+	It("Reads the plan from a cluster spec", func() {
+		spec, err := clusterspec.Parse([]byte(`apiVersion: rosa.openshift.io/v1alpha1
+kind: ClusterSpec
+spec:
+  name: mycluster
+  region: us-west-2
+  multiAZ: true
+  network:
+    subnetIDs: [subnet-a, subnet-b, subnet-c]
+  compute:
+    machineType: m6i.2xlarge
+    diskSize: 500GiB
+    autoscaling:
+      minReplicas: 3
+      maxReplicas: 9
+`))
+		Expect(err).NotTo(HaveOccurred())
+		plan, err := PlanFromSpec(spec)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan).To(Equal(&Plan{
+			Region:      "us-west-2",
+			MachineType: "m6i.2xlarge",
+			Replicas:    9,
+			MultiAZ:     true,
+			BYOVPC:      true,
+			DiskSize:    500,
+			Count:       1,
+		}))
+		Expect(plan.NatGateways()).To(BeZero())
+	})
+})
+
+var _ = Describe("Checker", func() {
+	It("Reports the headroom of each quota", func() {
+		awsClient := aws.NewMockClient(gomock.NewController(GinkgoT()))
+		plan := NewPlan()
+		plan.MachineType = "g4dn.xlarge"
+		plan.Count = 2
+
+		awsClient.EXPECT().GetInstanceTypeVCPUs([]string{"g4dn.xlarge", "m5.2xlarge", "m5.xlarge", "r5.xlarge"}).
+			Return(map[string]int{"g4dn.xlarge": 4, "m5.2xlarge": 8, "m5.xlarge": 4, "r5.xlarge": 4}, nil)
+		awsClient.EXPECT().GetRunningInstanceVCPUs().Return(map[string]int{"m5.xlarge": 16, "p3.2xlarge": 8}, nil)
+		awsClient.EXPECT().CountElasticIPs().Return(4, nil)
+		awsClient.EXPECT().CountNatGatewaysByZone().Return(map[string]int{"us-east-1a": 2, "us-east-1b": 1}, nil)
+		awsClient.EXPECT().GetVolumeStorage("gp3").Return(1024, nil)
+		awsClient.EXPECT().GetServiceQuotaValue("ec2", "L-1216C47A").Return(100.0, nil)
+		awsClient.EXPECT().GetServiceQuotaValue("ec2", "L-DB2E81BA").Return(8.0, nil)
+		awsClient.EXPECT().GetServiceQuotaValue("ec2", EIPsQuotaCode).Return(5.0, nil)
+		awsClient.EXPECT().GetServiceQuotaValue("vpc", NatGatewaysQuotaCode).Return(5.0, nil)
+		awsClient.EXPECT().GetServiceQuotaValue("ebs", StorageQuotaCode).Return(50.0, nil)
+
+		checks, err := NewChecker(awsClient).Check(plan)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checks).To(HaveLen(5))
+
+		// 2 clusters of 3 control plane, 2 infra, 1 bootstrap and 2 workers
+		Expect(checks[0].QuotaCode).To(Equal("L-1216C47A"))
+		Expect(checks[0].Required).To(Equal(2.0 * (3*8 + 2*4 + 4)))
+		Expect(checks[0].Usage).To(Equal(16.0))
+		Expect(checks[0].Headroom).To(Equal(100.0 - 16 - 72))
+		Expect(checks[0].IsSufficient()).To(BeTrue())
+
+		Expect(checks[1].QuotaCode).To(Equal("L-DB2E81BA"))
+		Expect(checks[1].Required).To(Equal(16.0))
+		Expect(checks[1].Headroom).To(Equal(-8.0))
+		Expect(checks[1].IsSufficient()).To(BeFalse())
+
+		Expect(checks[2].QuotaCode).To(Equal(EIPsQuotaCode))
+		Expect(checks[2].Headroom).To(Equal(-1.0))
+		Expect(checks[3].QuotaCode).To(Equal(NatGatewaysQuotaCode))
+		Expect(checks[3].Headroom).To(Equal(1.0))
+		Expect(checks[4].QuotaCode).To(Equal(StorageQuotaCode))
+		Expect(checks[4].Usage).To(Equal(1.0))
+	})
+
+	It("Rejects instance types that aren't offered", func() {
+		awsClient := aws.NewMockClient(gomock.NewController(GinkgoT()))
+		plan := NewPlan()
+		plan.HostedCP = true
+		plan.MachineType = "m5.huge"
+		awsClient.EXPECT().GetInstanceTypeVCPUs([]string{"m5.huge"}).Return(map[string]int{}, nil)
+
+		_, err := NewChecker(awsClient).Check(plan)
+		Expect(err).To(MatchError("Instance type 'm5.huge' isn't available in the region"))
+	})
+})
@@ -17,14 +17,69 @@ limitations under the License.
 package reporter
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/openshift/rosa/pkg/color"
 	"github.com/openshift/rosa/pkg/debug"
+	"github.com/openshift/rosa/pkg/errorcode"
 )
 
+// When enabled the errors are written to the standard error as JSON documents, see
+// 'errorcode.Envelope', instead of text. This is used with the '--output json' flag, so that
+// automation can parse both the results and the errors.
+var jsonErrors bool
+
+// SetJSONErrors enables or disables the JSON error envelope.
+func SetJSONErrors(value bool) {
+	jsonErrors = value
+}
+
+// exit is replaced by the tests.
+var exit = os.Exit
+
+// SetExit replaces the function that exits the process and returns the previous one. It is used by the
+// tests of the commands that exit themselves.
+func SetExit(value func(int)) func(int) {
+	previous := exit
+	exit = value
+	return previous
+}
+
+// Functions called before an error is reported. Most commands exit right after reporting an error, so
+// this is where the output that they accumulate, like the plan of a dry run, is written.
+var flushHooks []func()
+
+// AddFlushHook adds a function that is called before an error is reported, and before exiting with
+// Exit or ExitWithError.
+func AddFlushHook(hook func()) {
+	flushHooks = append(flushHooks, hook)
+}
+
+// The code of the last error reported with Errorf.
+var lastCode errorcode.Code
+
+// Exit calls the flush hooks and exits with the given code. Commands that finish before returning to
+// cobra must exit with this instead of os.Exit, so that the output that they accumulate isn't lost.
+// Commands exit with 1 after reporting an error with Errorf, so in that case the exit code is the one
+// that matches the code of the reported error.
+func Exit(code int) {
+	flush()
+	if code == errorcode.Unknown.ExitCode() && lastCode != "" {
+		code = lastCode.ExitCode()
+	}
+	exit(code)
+}
+
+func flush() {
+	for _, hook := range flushHooks {
+		hook()
+	}
+}
+
 // Object is the reported object used by the tool. It prints the messages to the standard output or
 // error streams.
 type Object struct {
@@ -60,15 +115,56 @@ func (r *Object) Warnf(format string, args ...interface{}) {
 
 // Errorf prints an error message with the given format and arguments. It also return an error
 // containing the same information, which will be usually discarded, except when the caller needs to
-// report the error and also return it.
+// report the error and also return it. The code of the returned error, and of the JSON error envelope,
+// is the code of the first error in the arguments, and Exit uses it when exiting with 1 afterwards.
 func (r *Object) Errorf(format string, args ...interface{}) error {
-	message := fmt.Sprintf(format, args...)
+	err := newError(fmt.Sprintf(format, args...), args)
+	lastCode = errorcode.Of(err)
+	flush()
+	if jsonErrors {
+		writeEnvelope(os.Stderr, errorcode.NewEnvelope(err))
+	} else {
+		r.printError(err.Error())
+	}
+	return err
+}
+
+// Returns an error with the given message that keeps the first error of the arguments as its cause,
+// so that its code and the details of the OCM operation aren't lost.
+func newError(message string, args []interface{}) error {
+	for _, arg := range args {
+		if cause, ok := arg.(error); ok {
+			return &errorcode.Error{Code: errorcode.Of(cause), Message: message, Err: cause}
+		}
+	}
+	return errors.New(message)
+}
+
+// ExitWithError reports the error and exits with the exit code that matches its error code. When the
+// JSON error envelope is enabled the report also contains the code and the identifier of the failed
+// OCM operation, if any.
+func (r *Object) ExitWithError(err error) {
+	flush()
+	if jsonErrors {
+		writeEnvelope(os.Stderr, errorcode.NewEnvelope(err))
+	} else {
+		r.printError(err.Error())
+	}
+	exit(errorcode.ExitCode(err))
+}
+
+func (r *Object) printError(message string) {
 	if color.UseColor() {
 		_, _ = fmt.Fprintf(os.Stderr, "%s%s\n", errorColorPrefix, message)
 	} else {
 		_, _ = fmt.Fprintf(os.Stderr, "%s%s\n", errorPrefix, message)
 	}
-	return errors.New(message)
+}
+
+func writeEnvelope(w io.Writer, envelope errorcode.Envelope) {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(envelope)
 }
 
 // Message prefix using ANSI scape sequences to set colors:
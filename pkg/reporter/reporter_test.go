@@ -10,6 +10,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/color"
 	"github.com/openshift/rosa/pkg/debug"
+	"github.com/openshift/rosa/pkg/errorcode"
 )
 
 func TestReporter(t *testing.T) {
@@ -109,6 +110,106 @@ var _ = Describe("Reporter Tests", func() {
 		})
 	})
 
+	Context("Exit with error", func() {
+		var exitCode int
+
+		BeforeEach(func() {
+			exitCode = -1
+			exit = func(code int) {
+				exitCode = code
+			}
+		})
+
+		AfterEach(func() {
+			exit = os.Exit
+			lastCode = ""
+			SetJSONErrors(false)
+		})
+
+		It("Prints the message and exits with the code of the error", func() {
+			color.SetColor("never")
+
+			stdOut, stdErr := captureStdOutAndStdError(func() {
+				reporter.ExitWithError(errorcode.New(errorcode.NotFound, "Hello World"))
+			})
+			Expect(stdErr).To(Equal(errorPrefix + "Hello World\n"))
+			Expect(stdOut).To(BeEmpty())
+			Expect(exitCode).To(Equal(7))
+		})
+
+		It("Calls the flush hooks before exiting successfully", func() {
+			previous := flushHooks
+			DeferCleanup(func() {
+				flushHooks = previous
+			})
+			flushed := false
+			AddFlushHook(func() {
+				flushed = true
+			})
+
+			Exit(0)
+			Expect(flushed).To(BeTrue())
+			Expect(exitCode).To(Equal(0))
+		})
+
+		It("Prints the JSON envelope", func() {
+			SetJSONErrors(true)
+
+			_, stdErr := captureStdOutAndStdError(func() {
+				reporter.ExitWithError(errorcode.New(errorcode.Forbidden, "Hello World"))
+			})
+			Expect(stdErr).To(MatchJSON(`{
+				"kind": "Error",
+				"code": "FORBIDDEN",
+				"exit_code": 6,
+				"message": "Hello World"
+			}`))
+			Expect(exitCode).To(Equal(6))
+		})
+
+		It("Prints errors without code as unknown", func() {
+			SetJSONErrors(true)
+
+			_, stdErr := captureStdOutAndStdError(func() {
+				reporter.Errorf("Hello %s", "World")
+			})
+			Expect(stdErr).To(MatchJSON(`{
+				"kind": "Error",
+				"code": "UNKNOWN",
+				"exit_code": 1,
+				"message": "Hello World"
+			}`))
+		})
+
+		It("Prints the code of the error in the arguments", func() {
+			SetJSONErrors(true)
+
+			var err error
+			_, stdErr := captureStdOutAndStdError(func() {
+				err = reporter.Errorf("Failed to get cluster: %v",
+					errorcode.New(errorcode.NotFound, "Cluster 'foo' not found"))
+			})
+			Expect(stdErr).To(MatchJSON(`{
+				"kind": "Error",
+				"code": "NOT_FOUND",
+				"exit_code": 7,
+				"message": "Failed to get cluster: Cluster 'foo' not found"
+			}`))
+			Expect(errorcode.Of(err)).To(Equal(errorcode.NotFound))
+			Expect(errorcode.ExitCode(err)).To(Equal(7))
+		})
+
+		It("Exits with the code of the last reported error", func() {
+			captureStdOutAndStdError(func() {
+				reporter.Errorf("Failed to get cluster: %v", errorcode.New(errorcode.Throttled, "Slow down"))
+			})
+			Exit(1)
+			Expect(exitCode).To(Equal(10))
+			Exit(0)
+			Expect(exitCode).To(Equal(0))
+		})
+	})
+
 	Context("Debug", func() {
 		It("Does not print if debug is not enabled", func() {
 			debug.SetEnabled(false)
@@ -0,0 +1,114 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the enumeration of the account and operator roles that ROSA creates, shared by the
+// commands that check those roles, like 'rosa verify roles' and 'rosa verify permissions --sts'.
+
+package roles
+
+import (
+	"fmt"
+	"sort"
+
+	common "github.com/openshift-online/ocm-common/pkg/aws/validations"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/aws"
+)
+
+const (
+	AccountRole  = "account"
+	OperatorRole = "operator"
+)
+
+// Role is an account or operator role created by ROSA.
+type Role struct {
+	Name string
+	Type string
+
+	// The name of the policy templates of an account role, for example 'installer':
+	File string
+
+	// The key of the credential request of an operator role, and the credential request:
+	Key      string
+	Operator *cmv1.STSOperator
+}
+
+// AccountRoles returns the account roles with the given prefix, sorted by the name of their policy
+// templates.
+func AccountRoles(prefix string, hostedCP bool) []Role {
+	accountRoles := aws.AccountRoles
+	if hostedCP {
+		accountRoles = aws.HCPAccountRoles
+	}
+	files := make([]string, 0, len(accountRoles))
+	for file := range accountRoles {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	result := []Role{}
+	for _, file := range files {
+		result = append(result, Role{
+			Name: common.GetRoleName(prefix, accountRoles[file].Name),
+			Type: AccountRole,
+			File: file,
+		})
+	}
+	return result
+}
+
+// OperatorRoles returns the operator roles of the cluster, sorted by the key of their credential request.
+// The credential requests that the cluster doesn't have a role for are skipped.
+func OperatorRoles(cluster *cmv1.Cluster, credRequests map[string]*cmv1.STSOperator) []Role {
+	keys := make([]string, 0, len(credRequests))
+	for key := range credRequests {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := []Role{}
+	for _, key := range keys {
+		roleName, found := aws.FindOperatorRoleNameBySTSOperator(cluster, credRequests[key])
+		if !found {
+			continue
+		}
+		result = append(result, Role{
+			Name:     roleName,
+			Type:     OperatorRole,
+			Key:      key,
+			Operator: credRequests[key],
+		})
+	}
+	return result
+}
+
+// HasManagedPolicies tells if the account roles with the given prefix use the policies managed by AWS.
+// The hosted control plane account roles always use them.
+func HasManagedPolicies(awsClient aws.Client, prefix string, hostedCP bool) (bool, error) {
+	if hostedCP {
+		return true, nil
+	}
+	roleARN, err := awsClient.GetAccountRoleARN(prefix, aws.AccountRoles[aws.InstallerAccountRole].Name)
+	if err != nil {
+		return false, fmt.Errorf("Failed to get the account roles with prefix '%s': %v", prefix, err)
+	}
+	managedPolicies, err := awsClient.HasManagedPolicies(roleARN)
+	if err != nil {
+		return false, fmt.Errorf("Failed to determine if the role has managed policies: %v", err)
+	}
+	return managedPolicies, nil
+}
@@ -0,0 +1,71 @@
+package roles
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+)
+
+var _ = Describe("AccountRoles", func() {
+	It("Returns the account roles sorted by policy template", func() {
+		accountRoles := AccountRoles("prefix", false)
+		Expect(accountRoles).To(HaveLen(len(aws.AccountRoles)))
+		Expect(accountRoles[0]).To(Equal(Role{
+			Name: "prefix-Installer-Role",
+			Type: AccountRole,
+			File: aws.InstallerAccountRole,
+		}))
+	})
+
+	It("Returns the hosted control plane account roles", func() {
+		accountRoles := AccountRoles("prefix", true)
+		Expect(accountRoles).To(HaveLen(len(aws.HCPAccountRoles)))
+		Expect(accountRoles[0].Name).To(Equal("prefix-HCP-ROSA-Installer-Role"))
+	})
+})
+
+var _ = Describe("OperatorRoles", func() {
+	It("Returns the operator roles of the cluster sorted by credential request", func() {
+		cluster, err := cmv1.NewCluster().AWS(cmv1.NewAWS().STS(cmv1.NewSTS().OperatorIAMRoles(
+			cmv1.NewOperatorIAMRole().Namespace("ns").Name("b").
+				RoleARN("arn:aws:iam::123456789012:role/prefix-b"),
+			cmv1.NewOperatorIAMRole().Namespace("ns").Name("a").
+				RoleARN("arn:aws:iam::123456789012:role/prefix-a"),
+		))).Build()
+		Expect(err).NotTo(HaveOccurred())
+		operator := func(name string) *cmv1.STSOperator {
+			operator, err := cmv1.NewSTSOperator().Namespace("ns").Name(name).Build()
+			Expect(err).NotTo(HaveOccurred())
+			return operator
+		}
+		credRequests := map[string]*cmv1.STSOperator{
+			"b_operator":       operator("b"),
+			"a_operator":       operator("a"),
+			"missing_operator": operator("missing"),
+		}
+
+		operatorRoles := OperatorRoles(cluster, credRequests)
+		Expect(operatorRoles).To(Equal([]Role{
+			{Name: "prefix-a", Type: OperatorRole, Key: "a_operator", Operator: credRequests["a_operator"]},
+			{Name: "prefix-b", Type: OperatorRole, Key: "b_operator", Operator: credRequests["b_operator"]},
+		}))
+	})
+})
+
+var _ = Describe("HasManagedPolicies", func() {
+	It("Checks the installer role", func() {
+		awsClient := aws.NewMockClient(gomock.NewController(GinkgoT()))
+		roleARN := "arn:aws:iam::123456789012:role/prefix-Installer-Role"
+		awsClient.EXPECT().GetAccountRoleARN("prefix", "Installer").Return(roleARN, nil)
+		awsClient.EXPECT().HasManagedPolicies(roleARN).Return(true, nil)
+		Expect(HasManagedPolicies(awsClient, "prefix", false)).To(BeTrue())
+	})
+
+	It("Always uses managed policies for hosted control planes", func() {
+		awsClient := aws.NewMockClient(gomock.NewController(GinkgoT()))
+		Expect(HasManagedPolicies(awsClient, "prefix", true)).To(BeTrue())
+	})
+})
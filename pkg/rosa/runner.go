@@ -2,7 +2,6 @@ package rosa
 
 import (
 	"context"
-	"os"
 
 	"github.com/spf13/cobra"
 )
@@ -28,8 +27,7 @@ func DefaultRunner(visitor RuntimeVisitor, runner CommandRunner) func(command *c
 
 		err := runner(ctx, r, command, args)
 		if err != nil {
-			r.Reporter.Errorf(err.Error())
-			os.Exit(1)
+			r.Reporter.ExitWithError(err)
 		}
 	}
 }
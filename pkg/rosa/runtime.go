@@ -1,7 +1,7 @@
 package rosa
 
 import (
-	"os"
+	"fmt"
 	"time"
 
 	"github.com/briandowns/spinner"
@@ -47,7 +47,7 @@ func (r *Runtime) WithAWS() *Runtime {
 	err := r.OCMClient.ValidateAwsClientRegion()
 	if err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if r.AWSClient == nil {
 		r.AWSClient = aws.CreateNewClientOrExit(r.Logger, r.Reporter)
@@ -57,7 +57,7 @@ func (r *Runtime) WithAWS() *Runtime {
 		r.Creator, err = r.AWSClient.GetCreator()
 		if err != nil {
 			r.Reporter.Errorf("Failed to get AWS creator: %v", err)
-			os.Exit(1)
+			reporter.Exit(1)
 		}
 	}
 	return r
@@ -76,7 +76,7 @@ func (r *Runtime) GetClusterKey() string {
 	clusterKey, err := ocm.GetClusterKey()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	r.ClusterKey = clusterKey
 	return clusterKey
@@ -90,7 +90,7 @@ func (r *Runtime) FetchCluster() *cmv1.Cluster {
 	// We don't want to lazy init the OCM client since it requires cleanup
 	if r.OCMClient == nil {
 		r.Reporter.Errorf("Tried to fetch a cluster without initializing the OCM client, exiting.")
-		os.Exit(1)
+		reporter.Exit(1)
 	}
 	if r.ClusterKey == "" {
 		r.GetClusterKey()
@@ -102,8 +102,7 @@ func (r *Runtime) FetchCluster() *cmv1.Cluster {
 	r.Reporter.Debugf("Loading cluster '%s'", r.ClusterKey)
 	cluster, err := r.OCMClient.GetCluster(r.ClusterKey, r.Creator)
 	if err != nil {
-		r.Reporter.Errorf("Failed to get cluster '%s': %v", r.ClusterKey, err)
-		os.Exit(1)
+		r.Reporter.ExitWithError(fmt.Errorf("Failed to get cluster '%s': %w", r.ClusterKey, err))
 	}
 	r.Cluster = cluster
 	return cluster
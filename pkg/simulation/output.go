@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulation
+
+import (
+	"github.com/openshift/rosa/pkg/output"
+)
+
+const (
+	allowed      = "allowed"
+	denied       = "denied"
+	notSimulated = "not simulated"
+)
+
+// NewTable returns a table with a row for each role and action. The roles that couldn't be simulated
+// have a single row with the error, and the actions that weren't simulated are only listed with the
+// allowed ones.
+func NewTable(results []Result, deniedOnly bool) *output.Table {
+	table := output.NewTable("ROLE NAME", "TYPE", "ACTION", "DECISION", "DENIED BY")
+	for _, result := range results {
+		if result.Error != "" {
+			table.AddRow(result.RoleName, result.RoleType, "", "error", result.Error)
+			continue
+		}
+		for _, action := range result.Actions {
+			if action.Allowed {
+				if !deniedOnly {
+					table.AddRow(result.RoleName, result.RoleType, action.Name, allowed, "")
+				}
+				continue
+			}
+			table.AddRow(result.RoleName, result.RoleType, action.Name, denied, action.DeniedBy)
+		}
+		if deniedOnly {
+			continue
+		}
+		for _, action := range result.NotSimulated {
+			table.AddRow(result.RoleName, result.RoleType, action, notSimulated, "")
+		}
+	}
+	return table
+}
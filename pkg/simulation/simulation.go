@@ -0,0 +1,247 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the simulation of the actions that the account and operator roles need, so that
+// denials by service control policies or permissions boundaries are found before installing a cluster.
+
+package simulation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/roles"
+)
+
+const (
+	DeniedByOrganizations       = "service control policy"
+	DeniedByPermissionsBoundary = "permissions boundary"
+	DeniedExplicitly            = "explicit deny"
+	DeniedByRolePolicies        = "role policies"
+)
+
+// Role is a role and the keys of the policy templates that contain the actions that it needs.
+type Role struct {
+	Name       string
+	Type       string
+	PolicyKeys []string
+}
+
+// Action is the result of the simulation of an action.
+type Action struct {
+	Name     string `json:"name"`
+	Allowed  bool   `json:"allowed"`
+	DeniedBy string `json:"denied_by,omitempty"`
+}
+
+// Result is the result of the simulation of the actions of a role. The actions that are only allowed with
+// conditions or on specific resources aren't simulated, as the simulation would deny them.
+type Result struct {
+	RoleName     string   `json:"role_name"`
+	RoleType     string   `json:"role_type"`
+	Actions      []Action `json:"actions"`
+	NotSimulated []string `json:"not_simulated,omitempty"`
+	Error        string   `json:"error,omitempty"`
+}
+
+// Denied returns the number of denied actions.
+func (r Result) Denied() int {
+	denied := 0
+	for _, action := range r.Actions {
+		if !action.Allowed {
+			denied++
+		}
+	}
+	return denied
+}
+
+// AccountRoles returns the account roles with the given prefix.
+func AccountRoles(prefix string, hostedCP bool, managedPolicies bool) []Role {
+	result := []Role{}
+	for _, role := range roles.AccountRoles(prefix, hostedCP) {
+		var keys []string
+		switch {
+		case hostedCP:
+			keys = []string{fmt.Sprintf("sts_hcp_%s_permission_policy", role.File)}
+		case managedPolicies:
+			keys = aws.GetAccountRolePolicyKeys(role.File)
+		default:
+			keys = []string{fmt.Sprintf("sts_%s_permission_policy", role.File)}
+		}
+		result = append(result, Role{
+			Name:       role.Name,
+			Type:       role.Type,
+			PolicyKeys: keys,
+		})
+	}
+	return result
+}
+
+// OperatorRoles returns the operator roles of the cluster.
+func OperatorRoles(cluster *cmv1.Cluster, credRequests map[string]*cmv1.STSOperator) []Role {
+	isSharedVpc := cluster.AWS().PrivateHostedZoneRoleARN() != ""
+	result := []Role{}
+	for _, role := range roles.OperatorRoles(cluster, credRequests) {
+		result = append(result, Role{
+			Name:       role.Name,
+			Type:       role.Type,
+			PolicyKeys: []string{aws.GetOperatorPolicyKey(role.Key, cluster.Hypershift().Enabled(), isSharedVpc)},
+		})
+	}
+	return result
+}
+
+type Simulator struct {
+	awsClient aws.Client
+	policies  map[string]*cmv1.AWSSTSPolicy
+}
+
+func NewSimulator(awsClient aws.Client, policies map[string]*cmv1.AWSSTSPolicy) *Simulator {
+	return &Simulator{
+		awsClient: awsClient,
+		policies:  policies,
+	}
+}
+
+// Simulate simulates the actions that the role needs. The actions with wildcards can't be simulated, so
+// they are skipped, and the actions of statements with conditions or specific resources are reported as
+// not simulated.
+func (s *Simulator) Simulate(role Role) Result {
+	result := Result{
+		RoleName: role.Name,
+		RoleType: role.Type,
+		Actions:  []Action{},
+	}
+	err := s.simulate(role, &result)
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+func (s *Simulator) simulate(role Role, result *Result) error {
+	actions, notSimulated, err := s.requiredActions(role.PolicyKeys)
+	if err != nil {
+		return err
+	}
+	result.NotSimulated = notSimulated
+	if len(actions) == 0 {
+		return nil
+	}
+	liveRole, err := s.awsClient.GetRoleByName(role.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get role: %v", err)
+	}
+	simulations, err := s.awsClient.SimulatePermissions(awssdk.ToString(liveRole.Arn), actions)
+	if err != nil {
+		return err
+	}
+	for _, simulation := range simulations {
+		action := Action{
+			Name:    simulation.Action,
+			Allowed: simulation.IsAllowed(),
+		}
+		if !action.Allowed {
+			action.DeniedBy = deniedBy(simulation)
+		}
+		result.Actions = append(result.Actions, action)
+	}
+	return nil
+}
+
+// Returns the actions allowed by the policies, sorted and without duplicates, and the actions that are
+// only allowed with conditions or on specific resources. The documents of the policies managed by AWS are
+// fetched from AWS.
+func (s *Simulator) requiredActions(keys []string) (actions []string, notSimulated []string, err error) {
+	unconditional := map[string]bool{}
+	conditional := map[string]bool{}
+	for _, key := range keys {
+		policy, ok := s.policies[key]
+		if !ok {
+			return nil, nil, fmt.Errorf("failed to find policy '%s'", key)
+		}
+		document := policy.Details()
+		if document == "" && policy.ARN() != "" {
+			var err error
+			document, err = s.awsClient.GetDefaultPolicyDocument(policy.ARN())
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get policy '%s': %v", policy.ARN(), err)
+			}
+		}
+		parsed, err := aws.ParsePolicyDocument(document)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse policy '%s': %v", key, err)
+		}
+		for _, statement := range parsed.Statement {
+			if statement.Effect != "Allow" {
+				continue
+			}
+			set := unconditional
+			if !simulable(statement) {
+				set = conditional
+			}
+			for _, action := range statement.GetActions() {
+				if !strings.Contains(action, "*") {
+					set[action] = true
+				}
+			}
+		}
+	}
+	actions = []string{}
+	for action := range unconditional {
+		actions = append(actions, action)
+	}
+	for action := range conditional {
+		if !unconditional[action] {
+			notSimulated = append(notSimulated, action)
+		}
+	}
+	sort.Strings(actions)
+	sort.Strings(notSimulated)
+	return actions, notSimulated, nil
+}
+
+// Returns true if the statement allows its actions on all the resources and without conditions, as the
+// actions are simulated without resources and with the region as the only context key.
+func simulable(statement aws.PolicyStatement) bool {
+	if len(statement.Condition) > 0 {
+		return false
+	}
+	for _, resource := range statement.GetResources() {
+		if resource != "*" {
+			return false
+		}
+	}
+	return true
+}
+
+func deniedBy(simulation aws.SimulationResult) string {
+	switch {
+	case simulation.DeniedByOrganizations:
+		return DeniedByOrganizations
+	case simulation.DeniedByPermissionsBoundary:
+		return DeniedByPermissionsBoundary
+	case simulation.Decision == string(iamtypes.PolicyEvaluationDecisionTypeExplicitDeny):
+		return DeniedExplicitly
+	}
+	return DeniedByRolePolicies
+}
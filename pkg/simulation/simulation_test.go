@@ -0,0 +1,162 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulation
+
+import (
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/roles"
+)
+
+const (
+	roleName = "prefix-Installer-Role"
+	roleARN  = "arn:aws:iam::123456789012:role/prefix-Installer-Role"
+	document = `{"Version": "2012-10-17", "Statement": [
+		{"Effect": "Allow", "Action": ["s3:GetObject", "ec2:DescribeRegions", "iam:Get*"], "Resource": "*"},
+		{"Effect": "Deny", "Action": "s3:DeleteBucket", "Resource": "*"}
+	]}`
+	managedDocument = `{"Version": "2012-10-17", "Statement": [
+		{"Effect": "Allow", "Action": ["ec2:DescribeRegions", "sts:AssumeRole"], "Resource": "*"}
+	]}`
+	managedARN = "arn:aws:iam::aws:policy/service-role/ROSAInstallerPolicy"
+)
+
+var _ = Describe("AccountRoles", func() {
+	It("Uses the permission policy of each role", func() {
+		accountRoles := AccountRoles("prefix", false, false)
+		Expect(accountRoles).To(HaveLen(len(aws.AccountRoles)))
+		Expect(accountRoles).To(ContainElement(Role{
+			Name:       roleName,
+			Type:       roles.AccountRole,
+			PolicyKeys: []string{"sts_installer_permission_policy"},
+		}))
+	})
+
+	It("Uses the hosted control plane policies", func() {
+		accountRoles := AccountRoles("prefix", true, true)
+		Expect(accountRoles).To(HaveLen(len(aws.HCPAccountRoles)))
+		Expect(accountRoles).To(ContainElement(Role{
+			Name:       "prefix-HCP-ROSA-Installer-Role",
+			Type:       roles.AccountRole,
+			PolicyKeys: []string{"sts_hcp_installer_permission_policy"},
+		}))
+	})
+})
+
+var _ = Describe("Simulator", func() {
+	var (
+		awsClient *aws.MockClient
+		policies  map[string]*cmv1.AWSSTSPolicy
+		simulator *Simulator
+		role      Role
+	)
+
+	BeforeEach(func() {
+		awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+		policy, err := cmv1.NewAWSSTSPolicy().Details(document).Build()
+		Expect(err).NotTo(HaveOccurred())
+		managed, err := cmv1.NewAWSSTSPolicy().ARN(managedARN).Build()
+		Expect(err).NotTo(HaveOccurred())
+		policies = map[string]*cmv1.AWSSTSPolicy{
+			"sts_installer_permission_policy": policy,
+			"sts_installer_managed_policy":    managed,
+		}
+		simulator = NewSimulator(awsClient, policies)
+		role = Role{
+			Name:       roleName,
+			Type:       roles.AccountRole,
+			PolicyKeys: []string{"sts_installer_permission_policy", "sts_installer_managed_policy"},
+		}
+		awsClient.EXPECT().GetDefaultPolicyDocument(managedARN).Return(managedDocument, nil).AnyTimes()
+	})
+
+	It("Simulates the allowed actions without wildcards and reports who denies them", func() {
+		awsClient.EXPECT().GetRoleByName(roleName).Return(iamtypes.Role{Arn: awssdk.String(roleARN)}, nil)
+		awsClient.EXPECT().SimulatePermissions(roleARN,
+			[]string{"ec2:DescribeRegions", "s3:GetObject", "sts:AssumeRole"}).Return([]aws.SimulationResult{
+			{Action: "ec2:DescribeRegions", Decision: "allowed"},
+			{Action: "s3:GetObject", Decision: "implicitDeny", DeniedByOrganizations: true},
+			{Action: "sts:AssumeRole", Decision: "implicitDeny", DeniedByPermissionsBoundary: true},
+		}, nil)
+
+		result := simulator.Simulate(role)
+		Expect(result.Error).To(BeEmpty())
+		Expect(result.Denied()).To(Equal(2))
+		Expect(result.Actions).To(Equal([]Action{
+			{Name: "ec2:DescribeRegions", Allowed: true},
+			{Name: "s3:GetObject", DeniedBy: DeniedByOrganizations},
+			{Name: "sts:AssumeRole", DeniedBy: DeniedByPermissionsBoundary},
+		}))
+	})
+
+	It("Distinguishes explicit denials from missing permissions", func() {
+		awsClient.EXPECT().GetRoleByName(roleName).Return(iamtypes.Role{Arn: awssdk.String(roleARN)}, nil)
+		awsClient.EXPECT().SimulatePermissions(roleARN, gomock.Any()).Return([]aws.SimulationResult{
+			{Action: "ec2:DescribeRegions", Decision: "explicitDeny"},
+			{Action: "s3:GetObject", Decision: "implicitDeny"},
+		}, nil)
+
+		result := simulator.Simulate(role)
+		Expect(result.Actions).To(Equal([]Action{
+			{Name: "ec2:DescribeRegions", DeniedBy: DeniedExplicitly},
+			{Name: "s3:GetObject", DeniedBy: DeniedByRolePolicies},
+		}))
+	})
+
+	It("Doesn't simulate the actions that are only allowed with conditions or on specific resources", func() {
+		conditional, err := cmv1.NewAWSSTSPolicy().Details(`{"Version": "2012-10-17", "Statement": [
+			{"Effect": "Allow", "Action": ["ec2:RunInstances", "s3:GetObject"], "Resource": "*",
+				"Condition": {"StringEquals": {"aws:ResourceTag/red-hat-managed": "true"}}},
+			{"Effect": "Allow", "Action": "s3:PutObject", "Resource": "arn:aws:s3:::bucket/*"}
+		]}`).Build()
+		Expect(err).NotTo(HaveOccurred())
+		policies["sts_installer_conditional_policy"] = conditional
+		role.PolicyKeys = append(role.PolicyKeys, "sts_installer_conditional_policy")
+		awsClient.EXPECT().GetRoleByName(roleName).Return(iamtypes.Role{Arn: awssdk.String(roleARN)}, nil)
+		awsClient.EXPECT().SimulatePermissions(roleARN,
+			[]string{"ec2:DescribeRegions", "s3:GetObject", "sts:AssumeRole"}).Return([]aws.SimulationResult{
+			{Action: "ec2:DescribeRegions", Decision: "allowed"},
+			{Action: "s3:GetObject", Decision: "allowed"},
+			{Action: "sts:AssumeRole", Decision: "allowed"},
+		}, nil)
+
+		result := simulator.Simulate(role)
+		Expect(result.Denied()).To(Equal(0))
+		Expect(result.NotSimulated).To(Equal([]string{"ec2:RunInstances", "s3:PutObject"}))
+	})
+
+	It("Reports the roles that can't be found", func() {
+		awsClient.EXPECT().GetRoleByName(roleName).Return(iamtypes.Role{}, fmt.Errorf("not found"))
+
+		result := simulator.Simulate(role)
+		Expect(result.Error).To(Equal("failed to get role: not found"))
+		Expect(result.Actions).To(BeEmpty())
+	})
+
+	It("Reports the missing policy templates", func() {
+		result := simulator.Simulate(Role{Name: roleName, PolicyKeys: []string{"sts_missing_policy"}})
+		Expect(result.Error).To(Equal("failed to find policy 'sts_missing_policy'"))
+	})
+})
@@ -18,6 +18,7 @@ import (
 	amsv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
 	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/openshift-online/ocm-sdk-go/logging"
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
 	. "github.com/openshift-online/ocm-sdk-go/testing"
 	"github.com/spf13/cobra"
 
@@ -200,6 +201,14 @@ func FormatKubeletConfigList(configs []*v1.KubeletConfig) string {
 	return FormatList(configs, v1.MarshalKubeletConfigList, "KubeletConfigList")
 }
 
+func FormatAccessRequestList(accessRequests []*accessv1.AccessRequest) string {
+	return FormatList(accessRequests, accessv1.MarshalAccessRequestList, "AccessRequestList")
+}
+
+func FormatLogEntryList(entries []*slv1.LogEntry) string {
+	return FormatList(entries, slv1.MarshalLogEntryList, "ClusterLogList")
+}
+
 func FormatClusterList(clusters []*v1.Cluster) string {
 	return FormatList(clusters, v1.MarshalClusterList, "ClusterList")
 }
@@ -281,6 +290,10 @@ func FormatResource(resource interface{}) string {
 		if res, ok := resource.(*amsv1.Account); ok {
 			err = amsv1.MarshalAccount(res, &outputJson)
 		}
+	case "*v1.AccessRequest":
+		if res, ok := resource.(*accessv1.AccessRequest); ok {
+			err = accessv1.MarshalAccessRequest(res, &outputJson)
+		}
 	case "*v1.Decision":
 		if res, ok := resource.(*accessv1.Decision); ok {
 			err = accessv1.MarshalDecision(res, &outputJson)
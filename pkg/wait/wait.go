@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the '--wait' and '--timeout' flags of the commands that start asynchronous
+// operations, and the exit codes used when the operation doesn't finish successfully.
+
+package wait
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/openshift/rosa/pkg/errorcode"
+	"github.com/openshift/rosa/pkg/reporter"
+)
+
+const DefaultTimeout = time.Hour
+
+var (
+	// ExitCodeFailed is returned when the resource reaches a failed or error state
+	ExitCodeFailed = errorcode.WaitFailed.ExitCode()
+
+	// ExitCodeTimeout is returned when the resource doesn't reach the expected state before the timeout
+	ExitCodeTimeout = errorcode.WaitTimeout.ExitCode()
+)
+
+var enabled bool
+var timeout time.Duration
+
+// AddFlags adds the '--wait' and '--timeout' flags to the given set of command line flags.
+func AddFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&enabled,
+		"wait",
+		false,
+		fmt.Sprintf("Wait until the operation is finished. Exits with code %d if it fails and with code %d "+
+			"if it doesn't finish before the timeout.", ExitCodeFailed, ExitCodeTimeout),
+	)
+	flags.DurationVar(
+		&timeout,
+		"timeout",
+		DefaultTimeout,
+		"Maximum time to wait for the operation to finish when using '--wait', for example '90m'. "+
+			"Must be greater than zero.",
+	)
+}
+
+// Validate checks that the timeout is greater than zero when waiting, as otherwise the wait would fail
+// immediately.
+func Validate() error {
+	if enabled && timeout <= 0 {
+		return errorcode.New(errorcode.InvalidInput,
+			"Invalid value '%s' for '--timeout', it must be greater than zero when using '--wait'", timeout)
+	}
+	return nil
+}
+
+func Enabled() bool {
+	return enabled
+}
+
+func Timeout() time.Duration {
+	return timeout
+}
+
+// ExitCode returns the exit code that matches the error returned while waiting.
+func ExitCode(err error) int {
+	return errorcode.ExitCode(err)
+}
+
+// Check reports the error returned while waiting, if any, and exits with the matching exit code.
+func Check(r *reporter.Object, err error) {
+	if err != nil {
+		r.ExitWithError(err)
+	}
+}
@@ -0,0 +1,62 @@
+package wait
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+
+	"github.com/openshift/rosa/pkg/errorcode"
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+var _ = Describe("Wait", func() {
+	It("Parses the flags", func() {
+		DeferCleanup(func() {
+			enabled = false
+			timeout = DefaultTimeout
+		})
+		flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		AddFlags(flags)
+		Expect(flags.Parse([]string{"--wait", "--timeout", "90m"})).To(Succeed())
+		Expect(Enabled()).To(BeTrue())
+		Expect(Timeout()).To(Equal(90 * time.Minute))
+	})
+
+	DescribeTable("Validates the timeout",
+		func(args []string, valid bool) {
+			DeferCleanup(func() {
+				enabled = false
+				timeout = DefaultTimeout
+			})
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			AddFlags(flags)
+			Expect(flags.Parse(args)).To(Succeed())
+			err := Validate()
+			if valid {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(ContainSubstring("it must be greater than zero")))
+				Expect(ExitCode(err)).To(Equal(errorcode.InvalidInput.ExitCode()))
+			}
+		},
+		Entry("default", []string{"--wait"}, true),
+		Entry("positive", []string{"--wait", "--timeout", "5m"}, true),
+		Entry("zero", []string{"--wait", "--timeout", "0s"}, false),
+		Entry("negative", []string{"--wait", "--timeout", "-5m"}, false),
+		Entry("zero without waiting", []string{"--timeout", "0s"}, true),
+	)
+
+	DescribeTable("Returns the exit code of the error",
+		func(err error, code int) {
+			Expect(ExitCode(err)).To(Equal(code))
+		},
+		Entry("success", nil, 0),
+		Entry("failure", &ocm.WaitFailedError{Message: "failed"}, ExitCodeFailed),
+		Entry("wrapped failure", fmt.Errorf("upgrade: %w", &ocm.WaitFailedError{}), ExitCodeFailed),
+		Entry("timeout", &ocm.WaitTimeoutError{Timeout: time.Minute}, ExitCodeTimeout),
+		Entry("other error", fmt.Errorf("boom"), 1),
+	)
+})